@@ -1,7 +1,33 @@
 package api
 
-import "errors"
+import (
+	"errors"
+	"net/http"
+)
 
 var (
 	ErrConnectionRefused = errors.New("Cannot connect to the Hyper daemon. Is 'hyperd' running on this host?")
 )
+
+// ResponseError is an error response of hyperd, which keeps the http status.
+type ResponseError struct {
+	Code    int
+	Message string
+}
+
+func (e *ResponseError) Error() string {
+	return e.Message
+}
+
+func (e *ResponseError) StatusCode() int {
+	return e.Code
+}
+
+// IsNotFound reports whether hyperd could not find the object, it works with
+// the errors of both the REST and the gRPC clients.
+func IsNotFound(err error) bool {
+	e, ok := err.(interface {
+		StatusCode() int
+	})
+	return ok && e.StatusCode() == http.StatusNotFound
+}
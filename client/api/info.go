@@ -45,6 +45,21 @@ func (cli *Client) GetPodInfo(podName string) (*types.PodInfo, error) {
 	return &jsonData, nil
 }
 
+func (cli *Client) ExportPodSpec(podName string) (*types.UserPod, error) {
+	v := url.Values{}
+	v.Set("podName", podName)
+	body, _, err := readBody(cli.call("GET", "/pod/export?"+v.Encode(), nil, nil))
+	if err != nil {
+		return nil, err
+	}
+	var spec types.UserPod
+	if err := json.Unmarshal(body, &spec); err != nil {
+		return nil, err
+	}
+
+	return &spec, nil
+}
+
 func (cli *Client) GetContainerInfo(container string) (*types.ContainerInfo, error) {
 	// get the pod or container info before we start the exec
 	v := url.Values{}
@@ -60,3 +75,65 @@ func (cli *Client) GetContainerInfo(container string) (*types.ContainerInfo, err
 
 	return &jsonData, nil
 }
+
+func (cli *Client) GetImageInfo(image string) (*types.ImageInfo, error) {
+	v := url.Values{}
+	v.Set("image", image)
+	body, _, err := readBody(cli.call("GET", "/image/info?"+v.Encode(), nil, nil))
+	if err != nil {
+		return nil, err
+	}
+	var jsonData types.ImageInfo
+	if err := json.Unmarshal(body, &jsonData); err != nil {
+		return nil, err
+	}
+
+	return &jsonData, nil
+}
+
+func (cli *Client) GetExecInfo(execId string) (*types.ExecInfo, error) {
+	v := url.Values{}
+	v.Set("exec", execId)
+	body, _, err := readBody(cli.call("GET", "/exec/info?"+v.Encode(), nil, nil))
+	if err != nil {
+		return nil, err
+	}
+	var jsonData types.ExecInfo
+	if err := json.Unmarshal(body, &jsonData); err != nil {
+		return nil, err
+	}
+
+	return &jsonData, nil
+}
+
+func (cli *Client) SystemGC(dryRun bool) ([]*types.StorageOrphan, error) {
+	v := url.Values{}
+	if dryRun {
+		v.Set("dryRun", "yes")
+	}
+	body, _, err := readBody(cli.call("POST", "/system/gc?"+v.Encode(), nil, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var orphans []*types.StorageOrphan
+	if err := json.Unmarshal(body, &orphans); err != nil {
+		return nil, err
+	}
+	return orphans, nil
+}
+
+func (cli *Client) SystemDBBackup(path string) (*types.SystemDBBackupResponse, error) {
+	v := url.Values{}
+	v.Set("path", path)
+	body, _, err := readBody(cli.call("POST", "/system/db/backup?"+v.Encode(), nil, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp types.SystemDBBackupResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -19,10 +19,11 @@ type APIInterface interface {
 
 	WinResize(id, tag string, height, width int) error
 
-	List(item, pod, vm string) (*engine.Env, error)
+	List(item, namespace, pod, vm string) (*engine.Env, error)
 	CreateContainer(podID string, spec interface{}) (string, int, error)
 	StartContainer(container string) error
 	GetContainerInfo(container string) (*types.ContainerInfo, error)
+	GetExecInfo(execId string) (*types.ExecInfo, error)
 	GetContainerByPod(podId string) (string, error)
 	GetExitCode(container, tag string, wait bool) error
 	ContainerLogs(container, since string, timestamp, follow, stdout, stderr bool, tail string) (io.ReadCloser, string, error)
@@ -31,6 +32,7 @@ type APIInterface interface {
 	RemoveContainer(container string) error
 
 	GetPodInfo(podName string) (*types.PodInfo, error)
+	ExportPodSpec(podName string) (*types.UserPod, error)
 	CreatePod(spec interface{}) (string, int, error)
 	StartPod(podId string) error
 	StopPod(podId, stopVm string) (int, string, error)
@@ -44,17 +46,39 @@ type APIInterface interface {
 	AddPortMappings(podId string, pms []*types.PortMapping) error
 	DeletePortMappings(podId string, pms []*types.PortMapping) error
 
+	// Service APIs
+	ListServices(podId string, live bool) ([]*types.UserService, error)
+	AddServices(podId string, srvs []*types.UserService) error
+	UpdateServices(podId string, srvs []*types.UserService) error
+	DeleteServices(podId string, srvs []*types.UserService) error
+
+	// Volume APIs
+	CreateVolume(name string, labels map[string]string, size int64) (*types.VolumeInfo, error)
+	ListVolumes() ([]*types.VolumeInfo, error)
+	GetVolumeInfo(name string) (*types.VolumeInfo, error)
+	RemoveVolume(name string) error
+	SnapshotVolume(podId, volume, name string, pause bool) (*types.VolumeSnapshotInfo, error)
+	ListVolumeSnapshots(podId string) ([]*types.VolumeSnapshotInfo, error)
+	RemoveVolumeSnapshot(name string) error
+	CloneVolume(snapshot, podId, volume string) error
+	ExportVolume(podId, volume string) (io.ReadCloser, error)
+	ImportVolume(podId, volume string, body io.Reader) error
+
 	Build(name string, hasBody bool, body io.Reader) (io.ReadCloser, string, error)
 	Commit(container, repo, author, message string, changes []string, pause bool) (string, error)
 	Load(body io.Reader, name string, refs map[string]string) (io.ReadCloser, string, error)
 	Save(imageIDs []string, format string, refs map[string]string) (io.ReadCloser, error)
 	GetImages(all, quiet bool) (*engine.Env, error)
+	GetImageInfo(image string) (*types.ImageInfo, error)
 	RemoveImage(image string, noprune, force bool) (*engine.Env, error)
 	Pull(image string, authConfig dockertypes.AuthConfig) (io.ReadCloser, string, int, error)
 	Push(tag, repo string, authConfig dockertypes.AuthConfig) (io.ReadCloser, string, int, error)
 
+	ListVMs(podId, vmId string) ([]*types.VMListResult, error)
 	CreateVm(cpu, mem int, async bool) (id string, err error)
 	RmVm(vm string) (err error)
 
 	Info() (*engine.Env, error)
+	SystemGC(dryRun bool) ([]*types.StorageOrphan, error)
+	SystemDBBackup(path string) (*types.SystemDBBackupResponse, error)
 }
@@ -44,9 +44,12 @@ func (cli *Client) GetContainerByPod(podId string) (string, error) {
 	return "", fmt.Errorf("Container not found")
 }
 
-func (cli *Client) List(item, pod, vm string) (*engine.Env, error) {
+func (cli *Client) List(item, namespace, pod, vm string) (*engine.Env, error) {
 	v := url.Values{}
 	v.Set("item", item)
+	if namespace != "" {
+		v.Set("namespace", namespace)
+	}
 	if pod != "" {
 		v.Set("pod", pod)
 	}
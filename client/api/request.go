@@ -109,7 +109,10 @@ func (cli *Client) httpRequest(method, path string, in io.Reader, headers map[st
 		if len(body) == 0 {
 			return nil, "", statusCode, fmt.Errorf("Error: request returned %s for API route and version %s, check if the server supports the requested API version", http.StatusText(statusCode), req.URL)
 		}
-		return nil, "", statusCode, fmt.Errorf("Error from daemon's response: %s", bytes.TrimSpace(body))
+		return nil, "", statusCode, &ResponseError{
+			Code:    statusCode,
+			Message: fmt.Sprintf("Error from daemon's response: %s", bytes.TrimSpace(body)),
+		}
 	}
 
 	return resp.Body, resp.Header.Get("Content-Type"), statusCode, nil
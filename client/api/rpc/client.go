@@ -0,0 +1,102 @@
+// Package rpc implements the hyperctl client API over the gRPC API of hyperd.
+package rpc
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hyperhq/hyperd/client/api"
+	"github.com/hyperhq/hyperd/types"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// Error is an error returned by hyperd over gRPC.
+type Error struct {
+	Code    codes.Code
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// StatusCode returns the http status the REST API returns for the error.
+func (e *Error) StatusCode() int {
+	switch e.Code {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists:
+		return http.StatusConflict
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	}
+	return http.StatusInternalServerError
+}
+
+// toError turns a gRPC error into an *Error.
+func toError(err error) error {
+	if err == nil || err == io.EOF {
+		return err
+	}
+	switch grpc.Code(err) {
+	case codes.Unavailable:
+		return api.ErrConnectionRefused
+	case codes.Unknown:
+		if _, ok := err.(*Error); ok {
+			return err
+		}
+	}
+	return &Error{
+		Code:    grpc.Code(err),
+		Message: grpc.ErrorDesc(err),
+	}
+}
+
+// statusCode returns the http status of an error returned by toError.
+func statusCode(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+	if e, ok := err.(*Error); ok {
+		return e.StatusCode()
+	}
+	return http.StatusInternalServerError
+}
+
+// Client is the gRPC implementation of api.APIInterface.
+type Client struct {
+	conn   *grpc.ClientConn
+	client types.PublicAPIClient
+	ctx    context.Context
+}
+
+var _ api.APIInterface = &Client{}
+
+// NewClient connects to the gRPC API of hyperd listening on addr.
+func NewClient(addr string, timeout time.Duration) (*Client, error) {
+	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithBlock(), grpc.WithTimeout(timeout))
+	if err != nil {
+		return nil, api.ErrConnectionRefused
+	}
+
+	return &Client{
+		conn:   conn,
+		client: types.NewPublicAPIClient(conn),
+		ctx:    context.Background(),
+	}, nil
+}
+
+// Close closes the connection to hyperd.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,189 @@
+package rpc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hyperhq/hyperd/client/api"
+	"github.com/hyperhq/hyperd/types"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestToError(t *testing.T) {
+	for _, err := range []error{nil, io.EOF} {
+		if toError(err) != err {
+			t.Fatalf("toError(%v) should be itself", err)
+		}
+	}
+	if err := toError(grpc.Errorf(codes.Unavailable, "connection refused")); err != api.ErrConnectionRefused {
+		t.Fatalf("unexpected error for unavailable: %v", err)
+	}
+
+	prev := &Error{Code: codes.NotFound, Message: "pod web not found"}
+	if err := toError(prev); err != prev {
+		t.Fatalf("the converted error is converted again: %v", err)
+	}
+
+	cases := []struct {
+		err     error
+		code    codes.Code
+		message string
+		status  int
+	}{
+		{grpc.Errorf(codes.InvalidArgument, "bad spec"), codes.InvalidArgument, "bad spec", http.StatusBadRequest},
+		{grpc.Errorf(codes.Unauthenticated, "login first"), codes.Unauthenticated, "login first", http.StatusUnauthorized},
+		{grpc.Errorf(codes.PermissionDenied, "denied"), codes.PermissionDenied, "denied", http.StatusForbidden},
+		{grpc.Errorf(codes.NotFound, "no such pod"), codes.NotFound, "no such pod", http.StatusNotFound},
+		{grpc.Errorf(codes.AlreadyExists, "pod exists"), codes.AlreadyExists, "pod exists", http.StatusConflict},
+		{grpc.Errorf(codes.FailedPrecondition, "pod running"), codes.FailedPrecondition, "pod running", http.StatusPreconditionFailed},
+		{grpc.Errorf(codes.Internal, "oops"), codes.Internal, "oops", http.StatusInternalServerError},
+		{errors.New("broken pipe"), codes.Unknown, "broken pipe", http.StatusInternalServerError},
+	}
+	for _, c := range cases {
+		err := toError(c.err)
+		e, ok := err.(*Error)
+		if !ok || e.Code != c.code || e.Message != c.message || statusCode(err) != c.status {
+			t.Errorf("toError(%v): unexpected %#v, status %d", c.err, err, statusCode(err))
+		}
+	}
+
+	if !api.IsNotFound(toError(grpc.Errorf(codes.NotFound, "no such pod"))) || api.IsNotFound(toError(grpc.Errorf(codes.Internal, "oops"))) {
+		t.Fatal("the not found errors are not recognized")
+	}
+	if statusCode(nil) != http.StatusOK || statusCode(api.ErrConnectionRefused) != http.StatusInternalServerError {
+		t.Fatal("unexpected status codes")
+	}
+}
+
+// imageServer echoes the image streams it receives
+type imageServer struct {
+	types.PublicAPIServer
+}
+
+func (s *imageServer) ContainerCommit(ctx context.Context, req *types.ContainerCommitRequest) (*types.ContainerCommitResponse, error) {
+	return &types.ContainerCommitResponse{
+		Id: fmt.Sprintf("%s %s:%s %s %s %v %v", req.Container, req.Repo, req.Tag, req.Author, req.Message, req.Changes, req.Pause),
+	}, nil
+}
+
+func (s *imageServer) ImageBuild(stream types.PublicAPI_ImageBuildServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if req.Name == "" {
+		return grpc.Errorf(codes.InvalidArgument, "no name")
+	}
+	for {
+		if err := stream.Send(&types.ImageBuildResponse{Data: req.Data}); err != nil {
+			return err
+		}
+		req, err = stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *imageServer) ImageLoad(stream types.PublicAPI_ImageLoadServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(&types.ImageLoadResponse{Data: []byte(req.Name + req.Refs["a"])}); err != nil {
+		return err
+	}
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(&types.ImageLoadResponse{Data: req.Data}); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *imageServer) ImageSave(req *types.ImageSaveRequest, stream types.PublicAPI_ImageSaveServer) error {
+	for _, name := range req.Names {
+		if err := stream.Send(&types.ImageSaveResponse{Data: []byte(name)}); err != nil {
+			return err
+		}
+	}
+	return stream.Send(&types.ImageSaveResponse{Data: []byte(req.Format + req.Refs["a"])})
+}
+
+func TestImageStreams(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	types.RegisterPublicAPIServer(server, &imageServer{})
+	go server.Serve(l)
+	defer server.Stop()
+
+	c, err := NewClient(l.Addr().String(), 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	readAll := func(r io.ReadCloser) string {
+		defer r.Close()
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+
+	buildContext := bytes.Repeat([]byte("context"), 10*1024)
+	out, ctype, err := c.Build("busybox", true, bytes.NewReader(buildContext))
+	if err != nil || ctype != "application/json" {
+		t.Fatalf("build failed: %v, %q", err, ctype)
+	}
+	if data := readAll(out); data != string(buildContext) {
+		t.Fatalf("the build context is not sent, got %d bytes", len(data))
+	}
+	if _, _, err := c.Build("", true, bytes.NewReader(buildContext)); statusCode(err) != http.StatusBadRequest {
+		t.Fatalf("the error of the build is not returned: %v", err)
+	}
+
+	id, err := c.Commit("web", "busybox:v1", "me", "msg", []string{"CMD sh"}, true)
+	if err != nil || id != "web busybox:v1 me msg [CMD sh] true" {
+		t.Fatalf("unexpected commit: %q, %v", id, err)
+	}
+
+	out, _, err = c.Load(bytes.NewReader([]byte("tarball")), "name", map[string]string{"a": "ref"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data := readAll(out); data != "nameref"+"tarball" {
+		t.Fatalf("unexpected load output %q", data)
+	}
+
+	out, err = c.Save([]string{"a", "b"}, "oci", map[string]string{"a": "ref"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data := readAll(out); data != "aboci"+"ref" {
+		t.Fatalf("unexpected saved tarball %q", data)
+	}
+}
@@ -0,0 +1,158 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/hyperhq/hyperd/client/api"
+	"github.com/hyperhq/hyperd/types"
+)
+
+// toUserContainer converts the spec accepted by the REST client into a
+// UserContainer.
+func toUserContainer(spec interface{}) (*types.UserContainer, error) {
+	if c, ok := spec.(*types.UserContainer); ok {
+		return c, nil
+	}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	var c types.UserContainer
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("failed to read container spec: %v", err)
+	}
+	return &c, nil
+}
+
+func (c *Client) CreateContainer(podID string, spec interface{}) (string, int, error) {
+	containerSpec, err := toUserContainer(spec)
+	if err != nil {
+		return "", 0, err
+	}
+
+	resp, err := c.client.ContainerCreate(c.ctx, &types.ContainerCreateRequest{
+		PodID:         podID,
+		ContainerSpec: containerSpec,
+	})
+	if err != nil {
+		err = toError(err)
+		return "", statusCode(err), err
+	}
+
+	return resp.ContainerID, statusCode(nil), nil
+}
+
+func (c *Client) StartContainer(container string) error {
+	_, err := c.client.ContainerStart(c.ctx, &types.ContainerStartRequest{
+		ContainerId: container,
+	})
+	return toError(err)
+}
+
+func (c *Client) GetContainerInfo(container string) (*types.ContainerInfo, error) {
+	resp, err := c.client.ContainerInfo(c.ctx, &types.ContainerInfoRequest{
+		Container: container,
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return resp.ContainerInfo, nil
+}
+
+func (c *Client) GetContainerByPod(podId string) (string, error) {
+	resp, err := c.client.ContainerList(c.ctx, &types.ContainerListRequest{
+		PodID: podId,
+	})
+	if err != nil {
+		return "", toError(err)
+	}
+
+	for _, cl := range resp.ContainerList {
+		if cl.PodID == podId {
+			return cl.ContainerID, nil
+		}
+	}
+	return "", fmt.Errorf("Container not found")
+}
+
+func (c *Client) GetExitCode(container, tag string, wait bool) error {
+	if !wait {
+		info, err := c.GetContainerInfo(container)
+		if err != nil {
+			return err
+		}
+		if info.Status.Phase == "running" || info.Status.Terminated == nil {
+			return nil
+		}
+		return api.StatusError{StatusCode: int(info.Status.Terminated.ExitCode)}
+	}
+
+	resp, err := c.client.Wait(c.ctx, &types.WaitRequest{
+		Container: container,
+		ProcessId: tag,
+	})
+	if err != nil {
+		return toError(err)
+	}
+	if resp.ExitCode != 0 {
+		return api.StatusError{StatusCode: int(resp.ExitCode)}
+	}
+	return nil
+}
+
+func (c *Client) ContainerLogs(container, since string, timestamp, follow, stdout, stderr bool, tail string) (io.ReadCloser, string, error) {
+	stream, err := c.client.ContainerLogs(c.ctx, &types.ContainerLogsRequest{
+		Container:  container,
+		Follow:     follow,
+		Timestamps: timestamp,
+		Tail:       tail,
+		Since:      since,
+		Stdout:     stdout,
+		Stderr:     stderr,
+	})
+	if err != nil {
+		return nil, "", toError(err)
+	}
+
+	return readStream(func() ([]byte, error) {
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return resp.Log, nil
+	}), "", nil
+}
+
+func (c *Client) KillContainer(container string, sig int) error {
+	// the signal is sent via the pod of the container
+	info, err := c.GetContainerInfo(container)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.ContainerSignal(c.ctx, &types.ContainerSignalRequest{
+		PodID:       info.PodID,
+		ContainerID: info.Container.ContainerID,
+		Signal:      int64(sig),
+	})
+	return toError(err)
+}
+
+func (c *Client) StopContainer(container string) error {
+	// the same graceful period as the REST API
+	_, err := c.client.ContainerStop(c.ctx, &types.ContainerStopRequest{
+		ContainerID: container,
+		Timeout:     5,
+	})
+	return toError(err)
+}
+
+func (c *Client) RemoveContainer(container string) error {
+	_, err := c.client.ContainerRemove(c.ctx, &types.ContainerRemoveRequest{
+		ContainerId: container,
+	})
+	return toError(err)
+}
@@ -0,0 +1,190 @@
+package rpc
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/hyperhq/hyperd/client/api"
+	"github.com/hyperhq/hyperd/types"
+
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+// readStream copies the data received from a gRPC stream into a pipe, the
+// error of the stream is returned by the reader of the pipe.
+func readStream(recv func() ([]byte, error)) io.ReadCloser {
+	r, w := io.Pipe()
+	go func() {
+		for {
+			data, err := recv()
+			if err == io.EOF {
+				w.Close()
+				return
+			}
+			if err != nil {
+				w.CloseWithError(toError(err))
+				return
+			}
+			if _, err := w.Write(data); err != nil {
+				return
+			}
+		}
+	}()
+	return r
+}
+
+// sendStream sends the stdin to a gRPC stream, and closes the sending side of
+// the stream after the stdin is drained.
+func sendStream(stdin io.Reader, send func([]byte) error, closeSend func() error) {
+	defer closeSend()
+	if stdin == nil {
+		return
+	}
+	buf := make([]byte, 32*1024)
+	for {
+		nr, err := stdin.Read(buf)
+		if nr > 0 {
+			data := make([]byte, nr)
+			copy(data, buf[:nr])
+			if err := send(data); err != nil {
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// copyOutput writes the output of a process to stdout and stderr, the output
+// is multiplexed by stdcopy if the process has no tty.
+func copyOutput(out io.ReadCloser, tty bool, stdout, stderr io.Writer) error {
+	defer out.Close()
+	var err error
+	if tty {
+		_, err = io.Copy(stdout, out)
+	} else {
+		_, err = stdcopy.StdCopy(stdout, stderr, out)
+	}
+	return err
+}
+
+func (c *Client) Attach(container string, tty bool, stdin io.ReadCloser, stdout, stderr io.Writer) error {
+	stream, err := c.client.Attach(c.ctx)
+	if err != nil {
+		return toError(err)
+	}
+	if err := stream.Send(&types.AttachMessage{ContainerID: container}); err != nil {
+		return toError(err)
+	}
+
+	go sendStream(stdin, func(data []byte) error {
+		return stream.Send(&types.AttachMessage{Data: data})
+	}, stream.CloseSend)
+
+	return copyOutput(readStream(func() ([]byte, error) {
+		msg, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return msg.Data, nil
+	}), tty, stdout, stderr)
+}
+
+func (c *Client) CreateExec(containerId string, command []byte, tty bool) (string, error) {
+	var cmd []string
+	if err := json.Unmarshal(command, &cmd); err != nil {
+		return "", err
+	}
+
+	resp, err := c.client.ExecCreate(c.ctx, &types.ExecCreateRequest{
+		ContainerID: containerId,
+		Command:     cmd,
+		Tty:         tty,
+	})
+	if err != nil {
+		return "", toError(err)
+	}
+
+	return resp.ExecID, nil
+}
+
+func (c *Client) StartExec(containerId, execId string, tty bool, stdin io.ReadCloser, stdout, stderr io.Writer) error {
+	stream, err := c.client.ExecStart(c.ctx)
+	if err != nil {
+		return toError(err)
+	}
+	if err := stream.Send(&types.ExecStartRequest{ContainerID: containerId, ExecID: execId}); err != nil {
+		return toError(err)
+	}
+
+	go sendStream(stdin, func(data []byte) error {
+		return stream.Send(&types.ExecStartRequest{Stdin: data})
+	}, stream.CloseSend)
+
+	return copyOutput(readStream(func() ([]byte, error) {
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return resp.Stdout, nil
+	}), tty, stdout, stderr)
+}
+
+func (c *Client) ExecVM(podID string, command []byte, stdin io.ReadCloser, stdout, stderr io.Writer) error {
+	var cmd []string
+	if err := json.Unmarshal(command, &cmd); err != nil {
+		return err
+	}
+
+	stream, err := c.client.ExecVM(c.ctx)
+	if err != nil {
+		return toError(err)
+	}
+	if err := stream.Send(&types.ExecVMRequest{PodID: podID, Command: cmd}); err != nil {
+		return toError(err)
+	}
+
+	go sendStream(stdin, func(data []byte) error {
+		return stream.Send(&types.ExecVMRequest{Stdin: data})
+	}, stream.CloseSend)
+
+	// the exit code comes with the last message of the stream
+	var code int32
+	err = copyOutput(readStream(func() ([]byte, error) {
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		code = resp.ExitCode
+		return resp.Stdout, nil
+	}), true, stdout, stderr)
+	if err != nil {
+		return err
+	}
+	if code != 0 {
+		return api.StatusError{StatusCode: int(code)}
+	}
+	return nil
+}
+
+func (c *Client) GetExecInfo(execId string) (*types.ExecInfo, error) {
+	resp, err := c.client.ExecInfo(c.ctx, &types.ExecInfoRequest{
+		ExecID: execId,
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return resp.ExecInfo, nil
+}
+
+func (c *Client) WinResize(id, tag string, height, width int) error {
+	_, err := c.client.TTYResize(c.ctx, &types.TTYResizeRequest{
+		ContainerID: id,
+		ExecID:      tag,
+		Height:      int32(height),
+		Width:       int32(width),
+	})
+	return toError(err)
+}
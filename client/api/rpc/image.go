@@ -0,0 +1,260 @@
+package rpc
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hyperhq/hyperd/engine"
+	"github.com/hyperhq/hyperd/types"
+	runvtypes "github.com/hyperhq/runv/hypervisor/types"
+
+	dockertypes "github.com/docker/engine-api/types"
+)
+
+func toAuthConfig(auth dockertypes.AuthConfig) *types.AuthConfig {
+	return &types.AuthConfig{
+		Username:      auth.Username,
+		Password:      auth.Password,
+		Auth:          auth.Auth,
+		Email:         auth.Email,
+		Serveraddress: auth.ServerAddress,
+		Registrytoken: auth.RegistryToken,
+	}
+}
+
+func (c *Client) Login(auth dockertypes.AuthConfig, response *dockertypes.AuthResponse) (remove bool, err error) {
+	resp, err := c.client.Auth(c.ctx, &types.AuthRequest{
+		Auth: toAuthConfig(auth),
+	})
+	if err != nil {
+		err = toError(err)
+		return statusCode(err) == http.StatusUnauthorized, err
+	}
+
+	response.Status = resp.Status
+	return false, nil
+}
+
+// GetImages lists the images in the same format as the REST API, the
+// quiet flag is handled by the caller.
+func (c *Client) GetImages(all, quiet bool) (*engine.Env, error) {
+	resp, err := c.client.ImageList(c.ctx, &types.ImageListRequest{
+		All: all,
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	imagesList := []string{}
+	for _, image := range resp.ImageList {
+		id := image.Id
+		if idx := strings.Index(id, ":"); idx >= 0 {
+			id = id[idx+1:]
+		}
+		suffix := fmt.Sprintf("%s:%d:%d", id, image.Created, image.VirtualSize)
+		for _, r := range image.RepoTags {
+			imagesList = append(imagesList, r+":"+suffix)
+		}
+		if len(image.RepoTags) == 0 && len(image.RepoDigests) > 0 {
+			repo := strings.Split(image.RepoDigests[0], "@")[0]
+			imagesList = append(imagesList, repo+":<none>:"+suffix)
+		}
+	}
+
+	v := &engine.Env{}
+	v.SetList("imagesList", imagesList)
+	return v, nil
+}
+
+func (c *Client) GetImageInfo(image string) (*types.ImageInfo, error) {
+	resp, err := c.client.ImageInfo(c.ctx, &types.ImageInfoRequest{
+		Image: image,
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return resp.ImageInfo, nil
+}
+
+func (c *Client) RemoveImage(image string, noprune, force bool) (*engine.Env, error) {
+	_, err := c.client.ImageRemove(c.ctx, &types.ImageRemoveRequest{
+		Image: image,
+		Force: force,
+		Prune: !noprune,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error remove the image(%s): %s", image, toError(err).Error())
+	}
+
+	v := &engine.Env{}
+	v.SetInt("Code", runvtypes.E_OK)
+	v.Set("Cause", "")
+	return v, nil
+}
+
+// Pull pulls an image, the progress is returned as a stream of JSON messages
+// as the REST API does.
+func (c *Client) Pull(image string, authConfig dockertypes.AuthConfig) (io.ReadCloser, string, int, error) {
+	stream, err := c.client.ImagePull(c.ctx, &types.ImagePullRequest{
+		Image: image,
+		Auth:  toAuthConfig(authConfig),
+	})
+	if err != nil {
+		err = toError(err)
+		return nil, "", statusCode(err), err
+	}
+
+	return c.progress(func() ([]byte, error) {
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, nil
+	})
+}
+
+// Push pushes an image, the progress is returned as a stream of JSON messages
+// as the REST API does.
+func (c *Client) Push(tag, repo string, authConfig dockertypes.AuthConfig) (io.ReadCloser, string, int, error) {
+	stream, err := c.client.ImagePush(c.ctx, &types.ImagePushRequest{
+		Repo: repo,
+		Tag:  tag,
+		Auth: toAuthConfig(authConfig),
+	})
+	if err != nil {
+		err = toError(err)
+		return nil, "", statusCode(err), err
+	}
+
+	return c.progress(func() ([]byte, error) {
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, nil
+	})
+}
+
+// progress waits for the first message of a pull or push stream, so that the
+// authentication errors are returned before the stream is handed out.
+func (c *Client) progress(recv func() ([]byte, error)) (io.ReadCloser, string, int, error) {
+	first, err := recv()
+	if err != nil && err != io.EOF {
+		err = toError(err)
+		return nil, "", statusCode(err), err
+	}
+
+	done := err == io.EOF
+	return readStream(func() ([]byte, error) {
+		if first != nil {
+			data := first
+			first = nil
+			return data, nil
+		}
+		if done {
+			return nil, io.EOF
+		}
+		return recv()
+	}), "application/json", http.StatusOK, nil
+}
+
+// Build builds an image from the build context in body, the progress is
+// returned as a stream of JSON messages as the REST API does.
+func (c *Client) Build(name string, hasBody bool, body io.Reader) (io.ReadCloser, string, error) {
+	stream, err := c.client.ImageBuild(c.ctx)
+	if err != nil {
+		return nil, "", toError(err)
+	}
+	if err := stream.Send(&types.ImageBuildRequest{Name: name}); err != nil {
+		return nil, "", toError(err)
+	}
+	if !hasBody {
+		body = nil
+	}
+	go sendStream(body, func(data []byte) error {
+		return stream.Send(&types.ImageBuildRequest{Data: data})
+	}, stream.CloseSend)
+
+	out, contentType, _, err := c.progress(func() ([]byte, error) {
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, nil
+	})
+	return out, contentType, err
+}
+
+// Commit commits the changes of a container to an image, repo is the name of
+// the image with an optional tag.
+func (c *Client) Commit(container, repo, author, message string, changes []string, pause bool) (string, error) {
+	tag := ""
+	if repo != "" {
+		s := strings.Split(repo, ":")
+		if len(s) == 2 {
+			repo = s[0]
+			tag = s[1]
+		}
+	}
+	resp, err := c.client.ContainerCommit(c.ctx, &types.ContainerCommitRequest{
+		Container: container,
+		Repo:      repo,
+		Tag:       tag,
+		Author:    author,
+		Message:   message,
+		Changes:   changes,
+		Pause:     pause,
+	})
+	if err != nil {
+		return "", toError(err)
+	}
+
+	return resp.Id, nil
+}
+
+// Load loads the images in the tarball, the progress is returned as a stream
+// of JSON messages as the REST API does.
+func (c *Client) Load(body io.Reader, name string, refs map[string]string) (io.ReadCloser, string, error) {
+	stream, err := c.client.ImageLoad(c.ctx)
+	if err != nil {
+		return nil, "", toError(err)
+	}
+	if err := stream.Send(&types.ImageLoadRequest{Name: name, Refs: refs}); err != nil {
+		return nil, "", toError(err)
+	}
+	go sendStream(body, func(data []byte) error {
+		return stream.Send(&types.ImageLoadRequest{Data: data})
+	}, stream.CloseSend)
+
+	out, contentType, _, err := c.progress(func() ([]byte, error) {
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, nil
+	})
+	return out, contentType, err
+}
+
+// Save returns the tarball of the images.
+func (c *Client) Save(imageIDs []string, format string, refs map[string]string) (io.ReadCloser, error) {
+	stream, err := c.client.ImageSave(c.ctx, &types.ImageSaveRequest{
+		Names:  imageIDs,
+		Format: format,
+		Refs:   refs,
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return readStream(func() ([]byte, error) {
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, nil
+	}), nil
+}
@@ -0,0 +1,121 @@
+package rpc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hyperhq/hyperd/engine"
+	"github.com/hyperhq/hyperd/types"
+)
+
+// Info returns the same keys as GET /info of the REST API.
+func (c *Client) Info() (*engine.Env, error) {
+	info, err := c.client.Info(c.ctx, &types.InfoRequest{})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	env := &engine.Env{}
+	status := [][2]string{}
+
+	env.Set("ID", info.ID)
+	env.SetInt("Containers", int(info.Containers))
+	env.SetInt("Images", int(info.Images))
+	env.Set("Driver", info.Driver)
+	env.Set("DockerRootDir", info.DockerRootDir)
+	env.Set("IndexServerAddress", info.IndexServerAddress)
+	env.Set("ExecutionDriver", info.ExecutionDriver)
+	env.SetInt64("MemTotal", info.MemTotal)
+	env.SetInt64("Pods", info.Pods)
+	env.Set("Operating System", info.OperatingSystem)
+
+	for _, driverStatus := range info.Dstatus {
+		status = append(status, [2]string{driverStatus.Name, driverStatus.Status})
+	}
+	env.SetJson("DriverStatus", status)
+
+	if info.Name != "" {
+		env.SetJson("Name", info.Name)
+	}
+
+	return env, nil
+}
+
+// List returns the same keys as GET /list of the REST API.
+func (c *Client) List(item, namespace, pod, vm string) (*engine.Env, error) {
+	var (
+		key  string
+		data = []string{}
+	)
+
+	switch item {
+	case "pod":
+		resp, err := c.client.PodList(c.ctx, &types.PodListRequest{
+			PodID:     pod,
+			VmID:      vm,
+			Namespace: namespace,
+		})
+		if err != nil {
+			return nil, toError(err)
+		}
+		key = "podData"
+		for _, p := range resp.PodList {
+			data = append(data, strings.Join([]string{p.PodID, p.PodName, p.VmID, p.Status}, ":"))
+		}
+	case "container":
+		resp, err := c.client.ContainerList(c.ctx, &types.ContainerListRequest{
+			PodID:     pod,
+			VmID:      vm,
+			Namespace: namespace,
+		})
+		if err != nil {
+			return nil, toError(err)
+		}
+		key = "cData"
+		for _, c := range resp.ContainerList {
+			data = append(data, strings.Join([]string{c.ContainerID, c.ContainerName, c.PodID, c.Status}, ":"))
+		}
+	case "vm":
+		resp, err := c.client.VMList(c.ctx, &types.VMListRequest{
+			PodID:     pod,
+			VmID:      vm,
+			Namespace: namespace,
+		})
+		if err != nil {
+			return nil, toError(err)
+		}
+		key = "vmData"
+		for _, v := range resp.VmList {
+			data = append(data, strings.Join([]string{v.VmID, v.PodID, v.Status}, ":"))
+		}
+	default:
+		return nil, fmt.Errorf("Can not support %s list!", item)
+	}
+
+	v := &engine.Env{}
+	v.Set("item", item)
+	v.SetList(key, data)
+	return v, nil
+}
+
+func (c *Client) SystemGC(dryRun bool) ([]*types.StorageOrphan, error) {
+	resp, err := c.client.SystemGC(c.ctx, &types.SystemGCRequest{
+		DryRun: dryRun,
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return resp.Orphans, nil
+}
+
+func (c *Client) SystemDBBackup(path string) (*types.SystemDBBackupResponse, error) {
+	resp, err := c.client.SystemDBBackup(c.ctx, &types.SystemDBBackupRequest{
+		Path: path,
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,118 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hyperhq/hyperd/types"
+	runvtypes "github.com/hyperhq/runv/hypervisor/types"
+)
+
+// toUserPod converts the spec accepted by the REST client into a UserPod.
+func toUserPod(spec interface{}) (*types.UserPod, error) {
+	if p, ok := spec.(*types.UserPod); ok {
+		return p, nil
+	}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	var p types.UserPod
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("failed to read pod spec: %v", err)
+	}
+	return &p, nil
+}
+
+func (c *Client) GetPodInfo(podName string) (*types.PodInfo, error) {
+	resp, err := c.client.PodInfo(c.ctx, &types.PodInfoRequest{
+		PodID: podName,
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return resp.PodInfo, nil
+}
+
+func (c *Client) ExportPodSpec(podName string) (*types.UserPod, error) {
+	resp, err := c.client.PodExport(c.ctx, &types.PodExportRequest{
+		PodID: podName,
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return resp.PodSpec, nil
+}
+
+func (c *Client) CreatePod(spec interface{}) (string, int, error) {
+	podSpec, err := toUserPod(spec)
+	if err != nil {
+		return "", 0, err
+	}
+
+	resp, err := c.client.PodCreate(c.ctx, &types.PodCreateRequest{
+		PodSpec: podSpec,
+	})
+	if err != nil {
+		err = toError(err)
+		return "", statusCode(err), err
+	}
+
+	return resp.PodID, statusCode(nil), nil
+}
+
+func (c *Client) StartPod(podId string) error {
+	_, err := c.client.PodStart(c.ctx, &types.PodStartRequest{
+		PodID: podId,
+	})
+	return toError(err)
+}
+
+func (c *Client) StopPod(podId, stopVm string) (int, string, error) {
+	resp, err := c.client.PodStop(c.ctx, &types.PodStopRequest{
+		PodID: podId,
+	})
+	if err != nil {
+		return -1, "", toError(err)
+	}
+
+	return int(resp.Code), resp.Cause, nil
+}
+
+func (c *Client) RmPod(id string) error {
+	resp, err := c.client.PodRemove(c.ctx, &types.PodRemoveRequest{
+		PodID: id,
+	})
+	if err != nil {
+		return fmt.Errorf("Error to remove pod(%s), %v", id, toError(err))
+	}
+
+	if !(resp.Code == runvtypes.E_OK || resp.Code == runvtypes.E_VM_SHUTDOWN) {
+		return fmt.Errorf("Error to remove pod(%s), %s", id, resp.Cause)
+	}
+	return nil
+}
+
+func (c *Client) PausePod(podId string) error {
+	_, err := c.client.PodPause(c.ctx, &types.PodPauseRequest{
+		PodID: podId,
+	})
+	return toError(err)
+}
+
+func (c *Client) UnpausePod(podId string) error {
+	_, err := c.client.PodUnpause(c.ctx, &types.PodUnpauseRequest{
+		PodID: podId,
+	})
+	return toError(err)
+}
+
+func (c *Client) KillPod(pod string, sig int) error {
+	_, err := c.client.PodSignal(c.ctx, &types.PodSignalRequest{
+		PodID:  pod,
+		Signal: int64(sig),
+	})
+	return toError(err)
+}
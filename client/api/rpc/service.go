@@ -0,0 +1,68 @@
+package rpc
+
+import (
+	"github.com/hyperhq/hyperd/types"
+)
+
+func (c *Client) ListPortMappings(podId string) ([]*types.PortMapping, error) {
+	resp, err := c.client.PortMappingList(c.ctx, &types.PortMappingListRequest{
+		PodID: podId,
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return resp.PortMappings, nil
+}
+
+func (c *Client) AddPortMappings(podId string, pms []*types.PortMapping) error {
+	_, err := c.client.PortMappingAdd(c.ctx, &types.PortMappingModifyRequest{
+		PodID:        podId,
+		PortMappings: pms,
+	})
+	return toError(err)
+}
+
+func (c *Client) DeletePortMappings(podId string, pms []*types.PortMapping) error {
+	_, err := c.client.PortMappingDel(c.ctx, &types.PortMappingModifyRequest{
+		PodID:        podId,
+		PortMappings: pms,
+	})
+	return toError(err)
+}
+
+func (c *Client) ListServices(podId string, live bool) ([]*types.UserService, error) {
+	resp, err := c.client.ServiceList(c.ctx, &types.ServiceListRequest{
+		PodID: podId,
+		Live:  live,
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return resp.Services, nil
+}
+
+func (c *Client) AddServices(podId string, srvs []*types.UserService) error {
+	_, err := c.client.ServiceAdd(c.ctx, &types.ServiceAddRequest{
+		PodID:    podId,
+		Services: srvs,
+	})
+	return toError(err)
+}
+
+func (c *Client) UpdateServices(podId string, srvs []*types.UserService) error {
+	_, err := c.client.ServiceUpdate(c.ctx, &types.ServiceUpdateRequest{
+		PodID:    podId,
+		Services: srvs,
+	})
+	return toError(err)
+}
+
+func (c *Client) DeleteServices(podId string, srvs []*types.UserService) error {
+	_, err := c.client.ServiceDelete(c.ctx, &types.ServiceDelRequest{
+		PodID:    podId,
+		Services: srvs,
+	})
+	return toError(err)
+}
@@ -0,0 +1,47 @@
+package rpc
+
+import (
+	"fmt"
+
+	"github.com/hyperhq/hyperd/types"
+	runvtypes "github.com/hyperhq/runv/hypervisor/types"
+)
+
+func (c *Client) ListVMs(podId, vmId string) ([]*types.VMListResult, error) {
+	resp, err := c.client.VMList(c.ctx, &types.VMListRequest{
+		PodID: podId,
+		VmID:  vmId,
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return resp.VmList, nil
+}
+
+func (c *Client) CreateVm(cpu, mem int, async bool) (id string, err error) {
+	resp, err := c.client.VMCreate(c.ctx, &types.VMCreateRequest{
+		Cpu:    int32(cpu),
+		Memory: int32(mem),
+		Async:  async,
+	})
+	if err != nil {
+		return "", fmt.Errorf("Cause is %s", toError(err).Error())
+	}
+
+	return resp.VmID, nil
+}
+
+func (c *Client) RmVm(vm string) (err error) {
+	resp, err := c.client.VMRemove(c.ctx, &types.VMRemoveRequest{
+		VmID: vm,
+	})
+	if err != nil {
+		return fmt.Errorf("Error to remove vm(%s), %s", vm, toError(err).Error())
+	}
+
+	if resp.Code != runvtypes.E_OK {
+		return fmt.Errorf("Cause is %s", resp.Cause)
+	}
+	return nil
+}
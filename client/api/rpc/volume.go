@@ -0,0 +1,135 @@
+package rpc
+
+import (
+	"io"
+
+	"github.com/hyperhq/hyperd/types"
+)
+
+func (c *Client) CreateVolume(name string, labels map[string]string, size int64) (*types.VolumeInfo, error) {
+	resp, err := c.client.VolumeCreate(c.ctx, &types.VolumeCreateRequest{
+		Name:   name,
+		Labels: labels,
+		Size_:  size,
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return resp.Volume, nil
+}
+
+func (c *Client) ListVolumes() ([]*types.VolumeInfo, error) {
+	resp, err := c.client.VolumeList(c.ctx, &types.VolumeListRequest{})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return resp.Volumes, nil
+}
+
+func (c *Client) GetVolumeInfo(name string) (*types.VolumeInfo, error) {
+	resp, err := c.client.VolumeInspect(c.ctx, &types.VolumeInspectRequest{
+		Name: name,
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return resp.Volume, nil
+}
+
+func (c *Client) RemoveVolume(name string) error {
+	_, err := c.client.VolumeRemove(c.ctx, &types.VolumeRemoveRequest{
+		Name: name,
+	})
+	return toError(err)
+}
+
+func (c *Client) SnapshotVolume(podId, volume, name string, pause bool) (*types.VolumeSnapshotInfo, error) {
+	resp, err := c.client.VolumeSnapshot(c.ctx, &types.VolumeSnapshotRequest{
+		PodID:  podId,
+		Volume: volume,
+		Name:   name,
+		Pause:  pause,
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return resp.Snapshot, nil
+}
+
+func (c *Client) ListVolumeSnapshots(podId string) ([]*types.VolumeSnapshotInfo, error) {
+	resp, err := c.client.VolumeSnapshotList(c.ctx, &types.VolumeSnapshotListRequest{
+		PodID: podId,
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return resp.Snapshots, nil
+}
+
+func (c *Client) RemoveVolumeSnapshot(name string) error {
+	_, err := c.client.VolumeSnapshotRemove(c.ctx, &types.VolumeSnapshotRemoveRequest{
+		Name: name,
+	})
+	return toError(err)
+}
+
+func (c *Client) CloneVolume(snapshot, podId, volume string) error {
+	_, err := c.client.VolumeClone(c.ctx, &types.VolumeCloneRequest{
+		Snapshot: snapshot,
+		PodID:    podId,
+		Volume:   volume,
+	})
+	return toError(err)
+}
+
+func (c *Client) ExportVolume(podId, volume string) (io.ReadCloser, error) {
+	stream, err := c.client.VolumeExport(c.ctx, &types.VolumeExportRequest{
+		PodID:  podId,
+		Volume: volume,
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return readStream(func() ([]byte, error) {
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, nil
+	}), nil
+}
+
+func (c *Client) ImportVolume(podId, volume string, body io.Reader) error {
+	stream, err := c.client.VolumeImport(c.ctx)
+	if err != nil {
+		return toError(err)
+	}
+	if err := stream.Send(&types.VolumeImportRequest{PodID: podId, Volume: volume}); err != nil {
+		return toError(err)
+	}
+
+	buf := make([]byte, 32*1024)
+	for {
+		nr, err := body.Read(buf)
+		if nr > 0 {
+			if err := stream.Send(&types.VolumeImportRequest{Data: buf[:nr]}); err != nil {
+				break
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	// the error of the import is returned even if the sending failed
+	_, err = stream.CloseAndRecv()
+	return toError(err)
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hyperhq/hyperd/types"
+)
+
+func (cli *Client) ListServices(podId string, live bool) ([]*types.UserService, error) {
+	v := url.Values{}
+	v.Set("podId", podId)
+	if live {
+		v.Set("live", "yes")
+	}
+	body, _, err := readBody(cli.call("GET", "/service/list?"+v.Encode(), nil, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var srvs []*types.UserService
+	if err := json.Unmarshal(body, &srvs); err != nil {
+		return nil, err
+	}
+	return srvs, nil
+}
+
+func (cli *Client) AddServices(podId string, srvs []*types.UserService) error {
+	return cli.modifyServices("POST", "/service/add", podId, srvs)
+}
+
+func (cli *Client) UpdateServices(podId string, srvs []*types.UserService) error {
+	return cli.modifyServices("POST", "/service/update", podId, srvs)
+}
+
+func (cli *Client) DeleteServices(podId string, srvs []*types.UserService) error {
+	return cli.modifyServices("DELETE", "/service", podId, srvs)
+}
+
+func (cli *Client) modifyServices(method, path, podId string, srvs []*types.UserService) error {
+	data, err := json.Marshal(srvs)
+	if err != nil {
+		return err
+	}
+
+	v := url.Values{}
+	v.Set("podId", podId)
+	v.Set("services", string(data))
+	_, _, err = readBody(cli.call(method, path+"?"+v.Encode(), nil, nil))
+	if err != nil {
+		return fmt.Errorf("Error to modify services of pod(%s), %s", podId, err.Error())
+	}
+	return nil
+}
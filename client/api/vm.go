@@ -1,14 +1,36 @@
 package api
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 
 	"github.com/hyperhq/hyperd/engine"
+	apitypes "github.com/hyperhq/hyperd/types"
 	"github.com/hyperhq/runv/hypervisor/types"
 )
 
+func (cli *Client) ListVMs(podId, vmId string) ([]*apitypes.VMListResult, error) {
+	v := url.Values{}
+	if podId != "" {
+		v.Set("pod", podId)
+	}
+	if vmId != "" {
+		v.Set("vm", vmId)
+	}
+	body, _, err := readBody(cli.call("GET", "/vm/list?"+v.Encode(), nil, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var vms []*apitypes.VMListResult
+	if err := json.Unmarshal(body, &vms); err != nil {
+		return nil, err
+	}
+	return vms, nil
+}
+
 func (cli *Client) CreateVm(cpu, mem int, async bool) (id string, err error) {
 	var (
 		body       []byte
@@ -0,0 +1,157 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+
+	"github.com/hyperhq/hyperd/types"
+)
+
+func (cli *Client) CreateVolume(name string, labels map[string]string, size int64) (*types.VolumeInfo, error) {
+	v := url.Values{}
+	v.Set("name", name)
+	if size > 0 {
+		v.Set("size", strconv.FormatInt(size, 10))
+	}
+	if len(labels) > 0 {
+		data, err := json.Marshal(labels)
+		if err != nil {
+			return nil, err
+		}
+		v.Set("labels", string(data))
+	}
+	body, _, err := readBody(cli.call("POST", "/volume/create?"+v.Encode(), nil, nil))
+	if err != nil {
+		return nil, fmt.Errorf("Error to create volume(%s), %s", name, err.Error())
+	}
+
+	var vol types.VolumeInfo
+	if err := json.Unmarshal(body, &vol); err != nil {
+		return nil, err
+	}
+	return &vol, nil
+}
+
+func (cli *Client) ListVolumes() ([]*types.VolumeInfo, error) {
+	body, _, err := readBody(cli.call("GET", "/volume/list", nil, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var vols []*types.VolumeInfo
+	if err := json.Unmarshal(body, &vols); err != nil {
+		return nil, err
+	}
+	return vols, nil
+}
+
+func (cli *Client) GetVolumeInfo(name string) (*types.VolumeInfo, error) {
+	v := url.Values{}
+	v.Set("name", name)
+	body, _, err := readBody(cli.call("GET", "/volume/info?"+v.Encode(), nil, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var vol types.VolumeInfo
+	if err := json.Unmarshal(body, &vol); err != nil {
+		return nil, err
+	}
+	return &vol, nil
+}
+
+func (cli *Client) RemoveVolume(name string) error {
+	v := url.Values{}
+	v.Set("name", name)
+	_, _, err := readBody(cli.call("DELETE", "/volume?"+v.Encode(), nil, nil))
+	if err != nil {
+		return fmt.Errorf("Error to remove volume(%s), %s", name, err.Error())
+	}
+	return nil
+}
+
+func (cli *Client) SnapshotVolume(podId, volume, name string, pause bool) (*types.VolumeSnapshotInfo, error) {
+	v := url.Values{}
+	v.Set("podId", podId)
+	v.Set("volume", volume)
+	v.Set("name", name)
+	if pause {
+		v.Set("pause", "yes")
+	}
+	body, _, err := readBody(cli.call("POST", "/volume/snapshot?"+v.Encode(), nil, nil))
+	if err != nil {
+		return nil, fmt.Errorf("Error to snapshot volume(%s) of pod(%s), %s", volume, podId, err.Error())
+	}
+
+	var snap types.VolumeSnapshotInfo
+	if err := json.Unmarshal(body, &snap); err != nil {
+		return nil, err
+	}
+	return &snap, nil
+}
+
+func (cli *Client) ListVolumeSnapshots(podId string) ([]*types.VolumeSnapshotInfo, error) {
+	v := url.Values{}
+	if podId != "" {
+		v.Set("podId", podId)
+	}
+	body, _, err := readBody(cli.call("GET", "/volume/snapshot/list?"+v.Encode(), nil, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var snaps []*types.VolumeSnapshotInfo
+	if err := json.Unmarshal(body, &snaps); err != nil {
+		return nil, err
+	}
+	return snaps, nil
+}
+
+func (cli *Client) RemoveVolumeSnapshot(name string) error {
+	v := url.Values{}
+	v.Set("name", name)
+	_, _, err := readBody(cli.call("DELETE", "/volume/snapshot?"+v.Encode(), nil, nil))
+	if err != nil {
+		return fmt.Errorf("Error to remove snapshot(%s), %s", name, err.Error())
+	}
+	return nil
+}
+
+func (cli *Client) CloneVolume(snapshot, podId, volume string) error {
+	v := url.Values{}
+	v.Set("snapshot", snapshot)
+	v.Set("podId", podId)
+	v.Set("volume", volume)
+	_, _, err := readBody(cli.call("POST", "/volume/clone?"+v.Encode(), nil, nil))
+	if err != nil {
+		return fmt.Errorf("Error to clone snapshot(%s), %s", snapshot, err.Error())
+	}
+	return nil
+}
+
+func (cli *Client) ExportVolume(podId, volume string) (io.ReadCloser, error) {
+	v := url.Values{}
+	v.Set("podId", podId)
+	v.Set("volume", volume)
+	body, _, err := cli.call("GET", "/volume/export?"+v.Encode(), nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error to export volume(%s), %s", volume, err.Error())
+	}
+	return body, nil
+}
+
+func (cli *Client) ImportVolume(podId, volume string, body io.Reader) error {
+	v := url.Values{}
+	v.Set("podId", podId)
+	v.Set("volume", volume)
+	headers := map[string][]string{"Content-Type": {"application/x-tar"}}
+	out, _, err := cli.stream("POST", "/volume/import?"+v.Encode(), body, headers)
+	if err != nil {
+		return fmt.Errorf("Error to import volume(%s), %s", volume, err.Error())
+	}
+	out.Close()
+	return nil
+}
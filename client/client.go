@@ -59,6 +59,12 @@ func NewHyperClient(proto, addr string, tlsConfig *tls.Config) *HyperClient {
 	}
 }
 
+// SetAPIClient replaces the client used to talk to hyperd, e.g. with the
+// gRPC one.
+func (cli *HyperClient) SetAPIClient(client api.APIInterface) {
+	cli.client = client
+}
+
 var funcMap = template.FuncMap{
 	"json": func(v interface{}) string {
 		a, _ := json.Marshal(v)
@@ -67,12 +73,15 @@ var funcMap = template.FuncMap{
 }
 
 func (cli *HyperClient) getMethod(args ...string) (func(...string) error, bool) {
-	camelArgs := make([]string, len(args))
-	for i, s := range args {
-		if len(s) == 0 {
-			return nil, false
+	camelArgs := make([]string, 0, len(args))
+	for _, arg := range args {
+		// hyphenated commands, e.g. export-spec, map to HyperCmdExportSpec
+		for _, s := range strings.Split(arg, "-") {
+			if len(s) == 0 {
+				return nil, false
+			}
+			camelArgs = append(camelArgs, strings.ToUpper(s[:1])+strings.ToLower(s[1:]))
 		}
-		camelArgs[i] = strings.ToUpper(s[:1]) + strings.ToLower(s[1:])
 	}
 	methodName := "HyperCmd" + strings.Join(camelArgs, "")
 	method := reflect.ValueOf(cli).MethodByName(methodName)
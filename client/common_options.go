@@ -11,6 +11,7 @@ import (
 
 	"github.com/docker/docker/pkg/namesgenerator"
 
+	"github.com/hyperhq/hyperd/lib/k8s"
 	apitype "github.com/hyperhq/hyperd/types"
 	"github.com/hyperhq/hyperd/utils"
 )
@@ -18,7 +19,10 @@ import (
 type CommonFlags struct {
 	PodFile       string   `short:"p" long:"podfile" value-name:"\"\"" description:"read spec from the pod file instead of command line"`
 	Yaml          bool     `short:"y" long:"yaml" default-mask:"-" description:"pod file in Yaml format instead of JSON"`
+	K8s           bool     `long:"k8s" default-mask:"-" description:"pod file is a Kubernetes v1 Pod manifest in Yaml or JSON"`
+	Rollback      bool     `long:"rollback" default-mask:"-" description:"Remove all the pods created from the pod file if any of them fails"`
 	Name          string   `long:"name" value-name:"\"\"" description:"Assign a name to the container"`
+	Namespace     string   `long:"namespace" value-name:"\"\"" description:"Create the pod in the namespace"`
 	Workdir       string   `long:"workdir" value-name:"\"\"" default-mask:"-" description:"Working directory inside the container"`
 	Tty           bool     `short:"t" long:"tty" default-mask:"-" description:"the run command in tty, such as bash shell"`
 	ReadOnly      bool     `long:"read-only" default-mast:"-" description:"Create container with read-only rootfs"`
@@ -53,7 +57,7 @@ func (cli *HyperClient) ParseCommonOptions(opts *CommonFlags, container bool, ar
 	)
 
 	if opts.PodFile != "" {
-		specJson, err = cli.JsonFromFile(opts.PodFile, container, opts.Yaml, false)
+		specJson, err = cli.JsonFromFile(opts.PodFile, container, opts.Yaml, opts.K8s)
 	} else {
 		if len(args) == 0 {
 			return nil, fmt.Errorf("%s: this command requires a minimum of 1 argument, please provide the image.", os.Args[0])
@@ -69,7 +73,7 @@ func (cli *HyperClient) ParseCommonOptions(opts *CommonFlags, container bool, ar
 	return []byte(specJson), nil
 }
 
-func (cli *HyperClient) JsonFromFile(filename string, container, yaml, k8s bool) (string, error) {
+func (cli *HyperClient) JsonFromFile(filename string, container, yaml, kubernetes bool) (string, error) {
 	if _, err := os.Stat(filename); err != nil {
 		return "", err
 	}
@@ -79,6 +83,24 @@ func (cli *HyperClient) JsonFromFile(filename string, container, yaml, k8s bool)
 		return "", err
 	}
 
+	if kubernetes {
+		if container {
+			return "", fmt.Errorf("a Kubernetes manifest can only be used to create a pod")
+		}
+		spec, warnings, err := k8s.Convert(jsonbody)
+		if err != nil {
+			return "", err
+		}
+		for _, w := range warnings {
+			fmt.Fprintf(cli.err, "WARNING: %s\n", w)
+		}
+		jsonbody, err = json.Marshal(spec)
+		if err != nil {
+			return "", err
+		}
+		return string(jsonbody), nil
+	}
+
 	if yaml == true {
 		jsonbody, err = cli.ConvertYamlToJson(jsonbody, container)
 		if err != nil {
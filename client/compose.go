@@ -0,0 +1,204 @@
+package client
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/hyperhq/hyperd/lib/compose"
+	apitype "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/hyperd/utils"
+
+	gflag "github.com/jessevdk/go-flags"
+)
+
+func (cli *HyperClient) HyperCmdCompose(args ...string) error {
+	var opts struct {
+		File    string `short:"f" long:"file" value-name:"docker-compose.yml" default:"docker-compose.yml" description:"The compose file"`
+		Project string `short:"p" long:"project-name" value-name:"\"\"" description:"The project name, default to the directory name of the compose file"`
+		Cpu     int    `long:"cpu" value-name:"0" default-mask:"-" description:"CPU number for the VM of the pod (only valid for up)"`
+		Memory  int    `long:"memory" value-name:"0" default-mask:"-" description:"Memory size (MB) for the VM of the pod (only valid for up)"`
+		Quiet   bool   `short:"q" long:"quiet" default-mask:"-" description:"Only display the container IDs (only valid for ps)"`
+	}
+	var parser = gflag.NewParser(&opts, gflag.Default|gflag.IgnoreUnknown)
+	parser.Usage = "compose up|down|ps [OPTIONS]\n\nRun the services of a docker-compose file as the containers of a pod, the\ncontainers are started in the order given by depends_on"
+
+	if len(args) == 0 {
+		parser.WriteHelp(cli.err)
+		return nil
+	}
+	cmd := args[0]
+
+	if _, err := parser.ParseArgs(args[1:]); err != nil {
+		if !strings.Contains(err.Error(), "Usage") {
+			return err
+		} else {
+			return nil
+		}
+	}
+
+	project, err := composeProject(opts.File, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	switch cmd {
+	case "up":
+		data, err := ioutil.ReadFile(opts.File)
+		if err != nil {
+			return err
+		}
+		dir, _ := filepath.Abs(filepath.Dir(opts.File))
+		spec, warnings, err := compose.Convert(data, project, dir)
+		if err != nil {
+			return err
+		}
+		for _, w := range warnings {
+			fmt.Fprintf(cli.err, "WARNING: %s\n", w)
+		}
+		if opts.Cpu > 0 || opts.Memory > 0 {
+			spec.Resource = &apitype.UserResource{Vcpu: int32(opts.Cpu), Memory: int32(opts.Memory)}
+		}
+
+		pods, err := cli.composePods(project)
+		if err != nil {
+			return err
+		}
+		if len(pods) > 0 {
+			return fmt.Errorf("project %s is already up in pod %s", project, pods[0].PodID)
+		}
+
+		podId, err := cli.composeUp(spec)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cli.out, "POD id is %s\n", podId)
+	case "down":
+		pods, err := cli.composePods(project)
+		if err != nil {
+			return err
+		}
+		if len(pods) == 0 {
+			return fmt.Errorf("project %s is not up", project)
+		}
+		for _, p := range pods {
+			if err := cli.client.RmPod(p.PodID); err != nil {
+				return err
+			}
+			fmt.Fprintf(cli.out, "Pod(%s) is successful to be deleted!\n", p.PodID)
+		}
+	case "ps":
+		pods, err := cli.composePods(project)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(cli.out, 20, 1, 3, ' ', 0)
+		if !opts.Quiet {
+			fmt.Fprintln(w, "Container ID\tName\tService\tPOD ID\tStatus")
+		}
+		for _, p := range pods {
+			status := make(map[string]string)
+			if p.Status != nil {
+				for _, cs := range p.Status.ContainerStatus {
+					status[cs.ContainerID] = cs.Phase
+				}
+			}
+			for _, c := range p.Spec.Containers {
+				if opts.Quiet {
+					fmt.Fprintf(w, "%s\n", c.ContainerID)
+					continue
+				}
+				service := c.Labels[compose.ServiceLabel]
+				if service == "" {
+					service = "-"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", c.ContainerID, strings.TrimPrefix(c.Name, "/"), service, p.PodID, status[c.ContainerID])
+			}
+		}
+		w.Flush()
+	default:
+		parser.WriteHelp(cli.err)
+	}
+	return nil
+}
+
+var composeNameReg = regexp.MustCompile("[^a-z0-9-]")
+
+// composeProject returns the project name, which names the pod as well.
+func composeProject(file, project string) (string, error) {
+	if project == "" {
+		project = os.Getenv("COMPOSE_PROJECT_NAME")
+	}
+	if project == "" {
+		dir, err := filepath.Abs(filepath.Dir(file))
+		if err != nil {
+			return "", err
+		}
+		project = composeNameReg.ReplaceAllString(strings.ToLower(filepath.Base(dir)), "")
+	}
+	if !utils.IsDNSLabel(project) {
+		return "", fmt.Errorf("invalid project name %q, please specify it with --project-name", project)
+	}
+	return project, nil
+}
+
+// composePods returns the pods labeled with the project.
+func (cli *HyperClient) composePods(project string) ([]*apitype.PodInfo, error) {
+	remoteInfo, err := cli.client.List("pod", "", "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	pods := []*apitype.PodInfo{}
+	for _, p := range remoteInfo.GetList("podData") {
+		podId := strings.Split(p, ":")[0]
+		info, err := cli.client.GetPodInfo(podId)
+		if err != nil {
+			continue
+		}
+		if info.Spec != nil && info.Spec.Labels[compose.ProjectLabel] == project {
+			pods = append(pods, info)
+		}
+	}
+	return pods, nil
+}
+
+// composeUp starts the pod without containers, then creates and starts the
+// containers one by one in the order of the spec. The pod is removed if any
+// of the containers fails.
+func (cli *HyperClient) composeUp(spec *apitype.UserPod) (string, error) {
+	if err := cli.PullImages(spec); err != nil {
+		return "", err
+	}
+
+	containers := spec.Containers
+	spec.Containers = []*apitype.UserContainer{}
+	podId, _, err := cli.client.CreatePod(spec)
+	if err != nil {
+		return "", err
+	}
+
+	if err = cli.client.StartPod(podId); err == nil {
+		for _, c := range containers {
+			var id string
+			if id, _, err = cli.client.CreateContainer(podId, c); err != nil {
+				break
+			}
+			if err = cli.client.StartContainer(id); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		if rmerr := cli.client.RmPod(podId); rmerr != nil {
+			fmt.Fprintf(cli.err, "failed to remove pod %s: %v\n", podId, rmerr)
+		}
+		return "", err
+	}
+
+	return podId, nil
+}
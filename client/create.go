@@ -38,6 +38,16 @@ func (cli *HyperClient) HyperCmdCreate(args ...string) error {
 		args = args[1:]
 	}
 
+	if opts.PodFile != "" && !opts.Container {
+		pods, multiple, err := cli.podsFromPath(opts.PodFile, opts.Yaml, opts.K8s)
+		if err != nil {
+			return err
+		}
+		if multiple {
+			return cli.createPods(pods, opts.Namespace, false, opts.Rollback)
+		}
+	}
+
 	specjson, err := cli.ParseCommonOptions(&opts.CommonFlags, opts.Container, args...)
 	if err != nil {
 		return err
@@ -49,6 +59,9 @@ func (cli *HyperClient) HyperCmdCreate(args ...string) error {
 		if err != nil {
 			return fmt.Errorf("failed to read json: %v", err)
 		}
+		if opts.Namespace != "" {
+			tmpPod.Namespace = opts.Namespace
+		}
 		podId, statusCode, err := cli.client.CreatePod(&tmpPod)
 		if err != nil {
 			if statusCode == http.StatusNotFound {
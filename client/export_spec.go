@@ -0,0 +1,51 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	gflag "github.com/jessevdk/go-flags"
+	"gopkg.in/yaml.v2"
+)
+
+func (cli *HyperClient) HyperCmdExportSpec(args ...string) error {
+	var opts struct {
+		Yaml bool `short:"y" long:"yaml" default-mask:"-" description:"Output the pod spec in Yaml format instead of JSON"`
+	}
+	var parser = gflag.NewParser(&opts, gflag.Default)
+	parser.Usage = "export-spec [OPTIONS] POD_ID\n\nExport the spec of a pod, which could be used to create the pod again with 'run -p'"
+	args, err := parser.ParseArgs(args)
+	if err != nil {
+		if !strings.Contains(err.Error(), "Usage") {
+			return err
+		} else {
+			return nil
+		}
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("\"export-spec\" requires a minimum of 1 argument, please provide POD ID.")
+	}
+
+	spec, err := cli.client.ExportPodSpec(args[0])
+	if err != nil {
+		return err
+	}
+
+	if opts.Yaml {
+		// keep the field names of yaml.Unmarshal, which is used by 'run -p --yaml'
+		out, err := yaml.Marshal(spec)
+		if err != nil {
+			return err
+		}
+		cli.out.Write(out)
+		return nil
+	}
+
+	out, err := json.MarshalIndent(spec, "", "    ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(cli.out, "%s\n", out)
+	return nil
+}
@@ -13,10 +13,13 @@ Command:
   attach                 Attach to the input/output of a specified container
   build                  Build an image from a Dockerfile
   commit                 Create a new image from a container's changes
+  compose                Run the services of a docker-compose file as a pod
   create                 Create a pod or create a container in a pod
   exec                   Run a command in a specified container
+  export-spec            Export the spec of a pod for creating it again
   images                 List images
   info                   Display system-wide information
+  inspect                Display detailed information on pods, containers, images, VMs or execs
   list                   List all pods or containers
   load                   Load a image from STDIN or tar archive file
   login                  Register or log in to a Docker registry server
@@ -29,9 +32,13 @@ Command:
   rmi                    Remove one or more images
   run                    Create a pod, and launch the new pod
   save                   Save one or more images to a tar archive (streamed to STDOUT by default)
+  service                Show or modify the services of a pod
   start                  Start a pod or container
   stop                   Stop a running pod or container
+  system                 Manage the hyperd system, e.g. remove the orphaned storage or back up the db
   unpause                Unpause a paused pod
+  vm                     Create, list, remove or execute commands in VMs
+  volume                 Manage named volumes and volume snapshots
 
 Help Options:
   -h, --help             Show this help message
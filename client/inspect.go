@@ -0,0 +1,153 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"text/template"
+
+	"github.com/hyperhq/hyperd/client/api"
+	"github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/hyperd/utils"
+
+	gflag "github.com/jessevdk/go-flags"
+)
+
+var inspectTypes = map[string]bool{"pod": true, "container": true, "image": true, "vm": true, "exec": true}
+
+func (cli *HyperClient) HyperCmdInspect(args ...string) error {
+	var opts struct {
+		Format string `short:"f" long:"format" value-name:"\"\"" description:"Format the output as json (default), yaml, or with the given Go template"`
+		Type   string `short:"t" long:"type" value-name:"\"\"" description:"Only inspect objects of the type: pod, container, image, vm or exec"`
+	}
+	var parser = gflag.NewParser(&opts, gflag.Default)
+	parser.Usage = "inspect [OPTIONS] NAME|ID [NAME|ID...]\n\nDisplay detailed information on pods, containers, images, VMs or execs"
+	args, err := parser.ParseArgs(args)
+	if err != nil {
+		if !strings.Contains(err.Error(), "Usage") {
+			return err
+		} else {
+			return nil
+		}
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("\"inspect\" requires a minimum of 1 argument.")
+	}
+	if opts.Type != "" && !inspectTypes[opts.Type] {
+		return fmt.Errorf("unknown type %q, should be one of pod, container, image, vm and exec", opts.Type)
+	}
+
+	var tmpl *template.Template
+	if opts.Format != "" && opts.Format != "json" && opts.Format != "yaml" {
+		tmpl, err = template.New("").Funcs(funcMap).Parse(opts.Format)
+		if err != nil {
+			return fmt.Errorf("Template parsing error: %v", err)
+		}
+	}
+
+	var (
+		objects = []interface{}{}
+		failed  = []string{}
+	)
+	for _, name := range args {
+		obj, err := cli.inspectObject(name, opts.Type)
+		if err != nil {
+			fmt.Fprintf(cli.err, "%v\n", err)
+			failed = append(failed, name)
+			continue
+		}
+		objects = append(objects, obj)
+	}
+
+	switch {
+	case tmpl != nil:
+		buf := &bytes.Buffer{}
+		for _, obj := range objects {
+			buf.Reset()
+			if err := tmpl.Execute(buf, obj); err != nil {
+				return fmt.Errorf("Template parsing error: %v", err)
+			}
+			fmt.Fprintf(cli.out, "%s\n", strings.TrimRight(buf.String(), "\n"))
+		}
+	case opts.Format == "yaml":
+		out, err := marshalYaml(objects)
+		if err != nil {
+			return err
+		}
+		cli.out.Write(out)
+	default:
+		out, err := json.MarshalIndent(objects, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cli.out, "%s\n", out)
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to inspect: %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// inspectObject resolves the name or ID to an object, in the order of pod,
+// container, image, vm and exec. Only the objects not found are skipped, the
+// other errors are returned at once.
+func (cli *HyperClient) inspectObject(name, objType string) (interface{}, error) {
+	lookups := []struct {
+		kind   string
+		lookup func() (interface{}, error)
+	}{
+		{"pod", func() (interface{}, error) { return cli.client.GetPodInfo(name) }},
+		{"container", func() (interface{}, error) { return cli.client.GetContainerInfo(name) }},
+		{"image", func() (interface{}, error) { return cli.client.GetImageInfo(name) }},
+		{"vm", func() (interface{}, error) { return cli.getVMInfo(name) }},
+		{"exec", func() (interface{}, error) { return cli.client.GetExecInfo(name) }},
+	}
+	for _, l := range lookups {
+		if objType != "" && objType != l.kind {
+			continue
+		}
+		info, err := l.lookup()
+		if err == nil {
+			return info, nil
+		}
+		if !api.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	if objType == "" {
+		objType = "object"
+	}
+	return nil, fmt.Errorf("Error: no such %s: %s", objType, name)
+}
+
+func (cli *HyperClient) getVMInfo(vm string) (*types.VMListResult, error) {
+	remoteInfo, err := cli.client.List("vm", "", "", vm)
+	if err != nil {
+		return nil, err
+	}
+	for _, data := range remoteInfo.GetList("vmData") {
+		fields := strings.Split(data, ":")
+		if len(fields) < 3 || fields[0] != vm {
+			continue
+		}
+		return &types.VMListResult{
+			VmID:   fields[0],
+			PodID:  fields[1],
+			Status: fields[2],
+		}, nil
+	}
+	return nil, &api.ResponseError{Code: http.StatusNotFound, Message: fmt.Sprintf("no such vm: %s", vm)}
+}
+
+// marshalYaml converts the objects to yaml with the same keys as json.
+func marshalYaml(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return utils.JsonToYaml(data)
+}
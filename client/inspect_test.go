@@ -0,0 +1,86 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hyperhq/hyperd/client/api"
+	"github.com/hyperhq/hyperd/engine"
+	"github.com/hyperhq/hyperd/types"
+)
+
+// inspectAPI finds the pods and the vms, and fails the other lookups with the
+// given error.
+type inspectAPI struct {
+	api.APIInterface
+	err error
+}
+
+var errNotFound = &api.ResponseError{Code: http.StatusNotFound, Message: "not found"}
+
+func (a *inspectAPI) GetPodInfo(name string) (*types.PodInfo, error) {
+	if name == "web" {
+		return &types.PodInfo{PodID: "web"}, nil
+	}
+	return nil, errNotFound
+}
+
+func (a *inspectAPI) GetContainerInfo(name string) (*types.ContainerInfo, error) {
+	return nil, a.err
+}
+
+func (a *inspectAPI) GetImageInfo(name string) (*types.ImageInfo, error) {
+	return nil, a.err
+}
+
+func (a *inspectAPI) GetExecInfo(name string) (*types.ExecInfo, error) {
+	return nil, a.err
+}
+
+func (a *inspectAPI) List(item, namespace, pod, vm string) (*engine.Env, error) {
+	v := &engine.Env{}
+	v.SetList("vmData", []string{"vm-web:web:running"})
+	return v, nil
+}
+
+func TestInspectObject(t *testing.T) {
+	fake := &inspectAPI{err: errNotFound}
+	cli := &HyperClient{client: fake}
+
+	if obj, err := cli.inspectObject("web", ""); err != nil || obj.(*types.PodInfo).PodID != "web" {
+		t.Fatalf("unexpected object %v, %v", obj, err)
+	}
+	if obj, err := cli.inspectObject("vm-web", ""); err != nil || obj.(*types.VMListResult).PodID != "web" {
+		t.Fatalf("unexpected object %v, %v", obj, err)
+	}
+	if _, err := cli.inspectObject("web", "container"); err == nil || err.Error() != "Error: no such container: web" {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := cli.inspectObject("gone", ""); err == nil || err.Error() != "Error: no such object: gone" {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// the errors other than not found are not hidden by the later lookups
+	fake.err = api.ErrConnectionRefused
+	if _, err := cli.inspectObject("vm-web", ""); err != api.ErrConnectionRefused {
+		t.Fatalf("unexpected error %v", err)
+	}
+	fake.err = &api.ResponseError{Code: http.StatusInternalServerError, Message: "broken"}
+	if _, err := cli.inspectObject("gone", "image"); err != fake.err {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	for err, notFound := range map[error]bool{
+		errNotFound:                 true,
+		api.ErrConnectionRefused:    false,
+		errors.New("no such pod"):   false,
+		&api.ResponseError{Code: 0}: false,
+	} {
+		if api.IsNotFound(err) != notFound {
+			t.Errorf("IsNotFound(%v): expect %v", err, notFound)
+		}
+	}
+}
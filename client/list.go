@@ -11,9 +11,10 @@ import (
 
 func (cli *HyperClient) HyperCmdList(args ...string) error {
 	var opts struct {
-		Pod   string `short:"p" long:"pod" value-name:"\"\"" description:"only list the specified pod"`
-		VM    string `short:"m" long:"vm" value-name:"\"\"" description:"only list resources on the specified vm"`
-		Quiet bool   `short:"q" long:"quiet" value-name:"\"\"" description:"Quiet mode"`
+		Pod       string `short:"p" long:"pod" value-name:"\"\"" description:"only list the specified pod"`
+		VM        string `short:"m" long:"vm" value-name:"\"\"" description:"only list resources on the specified vm"`
+		Namespace string `short:"n" long:"namespace" value-name:"\"\"" description:"only list resources in the specified namespace"`
+		Quiet     bool   `short:"q" long:"quiet" value-name:"\"\"" description:"Quiet mode"`
 	}
 
 	var parser = gflag.NewParser(&opts, gflag.Default|gflag.IgnoreUnknown)
@@ -37,7 +38,7 @@ func (cli *HyperClient) HyperCmdList(args ...string) error {
 		return fmt.Errorf("Error, the %s can not support %s list!", os.Args[0], item)
 	}
 
-	remoteInfo, err := cli.client.List(item, opts.Pod, opts.VM)
+	remoteInfo, err := cli.client.List(item, opts.Namespace, opts.Pod, opts.VM)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,222 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/hyperhq/hyperd/lib/k8s"
+	apitype "github.com/hyperhq/hyperd/types"
+)
+
+// podFileExts are the files picked up when a directory is given as the pod file
+var podFileExts = map[string]bool{".pod": true, ".json": true, ".yaml": true, ".yml": true}
+
+type podSource struct {
+	source string
+	spec   *apitype.UserPod
+}
+
+// podsFromPath reads the pod specs from a pod file or a directory of pod files.
+// A Yaml file or a Kubernetes manifest may hold several pods as "---"
+// separated documents, and a JSON file may hold a stream of pods. All the
+// specs are validated, so that no pod will be created if any of them is
+// invalid. The returned bool tells whether the path should be handled as a set
+// of pods.
+func (cli *HyperClient) podsFromPath(path string, isYaml, kubernetes bool) ([]*podSource, bool, error) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, false, err
+	}
+
+	files := []string{path}
+	if stat.IsDir() {
+		entries, err := ioutil.ReadDir(path)
+		if err != nil {
+			return nil, false, err
+		}
+		files = []string{}
+		for _, e := range entries {
+			if !e.IsDir() && podFileExts[filepath.Ext(e.Name())] {
+				files = append(files, filepath.Join(path, e.Name()))
+			}
+		}
+		sort.Strings(files)
+		if len(files) == 0 {
+			return nil, false, fmt.Errorf("no pod file found in %s", path)
+		}
+	}
+
+	var (
+		pods = []*podSource{}
+		ids  = make(map[string]string)
+	)
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, false, err
+		}
+		ext := filepath.Ext(file)
+		specs, err := cli.podsFromData(data, isYaml || ext == ".yaml" || ext == ".yml", kubernetes)
+		if err != nil {
+			return nil, false, fmt.Errorf("%s: %v", file, err)
+		}
+		for i, spec := range specs {
+			source := file
+			if len(specs) > 1 {
+				source = fmt.Sprintf("%s#%d", file, i+1)
+			}
+			if err := spec.Validate(); err != nil {
+				return nil, false, fmt.Errorf("%s: %v", source, err)
+			}
+			if spec.Id != "" {
+				if prev, ok := ids[spec.Id]; ok {
+					return nil, false, fmt.Errorf("%s: pod %s is already defined in %s", source, spec.Id, prev)
+				}
+				ids[spec.Id] = source
+			}
+			pods = append(pods, &podSource{source: source, spec: spec})
+		}
+	}
+
+	return pods, stat.IsDir() || len(pods) > 1, nil
+}
+
+func (cli *HyperClient) podsFromData(data []byte, isYaml, kubernetes bool) ([]*apitype.UserPod, error) {
+	if kubernetes {
+		specs, warnings, err := k8s.ConvertPods(data)
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range warnings {
+			fmt.Fprintf(cli.err, "WARNING: %s\n", w)
+		}
+		return specs, nil
+	}
+
+	specs := []*apitype.UserPod{}
+	if isYaml {
+		docs, err := k8s.Documents(data)
+		if err != nil {
+			return nil, err
+		}
+		for _, doc := range docs {
+			// the documents keep the yaml names of the fields, as used by 'run -p --yaml'
+			body, err := cli.ConvertYamlToJson(doc, false)
+			if err != nil {
+				return nil, err
+			}
+			var spec apitype.UserPod
+			if err := json.Unmarshal(body, &spec); err != nil {
+				return nil, err
+			}
+			specs = append(specs, &spec)
+		}
+	} else {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		for {
+			var spec apitype.UserPod
+			if err := decoder.Decode(&spec); err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, err
+			}
+			specs = append(specs, &spec)
+		}
+	}
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("no pod found")
+	}
+	return specs, nil
+}
+
+// createPods creates, and starts if required, the pods one by one, and prints
+// the result of each pod. If rollback is set, all the pods created are removed
+// once any of them fails.
+func (cli *HyperClient) createPods(pods []*podSource, namespace string, start, rollback bool) error {
+	var (
+		created = []string{}
+		results = make([]string, len(pods))
+		ids     = make([]string, len(pods))
+		failed  = 0
+	)
+
+	for i, p := range pods {
+		if namespace != "" {
+			p.spec.Namespace = namespace
+		}
+		podId, err := cli.createPod(p.spec)
+		ids[i] = p.spec.Id
+		if err != nil {
+			results[i] = fmt.Sprintf("create failed: %v", err)
+		} else {
+			ids[i] = podId
+			created = append(created, podId)
+			results[i] = "created"
+			if start {
+				if err = cli.client.StartPod(ids[i]); err != nil {
+					results[i] = fmt.Sprintf("start failed: %v", err)
+				} else {
+					results[i] = "started"
+				}
+			}
+		}
+		if err != nil {
+			failed++
+			if rollback {
+				for j := i + 1; j < len(pods); j++ {
+					ids[j], results[j] = pods[j].spec.Id, "skipped"
+				}
+				break
+			}
+		}
+	}
+
+	if failed > 0 && rollback {
+		for _, id := range created {
+			if rmerr := cli.client.RmPod(id); rmerr != nil {
+				fmt.Fprintf(cli.err, "failed to remove pod %s: %v\n", id, rmerr)
+				continue
+			}
+			for i := range ids {
+				if ids[i] == id {
+					results[i] += ", removed"
+				}
+			}
+		}
+	}
+
+	w := tabwriter.NewWriter(cli.out, 20, 1, 3, ' ', 0)
+	fmt.Fprintln(w, "POD ID\tSource\tResult")
+	for i, p := range pods {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", ids[i], p.source, results[i])
+	}
+	w.Flush()
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d pods failed", failed, len(pods))
+	}
+	return nil
+}
+
+// createPod creates the pod, and pulls the images if they are not found.
+func (cli *HyperClient) createPod(spec *apitype.UserPod) (string, error) {
+	podId, code, err := cli.client.CreatePod(spec)
+	if err != nil && code == http.StatusNotFound {
+		if err = cli.PullImages(spec); err != nil {
+			return "", err
+		}
+		podId, _, err = cli.client.CreatePod(spec)
+	}
+	if err != nil {
+		return "", err
+	}
+	return podId, nil
+}
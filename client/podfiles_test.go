@@ -0,0 +1,156 @@
+package client
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	yamlPods = `
+id: web
+containers:
+- image: nginx
+---
+# the empty documents are skipped
+---
+id: db
+restartpolicy: always
+containers:
+- image: redis
+`
+	jsonPods = `{"id": "cache", "containers": [{"image": "memcached"}]}
+{"id": "queue", "containers": [{"image": "rabbitmq"}]}
+`
+	k8sPods = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: conf
+data:
+  app.conf: "debug: false"
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web
+spec:
+  containers:
+  - name: nginx
+    image: nginx
+  nodeSelector:
+    disk: ssd
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: app
+spec:
+  volumes:
+  - name: conf
+    configMap:
+      name: conf
+  containers:
+  - name: app
+    image: busybox
+    volumeMounts:
+    - name: conf
+      mountPath: /etc/app
+`
+)
+
+func TestPodsFromData(t *testing.T) {
+	var stderr bytes.Buffer
+	cli := &HyperClient{err: &stderr}
+
+	specs, err := cli.podsFromData([]byte(yamlPods), true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(specs) != 2 || specs[0].Id != "web" || specs[1].Id != "db" || specs[1].RestartPolicy != "always" {
+		t.Fatalf("unexpected yaml pods %v", specs)
+	}
+
+	specs, err = cli.podsFromData([]byte(jsonPods), false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(specs) != 2 || specs[0].Id != "cache" || specs[1].Containers[0].Image != "rabbitmq" {
+		t.Fatalf("unexpected json pods %v", specs)
+	}
+
+	// the pods of a manifest share its ConfigMaps
+	specs, err = cli.podsFromData([]byte(k8sPods), true, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(specs) != 2 || specs[0].Id != "web" || specs[1].Id != "app" || len(specs[1].Files) != 1 {
+		t.Fatalf("unexpected kubernetes pods %v", specs)
+	}
+	if !strings.Contains(stderr.String(), "WARNING: pod web: spec.nodeSelector") {
+		t.Fatalf("unexpected warnings:\n%s", stderr.String())
+	}
+
+	for _, data := range []string{"", "---\n", "id: [web"} {
+		if _, err := cli.podsFromData([]byte(data), true, false); err == nil {
+			t.Fatalf("read pods from %q", data)
+		}
+	}
+}
+
+func TestPodsFromPath(t *testing.T) {
+	dir, err := ioutil.TempDir("", "podfiles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cli := &HyperClient{err: ioutil.Discard}
+
+	write := func(name, content string) string {
+		file := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return file
+	}
+
+	if _, _, err := cli.podsFromPath(dir, false, false); err == nil {
+		t.Fatal("read pods from an empty directory")
+	}
+
+	single := write("single.pod", `{"id": "single", "containers": [{"image": "busybox"}]}`)
+	pods, multiple, err := cli.podsFromPath(single, false, false)
+	if err != nil || multiple || len(pods) != 1 || pods[0].source != single {
+		t.Fatalf("unexpected pods %v, %v, %v", pods, multiple, err)
+	}
+
+	os.Remove(single)
+	write("a.yaml", yamlPods)
+	write("b.json", jsonPods)
+	write("notes.txt", "not a pod")
+	pods, multiple, err = cli.podsFromPath(dir, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sources := []string{}
+	for _, p := range pods {
+		sources = append(sources, filepath.Base(p.source)+"="+p.spec.Id)
+	}
+	if !multiple || strings.Join(sources, ",") != "a.yaml#1=web,a.yaml#2=db,b.json#1=cache,b.json#2=queue" {
+		t.Fatalf("unexpected pods %v", sources)
+	}
+
+	// all the pods are rejected if any of them is invalid
+	dup := write("c.json", `{"id": "web", "containers": [{"image": "nginx"}]}`)
+	if _, _, err := cli.podsFromPath(dir, false, false); err == nil || !strings.Contains(err.Error(), "already defined") {
+		t.Fatalf("unexpected error %v", err)
+	}
+	os.Remove(dup)
+	write("c.json", `{"id": "bad", "hostname": "-bad-", "containers": [{"image": "nginx"}]}`)
+	if _, _, err := cli.podsFromPath(dir, false, false); err == nil || !strings.Contains(err.Error(), "c.json") {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
@@ -31,6 +31,16 @@ func (cli *HyperClient) HyperCmdRun(args ...string) error {
 		}
 	}
 
+	if opts.PodFile != "" {
+		pods, multiple, err := cli.podsFromPath(opts.PodFile, opts.Yaml, opts.K8s)
+		if err != nil {
+			return err
+		}
+		if multiple {
+			return cli.createPods(pods, opts.Namespace, true, opts.Rollback)
+		}
+	}
+
 	specjson, err := cli.ParseCommonOptions(&opts.CommonFlags, false, args...)
 	if err != nil {
 		return err
@@ -51,6 +61,9 @@ func (cli *HyperClient) HyperCmdRun(args ...string) error {
 	if err != nil {
 		return err
 	}
+	if opts.Namespace != "" {
+		spec.Namespace = opts.Namespace
+	}
 
 	podId, code, err = cli.client.CreatePod(&spec)
 	if err != nil {
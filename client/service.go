@@ -0,0 +1,159 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/hyperd/utils"
+	gflag "github.com/jessevdk/go-flags"
+)
+
+func (cli *HyperClient) HyperCmdService(args ...string) error {
+	var opts struct {
+		Config   bool     `long:"config" default-mask:"-" description:"List the services in the pod spec instead of the rules applied in the VM (only valid for ls)"`
+		File     string   `short:"f" long:"file" value-name:"\"\"" description:"Read the services from a JSON or YAML file"`
+		IP       string   `long:"ip" value-name:"\"\"" description:"IP address of the service"`
+		Port     int32    `long:"port" value-name:"0" description:"Port of the service"`
+		Protocol string   `long:"protocol" value-name:"tcp" default:"tcp" description:"Protocol of the service, tcp or udp"`
+		Backends []string `short:"b" long:"backend" value-name:"[]" default-mask:"-" description:"Backend of the service, format: hostIP:hostPort"`
+	}
+	var parser = gflag.NewParser(&opts, gflag.Default|gflag.IgnoreUnknown|gflag.PassAfterNonOption)
+	parser.Usage = "service ls|add|update|rm [OPTIONS] POD\n\nList or modify the services of a Pod\n"
+
+	if len(args) == 0 {
+		parser.WriteHelp(cli.err)
+		return nil
+	}
+	cmd := args[0]
+
+	args, err := parser.ParseArgs(args[1:])
+	if err != nil {
+		if !strings.Contains(err.Error(), "Usage") {
+			return err
+		} else {
+			return nil
+		}
+	}
+
+	var modFunc func(string, []*types.UserService) error
+
+	switch cmd {
+	case "ls":
+		if len(args) != 1 {
+			return errors.New("need a Pod Id as command parameter")
+		}
+		srvs, err := cli.client.ListServices(args[0], !opts.Config)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(cli.out, 20, 1, 3, ' ', 0)
+		fmt.Fprintln(w, "Protocol\tService IP\tService Port\tBackends")
+		for _, srv := range srvs {
+			backends := make([]string, 0, len(srv.Hosts))
+			for _, b := range srv.Hosts {
+				backends = append(backends, net.JoinHostPort(b.HostIP, strconv.Itoa(int(b.HostPort))))
+			}
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", srv.Protocol, srv.ServiceIP, srv.ServicePort, strings.Join(backends, ","))
+		}
+		w.Flush()
+		return nil
+	case "add":
+		modFunc = cli.client.AddServices
+	case "update":
+		modFunc = cli.client.UpdateServices
+	case "rm":
+		modFunc = cli.client.DeleteServices
+	default:
+		parser.WriteHelp(cli.err)
+		return nil
+	}
+
+	if len(args) != 1 {
+		return errors.New("need a Pod Id as command parameter")
+	}
+
+	var srvs []*types.UserService
+	if opts.File != "" {
+		if srvs, err = readServiceFile(opts.File); err != nil {
+			return err
+		}
+	}
+	if opts.IP != "" || opts.Port != 0 {
+		srv, err := newService(opts.IP, opts.Port, opts.Protocol, opts.Backends)
+		if err != nil {
+			return err
+		}
+		srvs = append(srvs, srv)
+	}
+	// update with an empty list clears all the services
+	if len(srvs) == 0 && cmd != "update" {
+		return errors.New("no services to be add or remove, please specify --ip and --port, or --file")
+	}
+
+	return modFunc(args[0], srvs)
+}
+
+func newService(ip string, port int32, protocol string, backends []string) (*types.UserService, error) {
+	if net.ParseIP(ip) == nil {
+		return nil, fmt.Errorf("invalid service ip %q", ip)
+	}
+	if port <= 0 || port > 65535 {
+		return nil, fmt.Errorf("invalid service port %d", port)
+	}
+	protocol = strings.ToLower(protocol)
+	if protocol != "tcp" && protocol != "udp" {
+		return nil, fmt.Errorf("unsupported service protocol %q", protocol)
+	}
+
+	srv := &types.UserService{
+		ServiceIP:   ip,
+		ServicePort: port,
+		Protocol:    protocol,
+		Hosts:       []*types.UserServiceBackend{},
+	}
+	for _, b := range backends {
+		host, p, err := net.SplitHostPort(b)
+		if err != nil {
+			return nil, fmt.Errorf("invalid backend %q: %v", b, err)
+		}
+		hostPort, err := strconv.ParseInt(p, 10, 32)
+		if err != nil || hostPort <= 0 || hostPort > 65535 {
+			return nil, fmt.Errorf("invalid backend port %q", b)
+		}
+		srv.Hosts = append(srv.Hosts, &types.UserServiceBackend{
+			HostIP:   host,
+			HostPort: int32(hostPort),
+		})
+	}
+	return srv, nil
+}
+
+// readServiceFile reads a list of services, the file is in YAML format if it
+// has a .yaml or .yml extension, otherwise in JSON.
+func readServiceFile(file string) ([]*types.UserService, error) {
+	body, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".yaml", ".yml":
+		if body, err = utils.YamlToJson(body); err != nil {
+			return nil, fmt.Errorf("failed to read services from %s: %v", file, err)
+		}
+	}
+
+	var srvs []*types.UserService
+	if err = json.Unmarshal(body, &srvs); err != nil {
+		return nil, fmt.Errorf("failed to read services from %s: %v", file, err)
+	}
+	return srvs, nil
+}
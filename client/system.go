@@ -0,0 +1,77 @@
+package client
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	gflag "github.com/jessevdk/go-flags"
+)
+
+func (cli *HyperClient) HyperCmdSystem(args ...string) error {
+	var opts struct {
+		DryRun bool `long:"dry-run" default-mask:"-" description:"Only report the orphans without removing them (only valid for gc)"`
+	}
+	var parser = gflag.NewParser(&opts, gflag.Default|gflag.IgnoreUnknown|gflag.PassAfterNonOption)
+	parser.Usage = "system gc [OPTIONS] | db-backup PATH\n\n" +
+		"Manage the hyperd system\n\n" +
+		"gc removes the storage left by the pods which no longer exist, e.g. the\nvolumes, devices and mounts left when hyperd crashed during a pod removal\n\n" +
+		"db-backup backs up the hyperd db to PATH on the host of hyperd, which\nmust not exist; the backup is restored with `hyperd --db-restore=PATH`"
+
+	if len(args) == 0 {
+		parser.WriteHelp(cli.err)
+		return nil
+	}
+	cmd := args[0]
+
+	args, err := parser.ParseArgs(args[1:])
+	if err != nil {
+		if !strings.Contains(err.Error(), "Usage") {
+			return err
+		} else {
+			return nil
+		}
+	}
+
+	switch cmd {
+	case "gc":
+		orphans, err := cli.client.SystemGC(opts.DryRun)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(cli.out, 20, 1, 3, ' ', 0)
+		fmt.Fprintln(w, "Kind\tPath\tPod\tResult")
+		for _, o := range orphans {
+			result := "removed"
+			if o.Error != "" {
+				result = "failed: " + o.Error
+			} else if !o.Removed {
+				result = "orphan"
+			}
+			pod := o.Pod
+			if pod == "" {
+				pod = "-"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", o.Kind, o.Path, pod, result)
+		}
+		w.Flush()
+	case "db-backup":
+		if len(args) != 1 {
+			return fmt.Errorf("%s: \"system db-backup\" requires exactly 1 argument, See 'hyperctl system --help'.", os.Args[0])
+		}
+		path, err := filepath.Abs(args[0])
+		if err != nil {
+			return err
+		}
+		resp, err := cli.client.SystemDBBackup(path)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cli.out, "backed up %d records to %s\n", resp.Records, resp.Path)
+	default:
+		parser.WriteHelp(cli.err)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/docker/go-units"
+	gflag "github.com/jessevdk/go-flags"
+)
+
+func (cli *HyperClient) HyperCmdVm(args ...string) error {
+	var opts struct {
+		Cpu   int  `long:"cpu" value-name:"1" default:"1" description:"CPU number of the VM"`
+		Mem   int  `long:"mem" value-name:"128" default:"128" description:"Memory size (MB) of the VM"`
+		Async bool `long:"async" default-mask:"-" description:"Return without waiting for the VM to start (only valid for create)"`
+		Quiet bool `short:"q" long:"quiet" default-mask:"-" description:"Only display the VM IDs (only valid for ls)"`
+	}
+	var parser = gflag.NewParser(&opts, gflag.Default|gflag.IgnoreUnknown|gflag.PassAfterNonOption)
+	parser.Usage = "vm create|ls|rm|exec [OPTIONS] [VM...] [COMMAND [ARGS...]]\n\nManage the VMs which are created before the pods, a created VM is used by the\nnext pod with the same CPU and memory. The VMs not used by any pod are removed\nwhen hyperd stops, even if the VMs of the pods are kept"
+
+	if len(args) == 0 {
+		parser.WriteHelp(cli.err)
+		return nil
+	}
+	cmd := args[0]
+
+	args, err := parser.ParseArgs(args[1:])
+	if err != nil {
+		if !strings.Contains(err.Error(), "Usage") {
+			return err
+		} else {
+			return nil
+		}
+	}
+
+	switch cmd {
+	case "create":
+		if opts.Cpu <= 0 || opts.Mem <= 0 {
+			return fmt.Errorf("invalid size of VM: cpu %d, memory %dMB", opts.Cpu, opts.Mem)
+		}
+		id, err := cli.client.CreateVm(opts.Cpu, opts.Mem, opts.Async)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cli.out, "%s\n", id)
+	case "ls":
+		vms, err := cli.client.ListVMs("", "")
+		if err != nil {
+			return err
+		}
+		if opts.Quiet {
+			for _, vm := range vms {
+				fmt.Fprintf(cli.out, "%s\n", vm.VmID)
+			}
+			return nil
+		}
+		w := tabwriter.NewWriter(cli.out, 20, 1, 3, ' ', 0)
+		fmt.Fprintln(w, "VM ID\tPOD ID\tCPU\tMemory\tUptime\tStatus")
+		for _, vm := range vms {
+			uptime := "-"
+			if vm.CreatedAt > 0 {
+				uptime = units.HumanDuration(time.Since(time.Unix(vm.CreatedAt, 0)))
+			}
+			podId := vm.PodID
+			if podId == "" {
+				podId = "-"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%d\t%dMB\t%s\t%s\n", vm.VmID, podId, vm.Cpu, vm.Memory, uptime, vm.Status)
+		}
+		w.Flush()
+	case "rm":
+		if len(args) == 0 {
+			return errors.New("\"vm rm\" requires a minimum of 1 argument, please provide VM ID")
+		}
+		for _, id := range args {
+			if err := cli.client.RmVm(id); err == nil {
+				fmt.Fprintf(cli.out, "VM(%s) is successfully deleted!\n", id)
+			} else {
+				fmt.Fprintf(cli.err, "VM(%s) delete failed: %v\n", id, err)
+			}
+		}
+	case "exec":
+		if len(args) < 2 {
+			return errors.New("\"vm exec\" requires a VM ID and a command")
+		}
+		vmId := args[0]
+		vms, err := cli.client.ListVMs("", vmId)
+		if err != nil {
+			return err
+		}
+		if len(vms) == 0 {
+			return fmt.Errorf("cannot find vm %s", vmId)
+		}
+		// the commands in a VM associated with a pod are executed via the pod
+		target := vms[0].PodID
+		if target == "" {
+			target = vmId
+		}
+		command, err := json.Marshal(args[1:])
+		if err != nil {
+			return err
+		}
+		return cli.client.ExecVM(target, command, cli.in, cli.out, cli.err)
+	default:
+		parser.WriteHelp(cli.err)
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/hyperhq/hyperd/types"
+	gflag "github.com/jessevdk/go-flags"
+)
+
+func (cli *HyperClient) HyperCmdVolume(args ...string) error {
+	var opts struct {
+		Labels []string `long:"label" value-name:"[]" default-mask:"-" description:"Add labels for the volume, format: --label key=value (only valid for create)"`
+		Quiet  bool     `short:"q" long:"quiet" default-mask:"-" description:"Only display the names (only valid for ls and snapshots)"`
+		Size   string   `long:"size" value-name:"\"\"" default-mask:"-" description:"Limit the size of the volume, e.g. 10g (only valid for create)"`
+		Pause  bool     `long:"pause" default-mask:"-" description:"Pause the pod during the snapshot for consistency (only valid for snapshot)"`
+		Output string   `short:"o" long:"output" value-name:"\"\"" default-mask:"-" description:"Write to a file, instead of STDOUT (only valid for export)"`
+		Input  string   `short:"i" long:"input" value-name:"\"\"" default-mask:"-" description:"Read from a tar archive file, instead of STDIN (only valid for import)"`
+	}
+	var parser = gflag.NewParser(&opts, gflag.Default|gflag.IgnoreUnknown|gflag.PassAfterNonOption)
+	parser.Usage = "volume create|ls|inspect|rm [OPTIONS] [VOLUME...]\n" +
+		"       volume snapshot [OPTIONS] POD_ID VOLUME SNAPSHOT\n" +
+		"       volume snapshot rm SNAPSHOT...\n" +
+		"       volume snapshots [POD_ID]\n" +
+		"       volume clone SNAPSHOT POD_ID VOLUME\n" +
+		"       volume export|import [OPTIONS] [POD_ID] VOLUME\n\n" +
+		"Manage the named volumes, which could be mounted by the pods with a volume\nof the \"named\" format, e.g. {\"name\": \"data\", \"source\": \"VOLUME\", \"format\": \"named\"}\n\n" +
+		"Take snapshots of the devicemapper volumes of the pods, and clone the snapshots\nto the volumes of the pods to be created\n\n" +
+		"Export the files in a volume of a pod, or in a named volume, as a tar archive,\nor import a tar archive into the volume"
+
+	if len(args) == 0 {
+		parser.WriteHelp(cli.err)
+		return nil
+	}
+	cmd := args[0]
+
+	args, err := parser.ParseArgs(args[1:])
+	if err != nil {
+		if !strings.Contains(err.Error(), "Usage") {
+			return err
+		} else {
+			return nil
+		}
+	}
+
+	switch cmd {
+	case "create":
+		if len(args) != 1 {
+			return errors.New("need a volume name as command parameter")
+		}
+		labels := make(map[string]string)
+		for _, l := range opts.Labels {
+			kv := strings.SplitN(l, "=", 2)
+			if len(kv) != 2 || kv[0] == "" {
+				return fmt.Errorf("invalid label %q, format: key=value", l)
+			}
+			labels[kv[0]] = kv[1]
+		}
+		var size int64
+		if opts.Size != "" {
+			if size, err = units.RAMInBytes(opts.Size); err != nil || size <= 0 {
+				return fmt.Errorf("invalid volume size %q", opts.Size)
+			}
+		}
+		vol, err := cli.client.CreateVolume(args[0], labels, size)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cli.out, "%s\n", vol.Name)
+	case "ls":
+		vols, err := cli.client.ListVolumes()
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(cli.out, 20, 1, 3, ' ', 0)
+		if !opts.Quiet {
+			fmt.Fprintln(w, "Name\tFormat\tSource\tSize\tUsage\tPods\tCreated")
+		}
+		for _, vol := range vols {
+			if opts.Quiet {
+				fmt.Fprintf(w, "%s\n", vol.Name)
+				continue
+			}
+			if vol.Spec == nil {
+				vol.Spec = &types.UserVolume{}
+			}
+			created := units.HumanDuration(time.Since(time.Unix(vol.CreatedAt, 0))) + " ago"
+			size := "-"
+			if vol.Spec.Size_ > 0 {
+				size = units.BytesSize(float64(vol.Spec.Size_))
+			}
+			usage := units.BytesSize(float64(vol.Usage))
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%s\n", vol.Name, vol.Spec.Format, vol.Spec.Source, size, usage, len(vol.Pods), created)
+		}
+		w.Flush()
+	case "inspect":
+		if len(args) == 0 {
+			return errors.New("need a volume name as command parameter")
+		}
+		vols := []*types.VolumeInfo{}
+		for _, name := range args {
+			vol, err := cli.client.GetVolumeInfo(name)
+			if err != nil {
+				return err
+			}
+			vols = append(vols, vol)
+		}
+		out, err := json.MarshalIndent(vols, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cli.out, "%s\n", out)
+	case "rm":
+		if len(args) == 0 {
+			return errors.New("need a volume name as command parameter")
+		}
+		for _, name := range args {
+			if err := cli.client.RemoveVolume(name); err != nil {
+				return err
+			}
+			fmt.Fprintf(cli.out, "%s\n", name)
+		}
+	case "snapshot":
+		if len(args) > 0 && args[0] == "rm" {
+			if len(args) == 1 {
+				return errors.New("need a snapshot name as command parameter")
+			}
+			for _, name := range args[1:] {
+				if err := cli.client.RemoveVolumeSnapshot(name); err != nil {
+					return err
+				}
+				fmt.Fprintf(cli.out, "%s\n", name)
+			}
+			return nil
+		}
+		if len(args) != 3 {
+			return errors.New("need pod id, volume name and snapshot name as command parameters")
+		}
+		snap, err := cli.client.SnapshotVolume(args[0], args[1], args[2], opts.Pause)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cli.out, "%s\n", snap.Name)
+	case "snapshots":
+		podId := ""
+		if len(args) > 0 {
+			podId = args[0]
+		}
+		snaps, err := cli.client.ListVolumeSnapshots(podId)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(cli.out, 20, 1, 3, ' ', 0)
+		if !opts.Quiet {
+			fmt.Fprintln(w, "Name\tPod\tVolume\tSize\tCreated")
+		}
+		for _, snap := range snaps {
+			if opts.Quiet {
+				fmt.Fprintf(w, "%s\n", snap.Name)
+				continue
+			}
+			created := units.HumanDuration(time.Since(time.Unix(snap.CreatedAt, 0))) + " ago"
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", snap.Name, snap.PodID, snap.Volume, units.BytesSize(float64(snap.Size_)), created)
+		}
+		w.Flush()
+	case "clone":
+		if len(args) != 3 {
+			return errors.New("need snapshot name, pod id and volume name as command parameters")
+		}
+		if err := cli.client.CloneVolume(args[0], args[1], args[2]); err != nil {
+			return err
+		}
+		fmt.Fprintf(cli.out, "%s\n", args[2])
+	case "export":
+		podId, volume, err := volumeArgs(args)
+		if err != nil {
+			return err
+		}
+		output := cli.out
+		if opts.Output == "" && cli.isTerminalOut {
+			return errors.New("Cowardly refusing to export to a terminal. Use the -o flag or redirect.")
+		}
+		if opts.Output != "" {
+			file, err := os.Create(opts.Output)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			output = file
+		}
+		body, err := cli.client.ExportVolume(podId, volume)
+		if err != nil {
+			return err
+		}
+		defer body.Close()
+		if _, err := io.Copy(output, body); err != nil {
+			return err
+		}
+	case "import":
+		podId, volume, err := volumeArgs(args)
+		if err != nil {
+			return err
+		}
+		var input io.Reader = cli.in
+		if opts.Input != "" {
+			file, err := os.Open(opts.Input)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			input = file
+		}
+		if err := cli.client.ImportVolume(podId, volume, input); err != nil {
+			return err
+		}
+		fmt.Fprintf(cli.out, "%s\n", volume)
+	default:
+		parser.WriteHelp(cli.err)
+	}
+	return nil
+}
+
+// volumeArgs returns the pod and the volume of "[POD_ID] VOLUME", the pod is
+// empty for the named volumes.
+func volumeArgs(args []string) (string, string, error) {
+	switch len(args) {
+	case 1:
+		return "", args[0], nil
+	case 2:
+		return args[0], args[1], nil
+	}
+	return "", "", errors.New("need a volume name, or pod id and volume name as command parameters")
+}
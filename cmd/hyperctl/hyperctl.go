@@ -4,9 +4,11 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/hyperhq/hyperd/client"
 	"github.com/hyperhq/hyperd/client/api"
+	"github.com/hyperhq/hyperd/client/api/rpc"
 )
 
 func main() {
@@ -19,8 +21,18 @@ func main() {
 	// set the flag to output
 	flHelp := flag.Bool("help", false, "Help Message")
 	flVersion := flag.Bool("version", false, "Version Message")
+	flGrpc := flag.String("grpc", "", "Connect to the gRPC API of hyperd at the address, e.g. 127.0.0.1:22318")
 	flag.Usage = func() { cli.Cmd("help") }
 	flag.Parse()
+	if *flGrpc != "" {
+		c, err := rpc.NewClient(*flGrpc, 5*time.Second)
+		if err != nil {
+			fmt.Printf("%s ERROR: %s\n", os.Args[0], err.Error())
+			os.Exit(-1)
+		}
+		defer c.Close()
+		cli.SetAPIClient(c)
+	}
 	if flag.NArg() == 0 {
 		cli.Cmd("help")
 		return
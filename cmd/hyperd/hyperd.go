@@ -12,6 +12,8 @@ import (
 	"github.com/docker/docker/pkg/reexec"
 	"github.com/golang/glog"
 	"github.com/hyperhq/hyperd/daemon"
+	"github.com/hyperhq/hyperd/daemon/daemondb"
+	"github.com/hyperhq/hyperd/daemon/pod"
 	"github.com/hyperhq/hyperd/server"
 	"github.com/hyperhq/hyperd/serverrpc"
 	"github.com/hyperhq/hyperd/types"
@@ -33,23 +35,16 @@ func main() {
 		return
 	}
 
-	if os.Geteuid() != 0 {
-		glog.Errorf("The Hyper daemon needs to be run as root")
-		return
-	}
-
-	// hyper needs Linux kernel 3.8.0+
-	if err := checkKernel(3, 8, 0); err != nil {
-		glog.Errorf(err.Error())
-		return
-	}
-
 	fnd := flag.Bool("nondaemon", false, "[deprecated flag]") // TODO: remove it when 0.8 is released
 	flDisableIptables := flag.Bool("noniptables", false, "Don't enable iptables rules")
 	flConfig := flag.String("config", "", "Config file for hyperd")
 	flHost := flag.String("host", "", "Host for hyperd")
 	flMirrors := flag.String("registry_mirror", "", "Prefered docker registry mirror")
 	flInsecureRegistries := flag.String("insecure_registry", "", "Enable insecure registry communication")
+	flValidateConfig := flag.Bool("validate-config", false, "Validate the config file and exit")
+	flDBRestore := flag.String("db-restore", "", "Restore the db from the backup and exit")
+	flDBFsck := flag.Bool("db-fsck", false, "Check the pods in the db and exit")
+	flDBRepair := flag.Bool("db-repair", false, "Check the pods in the db, repair or quarantine the broken ones and exit")
 	flHelp := flag.Bool("help", false, "Print help message for Hyperd daemon")
 	flag.Set("log_dir", "/var/log/hyper/")
 	os.MkdirAll("/var/log/hyper/", 0755)
@@ -60,6 +55,25 @@ func main() {
 		return
 	}
 
+	if *flValidateConfig {
+		os.Exit(validateConfig(*flConfig))
+	}
+
+	if os.Geteuid() != 0 {
+		glog.Errorf("The Hyper daemon needs to be run as root")
+		return
+	}
+
+	if *flDBRestore != "" || *flDBFsck || *flDBRepair {
+		os.Exit(maintainDB(*flConfig, *flDBRestore, *flDBFsck || *flDBRepair, *flDBRepair))
+	}
+
+	// hyper needs Linux kernel 3.8.0+
+	if err := checkKernel(3, 8, 0); err != nil {
+		glog.Errorf(err.Error())
+		return
+	}
+
 	if *fnd {
 		fmt.Printf("flag --nondaemon is deprecated\n")
 	}
@@ -86,14 +100,96 @@ Application Options:
   --host                 Host address and port for hyperd(such as --host=tcp://127.0.0.1:12345)
   --registry_mirror      Prefered docker registry mirror, multiple values separated by a comma
   --insecure_registry    Enable insecure registry communication, multiple values separated by a comma
+  --validate-config      Validate the config file, print the problems found and exit
+  --db-restore=""        Restore the db from the backup created by 'hyperctl system db-backup' and exit,
+                         hyperd must be stopped, and the replaced db is kept aside
+  --db-fsck              Check the pods in the db, print the problems found and exit,
+                         hyperd must be stopped
+  --db-repair            Like --db-fsck, but repair the problems found, the pods which could not be
+                         repaired are quarantined
   --logtostderr          Log to standard error instead of files
   --alsologtostderr      Log to standard error as well as files
 
 Help Options:
   -h, --help             Show this help message
 
+Signals:
+  SIGINT, SIGTERM        Stop %s and the VMs
+  SIGHUP                 Stop %s and keep the VMs running, it does not reload the config
+  SIGUSR2                Reload the config file
+
 `
-	fmt.Printf(helpMessage, os.Args[0], os.Args[0])
+	fmt.Printf(helpMessage, os.Args[0], os.Args[0], os.Args[0], os.Args[0])
+}
+
+// validateConfig prints the problems of the config file, and returns the exit
+// code of the validate-config mode.
+func validateConfig(config string) int {
+	problems, err := types.ValidateConfigFile(config)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	for _, p := range problems {
+		fmt.Printf("%v\n", p)
+	}
+	if len(problems) > 0 {
+		return 1
+	}
+	fmt.Printf("config file is valid\n")
+	return 0
+}
+
+// maintainDB restores the db from the backup if backup is not empty, then
+// checks the pods in the db if check is set, and returns the exit code of the
+// offline db modes.
+func maintainDB(config, backup string, check, repair bool) int {
+	c := types.NewHyperConfig(config)
+	if c == nil {
+		return 1
+	}
+	c.AdvertiseEnv()
+	dbFile := daemon.DBFile()
+
+	if backup != "" {
+		aside, err := daemondb.Restore(backup, dbFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to restore the db: %v\n", err)
+			return 1
+		}
+		if aside != "" {
+			fmt.Printf("the replaced db is moved to %s\n", aside)
+		}
+		fmt.Printf("db restored from %s\n", backup)
+	}
+	if !check {
+		return 0
+	}
+
+	if _, err := os.Stat(dbFile); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to open the db: %v\n", err)
+		return 1
+	}
+	db, err := daemondb.NewDaemonDB(dbFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to open the db, is hyperd still running? %v\n", err)
+		return 1
+	}
+	defer db.Close()
+
+	problems, err := pod.CheckDB(db, repair)
+	for _, p := range problems {
+		fmt.Printf("%v\n", p)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to check the db: %v\n", err)
+		return 1
+	}
+	if len(problems) > 0 && !repair {
+		return 1
+	}
+	fmt.Printf("%d problems found in the db\n", len(problems))
+	return 0
 }
 
 func mainDaemon(opt *Options) {
@@ -101,7 +197,12 @@ func mainDaemon(opt *Options) {
 	if c == nil {
 		return
 	}
-	c.DisableIptables = c.DisableIptables || opt.DisableIptables
+	override := func(c *types.HyperConfig) {
+		c.DisableIptables = c.DisableIptables || opt.DisableIptables
+		c.Mirrors = append(c.Mirrors, types.SplitList(opt.Mirrors)...)
+		c.InsecureRegistries = append(c.InsecureRegistries, types.SplitList(opt.InsecureRegistries)...)
+	}
+	override(c)
 
 	c.AdvertiseEnv()
 	if _, err := os.Stat(c.Root); err != nil {
@@ -111,12 +212,13 @@ func mainDaemon(opt *Options) {
 		}
 	}
 
-	daemon.InitDockerCfg(strings.Split(opt.Mirrors, ","), strings.Split(opt.InsecureRegistries, ","), c.StorageDriver, c.StorageBaseSize, c.Root)
+	daemon.InitDockerCfg(c.Mirrors, c.InsecureRegistries, c.StorageDriver, c.StorageBaseSize, c.RawBlockFs, c.RawBlockFormat, c.Root)
 	d, err := daemon.NewDaemon(c)
 	if err != nil {
 		glog.Errorf("The hyperd create failed, %s", err.Error())
 		return
 	}
+	d.ConfigOverride = override
 
 	// Set the daemon object as the global varibal
 	// which will be used for puller and builder
@@ -126,6 +228,7 @@ func mainDaemon(opt *Options) {
 		glog.Warningf("Fail to restore the previous VM")
 		return
 	}
+	d.ReconcileStorage()
 
 	serverConfig := &server.Config{}
 
@@ -185,6 +288,17 @@ func mainDaemon(opt *Options) {
 	signal.Notify(stopAll, syscall.SIGINT, syscall.SIGTERM)
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGHUP)
+	// SIGHUP already stops hyperd and keeps the VMs running, which the
+	// existing deployments rely on, so the config is reloaded on SIGUSR2
+	// rather than on SIGHUP as most daemons do.
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGUSR2)
+	go func() {
+		for range reload {
+			glog.Infof("Got SIGUSR2, reloading config %s", c.ConfigFile)
+			d.ReloadConfig()
+		}
+	}()
 
 	glog.V(0).Infof("Hyper daemon: %s %s", utils.VERSION, utils.GITCOMMIT)
 
@@ -10,9 +10,10 @@ import (
 )
 
 type Buffer struct {
-	goroutinesLimit uint64
-	goroutinesLock  sync.Mutex
-	ch              chan *pod.ContainerBuffer
+	goroutinesMax     uint64
+	goroutinesRunning uint64
+	goroutinesLock    sync.Mutex
+	ch                chan *pod.ContainerBuffer
 }
 
 const (
@@ -28,8 +29,8 @@ func NewBuffer(cfg *apitypes.HyperConfig) *Buffer {
 	}
 
 	daemon := &Buffer{
-		goroutinesLimit: cfg.BufferGoroutinesMax,
-		ch:              make(chan *pod.ContainerBuffer, cfg.BufferChannelSize),
+		goroutinesMax: cfg.BufferGoroutinesMax,
+		ch:            make(chan *pod.ContainerBuffer, cfg.BufferChannelSize),
 	}
 
 	return daemon
@@ -52,10 +53,10 @@ func (b *Buffer) CreateContainerInPod(p *pod.XPod, c *apitypes.UserContainer) (s
 
 	b.goroutinesLock.Lock()
 	defer b.goroutinesLock.Unlock()
-	if b.goroutinesLimit != 0 {
-		b.goroutinesLimit--
+	if b.goroutinesRunning < b.goroutinesMax {
+		b.goroutinesRunning++
 		go b.containerHandler(cb)
-		glog.V(3).Infof("Put %+v to containerHandler, current limit %v", c, b.goroutinesLimit)
+		glog.V(3).Infof("Put %+v to containerHandler, current running %v", c, b.goroutinesRunning)
 	} else {
 		select {
 		case b.ch <- cb:
@@ -85,14 +86,46 @@ loop:
 		}
 
 		b.goroutinesLock.Lock()
+		if b.goroutinesRunning > b.goroutinesMax {
+			// the buffer has been resized to fewer goroutines
+			defer b.goroutinesLock.Unlock()
+			b.goroutinesRunning--
+			break loop
+		}
 		select {
 		case cb = <-b.ch:
 			b.goroutinesLock.Unlock()
 		default:
 			defer b.goroutinesLock.Unlock()
-			b.goroutinesLimit++
+			b.goroutinesRunning--
 			break loop
 		}
 	}
-	glog.V(3).Infof("Channel is empty, current limit %v", b.goroutinesLimit)
+	glog.V(3).Infof("Channel is empty, current running %v", b.goroutinesRunning)
+}
+
+// Resize changes the limits of the buffer at runtime. The running handlers
+// beyond a decreased goroutinesMax exit after they finish their current work,
+// and the channel never shrinks below the number of queued containers.
+func (b *Buffer) Resize(goroutinesMax, channelSize uint64) {
+	if channelSize == 0 {
+		channelSize = DefaultBufferChannelSize
+	}
+
+	b.goroutinesLock.Lock()
+	defer b.goroutinesLock.Unlock()
+
+	b.goroutinesMax = goroutinesMax
+	if uint64(cap(b.ch)) == channelSize {
+		return
+	}
+	if queued := uint64(len(b.ch)); channelSize < queued {
+		channelSize = queued
+	}
+	ch := make(chan *pod.ContainerBuffer, channelSize)
+	for len(b.ch) > 0 {
+		ch <- <-b.ch
+	}
+	b.ch = ch
+	glog.V(1).Infof("Buffer resized to %d goroutines and %d channel size", goroutinesMax, channelSize)
 }
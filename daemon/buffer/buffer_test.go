@@ -0,0 +1,40 @@
+package buffer
+
+import (
+	"testing"
+
+	"github.com/hyperhq/hyperd/daemon/pod"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+func TestResize(t *testing.T) {
+	if NewBuffer(&apitypes.HyperConfig{}) != nil {
+		t.Fatal("buffer enabled without goroutines")
+	}
+	b := NewBuffer(&apitypes.HyperConfig{BufferGoroutinesMax: 2})
+	if cap(b.ch) != DefaultBufferChannelSize {
+		t.Fatalf("unexpected channel size %d", cap(b.ch))
+	}
+
+	queued := []*pod.ContainerBuffer{{Id: "a"}, {Id: "b"}, {Id: "c"}}
+	for _, cb := range queued {
+		b.ch <- cb
+	}
+
+	// the channel does not shrink below the queued containers
+	b.Resize(1, 2)
+	if b.goroutinesMax != 1 || cap(b.ch) != 3 || len(b.ch) != 3 {
+		t.Fatalf("unexpected buffer after shrink: max %d, cap %d, len %d", b.goroutinesMax, cap(b.ch), len(b.ch))
+	}
+
+	b.Resize(4, 0)
+	if b.goroutinesMax != 4 || cap(b.ch) != DefaultBufferChannelSize {
+		t.Fatalf("unexpected buffer after grow: max %d, cap %d", b.goroutinesMax, cap(b.ch))
+	}
+	// the queued containers keep their order
+	for _, cb := range queued {
+		if got := <-b.ch; got != cb {
+			t.Fatalf("expect %s, got %s", cb.Id, got.Id)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package daemon
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/hyperhq/hyperd/daemon/buffer"
+	"github.com/hyperhq/hyperd/storage/luks"
+	apitypes "github.com/hyperhq/hyperd/types"
+
+	"github.com/docker/docker/opts"
+	"github.com/docker/docker/registry"
+	"github.com/golang/glog"
+)
+
+func newRegistryOptions(mirrors, insecureRegistries []string) (*registry.Options, error) {
+	registryOpts := &registry.Options{
+		Mirrors:            opts.NewListOpts(registry.ValidateMirror),
+		InsecureRegistries: opts.NewListOpts(registry.ValidateIndexName),
+	}
+	for _, m := range mirrors {
+		if err := registryOpts.Mirrors.Set(m); err != nil {
+			return nil, err
+		}
+	}
+	for _, ir := range insecureRegistries {
+		if err := registryOpts.InsecureRegistries.Set(ir); err != nil {
+			return nil, err
+		}
+	}
+	return registryOpts, nil
+}
+
+// RegistryOpStart marks a registry operation in flight, the registry config
+// is not replaced by a reload until RegistryOpDone is called. It never waits
+// for a reload or for the other operations.
+func (daemon *Daemon) RegistryOpStart() {
+	daemon.registryLock.Lock()
+	daemon.registryOps++
+	daemon.registryLock.Unlock()
+}
+
+func (daemon *Daemon) RegistryOpDone() {
+	daemon.registryLock.Lock()
+	defer daemon.registryLock.Unlock()
+	daemon.registryOps--
+	if daemon.registryOps == 0 && daemon.registryPending != nil {
+		glog.Infof("the registry operations finished, apply the reloaded registry config")
+		daemon.RegistryService.Config = registry.NewServiceConfig(daemon.registryPending)
+		daemon.registryPending = nil
+	}
+}
+
+// setRegistryOptions replaces the registry config if there is no registry
+// operation in flight, or keeps it until the last one is done. It returns
+// whether the config is replaced at once.
+func (daemon *Daemon) setRegistryOptions(opts *registry.Options) bool {
+	daemon.registryLock.Lock()
+	defer daemon.registryLock.Unlock()
+	if daemon.registryOps > 0 {
+		daemon.registryPending = opts
+		return false
+	}
+	daemon.RegistryService.Config = registry.NewServiceConfig(opts)
+	daemon.registryPending = nil
+	return true
+}
+
+// ReloadConfig parses the config file again and applies the items which could
+// be changed at runtime. The running daemon is left untouched if the file is
+// invalid, and the items need a restart are reported but not applied.
+func (daemon *Daemon) ReloadConfig() (*apitypes.ConfigReloadResponse, error) {
+	daemon.configLock.Lock()
+	defer daemon.configLock.Unlock()
+
+	old := daemon.config
+	// the problems only warned about on start reject a reload, so that an
+	// invalid item is never applied to the running daemon
+	problems, err := apitypes.ValidateConfigFile(old.ConfigFile)
+	if err == nil && len(problems) > 0 {
+		err = fmt.Errorf("invalid config: %v", problems)
+	}
+	if err != nil {
+		glog.Errorf("reload config failed: %v", err)
+		return nil, err
+	}
+	c, err := apitypes.ParseHyperConfig(old.ConfigFile)
+	if err != nil {
+		glog.Errorf("reload config failed: %v", err)
+		return nil, err
+	}
+	if daemon.ConfigOverride != nil {
+		daemon.ConfigOverride(c)
+	}
+
+	// validate everything before applying anything
+	registryOpts, err := newRegistryOptions(c.Mirrors, c.InsecureRegistries)
+	if err != nil {
+		err = fmt.Errorf("invalid registry config: %v", err)
+		glog.Errorf("reload config failed: %v", err)
+		return nil, err
+	}
+
+	resp := &apitypes.ConfigReloadResponse{
+		Applied:         []string{},
+		RestartRequired: old.RestartRequired(c),
+	}
+	applied := func(item string) {
+		resp.Applied = append(resp.Applied, item)
+	}
+
+	if c.DefaultLog != old.DefaultLog || !reflect.DeepEqual(c.DefaultLogOpt, old.DefaultLogOpt) {
+		daemon.initDefaultLog(c)
+		applied("Logger")
+	}
+
+	if !reflect.DeepEqual(c.Mirrors, old.Mirrors) || !reflect.DeepEqual(c.InsecureRegistries, old.InsecureRegistries) {
+		if daemon.Daemon != nil && daemon.RegistryService != nil {
+			if !daemon.setRegistryOptions(registryOpts) {
+				glog.Infof("the registry config is applied after the registry operations in flight")
+			}
+		}
+		applied("RegistryMirror")
+		applied("InsecureRegistry")
+	}
+
+	if c.BufferGoroutinesMax != 0 && c.BufferChannelSize == 0 {
+		c.BufferChannelSize = buffer.DefaultBufferChannelSize
+	}
+	if daemon.buffer != nil && c.BufferGoroutinesMax != 0 &&
+		(c.BufferGoroutinesMax != old.BufferGoroutinesMax || c.BufferChannelSize != old.BufferChannelSize) {
+		daemon.buffer.Resize(c.BufferGoroutinesMax, c.BufferChannelSize)
+		applied("BufferGoroutinesMax")
+		applied("BufferChannelSize")
+	}
+
+	if c.VmFactoryPolicy != old.VmFactoryPolicy {
+		if f, ok := daemon.Factory.(*vmFactory); ok {
+			// the boot config only changes after restart
+			f.replace(daemon.bootConfig, c.VmFactoryPolicy)
+			applied("VmFactoryPolicy")
+		} else {
+			resp.RestartRequired = append(resp.RestartRequired, "VmFactoryPolicy")
+			c.VmFactoryPolicy = old.VmFactoryPolicy
+		}
+	}
+
+	if c.DmPoolWarnThreshold != old.DmPoolWarnThreshold || c.DmPoolFullThreshold != old.DmPoolFullThreshold ||
+		c.DmPoolAutoExtend != old.DmPoolAutoExtend {
+		if daemon.poolMonitor != nil {
+			if err := daemon.poolMonitor.setConfig(c); err != nil {
+				glog.Errorf("failed to apply the pool thresholds: %v", err)
+				c.DmPoolWarnThreshold, c.DmPoolFullThreshold = old.DmPoolWarnThreshold, old.DmPoolFullThreshold
+				c.DmPoolAutoExtend = old.DmPoolAutoExtend
+			}
+		}
+		applied("DmPoolWarnThreshold")
+		applied("DmPoolFullThreshold")
+		applied("DmPoolAutoExtend")
+	}
+
+	if c.VolumeKeyDir != old.VolumeKeyDir {
+		luks.RegisterKeyProvider(luks.DefaultKeyProvider, &luks.FileKeyProvider{Dir: c.VolumeKeyDir})
+		applied("VolumeKeyDir")
+	}
+
+	if !reflect.DeepEqual(c.NamespaceQuotas, old.NamespaceQuotas) {
+		daemon.PodList.SetQuotas(c.NamespaceQuotas)
+		applied("NamespaceQuotas")
+	}
+
+	// the items need restart keep their running values, so that they are
+	// still reported by the next reload
+	c.Root, c.Host, c.GRPCHost = old.Root, old.Host, old.GRPCHost
+	c.StorageDriver, c.StorageBaseSize = old.StorageDriver, old.StorageBaseSize
+	c.RawBlockFs, c.RawBlockFormat = old.RawBlockFs, old.RawBlockFormat
+	c.Driver, c.Kernel, c.Initrd = old.Driver, old.Kernel, old.Initrd
+	c.Bridge, c.BridgeIP = old.Bridge, old.BridgeIP
+	c.DisableIptables, c.EnableVsock, c.GDBTCPPort = old.DisableIptables, old.EnableVsock, old.GDBTCPPort
+	if daemon.buffer == nil || c.BufferGoroutinesMax == 0 {
+		c.BufferGoroutinesMax, c.BufferChannelSize = old.BufferGoroutinesMax, old.BufferChannelSize
+	}
+	daemon.config = c
+
+	glog.Infof("config reloaded, applied: %v, restart required: %v", resp.Applied, resp.RestartRequired)
+	return resp, nil
+}
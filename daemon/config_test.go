@@ -0,0 +1,93 @@
+package daemon
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	docker "github.com/docker/docker/daemon"
+	"github.com/docker/docker/registry"
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/runv/hypervisor"
+)
+
+type fakeFactory struct {
+	closed bool
+}
+
+func (f *fakeFactory) GetVm(cpu, mem int) (*hypervisor.Vm, error) {
+	return nil, nil
+}
+
+func (f *fakeFactory) CloseFactory() {
+	f.closed = true
+}
+
+func TestReloadInvalidConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "config")
+
+	running := &fakeFactory{}
+	f := &vmFactory{Factory: running, pool: make(map[string]*idleVm)}
+	d := &Daemon{
+		Factory: f,
+		config:  &apitypes.HyperConfig{ConfigFile: file, Root: dir},
+	}
+
+	for _, content := range []string{
+		"Root=" + dir + "\nVmFactoryPolicy=[{\"cpu\": 1}]\n",
+		"Root=" + dir + "\nVmFactoryPolicy=none\nBridgeIP=10.0.0.1\n",
+		"Root=" + dir + "\nVmFactoryPolicy=none\nUnknownKey=1\n",
+	} {
+		if err := ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := d.ReloadConfig(); err == nil {
+			t.Fatalf("reloaded the invalid config:\n%s", content)
+		}
+		if f.Factory != running || running.closed || d.config.VmFactoryPolicy != "" {
+			t.Fatalf("the running daemon is changed by the invalid config:\n%s", content)
+		}
+	}
+}
+
+func TestRegistryOptions(t *testing.T) {
+	opts, err := newRegistryOptions(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Daemon{Daemon: &docker.Daemon{RegistryService: registry.NewService(opts)}}
+	origin := d.RegistryService.Config
+	opts, err = newRegistryOptions([]string{"https://mirror.example.com"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the registry operations do not wait for each other or for the reload
+	d.RegistryOpStart()
+	d.RegistryOpStart()
+	if d.setRegistryOptions(opts) || d.RegistryService.Config != origin {
+		t.Fatal("the registry config is replaced during a registry operation")
+	}
+	d.RegistryOpDone()
+	if d.RegistryService.Config != origin {
+		t.Fatal("the registry config is replaced during a registry operation")
+	}
+	d.RegistryOpDone()
+	if c := d.RegistryService.Config; len(c.Mirrors) != 1 || d.registryPending != nil {
+		t.Fatalf("the pending registry config is not applied: %v", c)
+	}
+
+	opts, err = newRegistryOptions(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !d.setRegistryOptions(opts) || len(d.RegistryService.Config.Mirrors) != 0 {
+		t.Fatal("the registry config is not replaced at once")
+	}
+}
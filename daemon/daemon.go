@@ -6,11 +6,13 @@ import (
 	"os"
 	"path"
 	"strings"
+	"sync"
 
 	"github.com/hyperhq/hyperd/daemon/buffer"
 	"github.com/hyperhq/hyperd/daemon/daemondb"
 	"github.com/hyperhq/hyperd/daemon/pod"
 	"github.com/hyperhq/hyperd/networking/portmapping"
+	"github.com/hyperhq/hyperd/storage/luks"
 	apitypes "github.com/hyperhq/hyperd/types"
 	"github.com/hyperhq/hyperd/utils"
 	"github.com/hyperhq/runv/driverloader"
@@ -43,7 +45,27 @@ type Daemon struct {
 	Hypervisor string
 	DefaultLog *pod.GlobalLogConfig
 
-	buffer *buffer.Buffer
+	// ConfigOverride applies the command line options on the config, it is
+	// called again when the config is reloaded.
+	ConfigOverride func(*apitypes.HyperConfig)
+
+	buffer     *buffer.Buffer
+	bootConfig hypervisor.BootConfig
+	config     *apitypes.HyperConfig
+	configLock sync.Mutex
+	volumeLock sync.Mutex
+	// volumeHolds counts the exports of the named volumes, or is -1 if the
+	// volume is being imported, guarded by volumeLock
+	volumeHolds map[string]int
+	// registryOps counts the registry operations in flight, the registry
+	// config of a reload is kept in registryPending until there is none, so
+	// that it is not replaced during a pull or push. Both are guarded by
+	// registryLock, which is never held across the network I/O.
+	registryLock    sync.Mutex
+	registryOps     int
+	registryPending *registry.Options
+
+	poolMonitor *poolMonitor
 }
 
 func (daemon *Daemon) Restore() error {
@@ -107,10 +129,7 @@ func NewDaemon(cfg *apitypes.HyperConfig) (*Daemon, error) {
 		return nil, err
 	}
 
-	var (
-		db_file = fmt.Sprintf("%s/hyper.db", realRoot)
-	)
-	db, err := daemondb.NewDaemonDB(db_file)
+	db, err := daemondb.NewDaemonDB(DBFile())
 	if err != nil {
 		return nil, err
 	}
@@ -121,6 +140,7 @@ func NewDaemon(cfg *apitypes.HyperConfig) (*Daemon, error) {
 		PodList: pod.NewPodList(),
 		Host:    cfg.Host,
 		buffer:  buffer.NewBuffer(cfg),
+		config:  cfg,
 	}
 
 	daemon.Daemon, err = docker.NewDaemon(dockerCfg, registryCfg)
@@ -139,6 +159,14 @@ func NewDaemon(cfg *apitypes.HyperConfig) (*Daemon, error) {
 	}
 	daemon.Storage = stor
 	daemon.Storage.Init(cfg)
+	if dms, ok := daemon.Storage.(*DevMapperStorage); ok {
+		daemon.poolMonitor, err = newPoolMonitor(dms, cfg, daemon.PodList.NotifyStoragePool)
+		if err != nil {
+			return nil, err
+		}
+		go daemon.poolMonitor.run()
+	}
+	luks.RegisterKeyProvider(luks.DefaultKeyProvider, &luks.FileKeyProvider{Dir: cfg.VolumeKeyDir})
 
 	err = daemon.initRunV(cfg)
 	if err != nil {
@@ -151,6 +179,7 @@ func NewDaemon(cfg *apitypes.HyperConfig) (*Daemon, error) {
 	}
 
 	daemon.initDefaultLog(cfg)
+	daemon.PodList.SetQuotas(cfg.NamespaceQuotas)
 
 	return daemon, nil
 }
@@ -163,7 +192,7 @@ var (
 func presentInHelp(usage string) string { return usage }
 func absentFromHelp(string) string      { return "" }
 
-func InitDockerCfg(mirrors []string, insecureRegistries []string, graphdriver, basesize, root string) {
+func InitDockerCfg(mirrors []string, insecureRegistries []string, graphdriver, basesize, blockFs, blockFormat, root string) {
 	if dockerCfg.LogConfig.Config == nil {
 		dockerCfg.LogConfig.Config = make(map[string]string)
 	}
@@ -183,6 +212,14 @@ func InitDockerCfg(mirrors []string, insecureRegistries []string, graphdriver, b
 			dockerCfg.GraphOptions = append(dockerCfg.GraphOptions, fmt.Sprintf("rawblock.basesize=%s", basesize))
 		}
 	}
+	if graphdriver == "rawblock" {
+		if blockFs != "" {
+			dockerCfg.GraphOptions = append(dockerCfg.GraphOptions, fmt.Sprintf("rawblock.fs=%s", blockFs))
+		}
+		if blockFormat != "" {
+			dockerCfg.GraphOptions = append(dockerCfg.GraphOptions, fmt.Sprintf("rawblock.format=%s", blockFormat))
+		}
+	}
 
 	// disable docker network
 	flags.Set("-bridge", "none")
@@ -227,13 +264,13 @@ func (daemon *Daemon) initRunV(c *apitypes.HyperConfig) error {
 
 	daemon.Hypervisor = c.Driver
 	glog.Infof("The hypervisor's driver is %s", c.Driver)
-	bootConfig := hypervisor.BootConfig{
+	daemon.bootConfig = hypervisor.BootConfig{
 		Kernel:      c.Kernel,
 		Initrd:      c.Initrd,
 		EnableVsock: c.EnableVsock,
 		GDBTCPPort:  c.GDBTCPPort,
 	}
-	daemon.Factory = factory.NewFromPolicy(bootConfig, c.VmFactoryPolicy)
+	daemon.Factory = newVmFactory(daemon.bootConfig, c.VmFactoryPolicy)
 
 	return nil
 }
@@ -267,9 +304,14 @@ func (daemon *Daemon) initNetworks(c *apitypes.HyperConfig) error {
 func (daemon *Daemon) initDefaultLog(c *apitypes.HyperConfig) {
 	var (
 		driver = c.DefaultLog
-		cfg    = c.DefaultLogOpt
+		cfg    = make(map[string]string, len(c.DefaultLogOpt))
 	)
 
+	// keep the options in config intact, they are compared on reload
+	for k, v := range c.DefaultLogOpt {
+		cfg[k] = v
+	}
+
 	if driver == "" {
 		driver = jsonfilelog.Name
 	}
@@ -319,7 +361,7 @@ func (daemon *Daemon) DeleteVolumeId(podId string) error {
 		return err
 	}
 	for _, vol := range vols {
-		daemon.Storage.RemoveVolume(podId, vol)
+		daemon.removeVolumeRecord(podId, vol)
 	}
 	return daemon.db.DeletePodVolumes(podId)
 }
@@ -368,7 +410,15 @@ func (daemon *Daemon) DestroyAllVm() error {
 	return nil
 }
 
+// DestroyAndKeepVm releases the VMs of the pods, which are associated again on
+// restore. The idle VMs belong to no pods and could not be restored, so they
+// are killed.
 func (daemon *Daemon) DestroyAndKeepVm() error {
+	if f := daemon.idleVms(); f != nil {
+		if ids := f.destroyIdle(); len(ids) > 0 {
+			glog.Infof("the idle vms %v are not kept across restart, killed", ids)
+		}
+	}
 	err := daemon.ReleaseAllVms()
 	if err != nil {
 		return err
@@ -396,6 +446,9 @@ func (daemon *Daemon) Shutdown() error {
 	glog.V(0).Info("Shutdown all VMs")
 
 	daemon.Factory.CloseFactory()
+	if daemon.poolMonitor != nil {
+		daemon.poolMonitor.Stop()
+	}
 	daemon.db.Close()
 	glog.Flush()
 	return nil
@@ -0,0 +1,114 @@
+package daemonbuilder
+
+import (
+	"errors"
+	"io"
+
+	"github.com/docker/docker/builder"
+	"github.com/docker/docker/builder/dockerfile"
+	"github.com/docker/docker/pkg/archive"
+	"github.com/docker/docker/pkg/chrootarchive"
+	"github.com/docker/docker/pkg/streamformatter"
+	"github.com/docker/docker/reference"
+	"github.com/docker/engine-api/types"
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/daemon"
+)
+
+// SanitizeRepoAndTags parses the names of a build to a slice of repoAndTag.
+// It also validates each repoName and tag.
+func SanitizeRepoAndTags(names []string) ([]reference.Named, error) {
+	var (
+		repoAndTags []reference.Named
+		// This map is used for deduplicating the "-t" parameter.
+		uniqNames = make(map[string]struct{})
+	)
+	for _, repo := range names {
+		if repo == "" {
+			continue
+		}
+
+		ref, err := reference.ParseNamed(repo)
+		if err != nil {
+			return nil, err
+		}
+
+		ref = reference.WithDefaultTag(ref)
+
+		if _, isCanonical := ref.(reference.Canonical); isCanonical {
+			return nil, errors.New("build tag cannot contain a digest")
+		}
+
+		if _, isTagged := ref.(reference.NamedTagged); !isTagged {
+			ref, err = reference.WithTag(ref, reference.DefaultTag)
+		}
+
+		nameWithTag := ref.String()
+
+		if _, exists := uniqNames[nameWithTag]; !exists {
+			uniqNames[nameWithTag] = struct{}{}
+			repoAndTags = append(repoAndTags, ref)
+		}
+	}
+	return repoAndTags, nil
+}
+
+// Build builds an image from the Dockerfile in the build context, and tags
+// the image with repoAndTags. The progress of the build is written to out
+// as a JSON stream, and the build is cancelled once cancel is closed.
+func Build(d *daemon.Daemon, options *types.ImageBuildOptions, context builder.ModifiableContext, repoAndTags []reference.Named, authConfigs map[string]types.AuthConfig, out io.Writer, cancel <-chan struct{}) (string, error) {
+	sf := streamformatter.NewJSONStreamFormatter()
+
+	uidMaps, gidMaps := d.GetUIDGIDMaps()
+	defaultArchiver := &archive.Archiver{
+		Untar:   chrootarchive.Untar,
+		UIDMaps: uidMaps,
+		GIDMaps: gidMaps,
+	}
+
+	docker := &Docker{
+		Daemon:      d,
+		OutOld:      out,
+		AuthConfigs: authConfigs,
+		Archiver:    defaultArchiver,
+	}
+
+	docker.InitHyper()
+	defer docker.Cleanup()
+
+	b, err := dockerfile.NewBuilder(
+		options, // result of newBuildConfig
+		docker,
+		builder.DockerIgnoreContext{ModifiableContext: context},
+		nil)
+	if err != nil {
+		return "", err
+	}
+	b.Stdout = &streamformatter.StdoutFormatter{Writer: out, StreamFormatter: sf}
+	b.Stderr = &streamformatter.StderrFormatter{Writer: out, StreamFormatter: sf}
+
+	if cancel != nil {
+		finished := make(chan struct{})
+		defer close(finished)
+		go func() {
+			select {
+			case <-finished:
+			case <-cancel:
+				glog.Infof("Client disconnected, cancelling job: build")
+				b.Cancel()
+			}
+		}()
+	}
+
+	imgID, err := b.Build()
+	if err != nil {
+		return "", err
+	}
+
+	for _, rt := range repoAndTags {
+		if err := d.TagImage(rt, imgID); err != nil {
+			return "", err
+		}
+	}
+	return imgID, nil
+}
@@ -53,6 +53,9 @@ func (d Docker) Pull(name string) (builder.Image, error) {
 	}
 	ref = reference.WithDefaultTag(ref)
 
+	d.Daemon.RegistryOpStart()
+	defer d.Daemon.RegistryOpDone()
+
 	pullRegistryAuth := &types.AuthConfig{}
 	if len(d.AuthConfigs) > 0 {
 		// The request came with a full auth config file, we prefer to use that
@@ -0,0 +1,122 @@
+package daemondb
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// the number of records written to the new db at a time
+const copyBatchSize = 1024
+
+// Backup copies a consistent snapshot of the db to a new db at path, and
+// returns the number of records copied. The db could be backed up while the
+// daemon is running.
+func (d *DaemonDB) Backup(path string) (int, error) {
+	snap, err := d.db.GetSnapshot()
+	if err != nil {
+		return 0, err
+	}
+	defer snap.Release()
+
+	iter := snap.NewIterator(nil, nil)
+	defer iter.Release()
+	return copyDB(iter, path)
+}
+
+// Restore replaces the db at dbFile with the backup, and returns the path the
+// replaced db is moved to, which is empty if there was no db. The db must not
+// be in use, i.e. hyperd must be stopped.
+func Restore(backup, dbFile string) (string, error) {
+	src, err := leveldb.OpenFile(backup, &opt.Options{ErrorIfMissing: true, ReadOnly: true})
+	if err != nil {
+		return "", fmt.Errorf("failed to open backup %s: %v", backup, err)
+	}
+	defer src.Close()
+
+	var aside string
+	if _, err := os.Stat(dbFile); err == nil {
+		// the db is locked by the running hyperd
+		cur, err := leveldb.OpenFile(dbFile, &opt.Options{ErrorIfMissing: true})
+		if err != nil {
+			return "", fmt.Errorf("failed to open %s, is hyperd still running? %v", dbFile, err)
+		}
+		cur.Close()
+
+		aside = fmt.Sprintf("%s.before-restore-%s", dbFile, time.Now().Format("20060102150405"))
+		if err := os.Rename(dbFile, aside); err != nil {
+			return "", err
+		}
+	} else if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	iter := src.NewIterator(nil, nil)
+	defer iter.Release()
+	n, err := copyDB(iter, dbFile)
+	if err != nil {
+		if aside != "" {
+			os.Rename(aside, dbFile)
+		}
+		return "", err
+	}
+	glog.Infof("restored %d records from %s to %s", n, backup, dbFile)
+	return aside, nil
+}
+
+// copyDB writes the records of iter to a new db at path, the path is removed
+// if the copy fails.
+func copyDB(iter iterator.Iterator, path string) (int, error) {
+	if _, err := os.Stat(path); err == nil {
+		return 0, fmt.Errorf("%s already exists", path)
+	} else if !os.IsNotExist(err) {
+		return 0, err
+	}
+
+	dst, err := leveldb.OpenFile(path, &opt.Options{ErrorIfExist: true})
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := copyRecords(iter, dst)
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		glog.Errorf("failed to copy db to %s: %v", path, err)
+		os.RemoveAll(path)
+		return 0, err
+	}
+	return n, nil
+}
+
+func copyRecords(iter iterator.Iterator, dst *leveldb.DB) (int, error) {
+	var (
+		n     int
+		batch = new(leveldb.Batch)
+	)
+	for iter.Next() {
+		batch.Put(iter.Key(), iter.Value())
+		n++
+		if batch.Len() >= copyBatchSize {
+			if err := dst.Write(batch, nil); err != nil {
+				return 0, err
+			}
+			batch.Reset()
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return 0, err
+	}
+	if batch.Len() > 0 {
+		if err := dst.Write(batch, nil); err != nil {
+			return 0, err
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,70 @@
+package daemondb
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBackupRestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "daemondb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	dbFile := filepath.Join(dir, "hyper.db")
+	db, err := NewDaemonDB(dbFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < copyBatchSize+10; i++ {
+		if err := db.Update([]byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("value-%d", i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	backup := filepath.Join(dir, "backup.db")
+	n, err := db.Backup(backup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != copyBatchSize+10 {
+		t.Fatalf("expect %d records backed up, got %d", copyBatchSize+10, n)
+	}
+	if _, err := db.Backup(backup); err == nil {
+		t.Fatal("backup to an existing path should fail")
+	}
+
+	if err := db.Update([]byte("key-0"), []byte("changed")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Restore(backup, dbFile); err == nil {
+		t.Fatal("restore should fail while the db is in use")
+	}
+	db.Close()
+
+	aside, err := Restore(backup, dbFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(aside); err != nil {
+		t.Fatalf("the replaced db is not kept: %v", err)
+	}
+
+	db, err = NewDaemonDB(dbFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	v, err := db.GetString([]byte("key-0"))
+	if err != nil || v != "value-0" {
+		t.Fatalf("expect the restored value-0, got %q, %v", v, err)
+	}
+	keys, err := db.PrefixListKey([]byte("key-"), nil)
+	if err != nil || len(keys) != copyBatchSize+10 {
+		t.Fatalf("expect %d restored records, got %d, %v", copyBatchSize+10, len(keys), err)
+	}
+}
@@ -81,10 +81,67 @@ func (d *DaemonDB) DeletePodVolume(podId, volName string) error {
 	return d.db.Delete(keyVolume(podId, volName), nil)
 }
 
+// ListAllPodVolumes returns the volume records of all the pods, keyed by the
+// db keys, which are in the format "vol-<pod>-<volume>".
+func (d *DaemonDB) ListAllPodVolumes() (map[string][]byte, error) {
+	results := make(map[string][]byte)
+	iter := d.db.NewIterator(util.BytesPrefix([]byte(POD_VOLUME_PREFIX[:4])), nil)
+	for iter.Next() {
+		results[string(iter.Key())] = append([]byte{}, iter.Value()...)
+	}
+	iter.Release()
+	return results, iter.Error()
+}
+
 func (d *DaemonDB) DeletePodVolumes(podId string) error {
 	return d.PrefixDelete(prefixVolume(podId))
 }
 
+// Named Volumes
+func (d *DaemonDB) UpdateNamedVolume(name string, data []byte) error {
+	return d.Update(keyNamedVolume(name), data)
+}
+
+func (d *DaemonDB) GetNamedVolume(name string) ([]byte, error) {
+	return d.db.Get(keyNamedVolume(name), nil)
+}
+
+func (d *DaemonDB) ListNamedVolumes() ([][]byte, error) {
+	return d.PrefixList(prefixNamedVolume(), nil)
+}
+
+func (d *DaemonDB) DeleteNamedVolume(name string) error {
+	return d.db.Delete(keyNamedVolume(name), nil)
+}
+
+func (d *DaemonDB) UpdateVolumeSnapshot(name string, data []byte) error {
+	return d.Update(keyVolumeSnapshot(name), data)
+}
+
+func (d *DaemonDB) GetVolumeSnapshot(name string) ([]byte, error) {
+	return d.db.Get(keyVolumeSnapshot(name), nil)
+}
+
+func (d *DaemonDB) ListVolumeSnapshots() ([][]byte, error) {
+	return d.PrefixList(prefixVolumeSnapshot(), nil)
+}
+
+func (d *DaemonDB) DeleteVolumeSnapshot(name string) error {
+	return d.db.Delete(keyVolumeSnapshot(name), nil)
+}
+
+func (d *DaemonDB) UpdateVolumeClone(device string, data []byte) error {
+	return d.Update(keyVolumeClone(device), data)
+}
+
+func (d *DaemonDB) GetVolumeClone(device string) ([]byte, error) {
+	return d.db.Get(keyVolumeClone(device), nil)
+}
+
+func (d *DaemonDB) DeleteVolumeClone(device string) error {
+	return d.db.Delete(keyVolumeClone(device), nil)
+}
+
 // POD to Containers (string to string list)
 func (d *DaemonDB) LagecyGetP2C(id string) ([]string, error) {
 	glog.V(3).Info("try get container list for pod ", id)
@@ -182,6 +239,33 @@ func (d *DaemonDB) Update(key, data []byte) error {
 	return nil
 }
 
+// Batch collects the puts and deletes which are applied to the db at once by
+// DaemonDB.Write, i.e. either all or none of them are persisted.
+type Batch struct {
+	batch *leveldb.Batch
+}
+
+func (d *DaemonDB) NewBatch() *Batch {
+	return &Batch{batch: new(leveldb.Batch)}
+}
+
+func (b *Batch) Put(key, data []byte) {
+	b.batch.Put(key, data)
+}
+
+func (b *Batch) Delete(key []byte) {
+	b.batch.Delete(key)
+}
+
+// Len returns the number of the puts and deletes in the batch
+func (b *Batch) Len() int {
+	return b.batch.Len()
+}
+
+func (d *DaemonDB) Write(b *Batch) error {
+	return d.db.Write(b.batch, nil)
+}
+
 func (d *DaemonDB) PrefixDelete(prefix []byte) error {
 	iter := d.db.NewIterator(util.BytesPrefix(prefix), nil)
 	for iter.Next() {
@@ -0,0 +1,49 @@
+package daemondb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "daemondb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	db, err := NewDaemonDB(filepath.Join(dir, "hyper.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if err := db.Update([]byte("key-old"), []byte("old")); err != nil {
+		t.Fatal(err)
+	}
+
+	b := db.NewBatch()
+	b.Put([]byte("key-1"), []byte("1"))
+	b.Put([]byte("key-2"), []byte("2"))
+	b.Delete([]byte("key-old"))
+	if b.Len() != 3 {
+		t.Fatalf("expect 3 writes in the batch, got %d", b.Len())
+	}
+	if _, err := db.Get([]byte("key-1")); err == nil {
+		t.Fatal("the batch is written before Write")
+	}
+
+	if err := db.Write(b); err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range map[string]string{"key-1": "1", "key-2": "2"} {
+		if data, err := db.GetString([]byte(k)); err != nil || data != v {
+			t.Fatalf("expect %s of %s, got %q, %v", v, k, data, err)
+		}
+	}
+	if _, err := db.Get([]byte("key-old")); err == nil {
+		t.Fatal("key-old is not deleted by the batch")
+	}
+}
@@ -5,16 +5,21 @@ import (
 )
 
 const (
-	VM_KEY            = "vmdata-%s"
-	POD_KEY           = "pod-%s"
-	POD_VM_KEY        = "vm-%s"
-	POD_CONTAINER_KEY = "pod-container-%s"
-	POD_VOLUME_KEY    = "vol-%s-%s"
-
-	POD_PREFIX           = "pod-"
-	POD_CONTAINER_PREFIX = "pod-container-"
-	POD_VOLUME_PREFIX    = "vol-%s"
-	POD_VM_PREFIX        = "vm-"
+	VM_KEY              = "vmdata-%s"
+	POD_KEY             = "pod-%s"
+	POD_VM_KEY          = "vm-%s"
+	POD_CONTAINER_KEY   = "pod-container-%s"
+	POD_VOLUME_KEY      = "vol-%s-%s"
+	NAMED_VOLUME_KEY    = "named-volume-%s"
+	VOLUME_SNAPSHOT_KEY = "volume-snapshot-%s"
+	VOLUME_CLONE_KEY    = "volume-clone-%s"
+
+	POD_PREFIX             = "pod-"
+	POD_CONTAINER_PREFIX   = "pod-container-"
+	POD_VOLUME_PREFIX      = "vol-%s"
+	POD_VM_PREFIX          = "vm-"
+	NAMED_VOLUME_PREFIX    = "named-volume-"
+	VOLUME_SNAPSHOT_PREFIX = "volume-snapshot-"
 )
 
 //the id is a vm id
@@ -55,3 +60,29 @@ func prefixP2V() []byte {
 func prefixVolume(podId string) []byte {
 	return []byte(fmt.Sprintf(POD_VOLUME_PREFIX, podId))
 }
+
+// the name is a named volume, which does not belong to any pod
+// and the content is the volume info
+func keyNamedVolume(name string) []byte {
+	return []byte(fmt.Sprintf(NAMED_VOLUME_KEY, name))
+}
+
+func prefixNamedVolume() []byte {
+	return []byte(NAMED_VOLUME_PREFIX)
+}
+
+// the name is a volume snapshot
+// and the content is the snapshot info
+func keyVolumeSnapshot(name string) []byte {
+	return []byte(fmt.Sprintf(VOLUME_SNAPSHOT_KEY, name))
+}
+
+func prefixVolumeSnapshot() []byte {
+	return []byte(VOLUME_SNAPSHOT_PREFIX)
+}
+
+// the device is a volume cloned from a snapshot, which is not yet taken by its
+// pod, and the content is the snapshot name
+func keyVolumeClone(device string) []byte {
+	return []byte(fmt.Sprintf(VOLUME_CLONE_KEY, device))
+}
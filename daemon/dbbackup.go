@@ -0,0 +1,31 @@
+package daemon
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/utils"
+)
+
+// DBFile returns the path of the daemon db under the hyperd root.
+func DBFile() string {
+	return path.Join(utils.HYPER_ROOT, "lib", "hyper.db")
+}
+
+// BackupDB copies a consistent snapshot of the daemon db to a new db at the
+// absolute path on the host, the backup could be restored offline with
+// `hyperd --db-restore`.
+func (daemon *Daemon) BackupDB(backup string) (int, error) {
+	if !filepath.IsAbs(backup) {
+		return 0, fmt.Errorf("the backup path %s is not absolute", backup)
+	}
+	n, err := daemon.db.Backup(backup)
+	if err != nil {
+		glog.Errorf("failed to back up the db to %s: %v", backup, err)
+		return 0, err
+	}
+	glog.Infof("backed up %d records of the db to %s", n, backup)
+	return n, nil
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/daemon/pod"
 )
 
 func (daemon *Daemon) ExitCode(containerId, execId string) (int, error) {
@@ -63,7 +64,13 @@ func (daemon *Daemon) ExecVM(podID, cmd string, stdin io.ReadCloser, stdout, std
 	glog.V(3).Infof("Starting ExecVM for pod %s", podID)
 	p, ok := daemon.PodList.Get(podID)
 	if !ok {
-		err := fmt.Errorf("cannot find pod %s", podID)
+		// the command could also be executed in an idle vm
+		if f := daemon.idleVms(); f != nil {
+			if vm := f.getIdle(podID); vm != nil {
+				return pod.ExecSandbox(vm, cmd, stdin, stdout, stderr)
+			}
+		}
+		err := fmt.Errorf("cannot find pod or vm %s", podID)
 		glog.Error(err)
 		return -1, err
 	}
@@ -0,0 +1,349 @@
+package daemon
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperhq/hyperd/daemon/pod"
+	"github.com/hyperhq/hyperd/storage"
+	dm "github.com/hyperhq/hyperd/storage/devicemapper"
+	"github.com/hyperhq/hyperd/storage/graphdriver/rawblock"
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/hyperd/utils"
+)
+
+// vfsVolumeRoot is the parent directory of the vfs volumes, see
+// storage.VFSVolumePath().
+const vfsVolumeRoot = "/var/tmp/hyper"
+
+// gcState is what the storage is expected to have, according to the db.
+type gcState struct {
+	pods    map[string]bool
+	volumes map[string]bool // names and sources of the named volumes
+	dryRun  bool
+	orphans []*apitypes.StorageOrphan
+}
+
+func (st *gcState) known(podId string) bool {
+	return podId == namedVolumeOwner || st.pods[podId]
+}
+
+// ownedBy returns whether name is "<pod>-<suffix>" of a known pod.
+func (st *gcState) ownedBy(name string) bool {
+	if strings.HasPrefix(name, namedVolumeOwner+"-") {
+		return st.volumes[strings.TrimPrefix(name, namedVolumeOwner+"-")]
+	}
+	for p := range st.pods {
+		if strings.HasPrefix(name, p+"-") {
+			return true
+		}
+	}
+	return false
+}
+
+func (st *gcState) collect(kind, path, podId string, remove func() error) {
+	orphan := &apitypes.StorageOrphan{
+		Kind: kind,
+		Path: path,
+		Pod:  podId,
+	}
+	st.orphans = append(st.orphans, orphan)
+	if st.dryRun {
+		glog.Infof("found orphan %s %s", kind, path)
+		return
+	}
+	if err := remove(); err != nil {
+		glog.Errorf("failed to remove orphan %s %s: %v", kind, path, err)
+		orphan.Error = err.Error()
+		return
+	}
+	glog.Infof("removed orphan %s %s", kind, path)
+	orphan.Removed = true
+}
+
+// StorageGC compares the storage and the host with the pods in the db, and
+// removes what is left by the pods which no longer exist, e.g. when hyperd
+// crashed during the removal of a pod. Nothing is removed if dryRun is set.
+func (daemon *Daemon) StorageGC(dryRun bool) ([]*apitypes.StorageOrphan, error) {
+	return daemon.storageGC(dryRun, false)
+}
+
+// ReconcileStorage is called once the pods are restored. It also unmounts the
+// rawblock images, which are only mounted temporarily by the running hyperd.
+func (daemon *Daemon) ReconcileStorage() {
+	orphans, err := daemon.storageGC(false, true)
+	if err != nil {
+		glog.Errorf("failed to reconcile the storage: %v", err)
+		return
+	}
+	if len(orphans) > 0 {
+		glog.Infof("%d orphans found in the storage", len(orphans))
+	}
+}
+
+func (daemon *Daemon) storageGC(dryRun, startup bool) ([]*apitypes.StorageOrphan, error) {
+	daemon.volumeLock.Lock()
+	defer daemon.volumeLock.Unlock()
+
+	st, err := daemon.gcState(dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := daemon.gcVolumeRecords(st); err != nil {
+		return nil, err
+	}
+	switch s := daemon.Storage.(type) {
+	case *DevMapperStorage:
+		err = daemon.gcDevMapper(st, s)
+	case *RawBlockStorage:
+		err = gcRawBlock(st, s, startup)
+	}
+	if err != nil {
+		return nil, err
+	}
+	gcVFSVolumes(st)
+	gcHosts(st)
+
+	return st.orphans, nil
+}
+
+func (daemon *Daemon) gcState(dryRun bool) (*gcState, error) {
+	st := &gcState{
+		pods:    make(map[string]bool),
+		volumes: make(map[string]bool),
+		dryRun:  dryRun,
+		orphans: []*apitypes.StorageOrphan{},
+	}
+
+	keys, err := pod.ListAllPods(daemon.db)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range keys {
+		st.pods[strings.TrimPrefix(string(k), pod.LAYOUT_KEY_PREFIX)] = true
+	}
+	// the pods being created are not in the db yet
+	daemon.PodList.Foreach(func(p *pod.XPod) error {
+		st.pods[p.Id()] = true
+		return nil
+	})
+
+	data, err := daemon.db.ListNamedVolumes()
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range data {
+		var vol apitypes.VolumeInfo
+		if err := proto.Unmarshal(d, &vol); err != nil {
+			glog.Errorf("failed to unpack volume info: %v", err)
+			continue
+		}
+		st.volumes[vol.Name] = true
+		if vol.Spec != nil && vol.Spec.Source != "" {
+			st.volumes[vol.Spec.Source] = true
+			st.volumes[filepath.Base(vol.Spec.Source)] = true
+		}
+	}
+	return st, nil
+}
+
+// gcVolumeRecords removes the volumes recorded by the storage driver for the
+// pods which no longer exist.
+func (daemon *Daemon) gcVolumeRecords(st *gcState) error {
+	records, err := daemon.db.ListAllPodVolumes()
+	if err != nil {
+		return err
+	}
+	for key, record := range records {
+		name := strings.TrimPrefix(key, "vol-")
+
+		key := key
+		if driver, vol, ok := pod.ParsePluginVolumeRecord(record); ok {
+			if st.ownedBy(name) {
+				continue
+			}
+			st.collect("plugin-volume", driver+":"+vol, "", func() error {
+				if err := pod.RemovePluginVolume(driver, vol); err != nil {
+					return err
+				}
+				return daemon.db.Delete([]byte(key))
+			})
+			continue
+		}
+
+		// only the devicemapper records tell the pod, as "<device>:<id>"
+		podId := ""
+		fields := strings.SplitN(string(record), ":", 2)
+		if len(fields) == 2 {
+			podId = strings.TrimSuffix(name, "-"+fields[0])
+			owned := st.pods[podId]
+			if podId == namedVolumeOwner {
+				owned = st.ownedBy(name)
+			}
+			if owned {
+				continue
+			}
+			// the clones are created before their pods
+			if _, err := daemon.db.GetVolumeClone(fields[0]); err == nil {
+				continue
+			}
+		} else if st.ownedBy(name) {
+			continue
+		}
+
+		st.collect("volume-record", string(record), podId, func() error {
+			if len(fields) == 2 {
+				if err := dm.UnmapVolume(filepath.Join("/dev/mapper", fields[0])); err != nil {
+					return err
+				}
+			}
+			if err := daemon.Storage.RemoveVolume(podId, record); err != nil {
+				return err
+			}
+			return daemon.db.Delete([]byte(key))
+		})
+	}
+	return nil
+}
+
+// gcDevMapper unmaps the active thin devices of the volume pool which are not
+// recorded in the db.
+func (daemon *Daemon) gcDevMapper(st *gcState, dms *DevMapperStorage) error {
+	dms.deviceLock.Lock()
+	defer dms.deviceLock.Unlock()
+
+	devices, err := dm.ListVolumes(dms.VolPoolName)
+	if err != nil {
+		return err
+	}
+	records, err := daemon.db.ListAllPodVolumes()
+	if err != nil {
+		return err
+	}
+	recorded := make(map[string]bool)
+	for _, record := range records {
+		recorded[strings.SplitN(string(record), ":", 2)[0]] = true
+	}
+	for _, dev := range devices {
+		if recorded[dev] {
+			continue
+		}
+		path := filepath.Join("/dev/mapper", dev)
+		st.collect("dm-device", path, "", func() error {
+			return dm.UnmapVolume(path)
+		})
+	}
+	return nil
+}
+
+// gcRawBlock removes the volume blocks of the pods which no longer exist. The
+// images mounted by the graph driver are unmounted at startup as well.
+func gcRawBlock(st *gcState, s *RawBlockStorage, startup bool) error {
+	dir := filepath.Join(s.RootPath(), "volumes")
+	files, err := ioutil.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for _, f := range files {
+		if st.ownedBy(f.Name()) {
+			continue
+		}
+		path := filepath.Join(dir, f.Name())
+		st.collect("rawblock-volume", path, "", func() error {
+			return os.Remove(path)
+		})
+	}
+
+	if !startup {
+		return nil
+	}
+	mntBase := filepath.Join(s.RootPath(), "mnt")
+	mounts, err := storage.MountPointsUnder(mntBase)
+	if err != nil {
+		return err
+	}
+	for _, mnt := range mounts {
+		id := strings.TrimPrefix(mnt, mntBase+"/")
+		st.collect("rawblock-mount", mnt, "", func() error {
+			return rawblock.PutImage(mntBase, id)
+		})
+	}
+	return nil
+}
+
+// gcVFSVolumes removes the vfs volumes of the pods which no longer exist, and
+// the named vfs volumes which are not in the db.
+func gcVFSVolumes(st *gcState) {
+	dirs, err := ioutil.ReadDir(vfsVolumeRoot)
+	if err != nil {
+		return
+	}
+	for _, d := range dirs {
+		if !d.IsDir() {
+			continue
+		}
+		podId := d.Name()
+		podDir := filepath.Join(vfsVolumeRoot, podId)
+		if podId == namedVolumeOwner {
+			vols, err := ioutil.ReadDir(podDir)
+			if err != nil {
+				continue
+			}
+			for _, v := range vols {
+				path := filepath.Join(podDir, v.Name())
+				// the loop images are removed along with their directories
+				if !v.IsDir() || st.volumes[path] {
+					continue
+				}
+				st.collect("vfs-volume", path, "", func() error {
+					return storage.RemoveVFSVolume(path)
+				})
+			}
+			continue
+		}
+		if st.known(podId) {
+			continue
+		}
+		st.collect("vfs-volume", podDir, podId, func() error {
+			if vols, err := ioutil.ReadDir(podDir); err == nil {
+				for _, v := range vols {
+					if v.IsDir() {
+						if err := storage.RemoveVFSVolume(filepath.Join(podDir, v.Name())); err != nil {
+							return err
+						}
+					}
+				}
+			}
+			return os.RemoveAll(podDir)
+		})
+	}
+}
+
+// gcHosts removes the hosts directories of the pods which no longer exist,
+// along with the tmpfs mounted on them.
+func gcHosts(st *gcState) {
+	root := filepath.Join(utils.HYPER_ROOT, "hosts")
+	dirs, err := ioutil.ReadDir(root)
+	if err != nil {
+		return
+	}
+	for _, d := range dirs {
+		podId := d.Name()
+		if st.known(podId) {
+			continue
+		}
+		path := filepath.Join(root, podId)
+		st.collect("hosts", path, podId, func() error {
+			if err := syscall.Unmount(path, syscall.MNT_DETACH); err != nil && err != syscall.EINVAL {
+				return err
+			}
+			return os.RemoveAll(path)
+		})
+	}
+}
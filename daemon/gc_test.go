@@ -0,0 +1,85 @@
+package daemon
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hyperhq/hyperd/daemon/pod"
+)
+
+func TestGCOwnedBy(t *testing.T) {
+	st := &gcState{
+		pods:    map[string]bool{"web": true, "db@team-a": true},
+		volumes: map[string]bool{"shared": true},
+	}
+	for name, owned := range map[string]bool{
+		"web-data":                   true,
+		"db@team-a-data":             true,
+		"db-data":                    false,
+		"cache-data":                 false,
+		namedVolumeOwner + "-shared": true,
+		namedVolumeOwner + "-gone":   false,
+	} {
+		if st.ownedBy(name) != owned {
+			t.Errorf("ownedBy(%s): expect %v", name, owned)
+		}
+	}
+	if !st.known(namedVolumeOwner) || !st.known("web") || st.known("web-2") {
+		t.Fatal("unexpected known pods")
+	}
+}
+
+func TestGCVolumeRecords(t *testing.T) {
+	d, cleanup := newTestDaemon(t)
+	defer cleanup()
+	d.PodList = pod.NewPodList()
+	d.Storage = &AufsStorage{}
+
+	d.db.Update([]byte(fmt.Sprintf(pod.LAYOUT_KEY_FMT, "web")), []byte{})
+	// the devices of the pods sharing a prefix
+	d.db.UpdatePodVolume("web", "pool-aaa", []byte("pool-aaa:1"))
+	d.db.UpdatePodVolume("web-2", "pool-bbb", []byte("pool-bbb:2"))
+	// a volume cloned for a pod not created yet
+	d.db.UpdatePodVolume("cache", "pool-ccc", []byte("pool-ccc:3"))
+	d.db.UpdateVolumeClone("pool-ccc", []byte("snap"))
+	// a volume of another driver
+	d.db.UpdatePodVolume("gone", "data", []byte("tank/gone/data"))
+
+	st, err := d.gcState(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(st.pods) != 1 || !st.pods["web"] {
+		t.Fatalf("unexpected pods %v", st.pods)
+	}
+	if err := d.gcVolumeRecords(st); err != nil {
+		t.Fatal(err)
+	}
+	orphans := map[string]string{}
+	for _, o := range st.orphans {
+		if o.Removed {
+			t.Fatalf("orphan %v removed in dry run", o)
+		}
+		orphans[o.Path] = o.Pod
+	}
+	if len(orphans) != 2 || orphans["pool-bbb:2"] != "web-2" {
+		t.Fatalf("unexpected orphans %v", st.orphans)
+	}
+	if _, ok := orphans["tank/gone/data"]; !ok {
+		t.Fatalf("unexpected orphans %v", st.orphans)
+	}
+	if _, err := d.db.GetPodVolume("web-2", "pool-bbb"); err != nil {
+		t.Fatal("the record is removed in dry run")
+	}
+
+	st, _ = d.gcState(false)
+	d.gcVolumeRecords(st)
+	if _, err := d.db.GetPodVolume("gone", "data"); err == nil {
+		t.Fatal("the orphan record is not removed")
+	}
+	for id, dev := range map[string]string{"web": "pool-aaa", "cache": "pool-ccc"} {
+		if _, err := d.db.GetPodVolume(id, dev); err != nil {
+			t.Fatalf("the volume of %s is removed", id)
+		}
+	}
+}
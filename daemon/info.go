@@ -2,6 +2,7 @@ package daemon
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/golang/glog"
 
@@ -9,19 +10,34 @@ import (
 	"github.com/hyperhq/hyperd/types"
 )
 
-func (daemon *Daemon) GetPodInfo(podName string) (*types.PodInfo, error) {
-	var (
-		p  *pod.XPod
-		ok bool
-	)
-	p, ok = daemon.PodList.Get(podName)
+func (daemon *Daemon) GetPodInfo(namespace, podName string) (*types.PodInfo, error) {
+	name, err := pod.ScopedName(namespace, podName)
+	if err != nil {
+		return &types.PodInfo{}, err
+	}
+	p, ok := daemon.PodList.Get(name)
 	if !ok {
-		return &types.PodInfo{}, fmt.Errorf("Can not get Pod info with pod ID(%s)", podName)
+		return &types.PodInfo{}, fmt.Errorf("Can not get Pod info with pod ID(%s), no such pod", podName)
 	}
 
 	return p.Info()
 }
 
+// ExportPodSpec returns the spec of the pod, which could be used to create the
+// pod again.
+func (daemon *Daemon) ExportPodSpec(namespace, podName string) (*types.UserPod, error) {
+	name, err := pod.ScopedName(namespace, podName)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := daemon.PodList.Get(name)
+	if !ok {
+		return nil, fmt.Errorf("Can not export Pod spec with pod ID(%s)", podName)
+	}
+
+	return p.ExportSpec()
+}
+
 func (daemon *Daemon) GetPodStats(podId string) (interface{}, error) {
 	var (
 		p  *pod.XPod
@@ -43,16 +59,59 @@ func (daemon *Daemon) GetPodStats(podId string) (interface{}, error) {
 	return nil, fmt.Errorf("Stats for pod %s is nil", podId)
 }
 
-func (daemon *Daemon) GetContainerInfo(name string) (*types.ContainerInfo, error) {
+func (daemon *Daemon) GetContainerInfo(namespace, name string) (*types.ContainerInfo, error) {
 	if name == "" {
 		return &types.ContainerInfo{}, fmt.Errorf("Empty container name")
 	}
-	glog.V(3).Infof("GetContainerInfo of %s", name)
+	glog.V(3).Infof("GetContainerInfo of %s in namespace %s", name, namespace)
 
-	p, id, ok := daemon.PodList.GetByContainerIdOrName(name)
+	ref, err := pod.ScopedName(namespace, name)
+	if err != nil {
+		return &types.ContainerInfo{}, err
+	}
+	p, id, ok := daemon.PodList.GetByContainerIdOrName(ref)
 	if !ok {
-		return &types.ContainerInfo{}, fmt.Errorf("Can not find container by name(%s)", name)
+		return &types.ContainerInfo{}, fmt.Errorf("Can not find container by name(%s), no such container", name)
 	}
 
 	return p.ContainerInfo(id)
 }
+
+func (daemon *Daemon) GetImageInfo(name string) (*types.ImageInfo, error) {
+	if name == "" {
+		return nil, fmt.Errorf("Empty image name")
+	}
+
+	img, err := daemon.Daemon.LookupImage(name)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &types.ImageInfo{
+		Id:          img.ID,
+		ParentID:    img.Parent,
+		RepoTags:    img.RepoTags,
+		RepoDigests: img.RepoDigests,
+		VirtualSize: img.VirtualSize,
+	}
+	if created, err := time.Parse(time.RFC3339Nano, img.Created); err == nil {
+		info.Created = created.Unix()
+	}
+	if img.Config != nil {
+		info.Labels = img.Config.Labels
+	}
+	return info, nil
+}
+
+func (daemon *Daemon) GetExecInfo(execId string) (*types.ExecInfo, error) {
+	var info *types.ExecInfo
+	daemon.PodList.Find(func(p *pod.XPod) bool {
+		var ok bool
+		info, ok = p.ExecInfo(execId)
+		return ok
+	})
+	if info == nil {
+		return nil, fmt.Errorf("Can not find exec %s, no such exec", execId)
+	}
+	return info, nil
+}
@@ -2,6 +2,7 @@ package daemon
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hyperhq/hypercontainer-utils/hlog"
 	"github.com/hyperhq/hyperd/daemon/pod"
@@ -10,25 +11,35 @@ import (
 
 type pMatcher func(p *pod.XPod) (match, quit bool)
 
-func (daemon *Daemon) snapshotPodList(podId, vmId string) []*pod.XPod {
+// snapshotPodList lists the pods in the namespace, or in all the namespaces
+// if namespace is empty.
+func (daemon *Daemon) snapshotPodList(namespace, podId, vmId string) []*pod.XPod {
 	var (
 		pl = []*pod.XPod{}
 	)
 
+	inNamespace := func(p *pod.XPod) bool {
+		return namespace == "" || p.Namespace() == pod.NormalizeNamespace(namespace)
+	}
+
 	if podId != "" {
-		p, ok := daemon.PodList.Get(podId)
-		if ok {
-			pl = append(pl, p)
+		name, err := pod.ScopedName(namespace, podId)
+		if err != nil {
+			return pl
+		}
+		p, ok := daemon.PodList.Get(name)
+		if !ok || !inNamespace(p) {
+			return pl
 		}
 		if vmId != "" && p.SandboxName() != vmId {
-			return []*pod.XPod{}
+			return pl
 		}
-		return pl
+		return append(pl, p)
 	}
 
 	if vmId != "" {
 		p := daemon.PodList.Find(func(p *pod.XPod) bool {
-			return p.SandboxName() == vmId
+			return p.SandboxName() == vmId && inNamespace(p)
 		})
 		if p != nil {
 			pl = append(pl, p)
@@ -37,17 +48,19 @@ func (daemon *Daemon) snapshotPodList(podId, vmId string) []*pod.XPod {
 	}
 
 	daemon.PodList.Foreach(func(p *pod.XPod) error {
-		pl = append(pl, p)
+		if inNamespace(p) {
+			pl = append(pl, p)
+		}
 		return nil
 	})
 	return pl
 }
 
-func (daemon *Daemon) ListContainers(podId, vmId string) ([]*apitypes.ContainerListResult, error) {
+func (daemon *Daemon) ListContainers(namespace, podId, vmId string) ([]*apitypes.ContainerListResult, error) {
 	var (
 		result = []*apitypes.ContainerListResult{}
 	)
-	pl := daemon.snapshotPodList(podId, vmId)
+	pl := daemon.snapshotPodList(namespace, podId, vmId)
 	for _, p := range pl {
 		for _, cid := range p.ContainerIds() {
 			status := p.ContainerBriefStatus(cid)
@@ -60,8 +73,8 @@ func (daemon *Daemon) ListContainers(podId, vmId string) ([]*apitypes.ContainerL
 	return result, nil
 }
 
-func (daemon *Daemon) ListPods(podId, vmId string) ([]*apitypes.PodListResult, error) {
-	pl := daemon.snapshotPodList(podId, vmId)
+func (daemon *Daemon) ListPods(namespace, podId, vmId string) ([]*apitypes.PodListResult, error) {
+	pl := daemon.snapshotPodList(namespace, podId, vmId)
 	result := make([]*apitypes.PodListResult, 0, len(pl))
 	for _, p := range pl {
 		if s := p.BriefStatus(); s != nil {
@@ -71,19 +84,23 @@ func (daemon *Daemon) ListPods(podId, vmId string) ([]*apitypes.PodListResult, e
 	return result, nil
 }
 
-func (daemon *Daemon) ListVMs(podId, vmId string) ([]*apitypes.VMListResult, error) {
-	pl := daemon.snapshotPodList(podId, vmId)
+func (daemon *Daemon) ListVMs(namespace, podId, vmId string) ([]*apitypes.VMListResult, error) {
+	pl := daemon.snapshotPodList(namespace, podId, vmId)
 	result := make([]*apitypes.VMListResult, 0, len(pl))
 	for _, p := range pl {
 		if s := p.SandboxBriefStatus(); s != nil {
 			result = append(result, s)
 		}
 	}
+	// the idle VMs belong to no pods and no namespaces
+	if f := daemon.idleVms(); f != nil && namespace == "" && podId == "" {
+		result = append(result, f.listIdle(vmId)...)
+	}
 
 	return result, nil
 }
 
-func (daemon *Daemon) List(item, podId, vmId string) (map[string][]string, error) {
+func (daemon *Daemon) List(item, namespace, podId, vmId string) (map[string][]string, error) {
 	var (
 		pl = []*pod.XPod{}
 
@@ -92,12 +109,12 @@ func (daemon *Daemon) List(item, podId, vmId string) (map[string][]string, error
 		podJsonResponse       = []string{}
 		containerJsonResponse = []string{}
 	)
-	hlog.Log(hlog.INFO, "got list request for %s (pod: %s, vm: %s)", item, podId, vmId)
+	hlog.Log(hlog.INFO, "got list request for %s (namespace: %s, pod: %s, vm: %s)", item, namespace, podId, vmId)
 	if item != "pod" && item != "container" && item != "vm" {
 		return list, fmt.Errorf("Can not support %s list!", item)
 	}
 
-	pl = daemon.snapshotPodList(podId, vmId)
+	pl = daemon.snapshotPodList(namespace, podId, vmId)
 
 	for _, p := range pl {
 		if p.IsNone() {
@@ -124,6 +141,12 @@ func (daemon *Daemon) List(item, podId, vmId string) (map[string][]string, error
 		}
 	}
 
+	if f := daemon.idleVms(); f != nil && item == "vm" && namespace == "" && podId == "" {
+		for _, s := range f.listIdle(vmId) {
+			vmJsonResponse = append(vmJsonResponse, strings.Join([]string{s.VmID, s.PodID, s.Status}, ":"))
+		}
+	}
+
 	switch item {
 	case "vm":
 		list["vmData"] = vmJsonResponse
@@ -138,3 +161,9 @@ func (daemon *Daemon) List(item, podId, vmId string) (map[string][]string, error
 
 	return list, nil
 }
+
+// WatchPods watches the changes of pods and their containers after the
+// resource version since, or from a snapshot of all the pods if since is 0.
+func (daemon *Daemon) WatchPods(since uint64) (*pod.PodWatcher, error) {
+	return daemon.PodList.Watch(since)
+}
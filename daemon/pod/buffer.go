@@ -84,12 +84,13 @@ func (p *XPod) AddContainerBuffer(c *apitypes.UserContainer) (*ContainerBuffer,
 
 func (p *XPod) RemoveContainerBuffer(cb *ContainerBuffer) {
 	p.statusLock.Lock()
-	defer p.statusLock.Unlock()
 	delete(p.containerBuffers, cb.Id)
+	p.statusLock.Unlock()
+	p.notifyWatchers()
 }
 
 func (p *XPod) RemoveContainerBufferAll(cb *ContainerBuffer) {
-	p.factory.registry.ReleaseContainer(cb.Id, cb.Spec.Name)
+	p.factory.registry.ReleaseContainer(cb.Id, cb.Spec.Name, p.Id())
 
 	p.RemoveContainerBuffer(cb)
 }
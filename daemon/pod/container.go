@@ -167,6 +167,11 @@ func (c *Container) Info() *apitypes.Container {
 	}
 	if c.descript != nil {
 		cinfo.ImageID = c.descript.Image
+		if sizer, ok := c.p.factory.sd.(RootfsSizer); ok {
+			if size, err := sizer.RootfsSize(c.descript.MountId); err == nil {
+				cinfo.RootfsSize = size
+			}
+		}
 		cinfo.Args = c.descript.Args
 		cinfo.WorkingDir = c.descript.Workdir
 		cinfo.Env = make([]*apitypes.EnvironmentVar, 0, len(c.descript.Envs))
@@ -254,6 +259,7 @@ func (c *Container) start() error {
 	}
 	c.Log(DEBUG, "container started")
 	c.status.Running(time.Now())
+	c.p.notifyWatchers()
 
 	return nil
 }
@@ -446,7 +452,7 @@ func (c *Container) loadJson() *dockertypes.ContainerJSON {
 		}
 
 		n := strings.TrimLeft(rsp.Name, "/")
-		if c.spec.Name != n {
+		if engineName(c.p.Namespace(), c.spec.Name) != n {
 			c.Log(ERROR, "name mismatch of loaded container, loaded is %s", n)
 			c.spec.Id = ""
 			return nil
@@ -492,7 +498,7 @@ func (c *Container) createByEngine() (*dockertypes.ContainerJSON, error) {
 	}
 
 	ccs, err = c.p.factory.engine.ContainerCreate(dockertypes.ContainerCreateConfig{
-		Name:       c.spec.Name,
+		Name:       engineName(c.p.Namespace(), c.spec.Name),
 		Config:     config,
 		HostConfig: &container.HostConfig{ReadonlyRootfs: c.spec.ReadOnly},
 	})
@@ -711,6 +717,7 @@ func (c *Container) createVolumes() error {
 	var (
 		err     error
 		created = []string{}
+		plugged = []*apitypes.UserVolume{}
 	)
 
 	defer func() {
@@ -718,6 +725,9 @@ func (c *Container) createVolumes() error {
 			for _, v := range created {
 				c.p.factory.sd.RemoveVolume(c.p.Id(), []byte(v))
 			}
+			for _, v := range plugged {
+				c.removePluginVolume(v)
+			}
 		}
 	}()
 
@@ -727,6 +737,15 @@ func (c *Container) createVolumes() error {
 		}
 		c.Log(INFO, "create volume %s", v.Volume)
 
+		if isPluginVolume(v.Detail) {
+			if err = c.createPluginVolume(v.Detail); err != nil {
+				c.Log(ERROR, "failed to create volume %s by plugin %s: %v", v.Volume, v.Detail.Format, err)
+				return err
+			}
+			plugged = append(plugged, v.Detail)
+			continue
+		}
+
 		err = c.p.factory.sd.CreateVolume(c.p.Id(), v.Detail)
 		if err != nil {
 			c.Log(ERROR, "failed to create volume %s: %v", v.Volume, err)
@@ -884,7 +903,7 @@ func (c *Container) addToSandbox() error {
 		}
 	}
 
-	root, err := c.p.factory.sd.PrepareContainer(c.descript.MountId, c.p.sandboxShareDir(), c.spec.ReadOnly)
+	root, err := c.p.factory.sd.PrepareContainer(c.descript.MountId, c.p.sandboxShareDir(), c.spec.ReadOnly, c.spec.RootfsSize)
 	if err != nil {
 		c.Log(ERROR, "failed to prepare rootfs: %v", err)
 		return err
@@ -912,10 +931,12 @@ func (c *Container) addToSandbox() error {
 		err := fmt.Errorf("failed to add container to sandbox: %s", r.Message())
 		c.Log(ERROR, err)
 		c.status.UnexpectedStopped()
+		c.p.notifyWatchers()
 		return err
 	}
 
 	c.status.Created(time.Now())
+	c.p.notifyWatchers()
 	return nil
 }
 
@@ -1075,6 +1096,7 @@ func (c *Container) waitFinish(timeout int) {
 			firstStop = c.status.Stopped(r.FinishedAt, r.Code)
 		}
 	}
+	c.p.notifyWatchers()
 
 	if firstStop {
 		c.Log(INFO, "clean up container")
@@ -1165,25 +1187,29 @@ func (c *Container) rename(name string) error {
 		c.Log(ERROR, err)
 		return err
 	}
+	if err = ValidateName(name); err != nil {
+		c.Log(ERROR, err)
+		return err
+	}
 	if err := c.p.factory.registry.ReserveContainerName(name, c.p.Id()); err != nil {
 		c.Log(ERROR, "failed to reserve new container name %s: %v", name, err)
 		return err
 	}
 	defer func() {
 		if err != nil {
-			c.p.factory.registry.ReleaseContainerName(name)
+			c.p.factory.registry.ReleaseContainerName(name, c.p.Id())
 		}
 	}()
 	if c.Id() != "" || c.descript != nil {
-		err = c.p.factory.engine.ContainerRename(old, name)
+		err = c.p.factory.engine.ContainerRename(engineName(c.p.Namespace(), old), engineName(c.p.Namespace(), name))
 		if err != nil {
 			return err
 		}
 	}
-	c.p.factory.registry.ReleaseContainerName(old)
+	c.p.factory.registry.ReleaseContainerName(old, c.p.Id())
 	c.spec.Name = name
 	if c.descript != nil {
-		c.descript.Name = "/" + name
+		c.descript.Name = "/" + engineName(c.p.Namespace(), name)
 	}
 	return err
 }
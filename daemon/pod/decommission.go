@@ -9,6 +9,7 @@ import (
 
 	dockertypes "github.com/docker/engine-api/types"
 
+	"github.com/hyperhq/hyperd/daemon/daemondb"
 	"github.com/hyperhq/hyperd/utils"
 	"github.com/hyperhq/runv/hypervisor"
 )
@@ -68,26 +69,34 @@ func (p *XPod) Remove(force bool) error {
 
 	p.Log(INFO, "removing pod")
 	p.statusLock.Lock()
+	if err := p.checkVolumeHolds("remove"); err != nil {
+		p.statusLock.Unlock()
+		return err
+	}
 	p.status = S_POD_NONE
 	p.statusLock.Unlock()
+	p.notifyWatchers()
 
 	os.RemoveAll(path.Join(utils.HYPER_ROOT, "hosts", p.Id()))
 
 	for id, c := range p.containers {
-		p.factory.registry.ReleaseContainer(id, c.SpecName())
+		p.factory.registry.ReleaseContainer(id, c.SpecName(), p.Id())
 		p.factory.engine.ContainerRm(id, &dockertypes.ContainerRmConfig{false, false, false})
 	}
 
 	//remove pod(including all containers/volumes/interfaces) in daemondb
-	p.removeFromDB()
+	err := p.removeFromDB()
+	if err != nil {
+		p.Log(ERROR, "failed to remove pod from db: %v", err)
+	}
 
 	if p.DelayDeleteOn() {
 		p.Log(DEBUG, "should wait periodical clean up")
 		p.factory.registry.Release(p.Id())
-		return nil
+		return err
 	}
 
-	return nil
+	return err
 }
 
 func (p *XPod) Dissociate() error {
@@ -97,7 +106,7 @@ func (p *XPod) Dissociate() error {
 	err := dissociateSandbox(p.sandbox, 0)
 	p.factory.registry.Release(p.Id())
 	for _, c := range p.containers {
-		p.factory.registry.ReleaseContainer(c.Id(), c.SpecName())
+		p.factory.registry.ReleaseContainer(c.Id(), c.SpecName(), p.Id())
 	}
 	if err != nil {
 		p.Log(ERROR, "failed to release vm: %v", err)
@@ -116,6 +125,7 @@ func (p *XPod) Pause() error {
 	}
 	p.status = S_POD_PAUSED
 	p.statusLock.Unlock()
+	p.notifyWatchers()
 
 	err := p.protectedSandboxOperation(
 		func(sb *hypervisor.Vm) error {
@@ -131,6 +141,7 @@ func (p *XPod) Pause() error {
 			p.status = S_POD_RUNNING
 		}
 		p.statusLock.Unlock()
+		p.notifyWatchers()
 	}
 
 	return err
@@ -144,8 +155,13 @@ func (p *XPod) UnPause() error {
 		p.Log(ERROR, err)
 		return err
 	}
+	if err := p.checkVolumeHolds("unpause"); err != nil {
+		p.statusLock.Unlock()
+		return err
+	}
 	p.status = S_POD_RUNNING
 	p.statusLock.Unlock()
+	p.notifyWatchers()
 
 	err := p.protectedSandboxOperation(
 		func(sb *hypervisor.Vm) error {
@@ -162,6 +178,7 @@ func (p *XPod) UnPause() error {
 			p.status = S_POD_PAUSED
 		}
 		p.statusLock.Unlock()
+		p.notifyWatchers()
 	}
 
 	return err
@@ -228,6 +245,7 @@ func (p *XPod) RemoveContainer(id string) error {
 				p.statusLock.Lock()
 				p.status = S_POD_ERROR
 				p.statusLock.Unlock()
+				p.notifyWatchers()
 			}
 		}
 	}()
@@ -274,33 +292,25 @@ func (p *XPod) RemoveContainer(id string) error {
 		c.Log(ERROR, "failed to remove container through engine")
 		return err
 	}
-	p.factory.registry.ReleaseContainer(id, c.SpecName())
+	p.factory.registry.ReleaseContainer(id, c.SpecName(), p.Id())
 	p.statusLock.Lock()
 	delete(p.containers, id)
 	p.statusLock.Unlock()
+	p.notifyWatchers()
 
-	//remove volumes from daemondb
+	return p.persist(p.removeContainerOps(c, removedVols)...)
+}
+
+// removeContainerOps returns the ops removing the volumes and the container
+// from daemondb, updating the pod layout and saving the sandbox change at once.
+func (p *XPod) removeContainerOps(c *Container, removedVols []string) []func(*daemondb.Batch) error {
+	ops := make([]func(*daemondb.Batch) error, 0, len(removedVols)+3)
 	for _, vName := range removedVols {
 		if v, ok := p.volumes[vName]; ok {
-			if err = v.removeFromDB(); err != nil {
-				return err
-			}
+			ops = append(ops, v.removeFromDB)
 		}
 	}
-	// remove container in daemondb.
-	if err = c.removeFromDB(); err != nil {
-		return err
-	}
-	// update layout to remove container from pod layout.
-	if err = p.saveLayout(); err != nil {
-		return err
-	}
-	// save sandbox change
-	if err = p.saveSandbox(); err != nil {
-		return err
-	}
-
-	return nil
+	return append(ops, c.removeFromDB, p.saveLayout, p.saveSandbox)
 }
 
 // protectedSandboxOperation() protect the hypervisor operations, which may
@@ -371,6 +381,7 @@ func (p *XPod) doStopPod(graceful int) error {
 		p.status = S_POD_STOPPING
 	}
 	p.statusLock.Unlock()
+	p.notifyWatchers()
 	if err != nil {
 		p.Log(ERROR, err)
 		return ret
@@ -555,6 +566,7 @@ func (p *XPod) cleanup() {
 		p.status = S_POD_STOPPING
 	}
 	p.statusLock.Unlock()
+	p.notifyWatchers()
 
 	err := p.decommissionResources()
 	if err != nil {
@@ -563,7 +575,7 @@ func (p *XPod) cleanup() {
 		err = nil
 	}
 
-	err = p.removeSandboxFromDB()
+	err = p.persist(p.removeSandboxFromDB)
 	if err != nil {
 		p.Log(ERROR, "pod stopping failed, failed to remove sandbox persist data: %v", err)
 		err = nil
@@ -575,6 +587,7 @@ func (p *XPod) cleanup() {
 		p.status = S_POD_STOPPED
 	}
 	p.statusLock.Unlock()
+	p.notifyWatchers()
 
 	p.Log(INFO, "pod stopped")
 	select {
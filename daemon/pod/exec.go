@@ -10,6 +10,7 @@ import (
 	"github.com/docker/docker/pkg/stdcopy"
 
 	"github.com/hyperhq/hypercontainer-utils/hlog"
+	apitypes "github.com/hyperhq/hyperd/types"
 	"github.com/hyperhq/hyperd/utils"
 	"github.com/hyperhq/runv/api"
 	"github.com/hyperhq/runv/hypervisor"
@@ -22,6 +23,7 @@ type Exec struct {
 	Terminal  bool
 	ExitCode  uint8
 
+	started   bool
 	logPrefix string
 	finChan   chan bool
 }
@@ -102,6 +104,10 @@ func (p *XPod) StartExec(stdin io.ReadCloser, stdout io.WriteCloser, containerId
 		return err
 	}
 
+	p.statusLock.Lock()
+	es.started = true
+	p.statusLock.Unlock()
+
 	wReader := &waitClose{ReadCloser: stdin, wait: make(chan bool)}
 	tty := &hypervisor.TtyIO{
 		Stdin:  wReader,
@@ -196,6 +202,36 @@ func (p *XPod) GetExecExitCode(containerId, execId string) (uint8, error) {
 	return es.ExitCode, nil
 }
 
+// ExecInfo returns the info of an exec, and false if the exec does not exist.
+func (p *XPod) ExecInfo(execId string) (*apitypes.ExecInfo, bool) {
+	p.statusLock.RLock()
+	defer p.statusLock.RUnlock()
+
+	es, ok := p.execs[execId]
+	if !ok {
+		return nil, false
+	}
+
+	status := "created"
+	if len(es.finChan) > 0 {
+		status = "exited"
+	} else if es.started {
+		status = "running"
+	}
+	info := &apitypes.ExecInfo{
+		ExecID:      es.Id,
+		ContainerID: es.Container,
+		PodID:       p.Id(),
+		Command:     es.Cmds,
+		Tty:         es.Terminal,
+		Status:      status,
+	}
+	if status == "exited" {
+		info.ExitCode = int32(es.ExitCode)
+	}
+	return info, true
+}
+
 func (p *XPod) KillExec(execId string, sig int64) error {
 	p.statusLock.RLock()
 	es, ok := p.execs[execId]
@@ -228,13 +264,18 @@ func (p *XPod) CleanupExecs() {
 }
 
 func (p *XPod) ExecVM(cmd string, stdin io.ReadCloser, stdout, stderr io.WriteCloser) (int, error) {
+	return ExecSandbox(p.sandbox, cmd, stdin, stdout, stderr)
+}
+
+// ExecSandbox executes a command in the sandbox outside of any containers.
+func ExecSandbox(sandbox *hypervisor.Vm, cmd string, stdin io.ReadCloser, stdout, stderr io.WriteCloser) (int, error) {
 	wReader := &waitClose{ReadCloser: stdin, wait: make(chan bool)}
 	tty := &hypervisor.TtyIO{
 		Stdin:  wReader,
 		Stdout: stdout,
 		Stderr: stderr,
 	}
-	res, err := p.sandbox.HyperstartExec(cmd, tty)
+	res, err := sandbox.HyperstartExec(cmd, tty)
 	if err != nil {
 		return res, err
 	}
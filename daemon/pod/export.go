@@ -0,0 +1,132 @@
+package pod
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hyperhq/hyperd/storage"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+type containerSpecs []*apitypes.UserContainer
+
+func (s containerSpecs) Len() int           { return len(s) }
+func (s containerSpecs) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s containerSpecs) Less(i, j int) bool { return s[i].Name < s[j].Name }
+
+// ExportSpec reassembles the pod spec from the running pod, which could be used
+// to create the pod again. The runtime generated parts, such as the container
+// IDs, the volumes created by the storage driver and the files injected by the
+// daemon, are stripped from the result.
+func (p *XPod) ExportSpec() (*apitypes.UserPod, error) {
+	// the labels, services and port mappings are changed under the
+	// resourceLock, as are the containers created in the pod
+	p.resourceLock.Lock()
+	defer p.resourceLock.Unlock()
+	p.statusLock.RLock()
+	defer p.statusLock.RUnlock()
+
+	spec := p.globalSpec.CloneGlobalPart()
+	// the hostname defaults to the pod id, see ReorganizeContainers()
+	if hostname := p.Id(); spec.Hostname == hostname || len(hostname) > 63 && spec.Hostname == hostname[:63] {
+		spec.Hostname = ""
+	}
+	for k, v := range p.labels {
+		spec.Labels[k] = v
+	}
+	spec.Portmappings = append(spec.Portmappings, p.portMappings...)
+	spec.Services = append(spec.Services, p.services.get()...)
+
+	for _, inf := range p.interfaces {
+		is := *inf.spec
+		if _, err := strconv.Atoi(is.Id); err == nil {
+			// the numeric ids are generated in initResources()
+			is.Id = ""
+		}
+		spec.Interfaces = append(spec.Interfaces, &is)
+	}
+
+	var (
+		volumes = make(map[string]bool)
+		files   = make(map[string]bool)
+	)
+	for _, c := range p.containers {
+		cs := *c.spec
+		cs.Id = ""
+		cs.Volumes = []*apitypes.UserVolumeReference{}
+		cs.Files = []*apitypes.UserFileReference{}
+
+		for _, ref := range c.spec.Volumes {
+			if p.injectedVolume(c.spec, ref) {
+				continue
+			}
+			r := *ref
+			r.Detail = nil
+			cs.Volumes = append(cs.Volumes, &r)
+			if ref.Detail == nil || volumes[ref.Volume] {
+				continue
+			}
+			vol := *ref.Detail
+			if p.createdVolume(&vol) && isPluginVolume(&vol) {
+				// created by the plugin again on import
+				vol.Source = ""
+			} else if p.createdVolume(&vol) {
+				vol.Source, vol.Format, vol.Fstype = "", "", ""
+			}
+			spec.Volumes = append(spec.Volumes, &vol)
+			volumes[ref.Volume] = true
+		}
+
+		for _, ref := range c.spec.Files {
+			if ref.Detail != nil && ref.Detail.Name == p.Id()+"-resolvconf" {
+				// inserted by configDNS()
+				continue
+			}
+			r := *ref
+			r.Detail = nil
+			cs.Files = append(cs.Files, &r)
+			if ref.Detail == nil || files[ref.Filename] {
+				continue
+			}
+			f := *ref.Detail
+			spec.Files = append(spec.Files, &f)
+			files[ref.Filename] = true
+		}
+
+		spec.Containers = append(spec.Containers, &cs)
+	}
+	sort.Sort(containerSpecs(spec.Containers))
+
+	return spec, nil
+}
+
+// injectedVolume reports whether the volume reference is inserted by the daemon,
+// i.e. the /etc/hosts of the pod or the volumes declared in the image.
+func (p *XPod) injectedVolume(c *apitypes.UserContainer, ref *apitypes.UserVolumeReference) bool {
+	if ref.Volume == "etchosts-volume" && ref.Detail != nil {
+		_, hostsPath := HostsPath(p.Id())
+		return ref.Detail.Source == hostsPath
+	}
+	return c.Id != "" && ref.Volume == c.Id+strings.Replace(ref.Path, "/", "_", -1)
+}
+
+// createdVolume reports whether the volume source is created by the storage
+// driver, see the CreateVolume() of the drivers.
+func (p *XPod) createdVolume(vol *apitypes.UserVolume) bool {
+	if vol.Source == "" || vol.Source == storage.VFSVolumePath(p.Id(), vol.Name) {
+		return true
+	}
+	if isPluginVolume(vol) {
+		return vol.Source == pluginVolumeName(p.Id(), vol.Name)
+	}
+	if vol.Format != "raw" {
+		return false
+	}
+	chksum := sha256.Sum256([]byte(p.Id() + vol.Name))
+	return filepath.Base(vol.Source) == p.Id()+"-"+vol.Name ||
+		strings.HasSuffix(vol.Source, hex.EncodeToString(chksum[:]))
+}
@@ -0,0 +1,147 @@
+package pod
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"gopkg.in/yaml.v2"
+
+	"github.com/hyperhq/hyperd/storage"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+func TestExportSpecRoundTrip(t *testing.T) {
+	_, hostsPath := HostsPath("web")
+	data := &apitypes.UserVolume{Name: "data", Source: storage.VFSVolumePath("web", "data"), Format: "vfs", Fstype: "dir"}
+	logs := &apitypes.UserVolume{Name: "logs", Source: "/var/log/web", Format: "vfs"}
+	conf := &apitypes.UserFile{Name: "conf", Content: "listen 80"}
+
+	p := &XPod{
+		name:         "web",
+		globalSpec:   &apitypes.UserPod{Id: "web", Hostname: "web", Tty: true},
+		labels:       map[string]string{"app": "web"},
+		portMappings: []*apitypes.PortMapping{{ContainerPort: "80", HostPort: "8080", Protocol: "tcp"}},
+		interfaces: map[string]*Interface{
+			"0": {spec: &apitypes.UserInterface{Bridge: "hyper0", Ip: "192.168.123.2/24", Id: "0"}},
+		},
+		containers: map[string]*Container{
+			"c2": {spec: &apitypes.UserContainer{Id: "c2", Name: "sidecar", Image: "busybox",
+				Volumes: []*apitypes.UserVolumeReference{{Path: "/data", Volume: "data", ReadOnly: true, Detail: data}},
+			}},
+			"c1": {spec: &apitypes.UserContainer{Id: "c1", Name: "nginx", Image: "nginx",
+				Volumes: []*apitypes.UserVolumeReference{
+					{Path: "/data", Volume: "data", Detail: data},
+					{Path: "/var/log", Volume: "logs", Detail: logs},
+					{Path: "/etc/hosts", Volume: "etchosts-volume", Detail: &apitypes.UserVolume{Name: "etchosts-volume", Source: hostsPath}},
+					{Path: "/cache", Volume: "c1_cache", Detail: &apitypes.UserVolume{Name: "c1_cache"}},
+				},
+				Files: []*apitypes.UserFileReference{
+					{Path: "/etc/nginx/nginx.conf", Filename: "conf", Detail: conf},
+					{Path: "/etc/resolv.conf", Filename: "web-resolvconf", Detail: &apitypes.UserFile{Name: "web-resolvconf"}},
+				},
+			}},
+		},
+		services:     &Services{},
+		resourceLock: &sync.Mutex{},
+		statusLock:   &sync.RWMutex{},
+	}
+
+	spec, err := p.ExportSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := &apitypes.UserPod{
+		Id:           "web",
+		Tty:          true,
+		Labels:       map[string]string{"app": "web"},
+		Portmappings: []*apitypes.PortMapping{{ContainerPort: "80", HostPort: "8080", Protocol: "tcp"}},
+		Interfaces:   []*apitypes.UserInterface{{Bridge: "hyper0", Ip: "192.168.123.2/24"}},
+		Containers: []*apitypes.UserContainer{
+			{Name: "nginx", Image: "nginx",
+				Volumes: []*apitypes.UserVolumeReference{{Path: "/data", Volume: "data"}, {Path: "/var/log", Volume: "logs"}},
+				// the default permission is filled in by Validate()
+				Files: []*apitypes.UserFileReference{{Path: "/etc/nginx/nginx.conf", Filename: "conf", Perm: "0755"}},
+			},
+			{Name: "sidecar", Image: "busybox",
+				Volumes: []*apitypes.UserVolumeReference{{Path: "/data", Volume: "data", ReadOnly: true}},
+			},
+		},
+		Files: []*apitypes.UserFile{conf},
+	}
+
+	// the spec is created again with 'run -p' from json, or from yaml with
+	// '--yaml', the volumes are collected from the containers in any order
+	out, err := json.Marshal(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromJson apitypes.UserPod
+	if err := json.Unmarshal(out, &fromJson); err != nil {
+		t.Fatal(err)
+	}
+	out, err = yaml.Marshal(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromYaml apitypes.UserPod
+	if err := yaml.Unmarshal(out, &fromYaml); err != nil {
+		t.Fatal(err)
+	}
+
+	for format, parsed := range map[string]*apitypes.UserPod{"json": &fromJson, "yaml": &fromYaml} {
+		if err := parsed.Validate(); err != nil {
+			t.Fatalf("the %s spec is invalid: %v", format, err)
+		}
+		byName := map[string]*apitypes.UserVolume{}
+		for _, v := range parsed.Volumes {
+			byName[v.Name] = v
+		}
+		if len(byName) != 2 || !proto.Equal(byName["data"], &apitypes.UserVolume{Name: "data"}) || !proto.Equal(byName["logs"], logs) {
+			t.Fatalf("unexpected volumes in the %s spec: %v", format, parsed.Volumes)
+		}
+		parsed.Volumes = nil
+		if !proto.Equal(parsed, expect) {
+			t.Fatalf("unexpected %s spec:\n%v\nexpect:\n%v", format, parsed, expect)
+		}
+	}
+}
+
+func TestExportSpecWithSetLabel(t *testing.T) {
+	p := &XPod{
+		name:         "web",
+		globalSpec:   &apitypes.UserPod{Id: "web"},
+		labels:       map[string]string{},
+		containers:   map[string]*Container{},
+		interfaces:   map[string]*Interface{},
+		services:     &Services{},
+		resourceLock: &sync.Mutex{},
+		statusLock:   &sync.RWMutex{},
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			p.SetLabel(map[string]string{fmt.Sprintf("label-%d", i): "v"}, true)
+		}
+	}()
+	for i := 0; i < 1000; i++ {
+		if _, err := p.ExportSpec(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wg.Wait()
+
+	spec, err := p.ExportSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(spec.Labels) != 1000 {
+		t.Fatalf("expect 1000 labels, got %d", len(spec.Labels))
+	}
+}
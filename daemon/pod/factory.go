@@ -25,13 +25,22 @@ type ContainerEngine interface {
 type PodStorage interface {
 	Type() string
 
-	PrepareContainer(mountId, sharedDir string, readonly bool) (*runv.VolumeDescription, error)
+	PrepareContainer(mountId, sharedDir string, readonly bool, rootfsSize int64) (*runv.VolumeDescription, error)
 	CleanupContainer(id, sharedDir string) error
 	InjectFile(src io.Reader, containerId, target, baseDir string, perm, uid, gid int) error
 	CreateVolume(podId string, spec *apitypes.UserVolume) error
 	RemoveVolume(podId string, record []byte) error
 }
 
+// RootfsSizer is implemented by the storages which could give each container
+// a rootfs of its own size, i.e. honor the rootfsSize of the containers. The
+// rootfs could grow only, BaseRootfsSize is the size of the rootfs without
+// rootfsSize, which is the minimal rootfsSize.
+type RootfsSizer interface {
+	RootfsSize(mountId string) (int64, error)
+	BaseRootfsSize() (int64, error)
+}
+
 type GlobalLogConfig struct {
 	*apitypes.PodLogConfig
 	PathPrefix  string
@@ -0,0 +1,413 @@
+package pod
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperhq/hypercontainer-utils/hlog"
+	"github.com/hyperhq/hyperd/daemon/daemondb"
+	"github.com/hyperhq/hyperd/types"
+)
+
+// the records of the pods which could not be repaired are moved under this
+// prefix, out of the sight of the daemon, e.g. PL-{Pod.Id()} is moved to
+// QR-PL-{Pod.Id()}.
+const QUARANTINE_KEY_PREFIX = "QR-"
+
+// DBProblem is an inconsistency of the persisted pods found by CheckDB.
+type DBProblem struct {
+	Pod     string
+	Key     string
+	Problem string
+	// Action is what is done, or what would be done in repair mode
+	Action   string
+	Repaired bool
+}
+
+func (p *DBProblem) String() string {
+	pod := p.Pod
+	if pod == "" {
+		pod = "-"
+	}
+	action := "would " + p.Action
+	if p.Repaired {
+		action = p.Action
+	}
+	return fmt.Sprintf("pod %s: %s: %s, %s", pod, p.Key, p.Problem, action)
+}
+
+type dbChecker struct {
+	db       *daemondb.DaemonDB
+	repair   bool
+	problems []*DBProblem
+	// the keys belonging to the pods walked
+	known map[string]bool
+	// the pods whose records are quarantined
+	quarantined []string
+}
+
+// CheckDB walks the layouts of the persisted pods and the records they refer
+// to, and reports the missing, corrupted and dangling records. In repair mode,
+// the records which could be rebuilt are rebuilt, the missing containers,
+// volumes and interfaces are dropped from the layouts, the dangling records
+// are removed, and the pods which could not be loaded any more are
+// quarantined. The db must not be used by a running hyperd.
+func CheckDB(db *daemondb.DaemonDB, repair bool) ([]*DBProblem, error) {
+	c := &dbChecker{
+		db:     db,
+		repair: repair,
+		known:  make(map[string]bool),
+	}
+
+	layouts := make(map[string][]byte)
+	for kv := range db.PrefixList2Chan([]byte(LAYOUT_KEY_PREFIX), nil) {
+		if kv == nil {
+			return nil, fmt.Errorf("failed to list the pod layouts")
+		}
+		layouts[strings.TrimPrefix(string(kv.K), LAYOUT_KEY_PREFIX)] = kv.V
+	}
+
+	for id, data := range layouts {
+		if err := c.checkPod(id, data); err != nil {
+			return c.problems, err
+		}
+	}
+
+	for _, prefix := range []string{"CX-", "VX-", "IF-", "PS-", "PM-", "PP-", "SB-"} {
+		keys, err := db.PrefixListKey([]byte(prefix), nil)
+		if err != nil {
+			return c.problems, err
+		}
+		for _, k := range keys {
+			key := string(k)
+			if c.known[key] {
+				continue
+			}
+			if err := c.fix(&DBProblem{Key: key, Problem: "not referred by any pod", Action: "remove it"}, func() error {
+				return db.Delete(k)
+			}); err != nil {
+				return c.problems, err
+			}
+		}
+	}
+
+	if err := c.checkPluginVolumes(); err != nil {
+		return c.problems, err
+	}
+	if err := c.checkNamedVolumes(layouts); err != nil {
+		return c.problems, err
+	}
+
+	return c.problems, nil
+}
+
+// checkPluginVolumes removes the volumes created by the plugins for the pods
+// which do not exist any more. The other volume records belong to the storage
+// drivers, or to the clones not yet taken by a pod, and are left to the gc.
+func (c *dbChecker) checkPluginVolumes() error {
+	records, err := c.db.ListAllPodVolumes()
+	if err != nil {
+		return err
+	}
+Records:
+	for key, record := range records {
+		driver, name, ok := ParsePluginVolumeRecord(record)
+		if !ok || c.known[key] {
+			continue
+		}
+		// the specs of the quarantined pods may be unknown
+		for _, id := range c.quarantined {
+			if strings.HasPrefix(key, fmt.Sprintf(daemondb.POD_VOLUME_KEY, id, "")) {
+				continue Records
+			}
+		}
+		k := key
+		if err := c.fix(&DBProblem{Key: key, Problem: "plugin volume not referred by any pod", Action: fmt.Sprintf("remove volume %s of plugin %s", name, driver)}, func() error {
+			if err := RemovePluginVolume(driver, name); err != nil {
+				return err
+			}
+			return c.db.Delete([]byte(k))
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkNamedVolumes drops the pods which do not exist any more from the
+// references of the named volumes, the quarantined pods are kept.
+func (c *dbChecker) checkNamedVolumes(layouts map[string][]byte) error {
+	records, err := c.db.ListNamedVolumes()
+	if err != nil {
+		return err
+	}
+	for _, data := range records {
+		var vol types.VolumeInfo
+		if err := proto.Unmarshal(data, &vol); err != nil {
+			// the named volumes are not restored from the db by the pods
+			hlog.Log(WARNING, "db check: failed to read a named volume: %v", err)
+			continue
+		}
+		pods, removed := []string{}, []string{}
+		for _, id := range vol.Pods {
+			if _, ok := layouts[id]; ok {
+				pods = append(pods, id)
+			} else {
+				removed = append(removed, id)
+			}
+		}
+		if len(removed) == 0 {
+			continue
+		}
+		key := fmt.Sprintf(daemondb.NAMED_VOLUME_KEY, vol.Name)
+		problem := "referred by the removed pods " + strings.Join(removed, ", ")
+		if err := c.fix(&DBProblem{Key: key, Problem: problem, Action: "drop the references"}, func() error {
+			vol.Pods = pods
+			return c.save(key, &vol)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *dbChecker) fix(p *DBProblem, repair func() error) error {
+	c.problems = append(c.problems, p)
+	hlog.Log(WARNING, "db check: %s", p)
+	if !c.repair {
+		return nil
+	}
+	if err := repair(); err != nil {
+		hlog.Log(ERROR, "failed to repair %s: %v", p.Key, err)
+		return err
+	}
+	p.Repaired = true
+	return nil
+}
+
+// load reads the message of key, the record is missing if found is false, and
+// is corrupted if err is not nil.
+func (c *dbChecker) load(key string, message proto.Message) (found bool, err error) {
+	data, err := c.db.Get([]byte(key))
+	if err != nil {
+		if strings.Contains(err.Error(), "leveldb: not found") {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, proto.Unmarshal(data, message)
+}
+
+func (c *dbChecker) save(key string, message proto.Message) error {
+	data, err := proto.Marshal(message)
+	if err != nil {
+		return err
+	}
+	return c.db.Update([]byte(key), data)
+}
+
+func (c *dbChecker) checkPod(id string, data []byte) error {
+	var layout types.PersistPodLayout
+	if err := proto.Unmarshal(data, &layout); err != nil || layout.Id != id {
+		return c.quarantine(id, nil, fmt.Sprintf(LAYOUT_KEY_FMT, id), "corrupted layout")
+	}
+
+	// the spec and the meta are required to load a pod
+	var spec types.UserPod
+	key := fmt.Sprintf(PS_KEY_FMT, id)
+	if found, err := c.load(key, &spec); !found || err != nil {
+		return c.quarantine(id, &layout, key, describe(found, err, "spec"))
+	}
+
+	var meta types.PersistPodMeta
+	key = fmt.Sprintf(PMETA_KEY_FMT, id)
+	if found, err := c.load(key, &meta); !found || err != nil {
+		ns, _ := SplitQualifiedName(id)
+		meta = types.PersistPodMeta{Id: id, Namespace: ns}
+		if err := c.fix(&DBProblem{Pod: id, Key: key, Problem: describe(found, err, "meta"), Action: "rebuild it without labels and services"}, func() error {
+			return c.save(key, &meta)
+		}); err != nil {
+			return err
+		}
+	}
+	c.known[key] = true
+
+	var pm types.PersistPortmappings
+	key = fmt.Sprintf(PMAP_KEY_FMT, id)
+	if found, err := c.load(key, &pm); !found || err != nil {
+		pm = types.PersistPortmappings{Pod: id}
+		if err := c.fix(&DBProblem{Pod: id, Key: key, Problem: describe(found, err, "port mappings"), Action: "rebuild it without port mappings"}, func() error {
+			return c.save(key, &pm)
+		}); err != nil {
+			return err
+		}
+	}
+	c.known[key] = true
+
+	// the sandbox info is absent for the stopped pods, and the pods with
+	// a corrupted one are loaded as stopped pods
+	var sb types.SandboxPersistInfo
+	key = fmt.Sprintf(SB_KEY_FMT, id)
+	if found, err := c.load(key, &sb); found && err != nil {
+		k := key
+		if err := c.fix(&DBProblem{Pod: id, Key: key, Problem: describe(found, err, "sandbox info"), Action: "remove it"}, func() error {
+			return c.db.Delete([]byte(k))
+		}); err != nil {
+			return err
+		}
+	} else if found {
+		c.known[key] = true
+	}
+
+	changed := false
+	containers := layout.Containers[:0]
+	for _, cid := range layout.Containers {
+		var cx types.PersistContainer
+		key = fmt.Sprintf(CX_KEY_FMT, cid)
+		found, err := c.load(key, &cx)
+		if found && err == nil && cx.Pod != id {
+			err = fmt.Errorf("belongs to pod %s", cx.Pod)
+		}
+		if !found || err != nil {
+			changed = true
+			c.problems = append(c.problems, &DBProblem{Pod: id, Key: key, Problem: describe(found, err, "container info"), Action: "drop the container from the layout", Repaired: c.repair})
+			continue
+		}
+		c.known[key] = true
+		containers = append(containers, cid)
+	}
+	layout.Containers = containers
+
+	volumes := layout.Volumes[:0]
+	for _, vid := range layout.Volumes {
+		var vx types.PersistVolume
+		key = fmt.Sprintf(VX_KEY_FMT, id, vid)
+		if found, err := c.load(key, &vx); !found || err != nil {
+			changed = true
+			c.problems = append(c.problems, &DBProblem{Pod: id, Key: key, Problem: describe(found, err, "volume info"), Action: "drop the volume from the layout", Repaired: c.repair})
+			continue
+		}
+		c.known[key] = true
+		volumes = append(volumes, vid)
+	}
+	layout.Volumes = volumes
+
+	interfaces := layout.Interfaces[:0]
+	for _, iid := range layout.Interfaces {
+		var ix types.PersistInterface
+		key = fmt.Sprintf(IF_KEY_FMT, id, iid)
+		if found, err := c.load(key, &ix); !found || err != nil {
+			changed = true
+			c.problems = append(c.problems, &DBProblem{Pod: id, Key: key, Problem: describe(found, err, "interface info"), Action: "drop the interface from the layout", Repaired: c.repair})
+			continue
+		}
+		c.known[key] = true
+		interfaces = append(interfaces, iid)
+	}
+	layout.Interfaces = interfaces
+
+	// the volumes created by the plugins are recorded by their names in spec
+	for _, v := range spec.Volumes {
+		c.known[fmt.Sprintf(daemondb.POD_VOLUME_KEY, id, v.Name)] = true
+	}
+	for _, cs := range spec.Containers {
+		for _, ref := range cs.Volumes {
+			c.known[fmt.Sprintf(daemondb.POD_VOLUME_KEY, id, ref.Volume)] = true
+		}
+	}
+
+	c.known[fmt.Sprintf(PS_KEY_FMT, id)] = true
+	if changed && c.repair {
+		if err := c.save(fmt.Sprintf(LAYOUT_KEY_FMT, id), &layout); err != nil {
+			hlog.Log(ERROR, "failed to save the repaired layout of pod %s: %v", id, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// quarantine moves all the records of the pod under QUARANTINE_KEY_PREFIX,
+// the containers of the pod are found by their records if the layout is
+// unknown.
+func (c *dbChecker) quarantine(id string, layout *types.PersistPodLayout, key, problem string) error {
+	keys := []string{
+		fmt.Sprintf(LAYOUT_KEY_FMT, id),
+		fmt.Sprintf(SB_KEY_FMT, id),
+		fmt.Sprintf(PS_KEY_FMT, id),
+		fmt.Sprintf(PMETA_KEY_FMT, id),
+		fmt.Sprintf(PMAP_KEY_FMT, id),
+	}
+	if layout != nil {
+		for _, cid := range layout.Containers {
+			keys = append(keys, fmt.Sprintf(CX_KEY_FMT, cid))
+		}
+	} else {
+		for kv := range c.db.PrefixList2Chan([]byte("CX-"), nil) {
+			if kv == nil {
+				return fmt.Errorf("failed to list the containers")
+			}
+			var cx types.PersistContainer
+			if proto.Unmarshal(kv.V, &cx) == nil && cx.Pod == id {
+				keys = append(keys, string(kv.K))
+			}
+		}
+	}
+	if layout != nil {
+		for _, vid := range layout.Volumes {
+			keys = append(keys, fmt.Sprintf(VX_KEY_FMT, id, vid))
+		}
+		for _, iid := range layout.Interfaces {
+			keys = append(keys, fmt.Sprintf(IF_KEY_FMT, id, iid))
+		}
+	} else {
+		// the ids of the pods may share a prefix, e.g. web and web-2, so the
+		// records are matched by their pods
+		for kv := range c.db.PrefixList2Chan([]byte("VX-"), nil) {
+			if kv == nil {
+				return fmt.Errorf("failed to list the volumes")
+			}
+			var vx types.PersistVolume
+			if proto.Unmarshal(kv.V, &vx) == nil && vx.Pod == id {
+				keys = append(keys, string(kv.K))
+			}
+		}
+		for kv := range c.db.PrefixList2Chan([]byte("IF-"), nil) {
+			if kv == nil {
+				return fmt.Errorf("failed to list the interfaces")
+			}
+			var ix types.PersistInterface
+			if proto.Unmarshal(kv.V, &ix) == nil && ix.Pod == id {
+				keys = append(keys, string(kv.K))
+			}
+		}
+	}
+
+	for _, k := range keys {
+		c.known[k] = true
+	}
+	c.quarantined = append(c.quarantined, id)
+	return c.fix(&DBProblem{Pod: id, Key: key, Problem: problem, Action: "quarantine the pod"}, func() error {
+		b := c.db.NewBatch()
+		for _, k := range keys {
+			data, err := c.db.Get([]byte(k))
+			if err != nil {
+				// not all of the records exist, e.g. the sandbox info
+				continue
+			}
+			b.Put([]byte(QUARANTINE_KEY_PREFIX+k), data)
+			b.Delete([]byte(k))
+		}
+		return c.db.Write(b)
+	})
+}
+
+func describe(found bool, err error, what string) string {
+	if !found && err == nil {
+		return "missing " + what
+	}
+	if !found {
+		return fmt.Sprintf("failed to read %s: %v", what, err)
+	}
+	return fmt.Sprintf("corrupted %s: %v", what, err)
+}
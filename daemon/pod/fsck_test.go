@@ -0,0 +1,215 @@
+package pod
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperhq/hyperd/daemon/daemondb"
+	"github.com/hyperhq/hyperd/types"
+)
+
+func putMessage(t *testing.T, db *daemondb.DaemonDB, key string, message proto.Message) {
+	data, err := proto.Marshal(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Update([]byte(key), data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func putPod(t *testing.T, db *daemondb.DaemonDB, id string, containers, volumes []string) {
+	putMessage(t, db, fmt.Sprintf(LAYOUT_KEY_FMT, id), &types.PersistPodLayout{Id: id, Containers: containers, Volumes: volumes})
+	putMessage(t, db, fmt.Sprintf(PS_KEY_FMT, id), &types.UserPod{Id: id})
+	putMessage(t, db, fmt.Sprintf(PMETA_KEY_FMT, id), &types.PersistPodMeta{Id: id, Namespace: "default"})
+	putMessage(t, db, fmt.Sprintf(PMAP_KEY_FMT, id), &types.PersistPortmappings{Pod: id})
+	for _, cid := range containers {
+		putMessage(t, db, fmt.Sprintf(CX_KEY_FMT, cid), &types.PersistContainer{Id: cid, Pod: id})
+	}
+	for _, vid := range volumes {
+		putMessage(t, db, fmt.Sprintf(VX_KEY_FMT, id, vid), &types.PersistVolume{Name: vid, Pod: id})
+	}
+}
+
+func exists(db *daemondb.DaemonDB, key string) bool {
+	_, err := db.Get([]byte(key))
+	return err == nil
+}
+
+func TestCheckDB(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fsck")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	db, err := daemondb.NewDaemonDB(filepath.Join(dir, "hyper.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// a healthy pod
+	putPod(t, db, "pod-good", []string{"c1"}, []string{"v1"})
+	// a pod missing its meta, a container and a volume
+	putPod(t, db, "pod-broken", []string{"c2", "c3"}, []string{"v2"})
+	db.Delete([]byte(fmt.Sprintf(PMETA_KEY_FMT, "pod-broken")))
+	db.Delete([]byte(fmt.Sprintf(CX_KEY_FMT, "c3")))
+	db.Delete([]byte(fmt.Sprintf(VX_KEY_FMT, "pod-broken", "v2")))
+	// a pod missing its spec
+	putPod(t, db, "pod-nospec", []string{"c4"}, nil)
+	db.Delete([]byte(fmt.Sprintf(PS_KEY_FMT, "pod-nospec")))
+	// records of a pod without layout
+	putMessage(t, db, fmt.Sprintf(CX_KEY_FMT, "c5"), &types.PersistContainer{Id: "c5", Pod: "pod-gone"})
+	putMessage(t, db, fmt.Sprintf(PS_KEY_FMT, "pod-gone"), &types.UserPod{Id: "pod-gone"})
+
+	problems, err := CheckDB(db, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// meta, c3 and v2 of pod-broken, the spec of pod-nospec, c5 and the spec of pod-gone
+	if len(problems) != 6 {
+		t.Fatalf("expect 6 problems, got %d: %v", len(problems), problems)
+	}
+	for _, p := range problems {
+		if p.Repaired {
+			t.Fatalf("problem repaired in check mode: %v", p)
+		}
+	}
+	if exists(db, fmt.Sprintf(CX_KEY_FMT, "c5")) == false || exists(db, fmt.Sprintf(LAYOUT_KEY_FMT, "pod-nospec")) == false {
+		t.Fatal("db modified in check mode")
+	}
+
+	problems, err = CheckDB(db, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(problems) != 6 {
+		t.Fatalf("expect 6 problems, got %d: %v", len(problems), problems)
+	}
+
+	var layout types.PersistPodLayout
+	data, _ := db.Get([]byte(fmt.Sprintf(LAYOUT_KEY_FMT, "pod-broken")))
+	if err := proto.Unmarshal(data, &layout); err != nil {
+		t.Fatal(err)
+	}
+	if len(layout.Containers) != 1 || layout.Containers[0] != "c2" || len(layout.Volumes) != 0 {
+		t.Fatalf("unexpected repaired layout %v", layout)
+	}
+	if !exists(db, fmt.Sprintf(PMETA_KEY_FMT, "pod-broken")) {
+		t.Fatal("pod meta is not rebuilt")
+	}
+	if exists(db, fmt.Sprintf(LAYOUT_KEY_FMT, "pod-nospec")) || !exists(db, QUARANTINE_KEY_PREFIX+fmt.Sprintf(LAYOUT_KEY_FMT, "pod-nospec")) ||
+		!exists(db, QUARANTINE_KEY_PREFIX+fmt.Sprintf(CX_KEY_FMT, "c4")) {
+		t.Fatal("pod-nospec is not quarantined")
+	}
+	if exists(db, fmt.Sprintf(CX_KEY_FMT, "c5")) || exists(db, fmt.Sprintf(PS_KEY_FMT, "pod-gone")) {
+		t.Fatal("dangling records are not removed")
+	}
+	if !exists(db, fmt.Sprintf(CX_KEY_FMT, "c1")) || !exists(db, fmt.Sprintf(VX_KEY_FMT, "pod-good", "v1")) {
+		t.Fatal("records of the healthy pod are removed")
+	}
+
+	problems, err = CheckDB(db, false)
+	if err != nil || len(problems) != 0 {
+		t.Fatalf("expect no problems after repair, got %v, %v", problems, err)
+	}
+}
+
+func TestQuarantineSharedPrefix(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fsck")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	db, err := daemondb.NewDaemonDB(filepath.Join(dir, "hyper.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// the layout of web is corrupted, web-2 shares its prefix
+	for _, id := range []string{"web", "web-2"} {
+		putPod(t, db, id, []string{"c-" + id}, []string{"data"})
+		putMessage(t, db, fmt.Sprintf(IF_KEY_FMT, id, "eth0"), &types.PersistInterface{Id: "eth0", Pod: id})
+	}
+	var layout types.PersistPodLayout
+	data, _ := db.Get([]byte(fmt.Sprintf(LAYOUT_KEY_FMT, "web-2")))
+	proto.Unmarshal(data, &layout)
+	layout.Interfaces = []string{"eth0"}
+	putMessage(t, db, fmt.Sprintf(LAYOUT_KEY_FMT, "web-2"), &layout)
+	db.Update([]byte(fmt.Sprintf(LAYOUT_KEY_FMT, "web")), []byte("corrupted"))
+
+	if _, err := CheckDB(db, true); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{fmt.Sprintf(CX_KEY_FMT, "c-web"), fmt.Sprintf(VX_KEY_FMT, "web", "data"), fmt.Sprintf(IF_KEY_FMT, "web", "eth0")} {
+		if exists(db, key) || !exists(db, QUARANTINE_KEY_PREFIX+key) {
+			t.Fatalf("%s is not quarantined", key)
+		}
+	}
+	for _, key := range []string{fmt.Sprintf(CX_KEY_FMT, "c-web-2"), fmt.Sprintf(VX_KEY_FMT, "web-2", "data"), fmt.Sprintf(IF_KEY_FMT, "web-2", "eth0")} {
+		if !exists(db, key) || exists(db, QUARANTINE_KEY_PREFIX+key) {
+			t.Fatalf("%s of web-2 is quarantined along with web", key)
+		}
+	}
+}
+
+func TestCheckVolumeRecords(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fsck")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	db, err := daemondb.NewDaemonDB(filepath.Join(dir, "hyper.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	putPod(t, db, "web", nil, nil)
+	putMessage(t, db, fmt.Sprintf(PS_KEY_FMT, "web"), &types.UserPod{Id: "web", Volumes: []*types.UserVolume{{Name: "data"}}})
+	db.UpdatePodVolume("web", "data", []byte("plugin:local:web-data"))
+	// the plugin volume of the removed pod web-2 shares the prefix of web
+	db.UpdatePodVolume("web-2", "data", []byte("plugin:local:web-2-data"))
+	// the volumes of the storage drivers are left to the gc
+	db.UpdatePodVolume("web-3", "data", []byte("web-3-data:1"))
+
+	putMessage(t, db, fmt.Sprintf(daemondb.NAMED_VOLUME_KEY, "shared"), &types.VolumeInfo{Name: "shared", Pods: []string{"web", "web-2"}})
+
+	problems, err := CheckDB(db, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(problems) != 2 {
+		t.Fatalf("expect 2 problems, got %d: %v", len(problems), problems)
+	}
+	for _, p := range problems {
+		if p.Key != fmt.Sprintf(daemondb.POD_VOLUME_KEY, "web-2", "data") && p.Key != fmt.Sprintf(daemondb.NAMED_VOLUME_KEY, "shared") {
+			t.Fatalf("unexpected problem %v", p)
+		}
+	}
+
+	// the plugin is not running here
+	db.DeletePodVolume("web-2", "data")
+	if _, err := CheckDB(db, true); err != nil {
+		t.Fatal(err)
+	}
+	var vol types.VolumeInfo
+	data, _ := db.GetNamedVolume("shared")
+	if err := proto.Unmarshal(data, &vol); err != nil {
+		t.Fatal(err)
+	}
+	if len(vol.Pods) != 1 || vol.Pods[0] != "web" {
+		t.Fatalf("unexpected references %v", vol.Pods)
+	}
+	if !exists(db, fmt.Sprintf(daemondb.POD_VOLUME_KEY, "web", "data")) || !exists(db, fmt.Sprintf(daemondb.POD_VOLUME_KEY, "web-3", "data")) {
+		t.Fatal("the volume records in use are removed")
+	}
+}
@@ -85,8 +85,11 @@ func MigrateLagecyPersistentData(db *daemondb.DaemonDB, podFactory func() *PodFa
 				Id:          vmID,
 				PersistInfo: vmData,
 			}
-			err = saveMessage(db, fmt.Sprintf(SB_KEY_FMT, podSpec.Id), &sandboxInfo, nil, "sandbox info")
-			if err != nil {
+			b := db.NewBatch()
+			if err = saveMessage(b, fmt.Sprintf(SB_KEY_FMT, podSpec.Id), &sandboxInfo, nil, "sandbox info"); err != nil {
+				return err
+			}
+			if err = db.Write(b); err != nil {
 				return err
 			}
 		}
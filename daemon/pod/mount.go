@@ -4,11 +4,17 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"path"
+	"path/filepath"
+	"strings"
+	"syscall"
 
 	"github.com/hyperhq/hypercontainer-utils/hlog"
 	"github.com/hyperhq/hyperd/storage"
 	dm "github.com/hyperhq/hyperd/storage/devicemapper"
+	"github.com/hyperhq/hyperd/storage/graphdriver/rawblock"
+	"github.com/hyperhq/hyperd/storage/luks"
 	apitypes "github.com/hyperhq/hyperd/types"
 	"github.com/hyperhq/hyperd/utils"
 	runv "github.com/hyperhq/runv/api"
@@ -50,7 +56,11 @@ func ProbeExistingVolume(v *apitypes.UserVolume, sharedDir string) (*runv.Volume
 		}
 	}
 
-	if v.Format == "vfs" {
+	if v.Encryption != nil {
+		if err = openEncryptedVolume(v, vol); err != nil {
+			return nil, err
+		}
+	} else if v.Format == "vfs" {
 		vol.Fstype = "dir"
 		vol.Source, err = storage.MountVFSVolume(v.Source, sharedDir)
 		if err != nil {
@@ -72,6 +82,153 @@ func ProbeExistingVolume(v *apitypes.UserVolume, sharedDir string) (*runv.Volume
 	return vol, nil
 }
 
+// openEncryptedVolume opens the LUKS volume, and the decrypted device is
+// inserted into the sandbox instead.
+func openEncryptedVolume(v *apitypes.UserVolume, vol *runv.VolumeDescription) error {
+	if v.Format != "raw" && v.Format != "qcow2" {
+		return fmt.Errorf("volume %s of format %s can not be encrypted", v.Name, v.Format)
+	}
+	key, err := luks.GetKey(v.Encryption.KeyRef)
+	if err != nil {
+		return fmt.Errorf("failed to get key of volume %s: %v", v.Name, err)
+	}
+	fstype := v.Fstype
+	if fstype == "" {
+		fstype = storage.DEFAULT_VOL_FS
+	}
+	dev, err := luks.Open(v.Source, v.Format, fstype, key, v.Encryption.Cipher, v.Encryption.Created)
+	if err != nil {
+		return fmt.Errorf("failed to open encrypted volume %s: %v", v.Name, err)
+	}
+	hlog.Log(DEBUG, "encrypted volume %s is opened as %s", v.Source, dev)
+	vol.Source, vol.Format, vol.Fstype = dev, "raw", fstype
+	return nil
+}
+
+// VolumeUsage returns the disk space used by the volume. Only the volumes
+// created by the storage drivers and the block devices are measured, as
+// walking through a directory of the host could be very slow.
+func VolumeUsage(v *apitypes.UserVolume) (int64, error) {
+	if v == nil || v.Source == "" {
+		return 0, nil
+	}
+	switch v.Format {
+	case "vfs":
+		if !strings.HasPrefix(v.Source, storage.VFSVolumePath("", "")+"/") {
+			return 0, nil
+		}
+		return storage.DirUsage(v.Source)
+	case "raw":
+		if strings.HasPrefix(v.Source, "/dev/mapper/") {
+			return dm.VolumeUsage(filepath.Base(v.Source))
+		}
+		fi, err := os.Stat(v.Source)
+		if err != nil {
+			return 0, err
+		}
+		if st, ok := fi.Sys().(*syscall.Stat_t); ok && fi.Mode().IsRegular() {
+			return st.Blocks * 512, nil
+		}
+	}
+	return 0, nil
+}
+
+// MountVolumeOnHost mounts the volume to the directory of the host, so that
+// the daemon could read or write the files in the volume. The vfs volumes are
+// not mounted, their directories are returned instead. The returned function
+// unmounts the volume and releases the devices attached for it.
+func MountVolumeOnHost(v *apitypes.UserVolume, target string, readonly bool) (string, func(), error) {
+	if v.Source == "" {
+		return "", nil, fmt.Errorf("volume %s is not created yet", v.Name)
+	}
+	if v.Format == "vfs" {
+		return v.Source, func() {}, nil
+	}
+	if v.Format != "raw" && v.Format != "qcow2" {
+		return "", nil, fmt.Errorf("volume %s of format %s could not be mounted on the host", v.Name, v.Format)
+	}
+
+	var (
+		source  = v.Source
+		fstype  = v.Fstype
+		release = []func(){}
+		err     error
+	)
+	cleanup := func() {
+		for i := len(release) - 1; i >= 0; i-- {
+			release[i]()
+		}
+	}
+	defer func() {
+		if err != nil {
+			cleanup()
+		}
+	}()
+
+	if v.Encryption != nil {
+		name := luks.MappingName(v.Source)
+		_, err = os.Stat(filepath.Join("/dev/mapper", name))
+		opened := err == nil
+		if fstype == "" {
+			fstype = storage.DEFAULT_VOL_FS
+		}
+		var key []byte
+		if key, err = luks.GetKey(v.Encryption.KeyRef); err != nil {
+			return "", nil, err
+		}
+		if source, err = luks.Open(v.Source, v.Format, fstype, key, v.Encryption.Cipher, v.Encryption.Created); err != nil {
+			return "", nil, err
+		}
+		if !opened {
+			release = append(release, func() { luks.Close(name) })
+		}
+	} else if v.Format == "qcow2" {
+		if source, err = rawblock.ConnectNbd(v.Source); err != nil {
+			return "", nil, err
+		}
+		release = append(release, func() { rawblock.DisconnectNbd(source) })
+	}
+
+	if fstype == "" {
+		if fstype, err = dm.ProbeFsType(source); err != nil {
+			fstype = storage.DEFAULT_VOL_FS
+		}
+	}
+	opts := []string{}
+	if fi, err := os.Stat(source); err == nil && fi.Mode().IsRegular() {
+		opts = append(opts, "loop")
+	}
+	if fstype == "xfs" {
+		opts = append(opts, "nouuid")
+	}
+	if readonly {
+		// the journal is left to the sandbox which may still have the
+		// volume mounted
+		opts = append(opts, "ro")
+		switch fstype {
+		case "ext3", "ext4":
+			opts = append(opts, "noload")
+		case "xfs":
+			opts = append(opts, "norecovery")
+		}
+	}
+	args := []string{"-t", fstype}
+	if len(opts) > 0 {
+		args = append(args, "-o", strings.Join(opts, ","))
+	}
+	var out []byte
+	if out, err = exec.Command("mount", append(args, source, target)...).CombinedOutput(); err != nil {
+		err = fmt.Errorf("failed to mount volume %s: %v: %s", v.Name, err, string(out))
+		return "", nil, err
+	}
+	release = append(release, func() {
+		if out, err := exec.Command("umount", "-d", target).CombinedOutput(); err != nil {
+			hlog.Log(ERROR, "failed to umount volume %s: %v: %s", v.Name, err, string(out))
+		}
+	})
+	return target, cleanup, nil
+}
+
 func UmountExistingVolume(fstype, target, sharedDir string) error {
 	if fstype == "dir" {
 		return storage.UmountVFSVolume(target, sharedDir)
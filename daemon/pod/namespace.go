@@ -0,0 +1,173 @@
+package pod
+
+import (
+	"fmt"
+	"strings"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/hyperd/utils"
+)
+
+const (
+	DEFAULT_NAMESPACE = "default"
+
+	// NAMESPACE_SEPARATOR joins the name and the namespace of a pod or a
+	// container, the pods in the default namespace keep their plain names
+	// so that the pods created before namespaces could be restored as is.
+	NAMESPACE_SEPARATOR = "@"
+
+	// ENGINE_NAME_SEPARATOR joins the name and the namespace of a container
+	// in the container engine, which only accepts [a-zA-Z0-9_.-] in names.
+	// The namespaces are DNS labels and could not contain it, so the engine
+	// names of the namespaces never collide with each other. The containers
+	// in the default namespace keep their plain names in the engine, and the
+	// PodList rejects a name only if its engine name is actually taken.
+	ENGINE_NAME_SEPARATOR = "."
+)
+
+// NormalizeNamespace turns the empty namespace into the default one.
+func NormalizeNamespace(namespace string) string {
+	if namespace == "" {
+		return DEFAULT_NAMESPACE
+	}
+	return namespace
+}
+
+func ValidateNamespace(namespace string) error {
+	if namespace == "" || namespace == DEFAULT_NAMESPACE {
+		return nil
+	}
+	if !utils.IsDNSLabel(namespace) {
+		return fmt.Errorf("namespace should fullfil the pattern: %s, input namespace: %s", utils.Dns1123LabelFmt, namespace)
+	}
+	return nil
+}
+
+// QualifiedName returns the unique key of a pod or container name in the
+// given namespace. The name is always taken as a plain one, a name carrying
+// the separator stays in the given namespace, use ScopedName for the names
+// which could be qualified by the callers.
+func QualifiedName(namespace, name string) string {
+	if name == "" {
+		return name
+	}
+	namespace = NormalizeNamespace(namespace)
+	if namespace == DEFAULT_NAMESPACE {
+		return name
+	}
+	return name + NAMESPACE_SEPARATOR + namespace
+}
+
+// ScopedName returns the unique key of a pod or container referred in the
+// namespace, a name already qualified must be in the same namespace, so that
+// the pods of the other namespaces could not be reached. Names referred out of
+// any namespace, i.e. in an empty one, are returned as is.
+func ScopedName(namespace, name string) (string, error) {
+	if namespace == "" {
+		return name, nil
+	}
+	if idx := strings.LastIndex(name, NAMESPACE_SEPARATOR); idx >= 0 {
+		if ns := name[idx+1:]; ns != namespace {
+			return "", fmt.Errorf("%s does not belong to namespace %s", name, namespace)
+		}
+		name = name[:idx]
+	}
+	if err := ValidateName(name); err != nil {
+		return "", err
+	}
+	return QualifiedName(namespace, name), nil
+}
+
+// ValidateName checks the plain name of a pod or container could be qualified
+// with a namespace unambiguously.
+func ValidateName(name string) error {
+	if strings.Contains(name, NAMESPACE_SEPARATOR) {
+		return fmt.Errorf("name %s could not contain %q", name, NAMESPACE_SEPARATOR)
+	}
+	return nil
+}
+
+// SplitQualifiedName splits a qualified name into its namespace and name.
+func SplitQualifiedName(qname string) (namespace, name string) {
+	if idx := strings.LastIndex(qname, NAMESPACE_SEPARATOR); idx >= 0 {
+		return qname[idx+1:], qname[:idx]
+	}
+	return DEFAULT_NAMESPACE, qname
+}
+
+// engineName is the name of a container in the container engine, which is
+// shared by all namespaces.
+func engineName(namespace, name string) string {
+	namespace = NormalizeNamespace(namespace)
+	if namespace == DEFAULT_NAMESPACE {
+		return name
+	}
+	return name + ENGINE_NAME_SEPARATOR + namespace
+}
+
+// engineAlias returns the qualified name of the container in another
+// namespace which would take the same engine name as the given one, or an
+// empty string if there is none.
+func engineAlias(qname string) string {
+	namespace, name := SplitQualifiedName(qname)
+	if namespace != DEFAULT_NAMESPACE {
+		return engineName(namespace, name)
+	}
+	idx := strings.LastIndex(name, ENGINE_NAME_SEPARATOR)
+	if idx <= 0 {
+		return ""
+	}
+	if ns := name[idx+1:]; ns != DEFAULT_NAMESPACE && utils.IsDNSLabel(ns) {
+		return QualifiedName(ns, name[:idx])
+	}
+	return ""
+}
+
+func (p *XPod) Namespace() string {
+	return p.namespace
+}
+
+// sandboxResource returns the vcpu and memory the pod occupied, the default
+// values are the same as startSandbox.
+func sandboxResource(spec *apitypes.UserPod) (int, int) {
+	cpu, mem := 1, 128
+	if spec != nil && spec.Resource != nil {
+		if spec.Resource.Vcpu > 0 {
+			cpu = int(spec.Resource.Vcpu)
+		}
+		if spec.Resource.Memory > 0 {
+			mem = int(spec.Resource.Memory)
+		}
+	}
+	return cpu, mem
+}
+
+// NamespaceUsage is the resources occupied by the pods of a namespace.
+type NamespaceUsage struct {
+	Pods   int
+	Vcpu   int
+	Memory int
+}
+
+func (u *NamespaceUsage) add(spec *apitypes.UserPod) {
+	cpu, mem := sandboxResource(spec)
+	u.Pods++
+	u.Vcpu += cpu
+	u.Memory += mem
+}
+
+func (u *NamespaceUsage) exceed(quota *apitypes.NamespaceQuota) error {
+	if quota == nil {
+		return nil
+	}
+	if quota.Pods > 0 && u.Pods > quota.Pods {
+		return fmt.Errorf("pod count %d exceeds the quota %d", u.Pods, quota.Pods)
+	}
+	if quota.Vcpu > 0 && u.Vcpu > quota.Vcpu {
+		return fmt.Errorf("vcpu %d exceeds the quota %d", u.Vcpu, quota.Vcpu)
+	}
+	if quota.Memory > 0 && u.Memory > quota.Memory {
+		return fmt.Errorf("memory %dMB exceeds the quota %dMB", u.Memory, quota.Memory)
+	}
+	return nil
+}
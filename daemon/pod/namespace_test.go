@@ -0,0 +1,145 @@
+package pod
+
+import (
+	"testing"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+func TestScopedName(t *testing.T) {
+	cases := []struct {
+		namespace, name, expect string
+		fail                    bool
+	}{
+		{"", "web", "web", false},
+		{"", "web@team-b", "web@team-b", false},
+		{"team-a", "web", "web@team-a", false},
+		{"team-a", "web@team-a", "web@team-a", false},
+		{"team-a", "web@team-b", "", true},
+		{"team-a", "web@team-b@team-a", "", true},
+		{"default", "web", "web", false},
+		{"default", "web@default", "web", false},
+		{"default", "web@team-a", "", true},
+		{"default", "web@team-a@default", "", true},
+	}
+	for _, c := range cases {
+		name, err := ScopedName(c.namespace, c.name)
+		if c.fail != (err != nil) || name != c.expect {
+			t.Errorf("ScopedName(%q, %q): expect %q, fail %v, got %q, %v", c.namespace, c.name, c.expect, c.fail, name, err)
+		}
+	}
+}
+
+func TestEngineName(t *testing.T) {
+	if engineName("", "web") != "web" || engineName("team-a", "web") != "web.team-a" {
+		t.Fatalf("unexpected engine names %s and %s", engineName("", "web"), engineName("team-a", "web"))
+	}
+	for qname, expect := range map[string]string{
+		"web":               "",
+		"my_web":            "",
+		"web.team-a":        "web@team-a",
+		"web.team-a@team-b": "web.team-a.team-b",
+		"web@team-a":        "web.team-a",
+		"web.default":       "",
+		"web.Team_A":        "",
+		".team-a":           "",
+	} {
+		if alias := engineAlias(qname); alias != expect {
+			t.Errorf("engineAlias(%s): expect %q, got %q", qname, expect, alias)
+		}
+	}
+
+	pl := NewPodList()
+	pl.hub = nil
+	testPod(pl, "", "web", map[string]string{"aaa111": "my_web"})
+	testPod(pl, "team-a", "web", map[string]string{"bbb222": "nginx"})
+	testPod(pl, "", "db", nil)
+	testPod(pl, "team-b", "db", nil)
+
+	// the names with underscores or dots are accepted in the default
+	// namespace, unless the engine name is taken by another namespace
+	if err := pl.ReserveContainerName("my_db", "db"); err != nil {
+		t.Fatal(err)
+	}
+	if err := pl.ReserveContainerName("nginx.team-a", "db"); err == nil {
+		t.Fatal("reserved the engine name of nginx in team-a")
+	}
+	if err := pl.ReserveContainerName("my_web.team-b", "db"); err != nil {
+		t.Fatal(err)
+	}
+	if err := pl.ReserveContainerName("my_web", "db@team-b"); err == nil {
+		t.Fatal("reserved the engine name of my_web.team-b in the default namespace")
+	}
+	if err := pl.ReserveContainerName("nginx", "db@team-b"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testPod(pl *PodList, namespace, name string, containers map[string]string) *XPod {
+	p := &XPod{
+		name:       QualifiedName(namespace, name),
+		namespace:  NormalizeNamespace(namespace),
+		containers: make(map[string]*Container),
+	}
+	pl.pods[p.name] = p
+	for id, cname := range containers {
+		p.containers[id] = &Container{spec: &apitypes.UserContainer{Id: id, Name: cname}}
+		pl.containers[id] = p.name
+		pl.containerNames[containerKey(cname, p.name)] = p.name
+	}
+	return p
+}
+
+func TestNamespaceIsolation(t *testing.T) {
+	pl := NewPodList()
+	pl.hub = nil
+
+	def := testPod(pl, "", "web", map[string]string{"aaa111": "nginx"})
+	teamA := testPod(pl, "team-a", "web", map[string]string{"bbb222": "nginx"})
+
+	for ref, expect := range map[string]*XPod{
+		"web":         def,
+		"web@default": def,
+		"web@team-a":  teamA,
+		"web@team-b":  nil,
+	} {
+		p, ok := pl.Get(ref)
+		if expect == nil && ok || expect != nil && p != expect {
+			t.Errorf("Get(%s): unexpected pod %v", ref, p)
+		}
+	}
+
+	for ref, expect := range map[string]*XPod{
+		// names
+		"nginx":        def,
+		"nginx@team-a": teamA,
+		// ids and id prefixes
+		"aaa111":        def,
+		"aaa1":          def,
+		"aaa111@team-a": nil,
+		"bbb222@team-a": teamA,
+		"bbb2@team-a":   teamA,
+		// a plain id refers to the default namespace only
+		"bbb222": nil,
+		"bbb2":   nil,
+	} {
+		p, _, ok := pl.GetByContainerIdOrName(ref)
+		if expect == nil && ok || expect != nil && p != expect {
+			t.Errorf("GetByContainerIdOrName(%s): unexpected pod %v", ref, p)
+		}
+	}
+
+	// the references scoped in team-b could not reach the other namespaces
+	for _, ref := range []string{"web", "nginx", "bbb222", "bbb222@team-a", "aaa111@default"} {
+		scoped, err := ScopedName("team-b", ref)
+		if err != nil {
+			continue
+		}
+		if _, ok := pl.Get(scoped); ok {
+			t.Errorf("pod %s reached in team-b", ref)
+		}
+		if _, _, ok := pl.GetByContainerIdOrName(scoped); ok {
+			t.Errorf("container %s reached in team-b", ref)
+		}
+	}
+}
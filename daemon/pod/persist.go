@@ -20,13 +20,15 @@ import (
 /// PS-{Pod.Id()}: Global Part of Pod Spec
 /// PM-{Pod.Id()}: Pod level metadata that could be changed
 ///         |`- services: service list
-///          `- labels
+///         |`- labels
+///          `- namespace
 /// PP-{Pod.Id()}: Port Mapping rules
 ///         |`- containerIp: container IP for portmapping operations
 ///          `- portMappings: rules
 /// CX-{Container.Id()} Container Persistent Info
 /// VX-{Pod.ID()}-{Volume.Name()} Volume Persist Info
 /// IF-{Pod.ID()}-{Inf.Id()}
+/// QR-{Key}: records of the pods quarantined by the db check
 
 const (
 	LAYOUT_KEY_PREFIX = "PL-"
@@ -77,6 +79,12 @@ func LoadXPod(factory *PodFactory, layout *types.PersistPodLayout) (*XPod, error
 	if err != nil {
 		return nil, err
 	}
+	// the namespace should be known before reserving the names of the pod
+	// and its containers
+	spec.Namespace, err = loadPodNamespace(factory.db, layout.Id)
+	if err != nil {
+		return nil, err
+	}
 
 	p, err := newXPod(factory, spec)
 	if err != nil {
@@ -117,7 +125,7 @@ func LoadXPod(factory *PodFactory, layout *types.PersistPodLayout) (*XPod, error
 	err = p.loadSandbox()
 	if err != nil {
 		if !strings.Contains(err.Error(), "leveldb: not found") {
-			p.removeSandboxFromDB()
+			p.persist(p.removeSandboxFromDB)
 			return nil, err
 		}
 		p.status = S_POD_STOPPED
@@ -155,103 +163,58 @@ func LoadXPod(factory *PodFactory, layout *types.PersistPodLayout) (*XPod, error
 	return p, nil
 }
 
-func (p *XPod) savePod() error {
-	var (
-		containers = make([]string, 0, len(p.containers))
-		volumes    = make([]string, 0, len(p.volumes))
-		interfaces = make([]string, 0, len(p.interfaces))
-	)
-
-	if err := p.saveGlobalSpec(); err != nil {
-		return err
-	}
-
-	if err := p.saveSandbox(); err != nil {
-		return err
-	}
-
-	if err := p.savePodMeta(); err != nil {
-		return err
+// persist writes the records saved or removed by ops to the db in one batch,
+// so that a logical update of the pod is either all or none persisted.
+func (p *XPod) persist(ops ...func(*daemondb.Batch) error) error {
+	b := p.factory.db.NewBatch()
+	for _, op := range ops {
+		if err := op(b); err != nil {
+			return err
+		}
 	}
-
-	if err := p.savePortMapping(); err != nil {
+	if err := p.factory.db.Write(b); err != nil {
+		p.Log(ERROR, "failed to write %d records to db: %v", b.Len(), err)
 		return err
 	}
+	return nil
+}
 
-	for cid, c := range p.containers {
-		containers = append(containers, cid)
-		if err := c.saveContainer(); err != nil {
-			return err
-		}
-	}
+func (p *XPod) savePod() error {
+	return p.persist(p.savePodOps()...)
+}
 
-	for vid, v := range p.volumes {
-		volumes = append(volumes, vid)
-		if err := v.saveVolume(); err != nil {
-			return err
-		}
+// savePodOps returns the ops saving all the records of the pod.
+func (p *XPod) savePodOps() []func(*daemondb.Batch) error {
+	ops := []func(*daemondb.Batch) error{p.saveGlobalSpec, p.saveSandbox, p.savePodMeta, p.savePortMapping}
+	for _, c := range p.containers {
+		ops = append(ops, c.saveContainer)
 	}
-
-	for iId, i := range p.interfaces {
-		interfaces = append(interfaces, iId)
-		if err := i.saveInterface(); err != nil {
-			return err
-		}
+	for _, v := range p.volumes {
+		ops = append(ops, v.saveVolume)
 	}
-
-	pl := &types.PersistPodLayout{
-		Id:         p.Id(),
-		Containers: containers,
-		Volumes:    volumes,
-		Interfaces: interfaces,
+	for _, i := range p.interfaces {
+		ops = append(ops, i.saveInterface)
 	}
-	return saveMessage(p.factory.db, fmt.Sprintf(LAYOUT_KEY_FMT, p.Id()), pl, p, "pod layout")
+	// the layout refers to all the records above
+	return append(ops, p.saveLayout)
 }
 
-func (p *XPod) removeFromDB() (err error) {
-	// remove pod layout entry first.
-	if err = removeMessage(p.factory.db, fmt.Sprintf(LAYOUT_KEY_FMT, p.Id()), p, "pod layout"); err != nil {
-		return err
-	}
-
+func (p *XPod) removeFromDB() error {
+	ops := []func(*daemondb.Batch) error{p.removeLayoutFromDB}
 	for _, i := range p.interfaces {
-		if err = i.removeFromDB(); err != nil {
-			return err
-		}
+		ops = append(ops, i.removeFromDB)
 	}
-
 	for _, v := range p.volumes {
-		if err = v.removeFromDB(); err != nil {
-			return err
-		}
+		ops = append(ops, v.removeFromDB)
 	}
-
 	for _, c := range p.containers {
-		if err = c.removeFromDB(); err != nil {
-			return err
-		}
-	}
-
-	if err = p.removePodMetaFromDB(); err != nil {
-		return err
+		ops = append(ops, c.removeFromDB)
 	}
-
-	if err = p.removePortMappingFromDB(); err != nil {
-		return err
-	}
-
-	if err = p.removeSandboxFromDB(); err != nil {
-		return err
-	}
-
-	if err = p.removeGlobalSpecFromDB(); err != nil {
-		return err
-	}
-
-	return nil
+	ops = append(ops, p.removePodMetaFromDB, p.removePortMappingFromDB, p.removeSandboxFromDB, p.removeGlobalSpecFromDB)
+	return p.persist(ops...)
 }
 
-func (p *XPod) saveLayout() error {
+func (p *XPod) saveLayout(b *daemondb.Batch) error {
 	var (
 		containers = make([]string, 0, len(p.containers))
 		volumes    = make([]string, 0, len(p.volumes))
@@ -276,11 +239,15 @@ func (p *XPod) saveLayout() error {
 		Volumes:    volumes,
 		Interfaces: interfaces,
 	}
-	return saveMessage(p.factory.db, fmt.Sprintf(LAYOUT_KEY_FMT, p.Id()), pl, p, "pod layout")
+	return saveMessage(b, fmt.Sprintf(LAYOUT_KEY_FMT, p.Id()), pl, p, "pod layout")
+}
+
+func (p *XPod) removeLayoutFromDB(b *daemondb.Batch) error {
+	return removeMessage(b, fmt.Sprintf(LAYOUT_KEY_FMT, p.Id()), p, "pod layout")
 }
 
-func (p *XPod) saveGlobalSpec() error {
-	return saveMessage(p.factory.db, fmt.Sprintf(PS_KEY_FMT, p.Id()), p.globalSpec, p, "global spec")
+func (p *XPod) saveGlobalSpec(b *daemondb.Batch) error {
+	return saveMessage(b, fmt.Sprintf(PS_KEY_FMT, p.Id()), p.globalSpec, p, "global spec")
 }
 
 func loadGloabalSpec(db *daemondb.DaemonDB, id string) (*types.UserPod, error) {
@@ -292,20 +259,21 @@ func loadGloabalSpec(db *daemondb.DaemonDB, id string) (*types.UserPod, error) {
 	return &spec, nil
 }
 
-func (p *XPod) removeGlobalSpecFromDB() error {
-	return removeMessage(p.factory.db, fmt.Sprintf(PS_KEY_FMT, p.Id()), p, "global spec")
+func (p *XPod) removeGlobalSpecFromDB(b *daemondb.Batch) error {
+	return removeMessage(b, fmt.Sprintf(PS_KEY_FMT, p.Id()), p, "global spec")
 }
 
-func (p *XPod) savePodMeta() error {
+func (p *XPod) savePodMeta(b *daemondb.Batch) error {
 	meta := &types.PersistPodMeta{
-		Id:       p.Id(),
-		Services: p.services.get(),
-		Labels:   p.labels,
+		Id:        p.Id(),
+		Services:  p.services.get(),
+		Labels:    p.labels,
+		Namespace: p.namespace,
 	}
 	if p.info != nil {
 		meta.CreatedAt = p.info.CreatedAt
 	}
-	return saveMessage(p.factory.db, fmt.Sprintf(PMETA_KEY_FMT, p.Id()), meta, p, "pod meta")
+	return saveMessage(b, fmt.Sprintf(PMETA_KEY_FMT, p.Id()), meta, p, "pod meta")
 }
 
 func (p *XPod) loadPodMeta() error {
@@ -323,17 +291,31 @@ func (p *XPod) loadPodMeta() error {
 	return nil
 }
 
-func (p *XPod) removePodMetaFromDB() error {
-	return removeMessage(p.factory.db, fmt.Sprintf(PMETA_KEY_FMT, p.Id()), p, "pod meta")
+func loadPodNamespace(db *daemondb.DaemonDB, id string) (string, error) {
+	var meta types.PersistPodMeta
+	err := loadMessage(db, fmt.Sprintf(PMETA_KEY_FMT, id), &meta, nil, "pod meta")
+	if err != nil {
+		return "", err
+	}
+	if meta.Namespace == "" {
+		// pods created before namespaces were introduced
+		ns, _ := SplitQualifiedName(id)
+		return ns, nil
+	}
+	return meta.Namespace, nil
+}
+
+func (p *XPod) removePodMetaFromDB(b *daemondb.Batch) error {
+	return removeMessage(b, fmt.Sprintf(PMETA_KEY_FMT, p.Id()), p, "pod meta")
 }
 
-func (p *XPod) savePortMapping() error {
+func (p *XPod) savePortMapping(b *daemondb.Batch) error {
 	pm := &types.PersistPortmappings{
 		Pod:          p.Id(),
 		ContainerIP:  p.containerIP,
 		PortMappings: p.portMappings,
 	}
-	return saveMessage(p.factory.db, fmt.Sprintf(PMAP_KEY_FMT, p.Id()), pm, p, "port mappings")
+	return saveMessage(b, fmt.Sprintf(PMAP_KEY_FMT, p.Id()), pm, p, "port mappings")
 }
 
 func (p *XPod) loadPortMapping() error {
@@ -347,18 +329,18 @@ func (p *XPod) loadPortMapping() error {
 	return nil
 }
 
-func (p *XPod) removePortMappingFromDB() error {
-	return removeMessage(p.factory.db, fmt.Sprintf(PMAP_KEY_FMT, p.Id()), p, "port mappings")
+func (p *XPod) removePortMappingFromDB(b *daemondb.Batch) error {
+	return removeMessage(b, fmt.Sprintf(PMAP_KEY_FMT, p.Id()), p, "port mappings")
 }
 
-func (c *Container) saveContainer() error {
+func (c *Container) saveContainer(b *daemondb.Batch) error {
 	cx := &types.PersistContainer{
 		Id:       c.Id(),
 		Pod:      c.p.Id(),
 		Spec:     c.spec,
 		Descript: c.descript,
 	}
-	return saveMessage(c.p.factory.db, fmt.Sprintf(CX_KEY_FMT, c.Id()), cx, c, "container info")
+	return saveMessage(b, fmt.Sprintf(CX_KEY_FMT, c.Id()), cx, c, "container info")
 }
 
 func (p *XPod) loadContainer(id string) error {
@@ -383,18 +365,18 @@ func (p *XPod) loadContainer(id string) error {
 	return nil
 }
 
-func (c *Container) removeFromDB() error {
-	return removeMessage(c.p.factory.db, fmt.Sprintf(CX_KEY_FMT, c.Id()), c, "container info")
+func (c *Container) removeFromDB(b *daemondb.Batch) error {
+	return removeMessage(b, fmt.Sprintf(CX_KEY_FMT, c.Id()), c, "container info")
 }
 
-func (v *Volume) saveVolume() error {
+func (v *Volume) saveVolume(b *daemondb.Batch) error {
 	vx := &types.PersistVolume{
 		Name:     v.spec.Name,
 		Pod:      v.p.Id(),
 		Spec:     v.spec,
 		Descript: v.descript,
 	}
-	return saveMessage(v.p.factory.db, fmt.Sprintf(VX_KEY_FMT, v.p.Id(), v.spec.Name), vx, v, "volume info")
+	return saveMessage(b, fmt.Sprintf(VX_KEY_FMT, v.p.Id(), v.spec.Name), vx, v, "volume info")
 }
 
 func (p *XPod) loadVolume(id string) error {
@@ -412,18 +394,18 @@ func (p *XPod) loadVolume(id string) error {
 	return nil
 }
 
-func (v *Volume) removeFromDB() error {
-	return removeMessage(v.p.factory.db, fmt.Sprintf(VX_KEY_FMT, v.p.Id(), v.spec.Name), v, "volume info")
+func (v *Volume) removeFromDB(b *daemondb.Batch) error {
+	return removeMessage(b, fmt.Sprintf(VX_KEY_FMT, v.p.Id(), v.spec.Name), v, "volume info")
 }
 
-func (inf *Interface) saveInterface() error {
+func (inf *Interface) saveInterface(b *daemondb.Batch) error {
 	ix := &types.PersistInterface{
 		Id:       inf.spec.Id,
 		Pod:      inf.p.Id(),
 		Spec:     inf.spec,
 		Descript: inf.descript,
 	}
-	return saveMessage(inf.p.factory.db, fmt.Sprintf(IF_KEY_FMT, inf.p.Id(), inf.spec.Id), ix, inf, "interface info")
+	return saveMessage(b, fmt.Sprintf(IF_KEY_FMT, inf.p.Id(), inf.spec.Id), ix, inf, "interface info")
 }
 
 func (p *XPod) loadInterface(id string) error {
@@ -438,11 +420,11 @@ func (p *XPod) loadInterface(id string) error {
 	return nil
 }
 
-func (inf *Interface) removeFromDB() error {
-	return removeMessage(inf.p.factory.db, fmt.Sprintf(IF_KEY_FMT, inf.p.Id(), inf.descript.Id), inf, "interface info")
+func (inf *Interface) removeFromDB(b *daemondb.Batch) error {
+	return removeMessage(b, fmt.Sprintf(IF_KEY_FMT, inf.p.Id(), inf.descript.Id), inf, "interface info")
 }
 
-func (p *XPod) saveSandbox() error {
+func (p *XPod) saveSandbox(b *daemondb.Batch) error {
 	var (
 		sb  types.SandboxPersistInfo
 		err error
@@ -462,7 +444,7 @@ func (p *XPod) saveSandbox() error {
 			hlog.HLog(ERROR, p, 2, "failed to dump sandbox %s: %v", sb.Id, err)
 			return err
 		}
-		return saveMessage(p.factory.db, fmt.Sprintf(SB_KEY_FMT, p.Id()), &sb, p, "sandbox info")
+		return saveMessage(b, fmt.Sprintf(SB_KEY_FMT, p.Id()), &sb, p, "sandbox info")
 	}
 	return nil
 }
@@ -476,22 +458,18 @@ func (p *XPod) loadSandbox() error {
 	return p.reconnectSandbox(sb.Id, sb.PersistInfo)
 }
 
-func (p *XPod) removeSandboxFromDB() error {
-	return removeMessage(p.factory.db, fmt.Sprintf(SB_KEY_FMT, p.Id()), p, "sandbox info")
+func (p *XPod) removeSandboxFromDB(b *daemondb.Batch) error {
+	return removeMessage(b, fmt.Sprintf(SB_KEY_FMT, p.Id()), p, "sandbox info")
 }
 
-func saveMessage(db *daemondb.DaemonDB, key string, message proto.Message, owner hlog.LogOwner, op string) error {
+func saveMessage(b *daemondb.Batch, key string, message proto.Message, owner hlog.LogOwner, op string) error {
 	pm, err := proto.Marshal(message)
 	if err != nil {
 		hlog.HLog(ERROR, owner, 2, "failed to serialize %s: %v", op, err)
 		return err
 	}
-	err = db.Update([]byte(key), pm)
-	if err != nil {
-		hlog.HLog(ERROR, owner, 2, "failed to write %s to db: %v", op, err)
-		return err
-	}
-	hlog.HLog(DEBUG, owner, 2, "%s serialized to db", op)
+	b.Put([]byte(key), pm)
+	hlog.HLog(DEBUG, owner, 2, "%s serialized", op)
 	return nil
 }
 
@@ -509,12 +487,8 @@ func loadMessage(db *daemondb.DaemonDB, key string, message proto.Message, owner
 	return nil
 }
 
-func removeMessage(db *daemondb.DaemonDB, key string, owner hlog.LogOwner, op string) error {
-	err := db.Delete([]byte(key))
-	if err != nil {
-		hlog.HLog(ERROR, owner, 2, "failed to remove %s: %v", op, err)
-		return err
-	}
-	hlog.HLog(DEBUG, owner, 2, "%s removed from db", op)
+func removeMessage(b *daemondb.Batch, key string, owner hlog.LogOwner, op string) error {
+	b.Delete([]byte(key))
+	hlog.HLog(DEBUG, owner, 2, "%s to be removed from db", op)
 	return nil
 }
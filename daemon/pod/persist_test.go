@@ -0,0 +1,98 @@
+package pod
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/hyperhq/hyperd/daemon/daemondb"
+	"github.com/hyperhq/hyperd/types"
+)
+
+// dumpDB returns all the records in the db
+func dumpDB(t *testing.T, db *daemondb.DaemonDB) map[string]string {
+	records := map[string]string{}
+	for kv := range db.PrefixList2Chan(nil, nil) {
+		if kv == nil {
+			t.Fatal("failed to list the db")
+		}
+		records[string(kv.K)] = string(kv.V)
+	}
+	return records
+}
+
+func TestPersistFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "persist")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	db, err := daemondb.NewDaemonDB(filepath.Join(dir, "hyper.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	p := &XPod{
+		name:         "web",
+		globalSpec:   &types.UserPod{Id: "web"},
+		containers:   map[string]*Container{},
+		volumes:      map[string]*Volume{},
+		interfaces:   map[string]*Interface{},
+		services:     &Services{},
+		factory:      &PodFactory{db: db},
+		status:       S_POD_STOPPED,
+		resourceLock: &sync.Mutex{},
+		statusLock:   &sync.RWMutex{},
+	}
+	for _, id := range []string{"c1", "c2"} {
+		p.containers[id] = &Container{p: p, spec: &types.UserContainer{Id: id, Name: id}}
+	}
+	p.volumes["data"] = &Volume{p: p, spec: &types.UserVolume{Name: "data"}}
+	if err := p.savePod(); err != nil {
+		t.Fatal(err)
+	}
+	saved := dumpDB(t, db)
+	if len(saved) != 7 {
+		t.Fatalf("expect 7 records of the pod, got %v", saved)
+	}
+
+	fail := func(*daemondb.Batch) error { return errors.New("failed") }
+	unchanged := func(op string) {
+		if records := dumpDB(t, db); fmt.Sprint(records) != fmt.Sprint(saved) {
+			t.Fatalf("the db is changed by the failed %s:\n%v\nexpect:\n%v", op, records, saved)
+		}
+	}
+
+	// the records saved before the failing op are not written
+	p.globalSpec.Hostname = "changed"
+	p.containers["c3"] = &Container{p: p, spec: &types.UserContainer{Id: "c3", Name: "c3"}}
+	ops := p.savePodOps()
+	if err := p.persist(append([]func(*daemondb.Batch) error{ops[0], fail}, ops[1:]...)...); err == nil {
+		t.Fatal("the pod is saved with a failing op")
+	}
+	unchanged("savePod")
+	delete(p.containers, "c3")
+	p.globalSpec.Hostname = ""
+
+	// neither the volume nor the container is removed
+	c := p.containers["c1"]
+	delete(p.containers, "c1")
+	if err := p.persist(append(p.removeContainerOps(c, []string{"data"}), fail)...); err == nil {
+		t.Fatal("the container is removed with a failing op")
+	}
+	unchanged("RemoveContainer")
+
+	if err := p.persist(p.removeContainerOps(c, []string{"data"})...); err != nil {
+		t.Fatal(err)
+	}
+	if exists(db, fmt.Sprintf(CX_KEY_FMT, "c1")) || exists(db, fmt.Sprintf(VX_KEY_FMT, "web", "data")) ||
+		!exists(db, fmt.Sprintf(CX_KEY_FMT, "c2")) {
+		t.Fatalf("unexpected records after removing the container: %v", dumpDB(t, db))
+	}
+}
@@ -0,0 +1,114 @@
+package pod
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hyperhq/hyperd/storage/volumeplugin"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+// the db record of the volumes created by the plugins for the pods, in the
+// format "plugin:<driver>:<volume>", beside the records of the storage drivers
+const pluginVolumeRecordPrefix = "plugin:"
+
+func isPluginVolume(spec *apitypes.UserVolume) bool {
+	return !apitypes.IsBuiltinVolumeFormat(spec.Format)
+}
+
+// pluginVolumeName is the name of the volume created by the plugin for the
+// pod volume without source.
+func pluginVolumeName(podId, name string) string {
+	return podId + "-" + name
+}
+
+// ParsePluginVolumeRecord returns the plugin and the volume of the record if
+// the volume is created by a volume plugin.
+func ParsePluginVolumeRecord(record []byte) (driver, name string, ok bool) {
+	r := string(record)
+	if !strings.HasPrefix(r, pluginVolumeRecordPrefix) {
+		return "", "", false
+	}
+	fields := strings.SplitN(strings.TrimPrefix(r, pluginVolumeRecordPrefix), ":", 2)
+	if len(fields) != 2 {
+		return "", "", false
+	}
+	return fields[0], fields[1], true
+}
+
+// RemovePluginVolume removes the volume created by the plugin for a pod.
+func RemovePluginVolume(driver, name string) error {
+	d, err := volumeplugin.Get(driver)
+	if err != nil {
+		return err
+	}
+	return d.Remove(name)
+}
+
+// createPluginVolume creates the volume without source by the plugin named by
+// the format, and records it so that it is removed along with the pod.
+func (c *Container) createPluginVolume(spec *apitypes.UserVolume) error {
+	d, err := volumeplugin.Get(spec.Format)
+	if err != nil {
+		return err
+	}
+	name := pluginVolumeName(c.p.Id(), spec.Name)
+	if err = d.Create(name, spec.DriverOpts); err != nil {
+		return err
+	}
+	record := fmt.Sprintf("%s%s:%s", pluginVolumeRecordPrefix, spec.Format, name)
+	if err = c.p.factory.db.UpdatePodVolume(c.p.Id(), spec.Name, []byte(record)); err != nil {
+		d.Remove(name)
+		return err
+	}
+	spec.Source = name
+	return nil
+}
+
+func (c *Container) removePluginVolume(spec *apitypes.UserVolume) {
+	if err := RemovePluginVolume(spec.Format, spec.Source); err != nil {
+		c.Log(ERROR, "failed to remove volume %s: %v", spec.Name, err)
+	}
+	c.p.factory.db.DeletePodVolume(c.p.Id(), spec.Name)
+}
+
+// mountPlugin asks the plugin for the volume, and returns the spec of what the
+// plugin gives, which is inserted into the sandbox as the builtin formats.
+func (v *Volume) mountPlugin() (*apitypes.UserVolume, error) {
+	d, err := volumeplugin.Get(v.spec.Format)
+	if err != nil {
+		return nil, err
+	}
+	mp, err := d.Mount(v.spec.Source, v.p.Id())
+	if err != nil {
+		return nil, err
+	}
+	v.Log(DEBUG, "volume mounted by plugin %s at %s (%s)", d.Name(), mp.Mountpoint, mp.Format)
+
+	spec := &apitypes.UserVolume{
+		Name:   v.spec.Name,
+		Source: mp.Mountpoint,
+		Format: mp.Format,
+		Fstype: mp.Fstype,
+		Cache:  v.spec.Cache,
+		Option: v.spec.Option,
+	}
+	if spec.Fstype == "" {
+		spec.Fstype = v.spec.Fstype
+	}
+	return spec, nil
+}
+
+func (v *Volume) umountPlugin() error {
+	// the devices given by the plugin are released by the plugin itself
+	if v.descript != nil && v.descript.Fstype == "dir" {
+		if err := UmountExistingVolume(v.descript.Fstype, v.descript.Source, v.p.sandboxShareDir()); err != nil {
+			return err
+		}
+	}
+	d, err := volumeplugin.Get(v.spec.Format)
+	if err != nil {
+		return err
+	}
+	return d.Unmount(v.spec.Source, v.p.Id())
+}
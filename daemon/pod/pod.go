@@ -42,9 +42,13 @@ const (
 // XPod is the Pod keeper, or, the App View of a sandbox. All API for Pod operations or Container operations should be
 // provided by this struct.
 type XPod struct {
-	// Name is the unique name of a pod provided by user
+	// Name is the unique name of a pod provided by user, qualified with the
+	// namespace if it is not in the default namespace
 	name string
 
+	// namespace is the tenancy namespace of the pod
+	namespace string
+
 	// logPrefix is the prefix for log message
 	logPrefix string
 
@@ -66,7 +70,10 @@ type XPod struct {
 	prestartExecs [][]string
 
 	sandbox *hypervisor.Vm
-	factory *PodFactory
+	// sandboxCreated is the time the sandbox was associated with the pod,
+	// it is unknown for the sandboxes reconnected after restart.
+	sandboxCreated time.Time
+	factory        *PodFactory
 
 	info       *apitypes.PodInfo
 	status     PodState
@@ -80,6 +87,12 @@ type XPod struct {
 	initCond    *sync.Cond
 
 	containerBuffers map[string]*ContainerBuffer
+
+	// volumeHolds is the number of the holders of the volumes on the host, or
+	// -1 if they are held for writing, see HoldVolumes()
+	volumeHolds int
+	// starting is set while a stopped pod is creating its sandbox
+	starting bool
 }
 
 // The Log infrastructure, to add pod name as prefix of the log message.
@@ -100,7 +113,7 @@ func (p *XPod) Id() string {
 }
 
 func (p *XPod) Name() string {
-	return p.name
+	return p.globalSpec.Id
 }
 
 func (p *XPod) SandboxNameLocked() string {
@@ -144,6 +157,14 @@ func (p *XPod) IsAlive() bool {
 	return alive
 }
 
+func (p *XPod) IsPaused() bool {
+	p.statusLock.RLock()
+	paused := p.status == S_POD_PAUSED
+	p.statusLock.RUnlock()
+
+	return paused
+}
+
 func (p *XPod) IsStopped() bool {
 	p.statusLock.RLock()
 	stopped := p.status == S_POD_STOPPED
@@ -181,6 +202,7 @@ func (p *XPod) BriefStatus() (s *apitypes.PodListResult) {
 		VmID:      p.SandboxNameLocked(),
 		CreatedAt: p.info.CreatedAt,
 		Labels:    p.labels,
+		Namespace: p.namespace,
 	}
 
 	switch p.status {
@@ -214,8 +236,13 @@ func (p *XPod) SandboxBriefStatus() (s *apitypes.VMListResult) {
 	p.statusLock.RLock()
 	if p.sandbox != nil {
 		s = &apitypes.VMListResult{
-			VmID:  p.SandboxNameLocked(),
-			PodID: p.Id(),
+			VmID:   p.SandboxNameLocked(),
+			PodID:  p.Id(),
+			Cpu:    int32(p.sandbox.Cpu),
+			Memory: int32(p.sandbox.Mem),
+		}
+		if !p.sandboxCreated.IsZero() {
+			s.CreatedAt = p.sandboxCreated.Unix()
 		}
 		if p.status == S_POD_PAUSED {
 			s.Status = "paused"
@@ -435,6 +462,7 @@ func (p *XPod) SetLabel(labels map[string]string, update bool) error {
 	for k, v := range labels {
 		p.labels[k] = v
 	}
+	p.notifyWatchers()
 
 	return nil
 }
@@ -562,5 +590,6 @@ func (p *XPod) RenameContainer(cid, name string) error {
 		return err
 	}
 	p.Log(INFO, "rename container from %s to %s", old, name)
+	p.notifyWatchers()
 	return nil
 }
@@ -4,29 +4,39 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+
+	apitypes "github.com/hyperhq/hyperd/types"
 )
 
+// PodList is the registry of pods. The pods are indexed by their qualified
+// names, and the containerNames are qualified with the namespace of the pods
+// as well, so that names are unique in a namespace only.
 type PodList struct {
 	pods           map[string]*XPod
 	containers     map[string]string
 	containerNames map[string]string
+	quotas         map[string]*apitypes.NamespaceQuota
 	mu             *sync.RWMutex
+	hub            *WatchHub
 }
 
 func NewPodList() *PodList {
-	return &PodList{
+	pl := &PodList{
 		pods:           make(map[string]*XPod),
 		containers:     make(map[string]string),
 		containerNames: make(map[string]string),
+		quotas:         make(map[string]*apitypes.NamespaceQuota),
 		mu:             &sync.RWMutex{},
 	}
+	pl.hub = newWatchHub(pl)
+	return pl
 }
 
 func (pl *PodList) Get(id string) (*XPod, bool) {
 	pl.mu.RLock()
 	defer pl.mu.RUnlock()
 
-	p, ok := pl.pods[id]
+	p, ok := pl.pods[QualifiedName(SplitQualifiedName(id))]
 	return p, ok
 }
 
@@ -38,6 +48,28 @@ func (pl *PodList) ReserveContainerID(id, pod string) error {
 		return fmt.Errorf("the container id %s has already taken by pod %s", id, pn)
 	}
 	pl.containers[id] = pod
+	pl.notify(pod)
+	return nil
+}
+
+// containerKey qualifies the container name with the namespace of the pod.
+func containerKey(name, pod string) string {
+	ns, _ := SplitQualifiedName(pod)
+	return QualifiedName(ns, name)
+}
+
+// checkEngineName checks the engine name of the container is not taken by a
+// container of another namespace, e.g. web.team-a in the default namespace
+// and web in team-a.
+func (pl *PodList) checkEngineName(key, pod string) error {
+	alias := engineAlias(key)
+	if alias == "" {
+		return nil
+	}
+	if pn, ok := pl.containerNames[alias]; ok && pn != pod {
+		ns, name := SplitQualifiedName(alias)
+		return fmt.Errorf("the container name %s is taken in the container engine by container %s in namespace %s", key, name, ns)
+	}
 	return nil
 }
 
@@ -45,10 +77,14 @@ func (pl *PodList) ReserveContainerName(name, pod string) error {
 	pl.mu.Lock()
 	defer pl.mu.Unlock()
 
-	if pn, ok := pl.containerNames[name]; ok && pn != pod {
+	key := containerKey(name, pod)
+	if pn, ok := pl.containerNames[key]; ok && pn != pod {
 		return fmt.Errorf("the container name %s has already taken by pod %s", name, pn)
 	}
-	pl.containerNames[name] = pod
+	if err := pl.checkEngineName(key, pod); err != nil {
+		return err
+	}
+	pl.containerNames[key] = pod
 	return nil
 }
 
@@ -58,16 +94,21 @@ func (pl *PodList) ReserveContainer(id, name, pod string) error {
 	if _, ok := pl.pods[pod]; !ok {
 		return fmt.Errorf("pod %s not exist for adding container %s(%s)", pod, name, id)
 	}
-	if pn, ok := pl.containerNames[name]; ok && pn != pod {
+	key := containerKey(name, pod)
+	if pn, ok := pl.containerNames[key]; ok && pn != pod {
 		return fmt.Errorf("container name %s has already taken by pod %s", name, pn)
 	}
+	if err := pl.checkEngineName(key, pod); err != nil {
+		return err
+	}
 	if id != "" {
 		if pn, ok := pl.containers[id]; ok && pn != pod {
 			return fmt.Errorf("the container id %s has already taken by pod %s", id, pn)
 		}
 		pl.containers[id] = pod
+		pl.notify(pod)
 	}
-	pl.containerNames[name] = pod
+	pl.containerNames[key] = pod
 	return nil
 }
 
@@ -82,6 +123,7 @@ func (pl *PodList) ChangeContainerId(oldId, newId, pod string) error {
 	}
 	delete(pl.containers, oldId)
 	pl.containers[newId] = pod
+	pl.notify(pod)
 	return nil
 }
 
@@ -96,36 +138,91 @@ func (pl *PodList) ReservePod(p *XPod) error {
 	}
 
 	pl.pods[name] = p
+	pl.notify(name)
+	return nil
+}
+
+// ReserveNewPod reserves the name of a newly created pod, and checks the
+// quota of its namespace in the same time.
+func (pl *PodList) ReserveNewPod(p *XPod) error {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+
+	name := p.Id()
+	if pe, ok := pl.pods[name]; ok && pe != p {
+		return fmt.Errorf("pod name %s has already in use", p.Id())
+	}
+
+	if quota, ok := pl.quotas[p.Namespace()]; ok {
+		usage := pl.namespaceUsageUnsafe(p.Namespace())
+		usage.add(p.globalSpec)
+		if err := usage.exceed(quota); err != nil {
+			return fmt.Errorf("namespace %s: %v", p.Namespace(), err)
+		}
+	}
+
+	pl.pods[name] = p
+	pl.notify(name)
 	return nil
 }
 
+// SetQuotas replaces the quotas of the namespaces, the pods already created
+// are not affected.
+func (pl *PodList) SetQuotas(quotas map[string]*apitypes.NamespaceQuota) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+
+	pl.quotas = make(map[string]*apitypes.NamespaceQuota, len(quotas))
+	for ns, q := range quotas {
+		pl.quotas[NormalizeNamespace(ns)] = q
+	}
+}
+
+func (pl *PodList) NamespaceUsage(namespace string) *NamespaceUsage {
+	pl.mu.RLock()
+	defer pl.mu.RUnlock()
+	return pl.namespaceUsageUnsafe(NormalizeNamespace(namespace))
+}
+
+func (pl *PodList) namespaceUsageUnsafe(namespace string) *NamespaceUsage {
+	usage := &NamespaceUsage{}
+	for _, p := range pl.pods {
+		if p.Namespace() == namespace {
+			usage.add(p.globalSpec)
+		}
+	}
+	return usage
+}
+
 func (pl *PodList) Release(id string) {
 	pl.mu.Lock()
 	defer pl.mu.Unlock()
+	id = QualifiedName(SplitQualifiedName(id))
 	if p, ok := pl.pods[id]; ok {
 		for _, c := range p.ContainerIds() {
 			delete(pl.containers, c)
 		}
 		for _, c := range p.ContainerNames() {
-			delete(pl.containerNames, c)
+			delete(pl.containerNames, containerKey(c, id))
 		}
 	}
 	delete(pl.pods, id)
+	pl.notify(id)
 }
 
-func (pl *PodList) ReleaseContainer(id, name string) {
+func (pl *PodList) ReleaseContainer(id, name, pod string) {
 	pl.mu.Lock()
 	defer pl.mu.Unlock()
 
 	delete(pl.containers, id)
-	delete(pl.containerNames, name)
+	delete(pl.containerNames, containerKey(name, pod))
 }
 
-func (pl *PodList) ReleaseContainerName(name string) {
+func (pl *PodList) ReleaseContainerName(name, pod string) {
 	pl.mu.Lock()
 	defer pl.mu.Unlock()
 
-	delete(pl.containerNames, name)
+	delete(pl.containerNames, containerKey(name, pod))
 }
 
 func (pl *PodList) GetByContainerId(cid string) (*XPod, bool) {
@@ -139,20 +236,24 @@ func (pl *PodList) GetByContainerId(cid string) (*XPod, bool) {
 	return nil, false
 }
 
+// GetByContainerIdOrName looks up a container by its id, or by its name
+// which could be qualified with the namespace as "name@namespace". A plain
+// name or id refers to the container in the default namespace.
 func (pl *PodList) GetByContainerIdOrName(cid string) (*XPod, string, bool) {
 	pl.mu.RLock()
 	defer pl.mu.RUnlock()
 
-	if podid, ok := pl.containerNames[cid]; ok {
+	ns, ref := SplitQualifiedName(cid)
+	if podid, ok := pl.containerNames[QualifiedName(ns, ref)]; ok {
 		if p, ok := pl.pods[podid]; ok {
-			id, _ := p.ContainerName2Id(cid)
+			id, _ := p.ContainerName2Id(ref)
 			return p, id, true
 		}
 		return nil, "", false
 	}
-	if podid, ok := pl.containers[cid]; ok {
-		if p, ok := pl.pods[podid]; ok {
-			return p, cid, true
+	if podid, ok := pl.containers[ref]; ok {
+		if p, ok := pl.pods[podid]; ok && p.Namespace() == ns {
+			return p, ref, true
 		}
 		return nil, "", false
 	}
@@ -160,7 +261,10 @@ func (pl *PodList) GetByContainerIdOrName(cid string) (*XPod, string, bool) {
 	matchPods := []string{}
 	fullid := ""
 	for c, p := range pl.containers {
-		if strings.HasPrefix(c, cid) {
+		if strings.HasPrefix(c, ref) {
+			if pn, _ := SplitQualifiedName(p); pn != ns {
+				continue
+			}
 			fullid = c
 			matchPods = append(matchPods, p)
 		}
@@ -212,6 +316,26 @@ func (pl *PodList) CountContainers() (num int64) {
 	return int64(len(pl.containers))
 }
 
+// Watch returns a watcher of the changes of pods and their containers after
+// the given resource version, or from a snapshot if the version is 0.
+func (pl *PodList) Watch(since uint64) (*PodWatcher, error) {
+	return pl.hub.Watch(since)
+}
+
+// NotifyStoragePool tells the watchers that the usage level of a storage pool
+// has changed.
+func (pl *PodList) NotifyStoragePool(pool *apitypes.StoragePoolStatus) {
+	if pl.hub != nil {
+		pl.hub.publishPool(pool)
+	}
+}
+
+func (pl *PodList) notify(id string) {
+	if pl.hub != nil {
+		pl.hub.notify(id)
+	}
+}
+
 type PodOp func(*XPod) error
 type PodFilterOp func(*XPod) bool
 
@@ -112,8 +112,9 @@ func (p *XPod) AddPortMapping(spec []*apitypes.PortMapping) error {
 	copy(all, spec)
 	copy(all[len(spec):], p.portMappings)
 	p.portMappings = all
+	p.notifyWatchers()
 
-	err = p.savePortMapping()
+	err = p.persist(p.savePortMapping)
 	if err != nil {
 		p.Log(WARNING, "failed to persist new portmapping rules")
 		// ignore the error
@@ -186,7 +187,8 @@ func (p *XPod) removePortMapping(tbr []*apitypes.PortMapping, eq portMappingComp
 	}
 
 	p.portMappings = other
-	err = p.savePortMapping()
+	p.notifyWatchers()
+	err = p.persist(p.savePortMapping)
 	if err != nil {
 		p.Log(WARNING, "failed to persist removed portmapping rules")
 		// ignore the error
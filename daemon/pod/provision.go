@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hyperhq/hypercontainer-utils/hlog"
+	"github.com/hyperhq/hyperd/daemon/daemondb"
 	"github.com/hyperhq/hyperd/errors"
 	apitypes "github.com/hyperhq/hyperd/types"
 	"github.com/hyperhq/hyperd/utils"
@@ -25,6 +26,18 @@ func CreateXPod(factory *PodFactory, spec *apitypes.UserPod) (*XPod, error) {
 	if err != nil {
 		return nil, err
 	}
+	for _, c := range spec.Containers {
+		if err = ValidateName(c.Name); err != nil {
+			p.Log(ERROR, err)
+			return nil, err
+		}
+	}
+	// check the namespace quota before any resource is allocated
+	err = factory.registry.ReserveNewPod(p)
+	if err != nil {
+		p.Log(ERROR, err)
+		return nil, err
+	}
 	err = p.reserveNames(spec.Containers)
 	if err != nil {
 		return nil, err
@@ -84,11 +97,14 @@ func newXPod(factory *PodFactory, spec *apitypes.UserPod) (*XPod, error) {
 		hlog.Log(ERROR, err)
 		return nil, err
 	}
-	factory.hosts = HostsCreator(spec.Id)
+	spec.Namespace = NormalizeNamespace(spec.Namespace)
+	name := QualifiedName(spec.Namespace, spec.Id)
+	factory.hosts = HostsCreator(name)
 	factory.logCreator = initLogCreator(factory, spec)
 	p := &XPod{
-		name:             spec.Id,
-		logPrefix:        fmt.Sprintf("Pod[%s] ", spec.Id),
+		name:             name,
+		namespace:        spec.Namespace,
+		logPrefix:        fmt.Sprintf("Pod[%s] ", name),
 		globalSpec:       spec.CloneGlobalPart(),
 		containers:       make(map[string]*Container),
 		volumes:          make(map[string]*Volume),
@@ -116,6 +132,10 @@ func (p *XPod) ReserveContainerName(c *apitypes.UserContainer) error {
 
 		c.Name = fmt.Sprintf("%s-%s-%s", p.Name(), img, utils.RandStr(10, "alpha"))
 	}
+	if err := ValidateName(c.Name); err != nil {
+		p.Log(ERROR, err)
+		return err
+	}
 
 	if err := p.factory.registry.ReserveContainerName(c.Name, p.Id()); err != nil {
 		err = fmt.Errorf("could not reserve name %s: %v", c.Name, err)
@@ -191,19 +211,13 @@ func (p *XPod) doContainerCreate(c *apitypes.UserContainer) (string, error) {
 	}
 
 	// serialize all changes to daemonDB
+	ops := make([]func(*daemondb.Batch) error, 0, len(nvs)+3)
 	for _, vn := range nvs {
-		if err = p.volumes[vn].saveVolume(); err != nil {
-			return "", err
-		}
-	}
-	if err = pc.saveContainer(); err != nil {
-		return "", err
-	}
-	if err = p.saveLayout(); err != nil {
-		return "", err
+		ops = append(ops, p.volumes[vn].saveVolume)
 	}
-	if err = p.saveSandbox(); err != nil {
-		p.Log(ERROR, "error during save sandbox: %v", err)
+	ops = append(ops, pc.saveContainer, p.saveLayout, p.saveSandbox)
+	if err = p.persist(ops...); err != nil {
+		p.Log(ERROR, "error during save container %s: %v", pc.Id(), err)
 		return "", err
 	}
 	return pc.Id(), nil
@@ -234,11 +248,23 @@ func (p *XPod) ContainerStart(cid string) error {
 	}
 
 	// save Sandbox state for attachID changed.
-	return p.saveSandbox()
+	return p.persist(p.saveSandbox)
 }
 
 // Start() means start a STOPPED pod.
 func (p *XPod) Start() error {
+	p.statusLock.Lock()
+	if err := p.checkVolumeHolds("start"); err != nil {
+		p.statusLock.Unlock()
+		return err
+	}
+	p.starting = true
+	p.statusLock.Unlock()
+	defer func() {
+		p.statusLock.Lock()
+		p.starting = false
+		p.statusLock.Unlock()
+	}()
 
 	if p.IsStopped() {
 		if err := p.createSandbox(p.globalSpec); err != nil {
@@ -264,7 +290,7 @@ func (p *XPod) Start() error {
 		return err
 	}
 
-	return p.saveSandbox()
+	return p.persist(p.saveSandbox)
 }
 
 func (p *XPod) createSandbox(spec *apitypes.UserPod) error {
@@ -291,7 +317,9 @@ func (p *XPod) createSandbox(spec *apitypes.UserPod) error {
 	}
 
 	p.sandbox = sandbox
+	p.sandboxCreated = time.Now()
 	p.status = S_POD_STARTING
+	p.notifyWatchers()
 
 	go p.waitVMStop()
 	err = sandbox.InitSandbox(config)
@@ -342,6 +370,7 @@ func (p *XPod) setPodInitStatus(initSuccess bool) {
 	}
 	p.initCond.Broadcast()
 	p.statusLock.Unlock()
+	p.notifyWatchers()
 }
 
 func (p *XPod) reserveNames(containers []*apitypes.UserContainer) error {
@@ -369,7 +398,7 @@ func (p *XPod) reserveNames(containers []*apitypes.UserContainer) error {
 
 func (p *XPod) releaseNames(containers []*apitypes.UserContainer) {
 	for _, c := range containers {
-		p.factory.registry.ReleaseContainer(c.Id, c.Name)
+		p.factory.registry.ReleaseContainer(c.Id, c.Name, p.Id())
 	}
 	p.factory.registry.Release(p.Id())
 }
@@ -2,6 +2,8 @@ package pod
 
 import (
 	"fmt"
+	"net"
+	"strconv"
 	"strings"
 
 	"github.com/hyperhq/hypercontainer-utils/hlog"
@@ -255,6 +257,62 @@ func (s *Services) getFromVm() ([]byte, error) {
 	return stdout, nil
 }
 
+// parseIPVSRules parses the output of `ipvsadm -Ln`, such as
+//
+//	IP Virtual Server version 1.2.1 (size=4096)
+//	Prot LocalAddress:Port Scheduler Flags
+//	  -> RemoteAddress:Port           Forward Weight ActiveConn InActConn
+//	TCP  10.254.0.24:2834 rr
+//	  -> 192.168.23.2:2345            Masq    1      0          0
+func parseIPVSRules(out []byte) ([]*apitypes.UserService, error) {
+	var (
+		srvs    = []*apitypes.UserService{}
+		current *apitypes.UserService
+	)
+	splitAddr := func(addr string) (string, int32, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return "", 0, err
+		}
+		p, err := strconv.ParseInt(port, 10, 32)
+		if err != nil {
+			return "", 0, err
+		}
+		return host, int32(p), nil
+	}
+
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		switch {
+		case fields[0] == "TCP" || fields[0] == "UDP":
+			ip, port, err := splitAddr(fields[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid ipvs service %q: %v", line, err)
+			}
+			current = &apitypes.UserService{
+				ServiceIP:   ip,
+				ServicePort: port,
+				Protocol:    strings.ToLower(fields[0]),
+				Hosts:       []*apitypes.UserServiceBackend{},
+			}
+			srvs = append(srvs, current)
+		case fields[0] == "->" && current != nil:
+			ip, port, err := splitAddr(fields[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid ipvs backend %q: %v", line, err)
+			}
+			current.Hosts = append(current.Hosts, &apitypes.UserServiceBackend{
+				HostIP:   ip,
+				HostPort: port,
+			})
+		}
+	}
+	return srvs, nil
+}
+
 func (s *Services) size() int {
 	if s.spec == nil {
 		return 0
@@ -273,6 +331,22 @@ func (p *XPod) GetServices() ([]*apitypes.UserService, error) {
 	return p.services.get(), nil
 }
 
+// GetLiveServices returns the services applied in the sandbox, which may be
+// different from the spec if the rules have been changed in the VM.
+func (p *XPod) GetLiveServices() ([]*apitypes.UserService, error) {
+	p.resourceLock.Lock()
+	defer p.resourceLock.Unlock()
+
+	if !p.IsRunning() {
+		return nil, fmt.Errorf("pod %s is not running", p.Id())
+	}
+	out, err := p.services.getFromVm()
+	if err != nil {
+		return nil, err
+	}
+	return parseIPVSRules(out)
+}
+
 func (p *XPod) UpdateService(srvs []*apitypes.UserService) error {
 	p.resourceLock.Lock()
 	defer p.resourceLock.Unlock()
@@ -281,8 +355,9 @@ func (p *XPod) UpdateService(srvs []*apitypes.UserService) error {
 		p.Log(ERROR, "failed to update services: %v", err)
 		return err
 	}
+	p.notifyWatchers()
 
-	return p.savePodMeta()
+	return p.persist(p.savePodMeta)
 }
 
 func (p *XPod) AddService(srvs []*apitypes.UserService) error {
@@ -293,8 +368,9 @@ func (p *XPod) AddService(srvs []*apitypes.UserService) error {
 		p.Log(ERROR, "failed to add services: %v", err)
 		return err
 	}
+	p.notifyWatchers()
 
-	return p.savePodMeta()
+	return p.persist(p.savePodMeta)
 }
 
 func (p *XPod) DeleteService(srvs []*apitypes.UserService) error {
@@ -305,6 +381,7 @@ func (p *XPod) DeleteService(srvs []*apitypes.UserService) error {
 		p.Log(ERROR, "failed to delete service: %v", err)
 		return err
 	}
+	p.notifyWatchers()
 
-	return p.savePodMeta()
+	return p.persist(p.savePodMeta)
 }
@@ -0,0 +1,111 @@
+package pod
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+const ipvsHeader = `IP Virtual Server version 1.2.1 (size=4096)
+Prot LocalAddress:Port Scheduler Flags
+  -> RemoteAddress:Port           Forward Weight ActiveConn InActConn
+`
+
+func TestParseIPVSRules(t *testing.T) {
+	cases := []struct {
+		name   string
+		out    string
+		expect []*apitypes.UserService
+		fail   bool
+	}{
+		{
+			name:   "empty",
+			out:    "",
+			expect: []*apitypes.UserService{},
+		},
+		{
+			name:   "no services",
+			out:    ipvsHeader,
+			expect: []*apitypes.UserService{},
+		},
+		{
+			name: "services and backends",
+			out: ipvsHeader + `TCP  10.254.0.24:2834 rr
+  -> 192.168.23.2:2345            Masq    1      0          0
+  -> 192.168.23.3:2345            Masq    1      3          1
+UDP  10.254.0.53:53 rr
+  -> 192.168.23.4:53              Masq    1      0          0
+`,
+			expect: []*apitypes.UserService{
+				{ServiceIP: "10.254.0.24", ServicePort: 2834, Protocol: "tcp", Hosts: []*apitypes.UserServiceBackend{
+					{HostIP: "192.168.23.2", HostPort: 2345},
+					{HostIP: "192.168.23.3", HostPort: 2345},
+				}},
+				{ServiceIP: "10.254.0.53", ServicePort: 53, Protocol: "udp", Hosts: []*apitypes.UserServiceBackend{
+					{HostIP: "192.168.23.4", HostPort: 53},
+				}},
+			},
+		},
+		{
+			name: "service without backends",
+			out:  ipvsHeader + "TCP  10.254.0.24:80 rr\r\n",
+			expect: []*apitypes.UserService{
+				{ServiceIP: "10.254.0.24", ServicePort: 80, Protocol: "tcp", Hosts: []*apitypes.UserServiceBackend{}},
+			},
+		},
+		{
+			name: "ipv6 service",
+			out:  "TCP  [fd00::1]:80 rr\n  -> [fd00::2]:8080 Masq 1 0 0\n",
+			expect: []*apitypes.UserService{
+				{ServiceIP: "fd00::1", ServicePort: 80, Protocol: "tcp", Hosts: []*apitypes.UserServiceBackend{
+					{HostIP: "fd00::2", HostPort: 8080},
+				}},
+			},
+		},
+		{
+			name:   "other protocols are skipped",
+			out:    "SCTP  10.254.0.24:80 rr\n  -> 192.168.23.2:80 Masq 1 0 0\n",
+			expect: []*apitypes.UserService{},
+		},
+		{
+			name: "invalid service address",
+			out:  "TCP  10.254.0.24 rr\n",
+			fail: true,
+		},
+		{
+			name: "invalid service port",
+			out:  "TCP  10.254.0.24:http rr\n",
+			fail: true,
+		},
+		{
+			name: "invalid backend",
+			out:  "TCP  10.254.0.24:80 rr\n  -> 192.168.23.2:99999999999 Masq 1 0 0\n",
+			fail: true,
+		},
+	}
+
+	for _, c := range cases {
+		srvs, err := parseIPVSRules([]byte(c.out))
+		if c.fail {
+			if err == nil {
+				t.Errorf("%s: expect an error, got %v", c.name, srvs)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", c.name, err)
+			continue
+		}
+		if len(srvs) != len(c.expect) {
+			t.Errorf("%s: expect %v, got %v", c.name, c.expect, srvs)
+			continue
+		}
+		for i := range srvs {
+			if !proto.Equal(srvs[i], c.expect[i]) {
+				t.Errorf("%s: expect %v, got %v", c.name, c.expect[i], srvs[i])
+			}
+		}
+	}
+}
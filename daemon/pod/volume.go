@@ -2,9 +2,11 @@ package pod
 
 import (
 	"fmt"
+	"path/filepath"
 	"sync"
 
 	"github.com/hyperhq/hypercontainer-utils/hlog"
+	"github.com/hyperhq/hyperd/storage/luks"
 	apitypes "github.com/hyperhq/hyperd/types"
 	"github.com/hyperhq/hyperd/utils"
 	runv "github.com/hyperhq/runv/api"
@@ -54,11 +56,86 @@ func (v *Volume) getStatus() VolumeState {
 	return s
 }
 
+// VolumeSpec returns a copy of the spec of the volume in the pod.
+func (p *XPod) VolumeSpec(name string) (*apitypes.UserVolume, bool) {
+	p.statusLock.RLock()
+	defer p.statusLock.RUnlock()
+
+	v, ok := p.volumes[name]
+	if !ok {
+		return nil, false
+	}
+	spec := *v.spec
+	return &spec, true
+}
+
+// HoldVolumes keeps the pod from starting, resuming and being removed until
+// the returned release function is called, so that the volume could be
+// accessed on the host. The volumes could be read while the pod is paused, and
+// be written only if the pod is stopped and nobody else holds them.
+func (p *XPod) HoldVolumes(volume string, write bool) (func(), error) {
+	p.statusLock.Lock()
+	defer p.statusLock.Unlock()
+
+	switch {
+	case p.status == S_POD_NONE:
+		return nil, fmt.Errorf("pod %s has been removed", p.Id())
+	case write && p.status != S_POD_STOPPED:
+		return nil, fmt.Errorf("volume %s is in use by pod %s, stop the pod first", volume, p.Id())
+	case p.starting || p.status != S_POD_STOPPED && p.status != S_POD_PAUSED:
+		return nil, fmt.Errorf("pod %s is running and writing to volume %s, pause it first", p.Id(), volume)
+	case p.volumeHolds < 0 || write && p.volumeHolds > 0:
+		return nil, fmt.Errorf("volume %s of pod %s is being exported or imported", volume, p.Id())
+	}
+
+	if write {
+		p.volumeHolds = -1
+	} else {
+		p.volumeHolds++
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			p.statusLock.Lock()
+			if write {
+				p.volumeHolds = 0
+			} else {
+				p.volumeHolds--
+			}
+			p.statusLock.Unlock()
+		})
+	}, nil
+}
+
+// VolumesHeld reports whether the volumes are held on the host.
+func (p *XPod) VolumesHeld() bool {
+	p.statusLock.RLock()
+	defer p.statusLock.RUnlock()
+	return p.volumeHolds != 0
+}
+
+// checkVolumeHolds fails if the volumes are held on the host, it should be
+// called with statusLock held.
+func (p *XPod) checkVolumeHolds(op string) error {
+	if p.volumeHolds != 0 {
+		err := fmt.Errorf("%s: the volumes of pod %s are being exported or imported", op, p.Id())
+		p.Log(ERROR, err)
+		return err
+	}
+	return nil
+}
+
 func (v *Volume) Info() *apitypes.PodVolume {
+	usage, err := VolumeUsage(v.spec)
+	if err != nil {
+		v.Log(DEBUG, "failed to get usage of volume: %v", err)
+	}
 	return &apitypes.PodVolume{
 		Name:   v.spec.Name,
 		Source: v.spec.Source,
 		Driver: v.spec.Format,
+		Size_:  v.spec.Size_,
+		Usage:  usage,
 	}
 }
 
@@ -160,9 +237,19 @@ func (v *Volume) mount() error {
 
 	v.Log(DEBUG, "mount volume")
 	sharedDir := v.p.sandboxShareDir()
-	v.descript, err = ProbeExistingVolume(v.spec, sharedDir)
+	spec := v.spec
+	if isPluginVolume(v.spec) {
+		if spec, err = v.mountPlugin(); err != nil {
+			v.Log(ERROR, "volume plugin mount failed: %v", err)
+			return err
+		}
+	}
+	v.descript, err = ProbeExistingVolume(spec, sharedDir)
 	if err != nil {
 		v.Log(ERROR, "volume probe/mount failed: %v", err)
+		if isPluginVolume(v.spec) {
+			v.umountPlugin()
+		}
 		return err
 	}
 
@@ -171,7 +258,14 @@ func (v *Volume) mount() error {
 
 func (v *Volume) umount() error {
 	var err error
-	if v.descript != nil {
+	if v.descript != nil && isPluginVolume(v.spec) {
+		err = v.umountPlugin()
+	} else if v.descript != nil && v.spec.Encryption != nil {
+		// close the decrypted device, then release the backing volume
+		if err = luks.Close(filepath.Base(v.descript.Source)); err == nil {
+			err = UmountExistingVolume(v.spec.Fstype, v.spec.Source, v.p.sandboxShareDir())
+		}
+	} else if v.descript != nil {
 		err = UmountExistingVolume(v.descript.Fstype, v.descript.Source, v.p.sandboxShareDir())
 	}
 	v.Lock()
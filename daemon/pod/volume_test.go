@@ -0,0 +1,75 @@
+package pod
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestHoldVolumes(t *testing.T) {
+	p := &XPod{
+		name:         "web",
+		status:       S_POD_RUNNING,
+		resourceLock: &sync.Mutex{},
+		statusLock:   &sync.RWMutex{},
+	}
+
+	if _, err := p.HoldVolumes("data", false); err == nil {
+		t.Fatal("held the volumes of a running pod")
+	}
+
+	p.status = S_POD_PAUSED
+	if _, err := p.HoldVolumes("data", true); err == nil {
+		t.Fatal("held the volumes of a paused pod for writing")
+	}
+	release, err := p.HoldVolumes("data", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.UnPause(); err == nil || p.status != S_POD_PAUSED {
+		t.Fatal("resumed the pod while its volumes are held")
+	}
+	release()
+	release()
+	if p.VolumesHeld() {
+		t.Fatal("the volumes are still held after release")
+	}
+
+	p.status = S_POD_STOPPED
+	r1, _ := p.HoldVolumes("data", false)
+	r2, err := p.HoldVolumes("data", false)
+	if err != nil {
+		t.Fatal("the volumes could not be read by several holders")
+	}
+	if _, err := p.HoldVolumes("data", true); err == nil {
+		t.Fatal("held the volumes for writing while they are read")
+	}
+	r1()
+	if err := p.Start(); err == nil || p.starting {
+		t.Fatal("started the pod while its volumes are held")
+	}
+	if err := p.Remove(true); err == nil || p.status != S_POD_STOPPED {
+		t.Fatal("removed the pod while its volumes are held")
+	}
+	r2()
+
+	release, err = p.HoldVolumes("data", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.HoldVolumes("data", false); err == nil {
+		t.Fatal("held the volumes for reading while they are written")
+	}
+	release()
+
+	// a pod creating its sandbox is running soon
+	p.starting = true
+	if _, err := p.HoldVolumes("data", false); err == nil {
+		t.Fatal("held the volumes of a starting pod")
+	}
+	p.starting = false
+
+	p.status = S_POD_NONE
+	if _, err := p.HoldVolumes("data", false); err == nil {
+		t.Fatal("held the volumes of a removed pod")
+	}
+}
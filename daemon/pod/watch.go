@@ -0,0 +1,353 @@
+package pod
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/hyperhq/hypercontainer-utils/hlog"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+const (
+	WATCH_SNAPSHOT = "SNAPSHOT"
+	WATCH_ADDED    = "ADDED"
+	WATCH_MODIFIED = "MODIFIED"
+	WATCH_DELETED  = "DELETED"
+
+	WATCH_KIND_POD          = "pod"
+	WATCH_KIND_CONTAINER    = "container"
+	WATCH_KIND_STORAGE_POOL = "storage-pool"
+
+	// watchHistorySize is the number of events kept for resuming watchers, a
+	// watcher asking for a version older than the history has to start over
+	// from a snapshot.
+	watchHistorySize = 4096
+	// watchChanSize is the event buffer of each watcher, a watcher that could
+	// not catch up is closed and should resume from its last seen version.
+	watchChanSize = 256
+	// watchEpochShift leaves the low bits of a resource version to the events
+	// of one daemon run, the high bits are the start time of the daemon.
+	watchEpochShift = 32
+)
+
+// PodWatcher receives the events of a WatchHub, the channel is closed when the
+// watcher is stopped or could not keep up with the events.
+type PodWatcher struct {
+	C   chan *apitypes.PodWatchEvent
+	hub *WatchHub
+}
+
+func (w *PodWatcher) Stop() {
+	w.hub.unsubscribe(w)
+}
+
+// WatchHub turns the changes of the pods in a PodList into a stream of
+// versioned events. Pods only tell the hub which pod has changed, and the hub
+// compares the current state of the pod with the state it has seen before.
+// Thus a notification never blocks and could be sent with any lock held.
+type WatchHub struct {
+	registry *PodList
+
+	lock     sync.Mutex
+	pending  map[string]bool
+	kick     chan struct{}
+	epoch    uint64
+	version  uint64
+	objects  map[string]*apitypes.PodWatchObject
+	history  []*apitypes.PodWatchEvent
+	watchers map[*PodWatcher]bool
+}
+
+// newWatchHub starts the versions from the epoch of the daemon, so a version
+// seen before hyperd restarts is never taken as one of the new events.
+func newWatchHub(registry *PodList) *WatchHub {
+	epoch := uint64(time.Now().Unix()) << watchEpochShift
+	h := &WatchHub{
+		registry: registry,
+		epoch:    epoch,
+		version:  epoch,
+		pending:  make(map[string]bool),
+		kick:     make(chan struct{}, 1),
+		objects:  make(map[string]*apitypes.PodWatchObject),
+		history:  []*apitypes.PodWatchEvent{},
+		watchers: make(map[*PodWatcher]bool),
+	}
+	go h.loop()
+	return h
+}
+
+func (h *WatchHub) notify(id string) {
+	h.lock.Lock()
+	h.pending[id] = true
+	h.lock.Unlock()
+
+	select {
+	case h.kick <- struct{}{}:
+	default:
+	}
+}
+
+// Watch subscribes the changes after version since. If since is 0, the first
+// event sent to the watcher is a snapshot of all the pods.
+func (h *WatchHub) Watch(since uint64) (*PodWatcher, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	var events []*apitypes.PodWatchEvent
+	if since == 0 {
+		events = []*apitypes.PodWatchEvent{h.snapshotLocked()}
+	} else {
+		if since < h.epoch {
+			return nil, fmt.Errorf("resource version %d is too old, hyperd has restarted at version %d", since, h.epoch)
+		}
+		if since > h.version {
+			return nil, fmt.Errorf("resource version %d is newer than current version %d", since, h.version)
+		}
+		if len(h.history) > 0 && since+1 < h.history[0].ResourceVersion {
+			return nil, fmt.Errorf("resource version %d is too old, the oldest available is %d", since, h.history[0].ResourceVersion)
+		}
+		for _, ev := range h.history {
+			if ev.ResourceVersion > since {
+				events = append(events, ev)
+			}
+		}
+	}
+
+	size := watchChanSize
+	if len(events) > size {
+		size = len(events)
+	}
+	w := &PodWatcher{
+		C:   make(chan *apitypes.PodWatchEvent, size),
+		hub: h,
+	}
+	for _, ev := range events {
+		w.C <- ev
+	}
+	h.watchers[w] = true
+	return w, nil
+}
+
+func (h *WatchHub) unsubscribe(w *PodWatcher) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if h.watchers[w] {
+		delete(h.watchers, w)
+		close(w.C)
+	}
+}
+
+func (h *WatchHub) snapshotLocked() *apitypes.PodWatchEvent {
+	ids := make([]string, 0, len(h.objects))
+	for id := range h.objects {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	snapshot := make([]*apitypes.PodWatchObject, 0, len(ids))
+	for _, id := range ids {
+		snapshot = append(snapshot, h.objects[id])
+	}
+	return &apitypes.PodWatchEvent{
+		Type:            WATCH_SNAPSHOT,
+		ResourceVersion: h.version,
+		Snapshot:        snapshot,
+	}
+}
+
+func (h *WatchHub) loop() {
+	for range h.kick {
+		h.lock.Lock()
+		pending := h.pending
+		h.pending = make(map[string]bool)
+		h.lock.Unlock()
+
+		ids := make([]string, 0, len(pending))
+		for id := range pending {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+
+		for _, id := range ids {
+			var current *apitypes.PodWatchObject
+			if p, ok := h.registry.Get(id); ok && !p.IsNone() {
+				current = p.watchObject()
+			}
+			h.update(id, current)
+		}
+	}
+}
+
+// update compares the current state of a pod with the last seen one, and
+// publishes the differences.
+func (h *WatchHub) update(id string, current *apitypes.PodWatchObject) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	last, existed := h.objects[id]
+	switch {
+	case current == nil && !existed:
+		return
+	case current == nil:
+		for _, c := range last.Containers {
+			h.publishLocked(WATCH_DELETED, WATCH_KIND_CONTAINER, nil, c)
+		}
+		h.publishLocked(WATCH_DELETED, WATCH_KIND_POD, last, nil)
+		delete(h.objects, id)
+		return
+	case !existed:
+		h.publishLocked(WATCH_ADDED, WATCH_KIND_POD, current, nil)
+		for _, c := range current.Containers {
+			h.publishLocked(WATCH_ADDED, WATCH_KIND_CONTAINER, nil, c)
+		}
+		h.objects[id] = current
+		return
+	}
+
+	if proto.Equal(last, current) {
+		return
+	}
+
+	if !proto.Equal(last.Pod, current.Pod) || !equalPortMappings(last.Portmappings, current.Portmappings) ||
+		!equalServices(last.Services, current.Services) {
+		h.publishLocked(WATCH_MODIFIED, WATCH_KIND_POD, current, nil)
+	}
+
+	lastContainers := make(map[string]*apitypes.ContainerListResult)
+	for _, c := range last.Containers {
+		lastContainers[c.ContainerID] = c
+	}
+	for _, c := range current.Containers {
+		if lc, ok := lastContainers[c.ContainerID]; !ok {
+			h.publishLocked(WATCH_ADDED, WATCH_KIND_CONTAINER, nil, c)
+		} else {
+			if !proto.Equal(lc, c) {
+				h.publishLocked(WATCH_MODIFIED, WATCH_KIND_CONTAINER, nil, c)
+			}
+			delete(lastContainers, c.ContainerID)
+		}
+	}
+	for _, c := range last.Containers {
+		if _, ok := lastContainers[c.ContainerID]; ok {
+			h.publishLocked(WATCH_DELETED, WATCH_KIND_CONTAINER, nil, c)
+		}
+	}
+	h.objects[id] = current
+}
+
+// publishPool sends the change of a storage pool to the watchers, the pools
+// are not part of the snapshots.
+func (h *WatchHub) publishPool(pool *apitypes.StoragePoolStatus) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.version++
+	h.sendLocked(&apitypes.PodWatchEvent{
+		Type:            WATCH_MODIFIED,
+		Kind:            WATCH_KIND_STORAGE_POOL,
+		ResourceVersion: h.version,
+		Pool:            pool,
+	})
+}
+
+func (h *WatchHub) publishLocked(t, kind string, p *apitypes.PodWatchObject, c *apitypes.ContainerListResult) {
+	h.version++
+	h.sendLocked(&apitypes.PodWatchEvent{
+		Type:            t,
+		Kind:            kind,
+		ResourceVersion: h.version,
+		Pod:             p,
+		Container:       c,
+	})
+}
+
+func (h *WatchHub) sendLocked(ev *apitypes.PodWatchEvent) {
+	h.history = append(h.history, ev)
+	if len(h.history) > watchHistorySize {
+		h.history = h.history[len(h.history)-watchHistorySize:]
+	}
+
+	for w := range h.watchers {
+		select {
+		case w.C <- ev:
+		default:
+			hlog.Log(WARNING, "pod watcher could not keep up with the events at version %d, close it", ev.ResourceVersion)
+			delete(h.watchers, w)
+			close(w.C)
+		}
+	}
+}
+
+func equalPortMappings(a, b []*apitypes.PortMapping) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func equalServices(a, b []*apitypes.UserService) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// watchObject collects the state of the pod which is visible to the watchers.
+func (p *XPod) watchObject() *apitypes.PodWatchObject {
+	s := p.BriefStatus()
+
+	p.resourceLock.Lock()
+	labels := make(map[string]string, len(p.labels))
+	for k, v := range p.labels {
+		labels[k] = v
+	}
+	services := []*apitypes.UserService{}
+	if p.services != nil {
+		for _, srv := range p.services.get() {
+			services = append(services, proto.Clone(srv).(*apitypes.UserService))
+		}
+	}
+	p.resourceLock.Unlock()
+	s.Labels = labels
+
+	containers := []*apitypes.ContainerListResult{}
+	for _, cid := range p.ContainerIds() {
+		if cs := p.ContainerBriefStatus(cid); cs != nil {
+			containers = append(containers, cs)
+		}
+	}
+	containers = p.AppendContainerBufferStatus(containers)
+	sort.Sort(containerListById(containers))
+
+	return &apitypes.PodWatchObject{
+		Pod:          s,
+		Containers:   containers,
+		Portmappings: p.ListPortMappings(),
+		Services:     services,
+	}
+}
+
+// notifyWatchers tells the watchers of the pod list that the pod has changed.
+func (p *XPod) notifyWatchers() {
+	if p.factory != nil && p.factory.registry != nil {
+		p.factory.registry.notify(p.Id())
+	}
+}
+
+type containerListById []*apitypes.ContainerListResult
+
+func (l containerListById) Len() int           { return len(l) }
+func (l containerListById) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+func (l containerListById) Less(i, j int) bool { return l[i].ContainerID < l[j].ContainerID }
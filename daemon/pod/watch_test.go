@@ -0,0 +1,121 @@
+package pod
+
+import (
+	"testing"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+func watchObject(id, status string, containers ...string) *apitypes.PodWatchObject {
+	obj := &apitypes.PodWatchObject{
+		Pod: &apitypes.PodListResult{PodID: id, Status: status},
+	}
+	for _, cid := range containers {
+		obj.Containers = append(obj.Containers, &apitypes.ContainerListResult{ContainerID: cid, PodID: id, Status: status})
+	}
+	return obj
+}
+
+func receive(t *testing.T, w *PodWatcher, n int) []*apitypes.PodWatchEvent {
+	events := []*apitypes.PodWatchEvent{}
+	for i := 0; i < n; i++ {
+		select {
+		case ev := <-w.C:
+			events = append(events, ev)
+		default:
+			t.Fatalf("expect %d events, got %d", n, len(events))
+		}
+	}
+	select {
+	case ev := <-w.C:
+		t.Fatalf("unexpected event %v", ev)
+	default:
+	}
+	return events
+}
+
+func TestWatchHub(t *testing.T) {
+	h := newWatchHub(NewPodList())
+
+	h.update("web", watchObject("web", "pending", "c1"))
+	h.update("db", watchObject("db", "running"))
+	start := h.version
+
+	w, err := h.Watch(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Stop()
+	snapshot := receive(t, w, 1)[0]
+	if snapshot.Type != WATCH_SNAPSHOT || snapshot.ResourceVersion != start || len(snapshot.Snapshot) != 2 ||
+		snapshot.Snapshot[0].Pod.PodID != "db" {
+		t.Fatalf("unexpected snapshot %v", snapshot)
+	}
+
+	h.update("web", watchObject("web", "running", "c1"))
+	h.update("db", nil)
+	expect := []struct{ t, kind string }{
+		{WATCH_MODIFIED, WATCH_KIND_POD},
+		{WATCH_MODIFIED, WATCH_KIND_CONTAINER},
+		{WATCH_DELETED, WATCH_KIND_POD},
+	}
+	events := receive(t, w, len(expect))
+	for i, ev := range events {
+		if ev.Type != expect[i].t || ev.Kind != expect[i].kind || ev.ResourceVersion != start+uint64(i)+1 {
+			t.Fatalf("unexpected event %d: %v", i, ev)
+		}
+	}
+
+	// nothing is sent if the pod has not changed
+	h.update("web", watchObject("web", "running", "c1"))
+	receive(t, w, 0)
+}
+
+func TestWatchHubResume(t *testing.T) {
+	h := newWatchHub(NewPodList())
+
+	h.update("web", watchObject("web", "pending"))
+	since := h.version
+	h.update("web", watchObject("web", "running"))
+	h.publishPool(&apitypes.StoragePoolStatus{})
+
+	w, err := h.Watch(since)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := receive(t, w, 2)
+	w.Stop()
+	if events[0].ResourceVersion != since+1 || events[0].Pod.Pod.Status != "running" ||
+		events[1].Kind != WATCH_KIND_STORAGE_POOL {
+		t.Fatalf("unexpected events %v", events)
+	}
+	if _, ok := <-w.C; ok {
+		t.Fatal("the stopped watcher is not closed")
+	}
+
+	w, err = h.Watch(h.version)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receive(t, w, 0)
+	w.Stop()
+
+	if _, err := h.Watch(h.version + 1); err == nil {
+		t.Fatal("watched from a version in the future")
+	}
+
+	// the versions seen before a restart are older than the new epoch, even
+	// if the new daemon has published fewer events than the old one
+	restarted := newWatchHub(NewPodList())
+	restarted.epoch = h.epoch + 1<<watchEpochShift
+	restarted.version = restarted.epoch
+	restarted.update("web", watchObject("web", "pending"))
+	if _, err := restarted.Watch(since); err == nil {
+		t.Fatal("resumed from a version before the restart")
+	}
+	if w, err := restarted.Watch(restarted.version); err != nil {
+		t.Fatal(err)
+	} else {
+		w.Stop()
+	}
+}
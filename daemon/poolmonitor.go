@@ -0,0 +1,213 @@
+package daemon
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/golang/glog"
+	dm "github.com/hyperhq/hyperd/storage/devicemapper"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+const (
+	POOL_LEVEL_OK      = "ok"
+	POOL_LEVEL_WARNING = "warning"
+	POOL_LEVEL_FULL    = "full"
+
+	poolMonitorInterval = 30 * time.Second
+)
+
+// poolMonitor watches the data and metadata usage of the thin pools of the
+// devicemapper storage. A full thin pool corrupts the filesystems of all the
+// pods on it, so the creation of pods, containers and volumes is refused once
+// a pool crosses the full threshold.
+type poolMonitor struct {
+	dms    *DevMapperStorage
+	notify func(*apitypes.StoragePoolStatus)
+	stop   chan struct{}
+
+	lock   sync.Mutex
+	warn   int
+	full   int
+	extend int64
+	pools  map[string]*apitypes.StoragePoolStatus
+}
+
+func newPoolMonitor(dms *DevMapperStorage, c *apitypes.HyperConfig, notify func(*apitypes.StoragePoolStatus)) (*poolMonitor, error) {
+	m := &poolMonitor{
+		dms:    dms,
+		notify: notify,
+		stop:   make(chan struct{}),
+		pools:  make(map[string]*apitypes.StoragePoolStatus),
+	}
+	if err := m.setConfig(c); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *poolMonitor) setConfig(c *apitypes.HyperConfig) error {
+	var extend int64
+	if c.DmPoolAutoExtend != "" {
+		var err error
+		if extend, err = units.RAMInBytes(c.DmPoolAutoExtend); err != nil {
+			return err
+		}
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.warn, m.full, m.extend = c.DmPoolWarnThreshold, c.DmPoolFullThreshold, extend
+	return nil
+}
+
+func (m *poolMonitor) run() {
+	ticker := time.NewTicker(poolMonitorInterval)
+	defer ticker.Stop()
+	for {
+		m.check()
+		select {
+		case <-ticker.C:
+		case <-m.stop:
+			return
+		}
+	}
+}
+
+func (m *poolMonitor) Stop() {
+	close(m.stop)
+}
+
+func (m *poolMonitor) poolNames() []string {
+	return []string{m.dms.CtnPoolName, m.dms.VolPoolName}
+}
+
+func (m *poolMonitor) check() {
+	for _, name := range m.poolNames() {
+		st, err := dm.GetPoolStatus(name)
+		if err != nil {
+			glog.V(1).Infof("failed to get status of pool %s: %v", name, err)
+			continue
+		}
+		level := m.level(st)
+		// only the volume pool is created by hyperd on loopback files
+		if level != POOL_LEVEL_OK && name == m.dms.VolPoolName && m.autoExtend(st) {
+			if st, err = dm.GetPoolStatus(name); err != nil {
+				continue
+			}
+			level = m.level(st)
+		}
+
+		status := &apitypes.StoragePoolStatus{
+			Name:          name,
+			DataUsed:      st.DataUsed,
+			DataTotal:     st.DataTotal,
+			MetadataUsed:  st.MetadataUsed,
+			MetadataTotal: st.MetadataTotal,
+			Level:         level,
+		}
+		m.lock.Lock()
+		last, ok := m.pools[name]
+		m.pools[name] = status
+		m.lock.Unlock()
+
+		if (ok && last.Level == level) || (!ok && level == POOL_LEVEL_OK) {
+			continue
+		}
+		if level == POOL_LEVEL_OK {
+			glog.Infof("usage of pool %s is back to normal", name)
+		} else {
+			glog.Warningf("usage of pool %s reaches %s level: data %d%%, metadata %d%%", name, level,
+				percent(st.DataUsed, st.DataTotal), percent(st.MetadataUsed, st.MetadataTotal))
+		}
+		m.notify(status)
+	}
+}
+
+func (m *poolMonitor) level(st *dm.PoolStatus) string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	usage := percent(st.DataUsed, st.DataTotal)
+	if meta := percent(st.MetadataUsed, st.MetadataTotal); meta > usage {
+		usage = meta
+	}
+	switch {
+	case usage >= m.full:
+		return POOL_LEVEL_FULL
+	case usage >= m.warn:
+		return POOL_LEVEL_WARNING
+	}
+	return POOL_LEVEL_OK
+}
+
+// autoExtend grows the data of the pool if auto-extend is configured, the
+// metadata could not be extended as the metadata file is fixed at creation.
+func (m *poolMonitor) autoExtend(st *dm.PoolStatus) bool {
+	m.lock.Lock()
+	extend, warn := m.extend, m.warn
+	m.lock.Unlock()
+
+	if extend <= 0 || percent(st.DataUsed, st.DataTotal) < warn {
+		return false
+	}
+	if err := dm.ExtendPool(m.dms.DmPoolData, int(st.DataTotal+extend)); err != nil {
+		glog.Errorf("failed to extend pool %s: %v", m.dms.VolPoolName, err)
+		return false
+	}
+	return true
+}
+
+// checkSpace returns an error if any of the pools is full.
+func (m *poolMonitor) checkSpace() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, name := range m.poolNames() {
+		if st, ok := m.pools[name]; ok && st.Level == POOL_LEVEL_FULL {
+			return fmt.Errorf("storage pool %s is almost full (data %d%%, metadata %d%%), free some space first",
+				name, percent(st.DataUsed, st.DataTotal), percent(st.MetadataUsed, st.MetadataTotal))
+		}
+	}
+	return nil
+}
+
+// Status reports the last seen usage of the pools, in the format of the
+// driver status.
+func (m *poolMonitor) Status() [][2]string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	status := [][2]string{}
+	for _, name := range m.poolNames() {
+		st, ok := m.pools[name]
+		if !ok {
+			continue
+		}
+		status = append(status,
+			[2]string{name + " Data Usage", usageString(st.DataUsed, st.DataTotal)},
+			[2]string{name + " Metadata Usage", usageString(st.MetadataUsed, st.MetadataTotal)},
+			[2]string{name + " Level", st.Level},
+		)
+	}
+	return status
+}
+
+// checkStorageSpace refuses to allocate more storage if the storage is full.
+func (daemon *Daemon) checkStorageSpace() error {
+	if daemon.poolMonitor == nil {
+		return nil
+	}
+	return daemon.poolMonitor.checkSpace()
+}
+
+func percent(used, total int64) int {
+	if total <= 0 {
+		return 0
+	}
+	return int(used * 100 / total)
+}
+
+func usageString(used, total int64) string {
+	return fmt.Sprintf("%s / %s (%d%%)", units.BytesSize(float64(used)), units.BytesSize(float64(total)), percent(used, total))
+}
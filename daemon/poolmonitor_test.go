@@ -0,0 +1,104 @@
+package daemon
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	dm "github.com/hyperhq/hyperd/storage/devicemapper"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+func TestPercent(t *testing.T) {
+	for _, c := range [][3]int64{
+		{0, 0, 0},
+		{10, 0, 0},
+		{1, 3, 33},
+		{80, 100, 80},
+		{100, 100, 100},
+	} {
+		if p := percent(c[0], c[1]); int64(p) != c[2] {
+			t.Errorf("percent(%d, %d): expect %d, got %d", c[0], c[1], c[2], p)
+		}
+	}
+}
+
+func TestPoolLevel(t *testing.T) {
+	m, err := newPoolMonitor(&DevMapperStorage{}, &apitypes.HyperConfig{DmPoolWarnThreshold: 80, DmPoolFullThreshold: 95}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		data, meta int64
+		level      string
+	}{
+		{10, 10, POOL_LEVEL_OK},
+		{79, 10, POOL_LEVEL_OK},
+		{80, 10, POOL_LEVEL_WARNING},
+		{10, 80, POOL_LEVEL_WARNING},
+		{95, 10, POOL_LEVEL_FULL},
+		{10, 99, POOL_LEVEL_FULL},
+	} {
+		st := &dm.PoolStatus{DataUsed: c.data, DataTotal: 100, MetadataUsed: c.meta, MetadataTotal: 100}
+		if level := m.level(st); level != c.level {
+			t.Errorf("data %d%%, metadata %d%%: expect %s, got %s", c.data, c.meta, c.level, level)
+		}
+	}
+
+	if err := m.setConfig(&apitypes.HyperConfig{DmPoolAutoExtend: "ten gigabytes"}); err == nil {
+		t.Fatal("invalid auto-extend size is accepted")
+	}
+	if err := m.setConfig(&apitypes.HyperConfig{DmPoolWarnThreshold: 50, DmPoolFullThreshold: 60, DmPoolAutoExtend: "10g"}); err != nil {
+		t.Fatal(err)
+	}
+	if m.warn != 50 || m.full != 60 || m.extend != 10<<30 {
+		t.Fatalf("unexpected config warn %d, full %d, extend %d", m.warn, m.full, m.extend)
+	}
+}
+
+func TestPoolMonitorCheck(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dmsetup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	// the data of both pools are used by 1251/1280 blocks, i.e. 97%
+	script := fmt.Sprintf("#!/bin/sh\ncase $1 in table) echo '%s';; status) echo '%s';; esac\n",
+		"0 163840 thin-pool 7:1 7:0 128 0", "0 163840 thin-pool 1 203/32768 1251/1280 - rw")
+	if err := ioutil.WriteFile(filepath.Join(dir, "dmsetup"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := os.Getenv("PATH")
+	os.Setenv("PATH", dir+":"+path)
+	defer os.Setenv("PATH", path)
+
+	notified := []*apitypes.StoragePoolStatus{}
+	dms := &DevMapperStorage{CtnPoolName: "hyper-container-pool", VolPoolName: "hyper-volume-pool"}
+	m, err := newPoolMonitor(dms, &apitypes.HyperConfig{DmPoolWarnThreshold: 80, DmPoolFullThreshold: 95}, func(st *apitypes.StoragePoolStatus) {
+		notified = append(notified, st)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.checkSpace(); err != nil {
+		t.Fatal(err)
+	}
+
+	m.check()
+	if len(notified) != 2 || notified[0].Level != POOL_LEVEL_FULL || notified[0].DataUsed != 1251*128*512 {
+		t.Fatalf("unexpected notifications %v", notified)
+	}
+	if err := m.checkSpace(); err == nil {
+		t.Fatal("the full pool accepts more storage")
+	}
+	// the level is notified when it changes only
+	m.check()
+	if len(notified) != 2 {
+		t.Fatalf("unexpected notifications %v", notified)
+	}
+	if status := m.Status(); len(status) != 6 || status[2][1] != POOL_LEVEL_FULL {
+		t.Fatalf("unexpected status %v", status)
+	}
+}
@@ -23,6 +23,10 @@ func (daemon *Daemon) RemovePod(podId string) (int, string, error) {
 	if !ok {
 		return E_NOT_FOUND, "", fmt.Errorf("Can not find that Pod(%s)", podId)
 	}
+	if p.VolumesHeld() {
+		err = fmt.Errorf("the volumes of pod %s are being exported or imported", podId)
+		return E_UNDER_OPERATION, err.Error(), err
+	}
 
 	daemon.PodList.Release(podId)
 
@@ -31,7 +35,12 @@ func (daemon *Daemon) RemovePod(podId string) (int, string, error) {
 		p.Stop(5)
 	}
 
-	p.Remove(true)
+	// the named volumes are still referred by the pod if it is not removed
+	if err = p.Remove(true); err != nil {
+		glog.Errorf("failed to remove pod %s: %v", podId, err)
+		return E_UNDER_OPERATION, err.Error(), err
+	}
+	daemon.releaseVolumes(p.Id())
 
 	return code, cause, err
 }
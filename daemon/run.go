@@ -2,10 +2,12 @@ package daemon
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/golang/glog"
 
 	"github.com/hyperhq/hyperd/daemon/pod"
+	"github.com/hyperhq/hyperd/lib/k8s"
 	apitypes "github.com/hyperhq/hyperd/types"
 	"github.com/hyperhq/hyperd/utils"
 )
@@ -23,21 +25,71 @@ func (daemon *Daemon) CreatePod(podId string, podSpec *apitypes.UserPod) (*pod.X
 		podSpec.Id = podId
 	}
 
+	// the pod id could be qualified with the namespace as "name@namespace"
+	if strings.Contains(podSpec.Id, pod.NAMESPACE_SEPARATOR) {
+		ns, name := pod.SplitQualifiedName(podSpec.Id)
+		if podSpec.Namespace != "" && podSpec.Namespace != ns {
+			return nil, fmt.Errorf("pod %s does not belong to namespace %s", podSpec.Id, podSpec.Namespace)
+		}
+		podSpec.Id, podSpec.Namespace = name, ns
+	}
+	if err := pod.ValidateName(podSpec.Id); err != nil {
+		return nil, err
+	}
+	if err := pod.ValidateNamespace(podSpec.Namespace); err != nil {
+		return nil, err
+	}
+
 	if err := podSpec.Validate(); err != nil {
 		return nil, err
 	}
+	if err := daemon.validateRootfsSize(podSpec.Containers); err != nil {
+		return nil, err
+	}
+	if err := daemon.validateEncryption(podSpec); err != nil {
+		return nil, err
+	}
+	if err := daemon.checkStorageSpace(); err != nil {
+		return nil, err
+	}
+
+	name := pod.QualifiedName(podSpec.Namespace, podSpec.Id)
+	vols, err := daemon.resolveVolumes(name, podSpec)
+	if err != nil {
+		return nil, err
+	}
 
 	factory := pod.NewPodFactory(daemon.Factory, daemon.PodList, daemon.db, daemon.Storage, daemon.Daemon, daemon.DefaultLog)
 
 	p, err := pod.CreateXPod(factory, podSpec)
 	if err != nil {
 		glog.Errorf("%s: failed to add pod: %v", podSpec.Id, err)
+		daemon.unreferVolumes(name, vols)
 		return nil, err
 	}
 
 	return p, nil
 }
 
+// CreateKubernetesPod creates a pod from a Kubernetes v1 Pod manifest, the
+// fields which are not supported are returned as warnings.
+func (daemon *Daemon) CreateKubernetesPod(podId string, manifest []byte) (*pod.XPod, []string, error) {
+	podSpec, warnings, err := k8s.Convert(manifest)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, w := range warnings {
+		glog.Warningf("%s: %s", podSpec.Id, w)
+	}
+
+	p, err := daemon.CreatePod(podId, podSpec)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return p, warnings, nil
+}
+
 func (daemon *Daemon) StartPod(podId string) error {
 	p, ok := daemon.PodList.Get(podId)
 	if !ok {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/docker/distribution/digest"
@@ -17,6 +18,7 @@ import (
 	"github.com/hyperhq/hyperd/libmoby/distribution"
 	apitypes "github.com/hyperhq/hyperd/types"
 	"github.com/hyperhq/hyperd/utils"
+	runvtypes "github.com/hyperhq/runv/hypervisor/types"
 )
 
 func (daemon *Daemon) CmdImages(args, filter string, all bool) (*engine.Env, error) {
@@ -51,6 +53,8 @@ func (daemon *Daemon) CmdImages(args, filter string, all bool) (*engine.Env, err
 }
 
 func (daemon *Daemon) CmdAuthenticateToRegistry(config *types.AuthConfig) (string, error) {
+	daemon.RegistryOpStart()
+	defer daemon.RegistryOpDone()
 	return daemon.Daemon.AuthenticateToRegistry(config)
 }
 
@@ -58,8 +62,18 @@ func (daemon *Daemon) CmdAttach(stdin io.ReadCloser, stdout io.WriteCloser, cont
 	return daemon.Attach(stdin, stdout, container)
 }
 
+// CommitContainer commits the changes of the container to a new image, and
+// returns the id of the image. The container could be qualified with its
+// namespace, which the container engine does not know.
+func (daemon *Daemon) CommitContainer(name string, cfg *types.ContainerCommitConfig) (string, error) {
+	if _, cid, ok := daemon.PodList.GetByContainerIdOrName(name); ok {
+		name = cid
+	}
+	return daemon.Daemon.Commit(name, cfg)
+}
+
 func (daemon *Daemon) CmdCommitImage(name string, cfg *types.ContainerCommitConfig) (*engine.Env, error) {
-	imgId, err := daemon.Daemon.Commit(name, cfg)
+	imgId, err := daemon.CommitContainer(name, cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -72,16 +86,35 @@ func (daemon *Daemon) CmdCommitImage(name string, cfg *types.ContainerCommitConf
 }
 
 func (daemon *Daemon) CreateContainerInPod(podId string, spec *apitypes.UserContainer) (string, error) {
+	if err := daemon.validateRootfsSize([]*apitypes.UserContainer{spec}); err != nil {
+		return "", err
+	}
+	if err := daemon.validateEncryption(&apitypes.UserPod{Containers: []*apitypes.UserContainer{spec}}); err != nil {
+		return "", err
+	}
+	if err := daemon.checkStorageSpace(); err != nil {
+		return "", err
+	}
 	p, ok := daemon.PodList.Get(podId)
 	if !ok {
 		return "", fmt.Errorf("The pod(%s) can not be found", podId)
 	}
 
-	if daemon.buffer != nil {
-		return daemon.buffer.CreateContainerInPod(p, spec)
+	vols, err := daemon.resolveVolumes(p.Id(), &apitypes.UserPod{Containers: []*apitypes.UserContainer{spec}})
+	if err != nil {
+		return "", err
 	}
 
-	return p.ContainerCreate(spec)
+	var id string
+	if daemon.buffer != nil {
+		id, err = daemon.buffer.CreateContainerInPod(p, spec)
+	} else {
+		id, err = p.ContainerCreate(spec)
+	}
+	if err != nil {
+		daemon.unreferVolumes(p.Id(), vols)
+	}
+	return id, err
 }
 
 func (daemon *Daemon) StartContainer(containerId string) error {
@@ -150,7 +183,9 @@ func (daemon *Daemon) CmdExitCode(containerId, execId string) (int, error) {
 }
 
 func (daemon *Daemon) CmdSystemInfo() (*apitypes.InfoResponse, error) {
+	daemon.RegistryOpStart()
 	sys, err := daemon.Daemon.SystemInfo()
+	daemon.RegistryOpDone()
 	if err != nil {
 		return nil, err
 	}
@@ -169,6 +204,16 @@ func (daemon *Daemon) CmdSystemInfo() (*apitypes.InfoResponse, error) {
 	for _, driverStatus := range sys.DriverStatus {
 		info.Dstatus = append(info.Dstatus, &apitypes.DriverStatus{Name: driverStatus[0], Status: driverStatus[1]})
 	}
+	if r, ok := daemon.Storage.(StatusReporter); ok {
+		for _, driverStatus := range r.Status() {
+			info.Dstatus = append(info.Dstatus, &apitypes.DriverStatus{Name: driverStatus[0], Status: driverStatus[1]})
+		}
+	}
+	if daemon.poolMonitor != nil {
+		for _, poolStatus := range daemon.poolMonitor.Status() {
+			info.Dstatus = append(info.Dstatus, &apitypes.DriverStatus{Name: poolStatus[0], Status: poolStatus[1]})
+		}
+	}
 
 	//Get system infomation
 	meminfo, err := sysinfo.GetMemInfo()
@@ -199,20 +244,40 @@ func (daemon *Daemon) CmdSystemVersion() *engine.Env {
 	return v
 }
 
-func (daemon *Daemon) CmdGetPodInfo(podName string) (interface{}, error) {
-	return daemon.GetPodInfo(podName)
+func (daemon *Daemon) CmdSystemGC(dryRun bool) ([]*apitypes.StorageOrphan, error) {
+	return daemon.StorageGC(dryRun)
+}
+
+func (daemon *Daemon) CmdSystemDBBackup(path string) (*apitypes.SystemDBBackupResponse, error) {
+	n, err := daemon.BackupDB(path)
+	if err != nil {
+		return nil, err
+	}
+	return &apitypes.SystemDBBackupResponse{Path: path, Records: int64(n)}, nil
+}
+
+func (daemon *Daemon) CmdGetPodInfo(namespace, podName string) (interface{}, error) {
+	return daemon.GetPodInfo(namespace, podName)
+}
+
+func (daemon *Daemon) CmdExportPodSpec(namespace, podName string) (interface{}, error) {
+	return daemon.ExportPodSpec(namespace, podName)
 }
 
 func (daemon *Daemon) CmdGetPodStats(podId string) (interface{}, error) {
 	return daemon.GetPodStats(podId)
 }
 
-func (daemon *Daemon) CmdGetContainerInfo(name string) (interface{}, error) {
-	return daemon.GetContainerInfo(name)
+func (daemon *Daemon) CmdGetContainerInfo(namespace, name string) (interface{}, error) {
+	return daemon.GetContainerInfo(namespace, name)
+}
+
+func (daemon *Daemon) CmdGetExecInfo(execId string) (interface{}, error) {
+	return daemon.GetExecInfo(execId)
 }
 
-func (daemon *Daemon) CmdList(item, podId, vmId string) (*engine.Env, error) {
-	list, err := daemon.List(item, podId, vmId)
+func (daemon *Daemon) CmdList(item, namespace, podId, vmId string) (*engine.Env, error) {
+	list, err := daemon.List(item, namespace, podId, vmId)
 	if err != nil {
 		return nil, err
 	}
@@ -227,6 +292,39 @@ func (daemon *Daemon) CmdList(item, podId, vmId string) (*engine.Env, error) {
 	return v, nil
 }
 
+func (daemon *Daemon) CmdListVMs(podId, vmId string) ([]*apitypes.VMListResult, error) {
+	return daemon.ListVMs("", podId, vmId)
+}
+
+func (daemon *Daemon) CmdCreateVm(cpu, mem int, async bool) (*engine.Env, error) {
+	v := &engine.Env{}
+	id, err := daemon.CreateVm(cpu, mem, async)
+	if err != nil {
+		v.SetInt("Code", runvtypes.E_FAILED)
+		v.Set("Cause", err.Error())
+		return v, nil
+	}
+
+	v.Set("ID", id)
+	v.SetInt("Code", runvtypes.E_OK)
+	v.Set("Cause", "")
+	return v, nil
+}
+
+func (daemon *Daemon) CmdRemoveVm(vmId string) (*engine.Env, error) {
+	v := &engine.Env{}
+	v.Set("ID", vmId)
+	if err := daemon.RemoveVm(vmId); err != nil {
+		v.SetInt("Code", runvtypes.E_FAILED)
+		v.Set("Cause", err.Error())
+		return v, nil
+	}
+
+	v.SetInt("Code", runvtypes.E_OK)
+	v.Set("Cause", "")
+	return v, nil
+}
+
 func (daemon *Daemon) CmdGetContainerLogs(container string, config *ContainerLogsConfig) (err error) {
 	return daemon.GetContainerLogs(container, config)
 }
@@ -275,6 +373,21 @@ func (daemon *Daemon) CmdCreatePod(podArgs string) (*engine.Env, error) {
 	return v, nil
 }
 
+func (daemon *Daemon) CmdCreateKubernetesPod(manifest string) (*engine.Env, error) {
+	p, warnings, err := daemon.CreateKubernetesPod("", []byte(manifest))
+	if err != nil {
+		return nil, err
+	}
+
+	v := &engine.Env{}
+	v.Set("ID", p.Id())
+	v.SetList("Warnings", warnings)
+	v.SetInt("Code", 0)
+	v.Set("Cause", "")
+
+	return v, nil
+}
+
 func (daemon *Daemon) CmdContainerRename(oldname, newname string) (*engine.Env, error) {
 	if err := daemon.ContainerRename(oldname, newname); err != nil {
 		return nil, err
@@ -389,6 +502,9 @@ func (daemon *Daemon) CmdImagePull(image, tag string, authConfig *types.AuthConf
 		return err
 	}
 
+	daemon.RegistryOpStart()
+	defer daemon.RegistryOpDone()
+
 	glog.Infof("getting image: %s:%s", ref.String(), tag)
 	if tag != "" {
 		// The "tag" could actually be a digest.
@@ -453,6 +569,8 @@ func (daemon *Daemon) CmdImagePush(repo, tag string, authConfig *types.AuthConfi
 		}
 	}
 
+	daemon.RegistryOpStart()
+	defer daemon.RegistryOpDone()
 	return daemon.Daemon.PushImage(ref, metaHeaders, authConfig, output)
 }
 
@@ -537,10 +655,92 @@ func (daemon *Daemon) CmdDeleteService(podId, data string) (*engine.Env, error)
 	return v, nil
 }
 
-func (daemon *Daemon) CmdGetServices(podId string) ([]*apitypes.UserService, error) {
+func (daemon *Daemon) CmdGetServices(podId string, live bool) ([]*apitypes.UserService, error) {
+	if live {
+		return daemon.GetLiveServices(podId)
+	}
 	return daemon.GetServices(podId)
 }
 
+func (daemon *Daemon) CmdCreateVolume(name, data, sizeStr string) (*apitypes.VolumeInfo, error) {
+	var labels map[string]string
+	if data != "" {
+		if err := json.Unmarshal([]byte(data), &labels); err != nil {
+			return nil, err
+		}
+	}
+
+	var size int64
+	if sizeStr != "" {
+		var err error
+		if size, err = strconv.ParseInt(sizeStr, 10, 64); err != nil || size < 0 {
+			return nil, fmt.Errorf("invalid volume size %q", sizeStr)
+		}
+	}
+
+	return daemon.CreateVolume(name, labels, size)
+}
+
+func (daemon *Daemon) CmdListVolumes() ([]*apitypes.VolumeInfo, error) {
+	return daemon.ListVolumes()
+}
+
+func (daemon *Daemon) CmdGetVolume(name string) (*apitypes.VolumeInfo, error) {
+	return daemon.GetVolume(name)
+}
+
+func (daemon *Daemon) CmdRemoveVolume(name string) (*engine.Env, error) {
+	if err := daemon.RemoveVolume(name); err != nil {
+		return nil, err
+	}
+
+	v := &engine.Env{}
+	v.Set("Result", "success")
+	return v, nil
+}
+
+func (daemon *Daemon) CmdSnapshotVolume(podId, volume, name string, pause bool) (*apitypes.VolumeSnapshotInfo, error) {
+	return daemon.SnapshotVolume(podId, volume, name, pause)
+}
+
+func (daemon *Daemon) CmdListVolumeSnapshots(podId string) ([]*apitypes.VolumeSnapshotInfo, error) {
+	return daemon.ListVolumeSnapshots(podId)
+}
+
+func (daemon *Daemon) CmdRemoveVolumeSnapshot(name string) (*engine.Env, error) {
+	if err := daemon.RemoveVolumeSnapshot(name); err != nil {
+		return nil, err
+	}
+
+	v := &engine.Env{}
+	v.Set("Result", "success")
+	return v, nil
+}
+
+func (daemon *Daemon) CmdCloneVolume(snapshot, podId, volume string) (*engine.Env, error) {
+	if err := daemon.CloneVolume(snapshot, podId, volume); err != nil {
+		return nil, err
+	}
+
+	v := &engine.Env{}
+	v.Set("Result", "success")
+	return v, nil
+}
+
+func (daemon *Daemon) CmdExportVolume(podId, volume string, out io.Writer) error {
+	return daemon.ExportVolume(podId, volume, out)
+}
+
+func (daemon *Daemon) CmdImportVolume(podId, volume string, in io.Reader) (*engine.Env, error) {
+	if err := daemon.ImportVolume(podId, volume, in); err != nil {
+		return nil, err
+	}
+
+	v := &engine.Env{}
+	v.Set("Result", "success")
+	return v, nil
+}
+
 func (daemon *Daemon) CmdPausePod(podId string) error {
 	glog.V(1).Infof("Pause pod %s", podId)
 	return daemon.PausePod(podId)
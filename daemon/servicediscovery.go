@@ -41,3 +41,12 @@ func (daemon *Daemon) GetServices(podId string) ([]*apitypes.UserService, error)
 
 	return p.GetServices()
 }
+
+func (daemon *Daemon) GetLiveServices(podId string) ([]*apitypes.UserService, error) {
+	p, ok := daemon.PodList.Get(podId)
+	if !ok {
+		return nil, fmt.Errorf("The pod(%s) can not be found, please create it first", podId)
+	}
+
+	return p.GetLiveServices()
+}
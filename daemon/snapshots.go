@@ -0,0 +1,208 @@
+package daemon
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/hyperd/utils"
+)
+
+type volumeSnapshots []*apitypes.VolumeSnapshotInfo
+
+func (v volumeSnapshots) Len() int           { return len(v) }
+func (v volumeSnapshots) Swap(i, j int)      { v[i], v[j] = v[j], v[i] }
+func (v volumeSnapshots) Less(i, j int) bool { return v[i].Name < v[j].Name }
+
+func (daemon *Daemon) snapshotStorage() (*DevMapperStorage, error) {
+	dms, ok := daemon.Storage.(*DevMapperStorage)
+	if !ok {
+		return nil, fmt.Errorf("volume snapshot is not supported by %s storage", daemon.Storage.Type())
+	}
+	return dms, nil
+}
+
+// SnapshotVolume takes a thin snapshot of the devicemapper volume of the pod.
+// The pod is paused during the snapshot if pause is set and the pod is running,
+// so that the data in the volume are consistent.
+func (daemon *Daemon) SnapshotVolume(podId, volume, name string, pause bool) (*apitypes.VolumeSnapshotInfo, error) {
+	dms, err := daemon.snapshotStorage()
+	if err != nil {
+		return nil, err
+	}
+	if !utils.IsDNSLabel(name) {
+		return nil, fmt.Errorf("snapshot name should fullfil the pattern: %s, input name: %s", utils.Dns1123LabelFmt, name)
+	}
+
+	p, ok := daemon.PodList.Get(podId)
+	if !ok {
+		return nil, fmt.Errorf("Can not get Pod info with pod ID(%s)", podId)
+	}
+	spec, ok := p.VolumeSpec(volume)
+	if !ok {
+		return nil, fmt.Errorf("volume %s not found in pod %s", volume, podId)
+	}
+	if spec.Format != "raw" || !strings.HasPrefix(spec.Source, "/dev/mapper/") {
+		return nil, fmt.Errorf("volume %s of pod %s is not created by devicemapper", volume, podId)
+	}
+
+	daemon.volumeLock.Lock()
+	defer daemon.volumeLock.Unlock()
+
+	if _, err := daemon.db.GetVolumeSnapshot(name); err == nil {
+		return nil, fmt.Errorf("snapshot %s already exists", name)
+	}
+
+	// the named volumes are kept under their own owner, see CreateVolume()
+	owner := p.Id()
+	if id, _ := dms.getPersistedId(owner, filepath.Base(spec.Source)); id <= 0 {
+		owner = namedVolumeOwner
+	}
+
+	if pause && p.IsRunning() {
+		if err := p.Pause(); err != nil {
+			return nil, err
+		}
+		defer p.UnPause()
+	}
+
+	snapId, size, err := dms.SnapshotVolume(owner, spec.Source)
+	if err != nil {
+		glog.Errorf("failed to snapshot volume %s of pod %s: %v", volume, podId, err)
+		return nil, err
+	}
+
+	snap := &apitypes.VolumeSnapshotInfo{
+		Name:      name,
+		PodID:     p.Id(),
+		Volume:    volume,
+		Size_:     int64(size),
+		CreatedAt: time.Now().Unix(),
+		DeviceID:  int32(snapId),
+	}
+	if err := daemon.saveSnapshot(snap); err != nil {
+		dms.RemoveSnapshot(snapId)
+		return nil, err
+	}
+
+	glog.Infof("snapshot %s taken for volume %s of pod %s", name, volume, podId)
+	return snap, nil
+}
+
+// ListVolumeSnapshots lists the volume snapshots, only the snapshots of the
+// pod are listed if podId is not empty.
+func (daemon *Daemon) ListVolumeSnapshots(podId string) ([]*apitypes.VolumeSnapshotInfo, error) {
+	daemon.volumeLock.Lock()
+	defer daemon.volumeLock.Unlock()
+
+	data, err := daemon.db.ListVolumeSnapshots()
+	if err != nil {
+		return nil, err
+	}
+
+	snaps := make([]*apitypes.VolumeSnapshotInfo, 0, len(data))
+	for _, d := range data {
+		var snap apitypes.VolumeSnapshotInfo
+		if err := proto.Unmarshal(d, &snap); err != nil {
+			glog.Errorf("failed to unpack volume snapshot: %v", err)
+			continue
+		}
+		if podId != "" && snap.PodID != podId {
+			continue
+		}
+		snaps = append(snaps, &snap)
+	}
+	sort.Sort(volumeSnapshots(snaps))
+	return snaps, nil
+}
+
+// CloneVolume creates the volume of a pod from the snapshot. The pod should
+// not have the volume yet, and the volume will be used when the pod is created
+// with a volume of the name and without source.
+func (daemon *Daemon) CloneVolume(snapshot, podId, volume string) error {
+	dms, err := daemon.snapshotStorage()
+	if err != nil {
+		return err
+	}
+	if err := daemon.checkStorageSpace(); err != nil {
+		return err
+	}
+	if p, ok := daemon.PodList.Get(podId); ok {
+		if _, ok := p.VolumeSpec(volume); ok {
+			return fmt.Errorf("volume %s already exists in pod %s", volume, podId)
+		}
+	}
+
+	daemon.volumeLock.Lock()
+	defer daemon.volumeLock.Unlock()
+
+	data, err := daemon.db.GetVolumeSnapshot(snapshot)
+	if err != nil {
+		return fmt.Errorf("snapshot %s not found", snapshot)
+	}
+	var snap apitypes.VolumeSnapshotInfo
+	if err := proto.Unmarshal(data, &snap); err != nil {
+		glog.Errorf("failed to unpack snapshot %s: %v", snapshot, err)
+		return err
+	}
+
+	if err := dms.CloneVolume(snapshot, int(snap.DeviceID), int(snap.Size_), podId, volume); err != nil {
+		glog.Errorf("failed to clone snapshot %s to volume %s of pod %s: %v", snapshot, volume, podId, err)
+		return err
+	}
+
+	glog.Infof("volume %s of pod %s cloned from snapshot %s", volume, podId, snapshot)
+	return nil
+}
+
+// RemoveVolumeSnapshot removes the snapshot and frees its thin device. The
+// volumes cloned from the snapshot are not affected.
+func (daemon *Daemon) RemoveVolumeSnapshot(name string) error {
+	dms, err := daemon.snapshotStorage()
+	if err != nil {
+		return err
+	}
+
+	daemon.volumeLock.Lock()
+	defer daemon.volumeLock.Unlock()
+
+	data, err := daemon.db.GetVolumeSnapshot(name)
+	if err != nil {
+		return fmt.Errorf("snapshot %s not found", name)
+	}
+	var snap apitypes.VolumeSnapshotInfo
+	if err := proto.Unmarshal(data, &snap); err != nil {
+		glog.Errorf("failed to unpack snapshot %s: %v", name, err)
+		return err
+	}
+
+	if err := dms.RemoveSnapshot(int(snap.DeviceID)); err != nil {
+		glog.Errorf("failed to remove the device of snapshot %s: %v", name, err)
+		return err
+	}
+	if err := daemon.db.DeleteVolumeSnapshot(name); err != nil {
+		glog.Errorf("failed to remove snapshot %s from db: %v", name, err)
+		return err
+	}
+
+	glog.Infof("snapshot %s removed", name)
+	return nil
+}
+
+func (daemon *Daemon) saveSnapshot(snap *apitypes.VolumeSnapshotInfo) error {
+	data, err := proto.Marshal(snap)
+	if err != nil {
+		glog.Errorf("failed to serialize snapshot %s: %v", snap.Name, err)
+		return err
+	}
+	if err := daemon.db.UpdateVolumeSnapshot(snap.Name, data); err != nil {
+		glog.Errorf("failed to write snapshot %s to db: %v", snap.Name, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package daemon
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hyperhq/hyperd/daemon/pod"
+	dm "github.com/hyperhq/hyperd/storage/devicemapper"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+// fakeDmsetup puts a dmsetup logging its arguments in PATH.
+func fakeDmsetup(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "dmsetup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	log := filepath.Join(dir, "dmsetup.log")
+	script := fmt.Sprintf("#!/bin/sh\necho \"$@\" >> %s\n", log)
+	if err := ioutil.WriteFile(filepath.Join(dir, "dmsetup"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := os.Getenv("PATH")
+	os.Setenv("PATH", dir+":"+path)
+	return log, func() {
+		os.Setenv("PATH", path)
+		os.RemoveAll(dir)
+	}
+}
+
+func TestVolumeSnapshots(t *testing.T) {
+	d, cleanup := newTestDaemon(t)
+	defer cleanup()
+	log, restore := fakeDmsetup(t)
+	defer restore()
+
+	d.PodList = pod.NewPodList()
+	d.Storage = &AufsStorage{}
+	if err := d.RemoveVolumeSnapshot("snap"); err == nil {
+		t.Fatal("snapshot removed by a storage without snapshots")
+	}
+
+	dms := &DevMapperStorage{db: d.db, VolPoolName: "hyper-volume-pool", DmPoolData: &dm.DeviceMapper{PoolName: "hyper-volume-pool"}}
+	d.Storage = dms
+	for _, snap := range []*apitypes.VolumeSnapshotInfo{
+		{Name: "snap-b", PodID: "web", Volume: "data", Size_: 1 << 30, DeviceID: 20},
+		{Name: "snap-a", PodID: "db", Volume: "data", Size_: 1 << 30, DeviceID: 10},
+	} {
+		if err := d.saveSnapshot(snap); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	snaps, err := d.ListVolumeSnapshots("")
+	if err != nil || len(snaps) != 2 || snaps[0].Name != "snap-a" {
+		t.Fatalf("unexpected snapshots %v, %v", snaps, err)
+	}
+	if snaps, _ := d.ListVolumeSnapshots("web"); len(snaps) != 1 || snaps[0].Name != "snap-b" {
+		t.Fatalf("unexpected snapshots of web %v", snaps)
+	}
+
+	// the clone is recorded as a volume of the pod, before the pod exists
+	if err := d.CloneVolume("snap-a", "cache", "data"); err != nil {
+		t.Fatal(err)
+	}
+	device := dms.volumeDeviceName("cache", "data")
+	if id, _ := dms.getPersistedId("cache", device); id <= 0 {
+		t.Fatal("the clone is not recorded")
+	}
+	if _, err := d.db.GetVolumeClone(device); err != nil {
+		t.Fatal("the clone is not recorded as pending")
+	}
+	if err := d.CloneVolume("snap-a", "cache", "data"); err == nil {
+		t.Fatal("the volume is cloned twice")
+	}
+	if err := d.CloneVolume("snap-c", "cache", "logs"); err == nil {
+		t.Fatal("the volume is cloned from a missing snapshot")
+	}
+
+	if err := d.RemoveVolumeSnapshot("snap-a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.db.GetVolumeSnapshot("snap-a"); err == nil {
+		t.Fatal("the snapshot is not removed")
+	}
+	if err := d.RemoveVolumeSnapshot("snap-a"); err == nil {
+		t.Fatal("the snapshot is removed twice")
+	}
+	if id, _ := dms.getPersistedId("cache", device); id <= 0 {
+		t.Fatal("the clone is removed along with the snapshot")
+	}
+
+	data, _ := ioutil.ReadFile(log)
+	for _, cmd := range []string{"create_snap", "create " + device, "delete 10"} {
+		if !strings.Contains(string(data), cmd) {
+			t.Fatalf("dmsetup %s is not called:\n%s", cmd, data)
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -18,12 +19,15 @@ import (
 	"github.com/docker/go-units"
 	"github.com/golang/glog"
 	"github.com/hyperhq/hyperd/daemon/daemondb"
+	"github.com/hyperhq/hyperd/daemon/pod"
 	"github.com/hyperhq/hyperd/storage"
 	"github.com/hyperhq/hyperd/storage/aufs"
 	dm "github.com/hyperhq/hyperd/storage/devicemapper"
 	"github.com/hyperhq/hyperd/storage/graphdriver/rawblock"
+	"github.com/hyperhq/hyperd/storage/luks"
 	"github.com/hyperhq/hyperd/storage/overlay"
 	"github.com/hyperhq/hyperd/storage/vbox"
+	"github.com/hyperhq/hyperd/storage/zfs"
 	apitypes "github.com/hyperhq/hyperd/types"
 	"github.com/hyperhq/hyperd/utils"
 	runv "github.com/hyperhq/runv/api"
@@ -36,13 +40,95 @@ type Storage interface {
 	Init(c *apitypes.HyperConfig) error
 	CleanUp() error
 
-	PrepareContainer(mountId, sharedDir string, readonly bool) (*runv.VolumeDescription, error)
+	PrepareContainer(mountId, sharedDir string, readonly bool, rootfsSize int64) (*runv.VolumeDescription, error)
 	CleanupContainer(id, sharedDir string) error
 	InjectFile(src io.Reader, containerId, target, baseDir string, perm, uid, gid int) error
 	CreateVolume(podId string, spec *apitypes.UserVolume) error
 	RemoveVolume(podId string, record []byte) error
 }
 
+// StatusReporter is implemented by the storage drivers which have status to
+// report in addition to the status of the graph driver.
+type StatusReporter interface {
+	Status() [][2]string
+}
+
+// validateRootfsSize refuses the containers with rootfsSize if the storage
+// could not honor it, or if it is smaller than the base size of the storage.
+func (daemon *Daemon) validateRootfsSize(containers []*apitypes.UserContainer) error {
+	for _, c := range containers {
+		if c.RootfsSize < 0 {
+			return fmt.Errorf("invalid rootfs size %d of container %s", c.RootfsSize, c.Name)
+		}
+		if c.RootfsSize == 0 {
+			continue
+		}
+		sizer, ok := daemon.Storage.(pod.RootfsSizer)
+		if !ok {
+			return fmt.Errorf("rootfsSize of container %s is not supported by %s storage", c.Name, daemon.Storage.Type())
+		}
+		base, err := sizer.BaseRootfsSize()
+		if err != nil {
+			return fmt.Errorf("failed to get the base rootfs size of %s storage: %v", daemon.Storage.Type(), err)
+		}
+		if c.RootfsSize < base {
+			return fmt.Errorf("rootfs size %d of container %s is smaller than the base size %d", c.RootfsSize, c.Name, base)
+		}
+	}
+	return nil
+}
+
+// validateEncryption refuses the encrypted volumes which will be created by
+// the storage, if the storage could not create block volumes. The volumes of
+// the pod and the volumes defined in the containers are both checked.
+func (daemon *Daemon) validateEncryption(spec *apitypes.UserPod) error {
+	volumes := append([]*apitypes.UserVolume{}, spec.Volumes...)
+	for _, c := range spec.Containers {
+		for _, ref := range c.Volumes {
+			if ref.Detail != nil {
+				volumes = append(volumes, ref.Detail)
+			}
+		}
+	}
+	for _, v := range volumes {
+		if v.Encryption == nil {
+			continue
+		}
+		if v.Source != "" {
+			// only the volumes created by the storage could be formatted
+			if v.Encryption.Created {
+				return fmt.Errorf("encrypted volume %s with source %s could not be marked as created", v.Name, v.Source)
+			}
+			continue
+		}
+		switch daemon.Storage.(type) {
+		case *DevMapperStorage, *RawBlockStorage:
+		default:
+			return fmt.Errorf("encrypted volume %s is not supported by %s storage", v.Name, daemon.Storage.Type())
+		}
+	}
+	return nil
+}
+
+// removeVolumeRecord removes the volume of the pod recorded in the db, by the
+// volume plugin or the storage which created it.
+func (daemon *Daemon) removeVolumeRecord(podId string, record []byte) error {
+	if driver, name, ok := pod.ParsePluginVolumeRecord(record); ok {
+		return pod.RemovePluginVolume(driver, name)
+	}
+	return daemon.Storage.RemoveVolume(podId, record)
+}
+
+// removeVFSVolume removes the vfs volume created for the pod, the record is
+// the name of the volume.
+func removeVFSVolume(podId string, record []byte) error {
+	name := string(record)
+	if podId == "" || name == "" || filepath.Base(name) != name {
+		return nil
+	}
+	return storage.RemoveVFSVolume(storage.VFSVolumePath(podId, name))
+}
+
 var StorageDrivers map[string]func(*dockertypes.Info, *daemondb.DaemonDB) (Storage, error) = map[string]func(*dockertypes.Info, *daemondb.DaemonDB) (Storage, error){
 	"devicemapper": DMFactory,
 	"aufs":         AufsFactory,
@@ -50,6 +136,7 @@ var StorageDrivers map[string]func(*dockertypes.Info, *daemondb.DaemonDB) (Stora
 	"btrfs":        BtrfsFactory,
 	"rawblock":     RawBlockFactory,
 	"vbox":         VBoxStorageFactory,
+	"zfs":          ZfsFactory,
 }
 
 func StorageFactory(sysinfo *dockertypes.Info, db *daemondb.DaemonDB) (Storage, error) {
@@ -67,6 +154,9 @@ type DevMapperStorage struct {
 	FsType      string
 	rootPath    string
 	DmPoolData  *dm.DeviceMapper
+	// deviceLock is held from the creation of a volume device to its record
+	// in the db, so that the gc does not take the device as an orphan
+	deviceLock sync.Mutex
 }
 
 func DMFactory(sysinfo *dockertypes.Info, db *daemondb.DaemonDB) (Storage, error) {
@@ -132,10 +222,16 @@ func (dms *DevMapperStorage) CleanUp() error {
 	return dm.DMCleanup(dms.DmPoolData)
 }
 
-func (dms *DevMapperStorage) PrepareContainer(mountId, sharedDir string, readonly bool) (*runv.VolumeDescription, error) {
+func (dms *DevMapperStorage) PrepareContainer(mountId, sharedDir string, readonly bool, rootfsSize int64) (*runv.VolumeDescription, error) {
 	if err := dm.CreateNewDevice(mountId, dms.DevPrefix, dms.RootPath()); err != nil {
 		return nil, err
 	}
+	if rootfsSize > 0 {
+		if err := dm.ResizeDevice(mountId, dms.DevPrefix, dms.RootPath(), rootfsSize); err != nil {
+			glog.Errorf("failed to resize the rootfs of %s: %v", mountId, err)
+			return nil, err
+		}
+	}
 	devFullName, err := dm.MountContainerToSharedDir(mountId, sharedDir, dms.DevPrefix)
 	if err != nil {
 		glog.Error("got error when mount container to share dir ", err.Error())
@@ -174,6 +270,17 @@ func (dms *DevMapperStorage) InjectFile(src io.Reader, mountId, target, baseDir
 	return dm.InjectFile(src, mountId, dms.DevPrefix, target, baseDir, perm, uid, gid)
 }
 
+// RootfsSize returns the size of the active device of the container.
+func (dms *DevMapperStorage) RootfsSize(mountId string) (int64, error) {
+	size, err := dm.VolumeSize(fmt.Sprintf("%s-%s", dms.DevPrefix, mountId))
+	return int64(size), err
+}
+
+// BaseRootfsSize returns the size of the base device of the graph driver.
+func (dms *DevMapperStorage) BaseRootfsSize() (int64, error) {
+	return dm.BaseDeviceSize(dms.RootPath())
+}
+
 func (dms *DevMapperStorage) getPersistedId(podId, volName string) (int, error) {
 	vols, err := dms.db.ListPodVolumes(podId)
 	if err != nil {
@@ -190,27 +297,47 @@ func (dms *DevMapperStorage) getPersistedId(podId, volName string) (int, error)
 	return dev_id, nil
 }
 
-func (dms *DevMapperStorage) CreateVolume(podId string, spec *apitypes.UserVolume) error {
-	var err error
-
+// volumeDeviceName returns the name of the thin device of the volume.
+func (dms *DevMapperStorage) volumeDeviceName(podId, volName string) string {
 	// kernel dm has limitation of 128 bytes on device name length
 	// include/uapi/linux/dm-ioctl.h#L16
 	// #define DM_NAME_LEN 128
 	// Use sha256 so it is fixed 64 bytes
-	chksum := sha256.Sum256([]byte(podId + spec.Name))
-	deviceName := fmt.Sprintf("%s-%s", dms.VolPoolName, hex.EncodeToString(chksum[:sha256.Size]))
+	chksum := sha256.Sum256([]byte(podId + volName))
+	return fmt.Sprintf("%s-%s", dms.VolPoolName, hex.EncodeToString(chksum[:sha256.Size]))
+}
+
+func (dms *DevMapperStorage) CreateVolume(podId string, spec *apitypes.UserVolume) error {
+	var err error
+
+	deviceName := dms.volumeDeviceName(podId, spec.Name)
 	dev_id, _ := dms.getPersistedId(podId, deviceName)
 	glog.Infof("DeviceID is %d for %s of pod %s container %s", dev_id, deviceName, podId, spec.Name)
 
 	restore := dev_id > 0
 
+	size := dms.DmPoolData.Size
+	if spec.Size_ > 0 {
+		// the thin device is made of 512 bytes sectors
+		size = int((spec.Size_ + 511) / 512 * 512)
+	}
+
+	mkfs := storage.DEFAULT_VOL_MKFS
+	if spec.Encryption != nil {
+		// formatted with LUKS when the volume is opened
+		mkfs = ""
+		spec.Encryption.Created = true
+	}
+
+	dms.deviceLock.Lock()
+	defer dms.deviceLock.Unlock()
 	for {
 		if !restore {
 			dev_id = dms.randDevId()
 		}
 		dev_id_str := strconv.Itoa(dev_id)
 
-		err = dm.CreateVolume(dms.VolPoolName, deviceName, dev_id_str, storage.DEFAULT_VOL_MKFS, dms.DmPoolData.Size, restore)
+		err = dm.CreateVolume(dms.VolPoolName, deviceName, dev_id_str, mkfs, size, restore)
 		if err != nil && !restore && strings.Contains(err.Error(), "failed: File exists") {
 			glog.V(1).Infof("retry for dev_id #%d creating collision: %v", dev_id, err)
 			continue
@@ -221,11 +348,17 @@ func (dms *DevMapperStorage) CreateVolume(podId string, spec *apitypes.UserVolum
 
 		glog.V(3).Infof("device (%d) created (restore:%v) for %s: %s", dev_id, restore, podId, deviceName)
 		dms.db.UpdatePodVolume(podId, deviceName, []byte(fmt.Sprintf("%s:%s", deviceName, dev_id_str)))
+		// the cloned volume is taken by the pod
+		dms.db.DeleteVolumeClone(deviceName)
 		break
 	}
 
 	fstype := storage.DEFAULT_VOL_FS
-	if !restore {
+	if spec.Encryption != nil {
+		if spec.Fstype != "" {
+			fstype = spec.Fstype
+		}
+	} else if !restore {
 		if spec.Fstype == "" {
 			fstype, err = dm.ProbeFsType("/dev/mapper/" + deviceName)
 			if err != nil {
@@ -260,6 +393,11 @@ func (dms *DevMapperStorage) RemoveVolume(podId string, record []byte) error {
 			return err
 		}
 	}
+	// the volume may still be opened if it is encrypted
+	if err := luks.Close(luks.MappingName(filepath.Join("/dev/mapper", fields[0]))); err != nil {
+		glog.Error(err.Error())
+		return err
+	}
 	dev_id, _ := strconv.Atoi(fields[1])
 	if err := dm.DeleteVolume(dms.DmPoolData, dev_id); err != nil {
 		glog.Error(err.Error())
@@ -269,9 +407,89 @@ func (dms *DevMapperStorage) RemoveVolume(podId string, record []byte) error {
 		glog.Error(err.Error())
 		return err
 	}
+	dms.db.DeleteVolumeClone(fields[0])
 	return nil
 }
 
+// SnapshotVolume takes a thin snapshot of the volume created for the pod, and
+// returns the device id and the size of the snapshot.
+func (dms *DevMapperStorage) SnapshotVolume(podId, source string) (int, int, error) {
+	deviceName := filepath.Base(source)
+	origin_id, _ := dms.getPersistedId(podId, deviceName)
+	if origin_id <= 0 {
+		return 0, 0, fmt.Errorf("cannot find the device of volume %s", source)
+	}
+
+	size, err := dm.VolumeSize(deviceName)
+	if err != nil {
+		glog.V(1).Infof("use the default size for the snapshot of %s: %v", deviceName, err)
+		size = dms.DmPoolData.Size
+	}
+
+	for {
+		snap_id := dms.randDevId()
+		err = dm.CreateSnapshot(dms.VolPoolName, deviceName, strconv.Itoa(origin_id), strconv.Itoa(snap_id))
+		if err != nil && strings.Contains(err.Error(), "failed: File exists") {
+			glog.V(1).Infof("retry for dev_id #%d creating collision: %v", snap_id, err)
+			continue
+		} else if err != nil {
+			return 0, 0, err
+		}
+		glog.V(1).Infof("snapshot #%d created for %s of pod %s", snap_id, deviceName, podId)
+		return snap_id, size, nil
+	}
+}
+
+// CloneVolume creates the volume of the pod from the snapshot. The volume is
+// persisted as the other volumes of the pod, so that it will be picked up by
+// CreateVolume() when the pod is created. Until then, the volume is recorded
+// as a clone of the snapshot, which is kept by the gc.
+func (dms *DevMapperStorage) CloneVolume(snapshot string, snap_id, size int, podId, volName string) error {
+	deviceName := dms.volumeDeviceName(podId, volName)
+	if dev_id, _ := dms.getPersistedId(podId, deviceName); dev_id > 0 {
+		return fmt.Errorf("volume %s of pod %s already exists", volName, podId)
+	}
+
+	dms.deviceLock.Lock()
+	defer dms.deviceLock.Unlock()
+	if err := dms.db.UpdateVolumeClone(deviceName, []byte(snapshot)); err != nil {
+		return err
+	}
+
+	for {
+		dev_id := dms.randDevId()
+		dev_id_str := strconv.Itoa(dev_id)
+		err := dm.CreateSnapshot(dms.VolPoolName, "", strconv.Itoa(snap_id), dev_id_str)
+		if err != nil && strings.Contains(err.Error(), "failed: File exists") {
+			glog.V(1).Infof("retry for dev_id #%d creating collision: %v", dev_id, err)
+			continue
+		} else if err != nil {
+			dms.db.DeleteVolumeClone(deviceName)
+			return err
+		}
+
+		// activate the device without mkfs
+		if err = dm.CreateVolume(dms.VolPoolName, deviceName, dev_id_str, storage.DEFAULT_VOL_MKFS, size, true); err != nil {
+			dm.DeleteVolume(dms.DmPoolData, dev_id)
+			dms.db.DeleteVolumeClone(deviceName)
+			return err
+		}
+		if err = dms.db.UpdatePodVolume(podId, deviceName, []byte(fmt.Sprintf("%s:%s", deviceName, dev_id_str))); err != nil {
+			dm.UnmapVolume("/dev/mapper/" + deviceName)
+			dm.DeleteVolume(dms.DmPoolData, dev_id)
+			dms.db.DeleteVolumeClone(deviceName)
+			return err
+		}
+		glog.V(1).Infof("volume %s of pod %s cloned from snapshot #%d as %s", volName, podId, snap_id, deviceName)
+		return nil
+	}
+}
+
+// RemoveSnapshot removes the thin snapshot from the pool.
+func (dms *DevMapperStorage) RemoveSnapshot(snap_id int) error {
+	return dm.DeleteVolume(dms.DmPoolData, snap_id)
+}
+
 func (dms *DevMapperStorage) randDevId() int {
 	return rand.Intn(1<<24-1) + 1 // 0 reserved for pool device
 }
@@ -302,7 +520,7 @@ func (*AufsStorage) Init(c *apitypes.HyperConfig) error { return nil }
 
 func (*AufsStorage) CleanUp() error { return nil }
 
-func (a *AufsStorage) PrepareContainer(mountId, sharedDir string, readonly bool) (*runv.VolumeDescription, error) {
+func (a *AufsStorage) PrepareContainer(mountId, sharedDir string, readonly bool, rootfsSize int64) (*runv.VolumeDescription, error) {
 	_, err := aufs.MountContainerToSharedDir(mountId, a.RootPath(), sharedDir, "", readonly)
 	if err != nil {
 		glog.Error("got error when mount container to share dir ", err.Error())
@@ -337,7 +555,7 @@ func (a *AufsStorage) InjectFile(src io.Reader, containerId, target, baseDir str
 }
 
 func (a *AufsStorage) CreateVolume(podId string, spec *apitypes.UserVolume) error {
-	volName, err := storage.CreateVFSVolume(podId, spec.Name)
+	volName, err := storage.CreateVFSVolume(podId, spec.Name, spec.Size_)
 	if err != nil {
 		return err
 	}
@@ -348,7 +566,7 @@ func (a *AufsStorage) CreateVolume(podId string, spec *apitypes.UserVolume) erro
 }
 
 func (a *AufsStorage) RemoveVolume(podId string, record []byte) error {
-	return nil
+	return removeVFSVolume(podId, record)
 }
 
 type OverlayFsStorage struct {
@@ -374,7 +592,7 @@ func (*OverlayFsStorage) Init(c *apitypes.HyperConfig) error { return nil }
 
 func (*OverlayFsStorage) CleanUp() error { return nil }
 
-func (o *OverlayFsStorage) PrepareContainer(mountId, sharedDir string, readonly bool) (*runv.VolumeDescription, error) {
+func (o *OverlayFsStorage) PrepareContainer(mountId, sharedDir string, readonly bool, rootfsSize int64) (*runv.VolumeDescription, error) {
 	_, err := overlay.MountContainerToSharedDir(mountId, o.RootPath(), sharedDir, "", readonly)
 	if err != nil {
 		glog.Error("got error when mount container to share dir ", err.Error())
@@ -409,7 +627,7 @@ func (o *OverlayFsStorage) InjectFile(src io.Reader, mountId, target, baseDir st
 }
 
 func (o *OverlayFsStorage) CreateVolume(podId string, spec *apitypes.UserVolume) error {
-	volName, err := storage.CreateVFSVolume(podId, spec.Name)
+	volName, err := storage.CreateVFSVolume(podId, spec.Name, spec.Size_)
 	if err != nil {
 		return err
 	}
@@ -420,7 +638,7 @@ func (o *OverlayFsStorage) CreateVolume(podId string, spec *apitypes.UserVolume)
 }
 
 func (o *OverlayFsStorage) RemoveVolume(podId string, record []byte) error {
-	return nil
+	return removeVFSVolume(podId, record)
 }
 
 type BtrfsStorage struct {
@@ -450,7 +668,7 @@ func (*BtrfsStorage) Init(c *apitypes.HyperConfig) error { return nil }
 
 func (*BtrfsStorage) CleanUp() error { return nil }
 
-func (s *BtrfsStorage) PrepareContainer(containerId, sharedDir string, readonly bool) (*runv.VolumeDescription, error) {
+func (s *BtrfsStorage) PrepareContainer(containerId, sharedDir string, readonly bool, rootfsSize int64) (*runv.VolumeDescription, error) {
 	btrfsRootfs := s.subvolumesDirID(containerId)
 	mountPoint := filepath.Join(sharedDir, containerId, "rootfs")
 
@@ -490,7 +708,7 @@ func (s *BtrfsStorage) InjectFile(src io.Reader, mountId, target, baseDir string
 }
 
 func (s *BtrfsStorage) CreateVolume(podId string, spec *apitypes.UserVolume) error {
-	volName, err := storage.CreateVFSVolume(podId, spec.Name)
+	volName, err := storage.CreateVFSVolume(podId, spec.Name, spec.Size_)
 	if err != nil {
 		return err
 	}
@@ -501,17 +719,24 @@ func (s *BtrfsStorage) CreateVolume(podId string, spec *apitypes.UserVolume) err
 }
 
 func (s *BtrfsStorage) RemoveVolume(podId string, record []byte) error {
-	return nil
+	return removeVFSVolume(podId, record)
 }
 
 type RawBlockStorage struct {
 	rootPath string
 	size     int64
+	blockFs  string
 }
 
-func RawBlockFactory(_ *dockertypes.Info, _ *daemondb.DaemonDB) (Storage, error) {
+func RawBlockFactory(sysinfo *dockertypes.Info, _ *daemondb.DaemonDB) (Storage, error) {
 	driver := &RawBlockStorage{
 		rootPath: filepath.Join(utils.HYPER_ROOT, "rawblock"),
+		blockFs:  "xfs",
+	}
+	for _, pair := range sysinfo.DriverStatus {
+		if pair[0] == "Block Filesystem" {
+			driver.blockFs = pair[1]
+		}
 	}
 	return driver, nil
 }
@@ -545,26 +770,48 @@ func (s *RawBlockStorage) Init(c *apitypes.HyperConfig) error {
 
 func (*RawBlockStorage) CleanUp() error { return nil }
 
-func (s *RawBlockStorage) PrepareContainer(containerId, sharedDir string, readonly bool) (*runv.VolumeDescription, error) {
+func (s *RawBlockStorage) PrepareContainer(containerId, sharedDir string, readonly bool, rootfsSize int64) (*runv.VolumeDescription, error) {
 	devFullName := filepath.Join(s.RootPath(), "blocks", containerId)
+	if rootfsSize > 0 {
+		if err := rawblock.ResizeBlock(devFullName, s.blockFs, rootfsSize); err != nil {
+			glog.Errorf("failed to resize the rootfs of %s: %v", containerId, err)
+			return nil, err
+		}
+	}
+
+	// the blocks created before switching the format are kept in their own
+	format := rawblock.FormatRaw
+	if rawblock.IsQcow2(devFullName) {
+		format = rawblock.FormatQcow2
+	}
 
 	vol := &runv.VolumeDescription{
 		Name:     devFullName,
 		Source:   devFullName,
-		Fstype:   "xfs",
-		Format:   "raw",
+		Fstype:   s.blockFs,
+		Format:   format,
 		ReadOnly: readonly,
 	}
 
 	return vol, nil
 }
 
+// RootfsSize returns the virtual size of the block of the container.
+func (s *RawBlockStorage) RootfsSize(mountId string) (int64, error) {
+	return rawblock.BlockSize(filepath.Join(s.RootPath(), "blocks", mountId))
+}
+
+// BaseRootfsSize returns the size of the base blocks, i.e. StorageBaseSize.
+func (s *RawBlockStorage) BaseRootfsSize() (int64, error) {
+	return s.size, nil
+}
+
 func (s *RawBlockStorage) CleanupContainer(id, sharedDir string) error {
 	return nil
 }
 
 func (s *RawBlockStorage) InjectFile(src io.Reader, mountId, target, baseDir string, perm, uid, gid int) error {
-	if err := rawblock.GetImage(filepath.Join(s.RootPath(), "blocks"), baseDir, mountId, "xfs", "", uid, gid); err != nil {
+	if err := rawblock.GetImage(filepath.Join(s.RootPath(), "blocks"), baseDir, mountId, s.blockFs, "", uid, gid); err != nil {
 		return err
 	}
 	defer rawblock.PutImage(baseDir, mountId)
@@ -573,11 +820,21 @@ func (s *RawBlockStorage) InjectFile(src io.Reader, mountId, target, baseDir str
 
 func (s *RawBlockStorage) CreateVolume(podId string, spec *apitypes.UserVolume) error {
 	block := filepath.Join(s.RootPath(), "volumes", fmt.Sprintf("%s-%s", podId, spec.Name))
-	if err := rawblock.CreateBlock(block, "xfs", "", uint64(s.size)); err != nil {
+	size := uint64(s.size)
+	if spec.Size_ > 0 {
+		size = uint64(spec.Size_)
+	}
+	fstype := s.blockFs
+	if spec.Encryption != nil {
+		// formatted with LUKS when the volume is opened
+		fstype = ""
+		spec.Encryption.Created = true
+	}
+	if err := rawblock.CreateBlock(block, fstype, "", size); err != nil {
 		return err
 	}
 	spec.Source = block
-	spec.Fstype = "xfs"
+	spec.Fstype = s.blockFs
 	spec.Format = "raw"
 	return nil
 }
@@ -586,6 +843,152 @@ func (s *RawBlockStorage) RemoveVolume(podId string, record []byte) error {
 	return nil
 }
 
+type ZfsStorage struct {
+	db         *daemondb.DaemonDB
+	rootPath   string
+	dataset    string // parent dataset of the graph driver
+	volDataset string // parent dataset of the volumes
+}
+
+func ZfsFactory(sysinfo *dockertypes.Info, db *daemondb.DaemonDB) (Storage, error) {
+	driver := &ZfsStorage{
+		db:       db,
+		rootPath: filepath.Join(utils.HYPER_ROOT, "zfs"),
+	}
+	for _, pair := range sysinfo.DriverStatus {
+		if pair[0] == "Parent Dataset" {
+			driver.dataset = pair[1]
+		}
+	}
+	if driver.dataset == "" {
+		return nil, fmt.Errorf("cannot find the parent dataset of zfs")
+	}
+	driver.volDataset = driver.dataset + "/hyper-volumes"
+	return driver, nil
+}
+
+func (s *ZfsStorage) Type() string {
+	return "zfs"
+}
+
+func (s *ZfsStorage) RootPath() string {
+	return s.rootPath
+}
+
+func (s *ZfsStorage) Init(c *apitypes.HyperConfig) error {
+	return zfs.InitVolumeDataset(s.volDataset, filepath.Join(s.RootPath(), "volumes"))
+}
+
+func (*ZfsStorage) CleanUp() error { return nil }
+
+// the datasets of the containers are created by the graph driver, and are named
+// after their mount ids
+func (s *ZfsStorage) containerDataset(mountId string) string {
+	return s.dataset + "/" + mountId
+}
+
+func (s *ZfsStorage) PrepareContainer(mountId, sharedDir string, readonly bool, rootfsSize int64) (*runv.VolumeDescription, error) {
+	if rootfsSize > 0 {
+		if err := zfs.SetQuota(s.containerDataset(mountId), rootfsSize); err != nil {
+			glog.Errorf("failed to limit the rootfs of %s: %v", mountId, err)
+			return nil, err
+		}
+	}
+	_, err := zfs.MountContainerToSharedDir(mountId, s.containerDataset(mountId), sharedDir, readonly)
+	if err != nil {
+		glog.Error("got error when mount container to share dir ", err.Error())
+		return nil, err
+	}
+
+	containerPath := "/" + mountId
+	vol := &runv.VolumeDescription{
+		Name:     containerPath,
+		Source:   containerPath,
+		Fstype:   "dir",
+		Format:   "vfs",
+		ReadOnly: readonly,
+	}
+
+	return vol, nil
+}
+
+// RootfsSize returns the quota of the dataset of the container, 0 means the
+// rootfs is only limited by the pool.
+func (s *ZfsStorage) RootfsSize(mountId string) (int64, error) {
+	return zfs.Quota(s.containerDataset(mountId))
+}
+
+// BaseRootfsSize returns 0, the datasets have no size of their own.
+func (s *ZfsStorage) BaseRootfsSize() (int64, error) {
+	return 0, nil
+}
+
+func (s *ZfsStorage) CleanupContainer(id, sharedDir string) error {
+	return zfs.Unmount(filepath.Join(sharedDir, id, "rootfs"))
+}
+
+func (s *ZfsStorage) InjectFile(src io.Reader, mountId, target, baseDir string, perm, uid, gid int) error {
+	mountPoint, err := zfs.MountContainerToSharedDir(mountId, s.containerDataset(mountId), baseDir, false)
+	if err != nil {
+		glog.Error("got error when mount container to share dir ", err.Error())
+		return err
+	}
+	defer zfs.Unmount(mountPoint)
+
+	return storage.FsInjectFile(src, mountId, target, baseDir, perm, uid, gid)
+}
+
+// CreateVolume creates the volume as a dataset under the dataset of the pod,
+// the dataset is recorded in the db for RemoveVolume().
+func (s *ZfsStorage) CreateVolume(podId string, spec *apitypes.UserVolume) error {
+	podDataset := s.volDataset + "/" + zfs.DatasetName(podId)
+	mountpoint, err := zfs.CreateVolume(podDataset, zfs.DatasetName(spec.Name), spec.Size_)
+	if err != nil {
+		return err
+	}
+	dataset := podDataset + "/" + zfs.DatasetName(spec.Name)
+	if err := s.db.UpdatePodVolume(podId, spec.Name, []byte(dataset)); err != nil {
+		zfs.DestroyVolume(dataset)
+		return err
+	}
+
+	glog.V(1).Infof("volume %s created with zfs as %s", spec.Name, dataset)
+
+	spec.Source = mountpoint
+	spec.Format = "vfs"
+	spec.Fstype = "dir"
+	return nil
+}
+
+// RemoveVolume destroys the dataset of the volume. The record is either the
+// dataset, whose record is removed by the caller, or the name of the volume.
+func (s *ZfsStorage) RemoveVolume(podId string, record []byte) error {
+	dataset := string(record)
+	if strings.HasPrefix(dataset, s.volDataset+"/") {
+		return zfs.DestroyVolume(dataset)
+	}
+
+	data, err := s.db.GetPodVolume(podId, dataset)
+	if err != nil {
+		glog.Error(err)
+		return err
+	}
+	if err := zfs.DestroyVolume(string(data)); err != nil {
+		glog.Error(err.Error())
+		return err
+	}
+	if err := s.db.DeletePodVolume(podId, dataset); err != nil {
+		glog.Error(err.Error())
+		return err
+	}
+	return nil
+}
+
+// Status reports the usage of the volumes and the health of their pool.
+func (s *ZfsStorage) Status() [][2]string {
+	return zfs.Status(s.volDataset)
+}
+
 type VBoxStorage struct {
 	rootPath string
 }
@@ -609,7 +1012,7 @@ func (*VBoxStorage) Init(c *apitypes.HyperConfig) error { return nil }
 
 func (*VBoxStorage) CleanUp() error { return nil }
 
-func (v *VBoxStorage) PrepareContainer(mountId, sharedDir string, readonly bool) (*runv.VolumeDescription, error) {
+func (v *VBoxStorage) PrepareContainer(mountId, sharedDir string, readonly bool, rootfsSize int64) (*runv.VolumeDescription, error) {
 	devFullName, err := vbox.MountContainerToSharedDir(mountId, v.RootPath(), "")
 	if err != nil {
 		glog.Error("got error when mount container to share dir ", err.Error())
@@ -636,7 +1039,7 @@ func (v *VBoxStorage) InjectFile(src io.Reader, containerId, target, rootDir str
 }
 
 func (v *VBoxStorage) CreateVolume(podId string, spec *apitypes.UserVolume) error {
-	volName, err := storage.CreateVFSVolume(podId, spec.Name)
+	volName, err := storage.CreateVFSVolume(podId, spec.Name, spec.Size_)
 	if err != nil {
 		return err
 	}
@@ -647,5 +1050,5 @@ func (v *VBoxStorage) CreateVolume(podId string, spec *apitypes.UserVolume) erro
 }
 
 func (v *VBoxStorage) RemoveVolume(podId string, record []byte) error {
-	return nil
+	return removeVFSVolume(podId, record)
 }
@@ -0,0 +1,70 @@
+package daemon
+
+import (
+	"testing"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+func TestValidateEncryption(t *testing.T) {
+	encrypted := func(source string, created bool) *apitypes.UserVolume {
+		return &apitypes.UserVolume{
+			Name:       "data",
+			Source:     source,
+			Encryption: &apitypes.UserVolumeEncryption{KeyRef: "tenant-a", Created: created},
+		}
+	}
+	inContainer := func(v *apitypes.UserVolume) *apitypes.UserPod {
+		return &apitypes.UserPod{Containers: []*apitypes.UserContainer{{
+			Volumes: []*apitypes.UserVolumeReference{{Volume: v.Name, Path: "/data", Detail: v}},
+		}}}
+	}
+
+	aufs := &Daemon{Storage: &AufsStorage{}}
+	for _, spec := range []*apitypes.UserPod{
+		{Volumes: []*apitypes.UserVolume{encrypted("", false)}},
+		inContainer(encrypted("", false)),
+		// the volumes of the users are never formatted
+		{Volumes: []*apitypes.UserVolume{encrypted("/dev/sdb", true)}},
+		inContainer(encrypted("/dev/sdb", true)),
+	} {
+		if err := aufs.validateEncryption(spec); err == nil {
+			t.Fatalf("expect spec %v refused", spec)
+		}
+	}
+	if err := aufs.validateEncryption(inContainer(encrypted("/dev/sdb", false))); err != nil {
+		t.Fatal(err)
+	}
+
+	rawblock := &Daemon{Storage: &RawBlockStorage{}}
+	if err := rawblock.validateEncryption(inContainer(encrypted("", false))); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidateRootfsSize(t *testing.T) {
+	container := func(size int64) []*apitypes.UserContainer {
+		return []*apitypes.UserContainer{{Name: "web", RootfsSize: size}}
+	}
+
+	aufs := &Daemon{Storage: &AufsStorage{}}
+	if err := aufs.validateRootfsSize(container(0)); err != nil {
+		t.Fatal(err)
+	}
+	if err := aufs.validateRootfsSize(container(20 << 30)); err == nil {
+		t.Fatal("rootfsSize is accepted by aufs")
+	}
+
+	rawblock := &Daemon{Storage: &RawBlockStorage{size: 10 << 30}}
+	for size, valid := range map[int64]bool{
+		-1:       false,
+		0:        true,
+		1 << 30:  false,
+		10 << 30: true,
+		20 << 30: true,
+	} {
+		if err := rawblock.validateRootfsSize(container(size)); valid != (err == nil) {
+			t.Fatalf("rootfsSize %d: expect valid %v, got %v", size, valid, err)
+		}
+	}
+}
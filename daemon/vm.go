@@ -0,0 +1,223 @@
+package daemon
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/daemon/pod"
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/runv/factory"
+	"github.com/hyperhq/runv/hypervisor"
+)
+
+// vmFactory wraps the factory built from VmFactoryPolicy, so that the policy
+// could be replaced without touching the pod factories holding it. It also
+// keeps the VMs pre-created with `hyperctl vm create`, which are handed out
+// before the policy factory to the pods asking for the same cpu and memory.
+type vmFactory struct {
+	sync.RWMutex
+	factory.Factory
+
+	poolLock sync.Mutex
+	pool     map[string]*idleVm
+}
+
+type idleVm struct {
+	vm      *hypervisor.Vm
+	created time.Time
+}
+
+func newVmFactory(bootConfig hypervisor.BootConfig, policy string) *vmFactory {
+	return &vmFactory{
+		Factory: factory.NewFromPolicy(bootConfig, policy),
+		pool:    make(map[string]*idleVm),
+	}
+}
+
+func (f *vmFactory) GetVm(cpu, mem int) (*hypervisor.Vm, error) {
+	if vm := f.takeIdle(cpu, mem); vm != nil {
+		glog.V(1).Infof("use the pre-created vm %s (cpu: %d, memory: %d)", vm.Id, cpu, mem)
+		return vm, nil
+	}
+
+	f.RLock()
+	defer f.RUnlock()
+	return f.Factory.GetVm(cpu, mem)
+}
+
+// CloseFactory closes the policy factory and kills the idle VMs.
+func (f *vmFactory) CloseFactory() {
+	f.Lock()
+	defer f.Unlock()
+	f.Factory.CloseFactory()
+	f.destroyIdle()
+}
+
+// destroyIdle kills all the idle VMs and returns their ids. The idle VMs are
+// not persisted, so they are not kept across the restarts of hyperd, even if
+// the VMs of the pods are.
+func (f *vmFactory) destroyIdle() []string {
+	f.poolLock.Lock()
+	defer f.poolLock.Unlock()
+
+	ids := []string{}
+	for id, iv := range f.pool {
+		iv.vm.Kill()
+		delete(f.pool, id)
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (f *vmFactory) replace(bootConfig hypervisor.BootConfig, policy string) {
+	f.Lock()
+	defer f.Unlock()
+	f.Factory.CloseFactory()
+	f.Factory = factory.NewFromPolicy(bootConfig, policy)
+}
+
+func (f *vmFactory) addIdle(vm *hypervisor.Vm) {
+	iv := &idleVm{
+		vm:      vm,
+		created: time.Now(),
+	}
+	f.poolLock.Lock()
+	f.pool[vm.Id] = iv
+	f.poolLock.Unlock()
+
+	go func() {
+		<-vm.WaitVm(-1)
+		f.poolLock.Lock()
+		defer f.poolLock.Unlock()
+		if f.pool[vm.Id] == iv {
+			glog.Warningf("idle vm %s exited", vm.Id)
+			delete(f.pool, vm.Id)
+		}
+	}()
+}
+
+// takeIdle removes the oldest idle VM with the given size from the pool.
+func (f *vmFactory) takeIdle(cpu, mem int) *hypervisor.Vm {
+	f.poolLock.Lock()
+	defer f.poolLock.Unlock()
+
+	var found *idleVm
+	for _, iv := range f.pool {
+		if iv.vm.Cpu != cpu || iv.vm.Mem != mem {
+			continue
+		}
+		if found == nil || iv.created.Before(found.created) {
+			found = iv
+		}
+	}
+	if found == nil {
+		return nil
+	}
+	delete(f.pool, found.vm.Id)
+	return found.vm
+}
+
+func (f *vmFactory) removeIdle(id string) *hypervisor.Vm {
+	f.poolLock.Lock()
+	defer f.poolLock.Unlock()
+	iv, ok := f.pool[id]
+	if !ok {
+		return nil
+	}
+	delete(f.pool, id)
+	return iv.vm
+}
+
+func (f *vmFactory) getIdle(id string) *hypervisor.Vm {
+	f.poolLock.Lock()
+	defer f.poolLock.Unlock()
+	if iv, ok := f.pool[id]; ok {
+		return iv.vm
+	}
+	return nil
+}
+
+func (f *vmFactory) listIdle(vmId string) []*apitypes.VMListResult {
+	f.poolLock.Lock()
+	defer f.poolLock.Unlock()
+
+	result := []*apitypes.VMListResult{}
+	for id, iv := range f.pool {
+		if vmId != "" && id != vmId {
+			continue
+		}
+		result = append(result, &apitypes.VMListResult{
+			VmID:      id,
+			Status:    "idle",
+			Cpu:       int32(iv.vm.Cpu),
+			Memory:    int32(iv.vm.Mem),
+			CreatedAt: iv.created.Unix(),
+		})
+	}
+	sort.Sort(vmListById(result))
+	return result
+}
+
+type vmListById []*apitypes.VMListResult
+
+func (l vmListById) Len() int           { return len(l) }
+func (l vmListById) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+func (l vmListById) Less(i, j int) bool { return l[i].VmID < l[j].VmID }
+
+func (daemon *Daemon) idleVms() *vmFactory {
+	f, _ := daemon.Factory.(*vmFactory)
+	return f
+}
+
+// CreateVm boots a VM which is not associated with any pods, it will be used
+// by the next pod asking for the same cpu and memory. If async is set, the
+// VM is returned without waiting for the guest to start.
+func (daemon *Daemon) CreateVm(cpu, mem int, async bool) (string, error) {
+	f := daemon.idleVms()
+	if f == nil {
+		return "", fmt.Errorf("the vm factory does not support pre-created vms")
+	}
+	if cpu <= 0 {
+		cpu = 1
+	}
+	if mem <= 0 {
+		mem = 128
+	}
+
+	boot := daemon.bootConfig
+	boot.CPU = cpu
+	boot.Memory = mem
+	vm, err := hypervisor.GetVm("", &boot, !async)
+	if err != nil {
+		glog.Errorf("failed to create vm (cpu: %d, memory: %d): %v", cpu, mem, err)
+		return "", err
+	}
+	f.addIdle(vm)
+
+	glog.Infof("vm %s created (cpu: %d, memory: %d)", vm.Id, cpu, mem)
+	return vm.Id, nil
+}
+
+// RemoveVm kills an idle VM, the VMs associated with pods are removed with
+// their pods.
+func (daemon *Daemon) RemoveVm(vmId string) error {
+	if f := daemon.idleVms(); f != nil {
+		if vm := f.removeIdle(vmId); vm != nil {
+			vm.Kill()
+			glog.Infof("vm %s removed", vmId)
+			return nil
+		}
+	}
+
+	p := daemon.PodList.Find(func(p *pod.XPod) bool {
+		return p.SandboxName() == vmId
+	})
+	if p != nil {
+		return fmt.Errorf("vm %s is associated with pod %s, please remove the pod instead", vmId, p.Id())
+	}
+	return fmt.Errorf("cannot find vm %s", vmId)
+}
@@ -0,0 +1,64 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperhq/hyperd/daemon/pod"
+	"github.com/hyperhq/runv/hypervisor"
+)
+
+func TestIdleVmPool(t *testing.T) {
+	now := time.Now()
+	f := &vmFactory{pool: make(map[string]*idleVm)}
+	for i, vm := range []*hypervisor.Vm{
+		{Id: "vm-c", Cpu: 1, Mem: 128},
+		{Id: "vm-a", Cpu: 1, Mem: 128},
+		{Id: "vm-b", Cpu: 2, Mem: 512},
+	} {
+		f.pool[vm.Id] = &idleVm{vm: vm, created: now.Add(time.Duration(i) * time.Second)}
+	}
+
+	list := f.listIdle("")
+	if len(list) != 3 || list[0].VmID != "vm-a" || list[2].VmID != "vm-c" || list[1].Memory != 512 {
+		t.Fatalf("unexpected idle vms %v", list)
+	}
+	if list := f.listIdle("vm-b"); len(list) != 1 || list[0].Status != "idle" {
+		t.Fatalf("unexpected idle vm %v", list)
+	}
+
+	// the oldest one of the size is taken first
+	if vm := f.takeIdle(1, 128); vm == nil || vm.Id != "vm-c" {
+		t.Fatalf("unexpected vm %v", vm)
+	}
+	if vm := f.takeIdle(1, 256); vm != nil {
+		t.Fatalf("took vm %s of another size", vm.Id)
+	}
+	if f.getIdle("vm-c") != nil || f.getIdle("vm-a") == nil {
+		t.Fatal("unexpected idle vms after take")
+	}
+	if vm := f.removeIdle("vm-b"); vm == nil || f.removeIdle("vm-b") != nil {
+		t.Fatal("unexpected vm removal")
+	}
+	if list := f.listIdle(""); len(list) != 1 || list[0].VmID != "vm-a" {
+		t.Fatalf("unexpected idle vms %v", list)
+	}
+}
+
+func TestDestroyAndKeepVm(t *testing.T) {
+	d, cleanup := newTestDaemon(t)
+	defer cleanup()
+	d.PodList = pod.NewPodList()
+
+	f := &vmFactory{pool: make(map[string]*idleVm)}
+	d.Factory = f
+	if err := d.DestroyAndKeepVm(); err != nil {
+		t.Fatal(err)
+	}
+	if ids := f.destroyIdle(); len(ids) != 0 {
+		t.Fatalf("unexpected idle vms %v", ids)
+	}
+	if vms, err := d.ListVMs("", "", ""); err != nil || len(vms) != 0 {
+		t.Fatalf("unexpected vms %v, %v", vms, err)
+	}
+}
@@ -0,0 +1,186 @@
+package daemon
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/docker/pkg/archive"
+	"github.com/docker/docker/pkg/chrootarchive"
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/daemon/pod"
+	dm "github.com/hyperhq/hyperd/storage/devicemapper"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+// ExportVolume writes the files in the volume of the pod to out as a tar
+// stream, or the files in the named volume if podId is empty. The volume is
+// refused if a pod using it is running, unless the pod is paused.
+func (daemon *Daemon) ExportVolume(podId, volume string, out io.Writer) error {
+	spec, owner, unhold, err := daemon.archiveVolume(podId, volume, true)
+	if err != nil {
+		return err
+	}
+	defer unhold()
+
+	dir, release, err := daemon.mountVolumeOnHost(owner, spec, true)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	tar, err := archive.TarWithOptions(dir, &archive.TarOptions{})
+	if err != nil {
+		return err
+	}
+	defer tar.Close()
+	if _, err := io.Copy(out, tar); err != nil {
+		glog.Errorf("failed to export volume %s: %v", volume, err)
+		return err
+	}
+	glog.Infof("volume %s of pod %s exported", volume, owner)
+	return nil
+}
+
+// ImportVolume extracts the tar stream into the volume of the pod, or into the
+// named volume if podId is empty. The volume is refused if it is used by any
+// pod which is not stopped.
+func (daemon *Daemon) ImportVolume(podId, volume string, in io.Reader) error {
+	spec, owner, unhold, err := daemon.archiveVolume(podId, volume, false)
+	if err != nil {
+		return err
+	}
+	defer unhold()
+	if err := daemon.checkStorageSpace(); err != nil {
+		return err
+	}
+
+	dir, release, err := daemon.mountVolumeOnHost(owner, spec, false)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if err := chrootarchive.Untar(in, dir, &archive.TarOptions{}); err != nil {
+		glog.Errorf("failed to import volume %s: %v", volume, err)
+		return err
+	}
+	glog.Infof("volume %s of pod %s imported", volume, owner)
+	return nil
+}
+
+// archiveVolume returns the spec of the volume and the owner of the volume in
+// the storage, i.e. the pod or namedVolumeOwner. The pods using the volume are
+// held until the returned function is called, so that they could not be
+// started, resumed or removed during the export or import.
+func (daemon *Daemon) archiveVolume(podId, volume string, export bool) (*apitypes.UserVolume, string, func(), error) {
+	if podId != "" {
+		p, ok := daemon.PodList.Get(podId)
+		if !ok {
+			return nil, "", nil, fmt.Errorf("Can not get Pod info with pod ID(%s)", podId)
+		}
+		spec, ok := p.VolumeSpec(volume)
+		if !ok {
+			return nil, "", nil, fmt.Errorf("volume %s not found in pod %s", volume, podId)
+		}
+		unhold, err := p.HoldVolumes(volume, !export)
+		if err != nil {
+			return nil, "", nil, err
+		}
+		return spec, p.Id(), unhold, nil
+	}
+
+	daemon.volumeLock.Lock()
+	defer daemon.volumeLock.Unlock()
+
+	vol, err := daemon.loadVolume(volume)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	if holds := daemon.volumeHolds[volume]; holds < 0 || !export && holds > 0 {
+		return nil, "", nil, fmt.Errorf("volume %s is being exported or imported", volume)
+	}
+
+	unholds := []func(){}
+	unhold := func() {
+		for _, f := range unholds {
+			f()
+		}
+	}
+	for _, id := range vol.Pods {
+		if p, ok := daemon.PodList.Get(id); ok {
+			f, err := p.HoldVolumes(volume, !export)
+			if err != nil {
+				unhold()
+				return nil, "", nil, err
+			}
+			unholds = append(unholds, f)
+		}
+	}
+
+	if daemon.volumeHolds == nil {
+		daemon.volumeHolds = make(map[string]int)
+	}
+	if export {
+		daemon.volumeHolds[volume]++
+	} else {
+		daemon.volumeHolds[volume] = -1
+	}
+	return vol.Spec, namedVolumeOwner, func() {
+		unhold()
+		daemon.volumeLock.Lock()
+		if export && daemon.volumeHolds[volume] > 1 {
+			daemon.volumeHolds[volume]--
+		} else {
+			delete(daemon.volumeHolds, volume)
+		}
+		daemon.volumeLock.Unlock()
+	}, nil
+}
+
+// volumeHeld fails if the named volume is being exported or imported, it
+// should be called with volumeLock held.
+func (daemon *Daemon) volumeHeld(name string) error {
+	if daemon.volumeHolds[name] != 0 {
+		return fmt.Errorf("volume %s is being exported or imported", name)
+	}
+	return nil
+}
+
+// mountVolumeOnHost mounts the volume to a temporary directory. The devicemapper
+// volumes are unmapped once their pods stop, so they are mapped again for the
+// mount if needed, and unmapped afterwards.
+func (daemon *Daemon) mountVolumeOnHost(owner string, spec *apitypes.UserVolume, readonly bool) (string, func(), error) {
+	unmap := func() {}
+	if dms, ok := daemon.Storage.(*DevMapperStorage); ok && strings.HasPrefix(spec.Source, "/dev/mapper/") {
+		if _, err := os.Stat(spec.Source); err != nil {
+			if id, _ := dms.getPersistedId(owner, filepath.Base(spec.Source)); id <= 0 {
+				return "", nil, fmt.Errorf("device of volume %s not found", spec.Name)
+			}
+			if err := dms.CreateVolume(owner, &apitypes.UserVolume{Name: spec.Name, Encryption: spec.Encryption}); err != nil {
+				return "", nil, err
+			}
+			unmap = func() { dm.UnmapVolume(spec.Source) }
+		}
+	}
+
+	mnt, err := ioutil.TempDir("", "hyper-volume")
+	if err != nil {
+		unmap()
+		return "", nil, err
+	}
+	dir, umount, err := pod.MountVolumeOnHost(spec, mnt, readonly)
+	if err != nil {
+		os.Remove(mnt)
+		unmap()
+		return "", nil, err
+	}
+	return dir, func() {
+		umount()
+		os.Remove(mnt)
+		unmap()
+	}, nil
+}
@@ -0,0 +1,62 @@
+package daemon
+
+import (
+	"testing"
+
+	"github.com/hyperhq/hyperd/daemon/pod"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+func TestArchiveNamedVolume(t *testing.T) {
+	d, cleanup := newTestDaemon(t)
+	defer cleanup()
+	d.PodList = pod.NewPodList()
+
+	if err := d.saveVolume(&apitypes.VolumeInfo{
+		Name: "shared",
+		Spec: &apitypes.UserVolume{Name: "shared", Source: "/volumes/shared", Format: "vfs"},
+		Pods: []string{},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, owner, unhold1, err := d.archiveVolume("", "shared", true)
+	if err != nil || owner != namedVolumeOwner {
+		t.Fatalf("failed to export the volume: %s, %v", owner, err)
+	}
+	_, _, unhold2, err := d.archiveVolume("", "shared", true)
+	if err != nil {
+		t.Fatal("the volume could not be exported twice at once")
+	}
+	if _, _, _, err := d.archiveVolume("", "shared", false); err == nil {
+		t.Fatal("imported the volume during an export")
+	}
+	if _, err := d.resolveVolumes("web", namedVolumePod("shared")); err == nil {
+		t.Fatal("the volume is referred by a pod during an export")
+	}
+	if err := d.RemoveVolume("shared"); err == nil {
+		t.Fatal("the volume is removed during an export")
+	}
+
+	unhold1()
+	if _, _, _, err := d.archiveVolume("", "shared", false); err == nil {
+		t.Fatal("imported the volume during an export")
+	}
+	unhold2()
+
+	_, _, unhold, err := d.archiveVolume("", "shared", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := d.archiveVolume("", "shared", true); err == nil {
+		t.Fatal("exported the volume during an import")
+	}
+	unhold()
+
+	if _, err := d.resolveVolumes("web", namedVolumePod("shared")); err != nil {
+		t.Fatal(err)
+	}
+	if len(d.volumeHolds) != 0 {
+		t.Fatalf("unexpected holds %v", d.volumeHolds)
+	}
+}
@@ -0,0 +1,306 @@
+package daemon
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperhq/hyperd/daemon/pod"
+	"github.com/hyperhq/hyperd/storage"
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/hyperd/utils"
+)
+
+// namedVolumeOwner is passed to the storage drivers as the pod id of the named
+// volumes, it could not conflict with the real pods as it is not a DNS label.
+// The records of the named volumes are kept under it, so that the cleanup of
+// the pods never removes them.
+const namedVolumeOwner = "_volumes"
+
+type volumeInfos []*apitypes.VolumeInfo
+
+func (v volumeInfos) Len() int           { return len(v) }
+func (v volumeInfos) Swap(i, j int)      { v[i], v[j] = v[j], v[i] }
+func (v volumeInfos) Less(i, j int) bool { return v[i].Name < v[j].Name }
+
+func (daemon *Daemon) CreateVolume(name string, labels map[string]string, size int64) (*apitypes.VolumeInfo, error) {
+	if !utils.IsDNSLabel(name) {
+		return nil, fmt.Errorf("volume name should fullfil the pattern: %s, input name: %s", utils.Dns1123LabelFmt, name)
+	}
+	if err := daemon.checkStorageSpace(); err != nil {
+		return nil, err
+	}
+
+	daemon.volumeLock.Lock()
+	defer daemon.volumeLock.Unlock()
+
+	if _, err := daemon.db.GetNamedVolume(name); err == nil {
+		return nil, fmt.Errorf("volume %s already exists", name)
+	}
+
+	spec := &apitypes.UserVolume{Name: name, Size_: size}
+	if err := daemon.Storage.CreateVolume(namedVolumeOwner, spec); err != nil {
+		glog.Errorf("failed to create volume %s: %v", name, err)
+		return nil, err
+	}
+
+	vol := &apitypes.VolumeInfo{
+		Name:      name,
+		Spec:      spec,
+		Labels:    labels,
+		Pods:      []string{},
+		CreatedAt: time.Now().Unix(),
+	}
+	if err := daemon.saveVolume(vol); err != nil {
+		daemon.destroyVolume(vol)
+		return nil, err
+	}
+
+	glog.Infof("volume %s created as %s", name, spec.Source)
+	return vol, nil
+}
+
+func (daemon *Daemon) ListVolumes() ([]*apitypes.VolumeInfo, error) {
+	daemon.volumeLock.Lock()
+	defer daemon.volumeLock.Unlock()
+
+	data, err := daemon.db.ListNamedVolumes()
+	if err != nil {
+		return nil, err
+	}
+
+	vols := make([]*apitypes.VolumeInfo, 0, len(data))
+	for _, d := range data {
+		var vol apitypes.VolumeInfo
+		if err := proto.Unmarshal(d, &vol); err != nil {
+			glog.Errorf("failed to unpack volume info: %v", err)
+			continue
+		}
+		vol.Usage = volumeUsage(&vol)
+		vols = append(vols, &vol)
+	}
+	sort.Sort(volumeInfos(vols))
+	return vols, nil
+}
+
+func (daemon *Daemon) GetVolume(name string) (*apitypes.VolumeInfo, error) {
+	daemon.volumeLock.Lock()
+	defer daemon.volumeLock.Unlock()
+
+	vol, err := daemon.loadVolume(name)
+	if err != nil {
+		return nil, err
+	}
+	vol.Usage = volumeUsage(vol)
+	return vol, nil
+}
+
+// RemoveVolume removes the named volume and its data, the volume could not be
+// removed if it is still referenced by any pod.
+func (daemon *Daemon) RemoveVolume(name string) error {
+	daemon.volumeLock.Lock()
+	defer daemon.volumeLock.Unlock()
+
+	vol, err := daemon.loadVolume(name)
+	if err != nil {
+		return err
+	}
+	if len(vol.Pods) > 0 {
+		return fmt.Errorf("volume %s is in use by pod(s) %v", name, vol.Pods)
+	}
+	if err := daemon.volumeHeld(name); err != nil {
+		return err
+	}
+
+	if err := daemon.destroyVolume(vol); err != nil {
+		return err
+	}
+	if err := daemon.db.DeleteNamedVolume(name); err != nil {
+		glog.Errorf("failed to remove volume %s from db: %v", name, err)
+		return err
+	}
+
+	glog.Infof("volume %s removed", name)
+	return nil
+}
+
+// resolveVolumes replaces the volumes of the "named" format in the pod spec
+// with the named volumes, and adds the pod to their references. The names of
+// the volumes newly referenced are returned.
+func (daemon *Daemon) resolveVolumes(podId string, spec *apitypes.UserPod) ([]string, error) {
+	vols := []*apitypes.UserVolume{}
+	for _, v := range spec.Volumes {
+		if v.Format == "named" {
+			vols = append(vols, v)
+		}
+	}
+	for _, c := range spec.Containers {
+		for _, ref := range c.Volumes {
+			if ref.Detail != nil && ref.Detail.Format == "named" {
+				vols = append(vols, ref.Detail)
+			}
+		}
+	}
+	if len(vols) == 0 {
+		return nil, nil
+	}
+
+	daemon.volumeLock.Lock()
+	defer daemon.volumeLock.Unlock()
+
+	var (
+		referred = []string{}
+		err      error
+	)
+	defer func() {
+		if err != nil {
+			for _, name := range referred {
+				if vol, err := daemon.loadVolume(name); err == nil {
+					daemon.unreferVolume(vol, podId)
+				}
+			}
+		}
+	}()
+
+	for _, v := range vols {
+		name := v.Source
+		if name == "" {
+			name = v.Name
+		}
+		var vol *apitypes.VolumeInfo
+		if vol, err = daemon.loadVolume(name); err != nil {
+			return nil, err
+		}
+		v.Source, v.Format, v.Fstype, v.Size_ = vol.Spec.Source, vol.Spec.Format, vol.Spec.Fstype, vol.Spec.Size_
+		if volumeReferred(vol, podId) {
+			continue
+		}
+		if err = daemon.volumeHeld(name); err != nil {
+			return nil, err
+		}
+		// the filesystems in the block volumes are corrupted if they are
+		// mounted by more than one VM
+		if len(vol.Pods) > 0 && vol.Spec.Format != "vfs" {
+			err = fmt.Errorf("volume %s of format %s is in use by pod(s) %v, and could not be shared", name, vol.Spec.Format, vol.Pods)
+			return nil, err
+		}
+		vol.Pods = append(vol.Pods, podId)
+		if err = daemon.saveVolume(vol); err != nil {
+			return nil, err
+		}
+		referred = append(referred, name)
+	}
+	return referred, nil
+}
+
+// unreferVolumes removes the pod from the references of the given volumes.
+func (daemon *Daemon) unreferVolumes(podId string, names []string) {
+	daemon.volumeLock.Lock()
+	defer daemon.volumeLock.Unlock()
+
+	for _, name := range names {
+		if vol, err := daemon.loadVolume(name); err == nil {
+			daemon.unreferVolume(vol, podId)
+		}
+	}
+}
+
+// releaseVolumes removes the pod from the references of the named volumes.
+func (daemon *Daemon) releaseVolumes(podId string) {
+	daemon.volumeLock.Lock()
+	defer daemon.volumeLock.Unlock()
+
+	data, err := daemon.db.ListNamedVolumes()
+	if err != nil {
+		glog.Errorf("failed to list volumes: %v", err)
+		return
+	}
+	for _, d := range data {
+		var vol apitypes.VolumeInfo
+		if err := proto.Unmarshal(d, &vol); err != nil {
+			continue
+		}
+		daemon.unreferVolume(&vol, podId)
+	}
+}
+
+func (daemon *Daemon) unreferVolume(vol *apitypes.VolumeInfo, podId string) {
+	pods := make([]string, 0, len(vol.Pods))
+	for _, p := range vol.Pods {
+		if p != podId {
+			pods = append(pods, p)
+		}
+	}
+	if len(pods) == len(vol.Pods) {
+		return
+	}
+	vol.Pods = pods
+	daemon.saveVolume(vol)
+}
+
+func (daemon *Daemon) loadVolume(name string) (*apitypes.VolumeInfo, error) {
+	data, err := daemon.db.GetNamedVolume(name)
+	if err != nil {
+		return nil, fmt.Errorf("volume %s not found", name)
+	}
+	var vol apitypes.VolumeInfo
+	if err := proto.Unmarshal(data, &vol); err != nil {
+		glog.Errorf("failed to unpack volume %s: %v", name, err)
+		return nil, err
+	}
+	return &vol, nil
+}
+
+func (daemon *Daemon) saveVolume(vol *apitypes.VolumeInfo) error {
+	data, err := proto.Marshal(vol)
+	if err != nil {
+		glog.Errorf("failed to serialize volume %s: %v", vol.Name, err)
+		return err
+	}
+	if err := daemon.db.UpdateNamedVolume(vol.Name, data); err != nil {
+		glog.Errorf("failed to write volume %s to db: %v", vol.Name, err)
+		return err
+	}
+	return nil
+}
+
+// destroyVolume removes the data of the volume from the storage.
+func (daemon *Daemon) destroyVolume(vol *apitypes.VolumeInfo) error {
+	if vol.Spec == nil || vol.Spec.Source == "" {
+		return nil
+	}
+	if daemon.Storage.Type() == "devicemapper" {
+		// the record of the device is named after the device
+		return daemon.Storage.RemoveVolume(namedVolumeOwner, []byte(filepath.Base(vol.Spec.Source)))
+	}
+	if daemon.Storage.Type() == "zfs" {
+		// the record of the dataset is named after the volume
+		return daemon.Storage.RemoveVolume(namedVolumeOwner, []byte(vol.Spec.Name))
+	}
+	// the other drivers leave the volume directory or block file to the caller
+	if vol.Spec.Format == "vfs" {
+		return storage.RemoveVFSVolume(vol.Spec.Source)
+	}
+	return os.RemoveAll(vol.Spec.Source)
+}
+
+func volumeUsage(vol *apitypes.VolumeInfo) int64 {
+	usage, err := pod.VolumeUsage(vol.Spec)
+	if err != nil {
+		glog.V(1).Infof("failed to get usage of volume %s: %v", vol.Name, err)
+	}
+	return usage
+}
+
+func volumeReferred(vol *apitypes.VolumeInfo, podId string) bool {
+	for _, p := range vol.Pods {
+		if p == podId {
+			return true
+		}
+	}
+	return false
+}
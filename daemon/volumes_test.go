@@ -0,0 +1,113 @@
+package daemon
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hyperhq/hyperd/daemon/daemondb"
+	"github.com/hyperhq/hyperd/daemon/pod"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+func newTestDaemon(t *testing.T) (*Daemon, func()) {
+	dir, err := ioutil.TempDir("", "daemon")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := daemondb.NewDaemonDB(filepath.Join(dir, "hyper.db"))
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return &Daemon{db: db}, func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func namedVolumePod(vols ...string) *apitypes.UserPod {
+	spec := &apitypes.UserPod{}
+	for _, v := range vols {
+		spec.Volumes = append(spec.Volumes, &apitypes.UserVolume{Name: v, Format: "named"})
+	}
+	return spec
+}
+
+func volumePods(t *testing.T, d *Daemon, name string) []string {
+	vol, err := d.loadVolume(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return vol.Pods
+}
+
+func TestNamedVolumeReferences(t *testing.T) {
+	d, cleanup := newTestDaemon(t)
+	defer cleanup()
+
+	for name, format := range map[string]string{"shared": "vfs", "block": "raw"} {
+		if err := d.saveVolume(&apitypes.VolumeInfo{
+			Name: name,
+			Spec: &apitypes.UserVolume{Name: name, Source: "/volumes/" + name, Format: format},
+			Pods: []string{},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the pods in the namespaces refer the volumes by their qualified ids
+	podA := pod.QualifiedName("team-a", "web")
+	podB := pod.QualifiedName("team-b", "web")
+
+	spec := namedVolumePod("shared", "block")
+	referred, err := d.resolveVolumes(podA, spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(referred, []string{"shared", "block"}) {
+		t.Fatalf("unexpected referred volumes %v", referred)
+	}
+	if spec.Volumes[0].Source != "/volumes/shared" || spec.Volumes[0].Format != "vfs" {
+		t.Fatalf("the volume is not resolved: %v", spec.Volumes[0])
+	}
+
+	// referring again from the same pod, e.g. by a new container, is a no-op
+	referred, err = d.resolveVolumes(podA, namedVolumePod("shared"))
+	if err != nil || len(referred) != 0 {
+		t.Fatalf("expect no new references, got %v, %v", referred, err)
+	}
+
+	// the block volume could not be attached by another pod, and the
+	// references taken before the failure are dropped
+	if _, err := d.resolveVolumes(podB, namedVolumePod("shared", "block")); err == nil {
+		t.Fatal("the block volume is shared by two pods")
+	}
+	if pods := volumePods(t, d, "shared"); !reflect.DeepEqual(pods, []string{podA}) {
+		t.Fatalf("unexpected references of shared after the failure: %v", pods)
+	}
+
+	if _, err := d.resolveVolumes(podB, namedVolumePod("shared")); err != nil {
+		t.Fatal(err)
+	}
+	if pods := volumePods(t, d, "shared"); !reflect.DeepEqual(pods, []string{podA, podB}) {
+		t.Fatalf("unexpected references of shared: %v", pods)
+	}
+
+	d.unreferVolumes(podB, []string{"shared"})
+	if pods := volumePods(t, d, "shared"); !reflect.DeepEqual(pods, []string{podA}) {
+		t.Fatalf("unexpected references of shared after unrefer: %v", pods)
+	}
+	if err := d.RemoveVolume("shared"); err == nil {
+		t.Fatal("the volume in use is removed")
+	}
+
+	d.releaseVolumes(podA)
+	for _, name := range []string{"shared", "block"} {
+		if pods := volumePods(t, d, name); len(pods) != 0 {
+			t.Fatalf("the references of %s are not released: %v", name, pods)
+		}
+	}
+}
@@ -0,0 +1,485 @@
+// Package compose converts docker-compose files into hyperd pod specs, all the
+// services of a project run as the containers of a single pod.
+package compose
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+
+	"github.com/mattn/go-shellwords"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// ProjectLabel is the label of the pod holding the project name
+	ProjectLabel = "sh.hyper.compose.project"
+	// ServiceLabel is the label of a container holding the service name
+	ServiceLabel = "sh.hyper.compose.service"
+)
+
+var (
+	topLevelKeys = []string{"version", "services", "volumes"}
+	serviceKeys  = []string{"image", "command", "entrypoint", "environment", "env_file", "ports", "expose", "volumes",
+		"depends_on", "user", "working_dir", "tty", "labels", "container_name", "restart"}
+)
+
+// Convert converts a compose file into a UserPod named after the project,
+// the relative paths in the file are resolved against dir. The containers
+// of the pod are sorted in the start order given by depends_on. The keys
+// which are not supported are returned as warnings.
+func Convert(data []byte, project, dir string) (*apitypes.UserPod, []string, error) {
+	var (
+		raw      interface{}
+		warnings = []string{}
+		warn     = func(format string, args ...interface{}) {
+			warnings = append(warnings, fmt.Sprintf(format, args...))
+		}
+	)
+
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, nil, err
+	}
+	file, ok := normalize(raw).(map[string]interface{})
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid compose file")
+	}
+	for _, k := range unknownKeys(file, topLevelKeys) {
+		warn("%s is not supported, ignored", k)
+	}
+
+	services, _ := file["services"].(map[string]interface{})
+	if len(services) == 0 {
+		return nil, nil, fmt.Errorf("no services defined in the compose file")
+	}
+
+	named := make(map[string]bool)
+	if volumes, ok := file["volumes"].(map[string]interface{}); ok {
+		for name, opts := range volumes {
+			if opts, ok := opts.(map[string]interface{}); ok && len(opts) > 0 {
+				warn("volumes.%s: the volume options are not supported, ignored", name)
+			}
+			named[name] = true
+		}
+	}
+
+	order, err := startOrder(services)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	spec := &apitypes.UserPod{
+		Id:           project,
+		Labels:       map[string]string{ProjectLabel: project},
+		Containers:   []*apitypes.UserContainer{},
+		Volumes:      []*apitypes.UserVolume{},
+		Portmappings: []*apitypes.PortMapping{},
+	}
+	volumes := make(map[string]*apitypes.UserVolume)
+
+	for _, name := range order {
+		s, _ := services[name].(map[string]interface{})
+		prefix := "services." + name
+		for _, k := range unknownKeys(s, serviceKeys) {
+			warn("%s.%s is not supported, ignored", prefix, k)
+		}
+
+		c := &apitypes.UserContainer{
+			Name:    project + "-" + name,
+			Envs:    []*apitypes.EnvironmentVar{},
+			Volumes: []*apitypes.UserVolumeReference{},
+			Labels:  map[string]string{},
+		}
+		c.Image, _ = s["image"].(string)
+		if c.Image == "" {
+			return nil, nil, fmt.Errorf("%s: image is required, build is not supported", prefix)
+		}
+		if n, ok := s["container_name"].(string); ok {
+			c.Name = n
+		}
+		c.Workdir, _ = s["working_dir"].(string)
+		c.Tty, _ = s["tty"].(bool)
+
+		if c.Command, err = command(s["command"]); err != nil {
+			return nil, nil, fmt.Errorf("%s.command: %v", prefix, err)
+		}
+		if c.Entrypoint, err = command(s["entrypoint"]); err != nil {
+			return nil, nil, fmt.Errorf("%s.entrypoint: %v", prefix, err)
+		}
+
+		if user, ok := s["user"]; ok {
+			parts := strings.SplitN(fmt.Sprint(user), ":", 2)
+			c.User = &apitypes.UserUser{Name: parts[0]}
+			if len(parts) == 2 {
+				c.User.Group = parts[1]
+			}
+		}
+
+		// yaml reads a bare no as false
+		restart, _ := s["restart"].(string)
+		if b, ok := s["restart"].(bool); ok && !b {
+			restart = "no"
+		}
+		switch {
+		case restart == "" || restart == "no":
+			c.RestartPolicy = "never"
+		case restart == "always" || restart == "unless-stopped":
+			c.RestartPolicy = "always"
+		case strings.HasPrefix(restart, "on-failure"):
+			c.RestartPolicy = "onFailure"
+			if restart != "on-failure" {
+				warn("%s.restart: the maximum retry count is not supported, ignored", prefix)
+			}
+		default:
+			return nil, nil, fmt.Errorf("%s.restart: unsupported policy %s", prefix, restart)
+		}
+
+		for k, v := range keyValues(s["labels"]) {
+			c.Labels[k] = v
+		}
+		c.Labels[ServiceLabel] = name
+
+		env, err := environment(s, dir)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %v", prefix, err)
+		}
+		keys := []string{}
+		for k := range env {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			c.Envs = append(c.Envs, &apitypes.EnvironmentVar{Env: k, Value: env[k]})
+		}
+
+		ports, _ := s["ports"].([]interface{})
+		for _, p := range ports {
+			pm, err := portMapping(p, func(format string, args ...interface{}) {
+				warn(prefix+".ports: "+format, args...)
+			})
+			if err != nil {
+				return nil, nil, fmt.Errorf("%s.ports: %v", prefix, err)
+			}
+			if pm != nil {
+				spec.Portmappings = append(spec.Portmappings, pm)
+			}
+		}
+
+		mounts, _ := s["volumes"].([]interface{})
+		for i, m := range mounts {
+			ref, vol, err := volumeMount(m, name, i, dir, named)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%s.volumes: %v", prefix, err)
+			}
+			if _, ok := volumes[vol.Name]; !ok {
+				volumes[vol.Name] = vol
+				spec.Volumes = append(spec.Volumes, vol)
+			}
+			ref.Detail = volumes[vol.Name]
+			c.Volumes = append(c.Volumes, ref)
+		}
+
+		spec.Containers = append(spec.Containers, c)
+	}
+
+	return spec, warnings, nil
+}
+
+// normalize turns the maps decoded by yaml into map[string]interface{}.
+func normalize(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			m[fmt.Sprint(k)] = normalize(val)
+		}
+		return m
+	case []interface{}:
+		for i := range v {
+			v[i] = normalize(v[i])
+		}
+	}
+	return v
+}
+
+func unknownKeys(m map[string]interface{}, known []string) []string {
+	var (
+		keys   = []string{}
+		fields = make(map[string]bool)
+	)
+	for _, k := range known {
+		fields[k] = true
+	}
+	for k := range m {
+		if !fields[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// stringList returns a string or a list of strings as a list.
+func stringList(v interface{}) []string {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, s := range v {
+			result = append(result, fmt.Sprint(s))
+		}
+		return result
+	case map[string]interface{}:
+		result := make([]string, 0, len(v))
+		for s := range v {
+			result = append(result, s)
+		}
+		sort.Strings(result)
+		return result
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
+
+// keyValues reads a map or a list of "key=value".
+func keyValues(v interface{}) map[string]string {
+	result := make(map[string]string)
+	if m, ok := v.(map[string]interface{}); ok {
+		for k, val := range m {
+			if val == nil {
+				result[k] = ""
+			} else {
+				result[k] = fmt.Sprint(val)
+			}
+		}
+		return result
+	}
+	for _, kv := range stringList(v) {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) == 2 {
+			result[parts[0]] = parts[1]
+		} else {
+			result[parts[0]] = ""
+		}
+	}
+	return result
+}
+
+func command(v interface{}) ([]string, error) {
+	if s, ok := v.(string); ok {
+		return shellwords.Parse(s)
+	}
+	return stringList(v), nil
+}
+
+// environment merges env_file and environment of a service, a variable
+// without value is read from the environment of hyperctl.
+func environment(s map[string]interface{}, dir string) (map[string]string, error) {
+	env := make(map[string]string)
+	for _, f := range stringList(s["env_file"]) {
+		if !filepath.IsAbs(f) {
+			f = filepath.Join(dir, f)
+		}
+		data, err := ioutil.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			parts := strings.SplitN(line, "=", 2)
+			if len(parts) == 2 {
+				env[parts[0]] = parts[1]
+			} else if val, ok := os.LookupEnv(parts[0]); ok {
+				env[parts[0]] = val
+			}
+		}
+	}
+
+	var unset []string
+	if m, ok := s["environment"].(map[string]interface{}); ok {
+		for k, v := range m {
+			if v == nil {
+				unset = append(unset, k)
+			} else {
+				env[k] = fmt.Sprint(v)
+			}
+		}
+	} else {
+		for _, kv := range stringList(s["environment"]) {
+			parts := strings.SplitN(kv, "=", 2)
+			if len(parts) == 2 {
+				env[parts[0]] = parts[1]
+			} else {
+				unset = append(unset, parts[0])
+			}
+		}
+	}
+	for _, k := range unset {
+		if val, ok := os.LookupEnv(k); ok {
+			env[k] = val
+		}
+	}
+	return env, nil
+}
+
+// portMapping reads "[ip:][hostPort:]containerPort[/protocol]" or the long
+// syntax, nil is returned for the ports which are not published.
+func portMapping(v interface{}, warn func(string, ...interface{})) (*apitypes.PortMapping, error) {
+	pm := &apitypes.PortMapping{Protocol: "tcp"}
+
+	if m, ok := v.(map[string]interface{}); ok {
+		if m["target"] == nil {
+			return nil, fmt.Errorf("target is required")
+		}
+		if m["published"] == nil {
+			return nil, nil
+		}
+		pm.ContainerPort = fmt.Sprint(m["target"])
+		pm.HostPort = fmt.Sprint(m["published"])
+		if p, ok := m["protocol"].(string); ok {
+			pm.Protocol = p
+		}
+	} else {
+		s := fmt.Sprint(v)
+		if idx := strings.LastIndex(s, "/"); idx >= 0 {
+			s, pm.Protocol = s[:idx], s[idx+1:]
+		}
+		parts := strings.Split(s, ":")
+		switch len(parts) {
+		case 1:
+			return nil, nil
+		case 3:
+			warn("the host ip of %s is not supported, ignored", fmt.Sprint(v))
+			parts = parts[1:]
+			fallthrough
+		case 2:
+			if parts[0] == "" {
+				return nil, nil
+			}
+			pm.HostPort, pm.ContainerPort = parts[0], parts[1]
+		default:
+			return nil, fmt.Errorf("invalid port %s", fmt.Sprint(v))
+		}
+	}
+
+	if pm.Protocol != "tcp" && pm.Protocol != "udp" {
+		return nil, fmt.Errorf("unsupported protocol %s", pm.Protocol)
+	}
+	return pm, nil
+}
+
+// volumeMount reads "[source:]target[:mode]" or the long syntax. The named
+// volumes are shared by the services, the others are named after the service.
+func volumeMount(v interface{}, service string, idx int, dir string, named map[string]bool) (*apitypes.UserVolumeReference, *apitypes.UserVolume, error) {
+	var (
+		source, target string
+		readOnly       bool
+	)
+
+	if m, ok := v.(map[string]interface{}); ok {
+		source, _ = m["source"].(string)
+		target, _ = m["target"].(string)
+		readOnly, _ = m["read_only"].(bool)
+		if t, ok := m["type"].(string); ok && t != "volume" && t != "bind" {
+			return nil, nil, fmt.Errorf("volume type %s is not supported", t)
+		}
+	} else {
+		parts := strings.Split(fmt.Sprint(v), ":")
+		switch len(parts) {
+		case 1:
+			target = parts[0]
+		case 2, 3:
+			source, target = parts[0], parts[1]
+			if len(parts) == 3 {
+				if parts[2] != "ro" && parts[2] != "rw" {
+					return nil, nil, fmt.Errorf("unsupported volume mode %s", parts[2])
+				}
+				readOnly = parts[2] == "ro"
+			}
+		default:
+			return nil, nil, fmt.Errorf("invalid volume %s", fmt.Sprint(v))
+		}
+	}
+	if !filepath.IsAbs(target) {
+		return nil, nil, fmt.Errorf("the target %s should be an absolute path", target)
+	}
+
+	vol := &apitypes.UserVolume{}
+	switch {
+	case source == "":
+		vol.Name = fmt.Sprintf("%s-volume-%d", service, idx)
+	case filepath.IsAbs(source) || strings.HasPrefix(source, "."):
+		if !filepath.IsAbs(source) {
+			source = filepath.Join(dir, source)
+		}
+		vol.Name = fmt.Sprintf("%s-bind-%d", service, idx)
+		vol.Source = source
+		vol.Format = "vfs"
+	default:
+		if !named[source] {
+			return nil, nil, fmt.Errorf("volume %s is not defined in the top level volumes", source)
+		}
+		vol.Name = source
+	}
+
+	return &apitypes.UserVolumeReference{
+		Path:     target,
+		Volume:   vol.Name,
+		ReadOnly: readOnly,
+	}, vol, nil
+}
+
+// startOrder sorts the services so that a service comes after the ones it
+// depends on, the independent services are sorted by name.
+func startOrder(services map[string]interface{}) ([]string, error) {
+	var (
+		order   = []string{}
+		pending = make(map[string]int)
+		users   = make(map[string][]string)
+	)
+
+	for name, s := range services {
+		s, _ := s.(map[string]interface{})
+		deps := stringList(s["depends_on"])
+		for _, dep := range deps {
+			if _, ok := services[dep]; !ok {
+				return nil, fmt.Errorf("service %s depends on undefined service %s", name, dep)
+			}
+			users[dep] = append(users[dep], name)
+		}
+		pending[name] = len(deps)
+	}
+
+	for len(pending) > 0 {
+		ready := []string{}
+		for name, n := range pending {
+			if n == 0 {
+				ready = append(ready, name)
+			}
+		}
+		if len(ready) == 0 {
+			return nil, fmt.Errorf("circular dependency between the services")
+		}
+		sort.Strings(ready)
+		for _, name := range ready {
+			delete(pending, name)
+			for _, u := range users[name] {
+				pending[u]--
+			}
+		}
+		order = append(order, ready...)
+	}
+	return order, nil
+}
@@ -0,0 +1,134 @@
+package compose
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testComposeFile = `
+version: "3"
+services:
+  web:
+    image: nginx
+    command: nginx -g "daemon off;"
+    ports:
+    - "8080:80"
+    - "127.0.0.1:8443:443"
+    - "9000"
+    - target: 53
+      published: 5353
+      protocol: udp
+    environment:
+      MODE: prod
+    env_file: web.env
+    depends_on: [app]
+    volumes:
+    - ./html:/usr/share/nginx/html:ro
+    restart: always
+    build: .
+  app:
+    image: app
+    entrypoint: ["/bin/app"]
+    user: "1000:1000"
+    working_dir: /srv
+    depends_on:
+      db:
+        condition: service_started
+    volumes:
+    - data:/data
+    restart: "on-failure:3"
+  db:
+    image: redis
+    volumes:
+    - data:/data
+    - /tmp
+    restart: no
+volumes:
+  data: {}
+networks:
+  default: {}
+`
+
+func TestConvert(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compose")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "web.env"), []byte("# comment\nMODE=dev\nPORT=80\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	spec, warnings, err := Convert([]byte(testComposeFile), "demo", dir)
+	if err != nil {
+		t.Fatalf("failed to convert compose file: %v", err)
+	}
+
+	if spec.Id != "demo" || spec.Labels[ProjectLabel] != "demo" {
+		t.Fatalf("unexpected pod: %s %v", spec.Id, spec.Labels)
+	}
+	if len(spec.Containers) != 3 {
+		t.Fatalf("unexpected containers: %v", spec.Containers)
+	}
+	for i, name := range []string{"db", "app", "web"} {
+		c := spec.Containers[i]
+		if c.Name != "demo-"+name || c.Labels[ServiceLabel] != name {
+			t.Fatalf("container %d should be %s, got %s", i, name, c.Name)
+		}
+	}
+
+	db, app, web := spec.Containers[0], spec.Containers[1], spec.Containers[2]
+	if db.RestartPolicy != "never" || app.RestartPolicy != "onFailure" || web.RestartPolicy != "always" {
+		t.Fatalf("unexpected restart policies: %s %s %s", db.RestartPolicy, app.RestartPolicy, web.RestartPolicy)
+	}
+	if len(web.Command) != 3 || web.Command[2] != "daemon off;" {
+		t.Fatalf("unexpected command: %v", web.Command)
+	}
+	if len(app.Entrypoint) != 1 || app.Workdir != "/srv" || app.User.Name != "1000" || app.User.Group != "1000" {
+		t.Fatalf("unexpected app: %v", app)
+	}
+	if len(web.Envs) != 2 || web.Envs[0].Env != "MODE" || web.Envs[0].Value != "prod" || web.Envs[1].Value != "80" {
+		t.Fatalf("unexpected envs: %v", web.Envs)
+	}
+
+	if len(spec.Portmappings) != 3 || spec.Portmappings[1].HostPort != "8443" || spec.Portmappings[2].Protocol != "udp" {
+		t.Fatalf("unexpected portmappings: %v", spec.Portmappings)
+	}
+
+	if len(spec.Volumes) != 3 {
+		t.Fatalf("unexpected volumes: %v", spec.Volumes)
+	}
+	if db.Volumes[0].Volume != "data" || app.Volumes[0].Volume != "data" || db.Volumes[1].Volume != "db-volume-1" {
+		t.Fatalf("unexpected volume mounts: %v %v", db.Volumes, app.Volumes)
+	}
+	if bind := web.Volumes[0]; !bind.ReadOnly || bind.Detail.Source != filepath.Join(dir, "html") || bind.Detail.Format != "vfs" {
+		t.Fatalf("unexpected bind mount: %v", bind)
+	}
+
+	expected := []string{"networks", "services.web.build", "services.app.restart", "127.0.0.1:8443:443"}
+	all := strings.Join(warnings, "\n")
+	for _, w := range expected {
+		if !strings.Contains(all, w) {
+			t.Fatalf("expected warning about %s, got:\n%s", w, all)
+		}
+	}
+	if len(warnings) != len(expected) {
+		t.Fatalf("unexpected warnings:\n%s", all)
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	for _, data := range []string{
+		"services:\n  a:\n    image: a\n    depends_on: [b]\n  b:\n    image: b\n    depends_on: [a]\n",
+		"services:\n  a:\n    image: a\n    depends_on: [c]\n",
+		"services:\n  a:\n    build: .\n",
+		"services:\n  a:\n    image: a\n    volumes: [\"undefined:/data\"]\n",
+	} {
+		if _, _, err := Convert([]byte(data), "demo", "."); err == nil {
+			t.Fatalf("compose file should fail:\n%s", data)
+		}
+	}
+}
@@ -0,0 +1,509 @@
+// Package k8s converts Kubernetes v1 Pod manifests into hyperd pod specs.
+package k8s
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/hyperd/utils"
+)
+
+// the fields which are converted, or safely ignored as they are filled by
+// the apiserver or only have defaults meaningful to kubelet
+var (
+	podFields       = []string{"apiVersion", "kind", "metadata", "spec", "status"}
+	metadataFields  = []string{"name", "namespace", "labels", "uid", "resourceVersion", "creationTimestamp", "generation", "selfLink"}
+	specFields      = []string{"volumes", "containers", "restartPolicy", "dnsPolicy", "dnsConfig", "hostname"}
+	containerFields = []string{"name", "image", "command", "args", "workingDir", "ports", "env", "resources", "volumeMounts", "tty",
+		"imagePullPolicy", "terminationMessagePath", "terminationMessagePolicy"}
+)
+
+// Convert converts a Kubernetes v1 Pod manifest in YAML or JSON into a
+// UserPod. The ConfigMaps mounted by the pod can be put in the same file as
+// separate YAML documents. The unsupported fields are returned as warnings.
+func Convert(data []byte) (*apitypes.UserPod, []string, error) {
+	specs, warnings, err := ConvertPods(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(specs) > 1 {
+		return nil, nil, fmt.Errorf("only one Pod is allowed in a manifest")
+	}
+	return specs[0], warnings, nil
+}
+
+// ConvertPods converts all the Pods of a manifest in the order they appear,
+// the ConfigMaps in the manifest could be mounted by any of the pods. If there
+// are several pods, the errors and warnings are prefixed with the pod name.
+func ConvertPods(data []byte) ([]*apitypes.UserPod, []string, error) {
+	var (
+		pods       = []*Pod{}
+		raws       = []map[string]interface{}{}
+		configMaps = make(map[string]*ConfigMap)
+		warnings   = []string{}
+	)
+
+	docs, err := Documents(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, doc := range docs {
+		var obj map[string]interface{}
+		if err := json.Unmarshal(doc, &obj); err != nil {
+			return nil, nil, err
+		}
+
+		switch kind, _ := obj["kind"].(string); kind {
+		case "Pod":
+			pod := &Pod{}
+			if err := json.Unmarshal(doc, pod); err != nil {
+				return nil, nil, fmt.Errorf("failed to read Pod: %v", err)
+			}
+			pods = append(pods, pod)
+			raws = append(raws, obj)
+		case "ConfigMap":
+			cm := &ConfigMap{}
+			if err := json.Unmarshal(doc, cm); err != nil {
+				return nil, nil, fmt.Errorf("failed to read ConfigMap: %v", err)
+			}
+			configMaps[cm.Metadata.Name] = cm
+		default:
+			warnings = append(warnings, fmt.Sprintf("kind %q is not supported, ignored", kind))
+		}
+	}
+
+	if len(pods) == 0 {
+		return nil, nil, fmt.Errorf("no Pod found in the manifest")
+	}
+
+	specs := make([]*apitypes.UserPod, 0, len(pods))
+	for i, pod := range pods {
+		prefix := ""
+		if len(pods) > 1 {
+			prefix = fmt.Sprintf("pod %s: ", pod.Metadata.Name)
+		}
+		spec, w, err := ConvertPod(pod, configMaps)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s%v", prefix, err)
+		}
+		for _, msg := range append(unsupportedFields(raws[i]), w...) {
+			warnings = append(warnings, prefix+msg)
+		}
+		specs = append(specs, spec)
+	}
+
+	return specs, warnings, nil
+}
+
+// Documents splits a YAML stream into documents, and converts each of them
+// into JSON. A JSON input is returned as is.
+func Documents(data []byte) ([][]byte, error) {
+	if json.Valid(data) {
+		return [][]byte{data}, nil
+	}
+
+	var (
+		docs   = [][]byte{}
+		chunks = [][]byte{}
+		cur    = []byte{}
+	)
+	for _, line := range bytes.SplitAfter(data, []byte("\n")) {
+		if trimmed := bytes.TrimRight(line, " \t\r\n"); bytes.Equal(trimmed, []byte("---")) {
+			chunks = append(chunks, cur)
+			cur = []byte{}
+			continue
+		}
+		cur = append(cur, line...)
+	}
+	chunks = append(chunks, cur)
+
+	for _, chunk := range chunks {
+		doc, err := utils.YamlToJson(chunk)
+		if err != nil {
+			return nil, err
+		}
+		if string(doc) == "null" {
+			continue
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+// ConvertPod converts a Pod into a UserPod, configMaps are the ConfigMaps
+// which could be mounted by the pod, keyed by name.
+func ConvertPod(pod *Pod, configMaps map[string]*ConfigMap) (*apitypes.UserPod, []string, error) {
+	var (
+		warnings = []string{}
+		warn     = func(format string, args ...interface{}) {
+			warnings = append(warnings, fmt.Sprintf(format, args...))
+		}
+	)
+
+	if pod.APIVersion != "v1" || pod.Kind != "Pod" {
+		return nil, nil, fmt.Errorf("unsupported object %s/%s, only v1 Pod is supported", pod.APIVersion, pod.Kind)
+	}
+
+	spec := &apitypes.UserPod{
+		Id:           pod.Metadata.Name,
+		Namespace:    pod.Metadata.Namespace,
+		Labels:       pod.Metadata.Labels,
+		Hostname:     pod.Spec.Hostname,
+		Containers:   []*apitypes.UserContainer{},
+		Files:        []*apitypes.UserFile{},
+		Volumes:      []*apitypes.UserVolume{},
+		Portmappings: []*apitypes.PortMapping{},
+	}
+
+	switch pod.Spec.RestartPolicy {
+	case "", "Always":
+		spec.RestartPolicy = "always"
+	case "OnFailure":
+		spec.RestartPolicy = "onFailure"
+	case "Never":
+		spec.RestartPolicy = "never"
+	default:
+		return nil, nil, fmt.Errorf("unsupported restartPolicy %s", pod.Spec.RestartPolicy)
+	}
+
+	switch pod.Spec.DNSPolicy {
+	case "", "Default", "None":
+	default:
+		warn("dnsPolicy %s is not supported, the DNS of the host is used", pod.Spec.DNSPolicy)
+	}
+	if dns := pod.Spec.DNSConfig; dns != nil {
+		spec.Dns = dns.Nameservers
+		spec.DnsSearch = dns.Searches
+		for _, opt := range dns.Options {
+			if opt.Value != nil {
+				spec.DnsOptions = append(spec.DnsOptions, opt.Name+":"+*opt.Value)
+			} else {
+				spec.DnsOptions = append(spec.DnsOptions, opt.Name)
+			}
+		}
+	}
+
+	// the volumes which are mounted as is, and the files of the configMaps
+	// keyed by volume name then by path in the volume
+	volumes := make(map[string]bool)
+	configFiles := make(map[string]map[string]*apitypes.UserFileReference)
+	for _, v := range pod.Spec.Volumes {
+		switch {
+		case v.EmptyDir != nil:
+			if v.EmptyDir.Medium != "" {
+				warn("volume %s: emptyDir medium %s is not supported, ignored", v.Name, v.EmptyDir.Medium)
+			}
+			if v.EmptyDir.SizeLimit != nil {
+				warn("volume %s: emptyDir sizeLimit is not supported, ignored", v.Name)
+			}
+			spec.Volumes = append(spec.Volumes, &apitypes.UserVolume{
+				Name: v.Name,
+			})
+			volumes[v.Name] = true
+		case v.HostPath != nil:
+			switch v.HostPath.Type {
+			case "", "Directory", "DirectoryOrCreate":
+			default:
+				warn("volume %s: hostPath type %s is not supported, mounted as a directory", v.Name, v.HostPath.Type)
+			}
+			spec.Volumes = append(spec.Volumes, &apitypes.UserVolume{
+				Name:   v.Name,
+				Source: v.HostPath.Path,
+				Format: "vfs",
+			})
+			volumes[v.Name] = true
+		case v.ConfigMap != nil:
+			files, err := configMapFiles(v.Name, v.ConfigMap, configMaps[v.ConfigMap.Name])
+			if err != nil {
+				return nil, nil, err
+			}
+			if files == nil {
+				warn("volume %s: optional configMap %s is not found, ignored", v.Name, v.ConfigMap.Name)
+			}
+			configFiles[v.Name] = make(map[string]*apitypes.UserFileReference)
+			for _, f := range files {
+				spec.Files = append(spec.Files, f.Detail)
+				configFiles[v.Name][f.Path] = f
+			}
+		default:
+			warn("volume %s: the volume type is not supported, ignored", v.Name)
+		}
+	}
+
+	var (
+		cpu    int64
+		memory int64
+	)
+	for i, c := range pod.Spec.Containers {
+		prefix := fmt.Sprintf("spec.containers[%d]", i)
+		uc := &apitypes.UserContainer{
+			Name:       c.Name,
+			Image:      c.Image,
+			Entrypoint: c.Command,
+			Command:    c.Args,
+			Workdir:    c.WorkingDir,
+			Tty:        c.TTY,
+			Envs:       []*apitypes.EnvironmentVar{},
+			Volumes:    []*apitypes.UserVolumeReference{},
+			Files:      []*apitypes.UserFileReference{},
+		}
+
+		for _, e := range c.Env {
+			if len(e.ValueFrom) > 0 {
+				warn("%s.env[%s].valueFrom is not supported, ignored", prefix, e.Name)
+				continue
+			}
+			uc.Envs = append(uc.Envs, &apitypes.EnvironmentVar{
+				Env:   e.Name,
+				Value: e.Value,
+			})
+		}
+
+		for _, p := range c.Ports {
+			if p.HostPort == 0 {
+				continue
+			}
+			protocol := strings.ToLower(p.Protocol)
+			if protocol == "" {
+				protocol = "tcp"
+			}
+			if protocol != "tcp" && protocol != "udp" {
+				warn("%s.ports[%d]: protocol %s is not supported, ignored", prefix, p.ContainerPort, p.Protocol)
+				continue
+			}
+			spec.Portmappings = append(spec.Portmappings, &apitypes.PortMapping{
+				ContainerPort: strconv.Itoa(int(p.ContainerPort)),
+				HostPort:      strconv.Itoa(int(p.HostPort)),
+				Protocol:      protocol,
+			})
+		}
+
+		for _, m := range c.VolumeMounts {
+			if files, ok := configFiles[m.Name]; ok {
+				for p, f := range files {
+					if m.SubPath != "" && m.SubPath != p {
+						continue
+					}
+					ref := *f
+					ref.Path, ref.Detail = path.Join(m.MountPath, p), nil
+					if m.SubPath != "" {
+						ref.Path = m.MountPath
+					}
+					uc.Files = append(uc.Files, &ref)
+				}
+				continue
+			}
+			if !volumes[m.Name] {
+				warn("%s.volumeMounts[%s]: volume is not supported, ignored", prefix, m.Name)
+				continue
+			}
+			if m.SubPath != "" {
+				warn("%s.volumeMounts[%s]: subPath is not supported, ignored", prefix, m.Name)
+				continue
+			}
+			uc.Volumes = append(uc.Volumes, &apitypes.UserVolumeReference{
+				Path:     m.MountPath,
+				Volume:   m.Name,
+				ReadOnly: m.ReadOnly,
+			})
+		}
+		sort.Sort(fileReferences(uc.Files))
+
+		for _, resources := range []map[string]Quantity{c.Resources.Limits, c.Resources.Requests} {
+			for name := range resources {
+				if name != "cpu" && name != "memory" {
+					warn("%s.resources: resource %s is not supported, ignored", prefix, name)
+				}
+			}
+		}
+		if q, ok := resource(c.Resources, "cpu"); ok {
+			n, err := cpuMillis(q)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%s.resources: %v", prefix, err)
+			}
+			cpu += n
+		}
+		if q, ok := resource(c.Resources, "memory"); ok {
+			n, err := memoryBytes(q)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%s.resources: %v", prefix, err)
+			}
+			memory += n
+		}
+
+		spec.Containers = append(spec.Containers, uc)
+	}
+
+	// the VM is sized to hold the limits of all the containers
+	if cpu > 0 || memory > 0 {
+		spec.Resource = &apitypes.UserResource{
+			Vcpu:   int32((cpu + 999) / 1000),
+			Memory: int32((memory + 1<<20 - 1) >> 20),
+		}
+	}
+
+	return spec, warnings, nil
+}
+
+// configMapFiles returns the files of a configMap volume, keyed by the path
+// in the volume. nil is returned if an optional configMap is absent.
+func configMapFiles(volume string, source *ConfigMapVolumeSource, cm *ConfigMap) ([]*apitypes.UserFileReference, error) {
+	if cm == nil {
+		if source.Optional != nil && *source.Optional {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("volume %s: configMap %s is not found in the manifest", volume, source.Name)
+	}
+
+	mode := int32(0644)
+	if source.DefaultMode != nil {
+		mode = *source.DefaultMode
+	}
+
+	items := source.Items
+	if len(items) == 0 {
+		for key := range cm.Data {
+			items = append(items, KeyToPath{Key: key, Path: key})
+		}
+		for key := range cm.BinaryData {
+			items = append(items, KeyToPath{Key: key, Path: key})
+		}
+	}
+
+	files := []*apitypes.UserFileReference{}
+	for _, item := range items {
+		file := &apitypes.UserFile{
+			Name:     volume + "-" + item.Key,
+			Encoding: "raw",
+		}
+		if data, ok := cm.Data[item.Key]; ok {
+			file.Content = data
+		} else if data, ok := cm.BinaryData[item.Key]; ok {
+			file.Encoding = "base64"
+			file.Content = base64.StdEncoding.EncodeToString(data)
+		} else {
+			return nil, fmt.Errorf("volume %s: key %s is not found in configMap %s", volume, item.Key, cm.Metadata.Name)
+		}
+
+		perm := mode
+		if item.Mode != nil {
+			perm = *item.Mode
+		}
+		files = append(files, &apitypes.UserFileReference{
+			Path:     item.Path,
+			Filename: file.Name,
+			Perm:     fmt.Sprintf("%04o", perm&0777),
+			Detail:   file,
+		})
+	}
+	sort.Sort(fileReferences(files))
+	return files, nil
+}
+
+type fileReferences []*apitypes.UserFileReference
+
+func (f fileReferences) Len() int           { return len(f) }
+func (f fileReferences) Swap(i, j int)      { f[i], f[j] = f[j], f[i] }
+func (f fileReferences) Less(i, j int) bool { return f[i].Path < f[j].Path }
+
+// resource returns the limit of a resource, or the request if no limit is
+// given.
+func resource(r ResourceRequirements, name string) (Quantity, bool) {
+	if q, ok := r.Limits[name]; ok {
+		return q, true
+	}
+	q, ok := r.Requests[name]
+	return q, ok
+}
+
+func cpuMillis(q Quantity) (int64, error) {
+	s := string(q)
+	if strings.HasSuffix(s, "m") {
+		return strconv.ParseInt(strings.TrimSuffix(s, "m"), 10, 64)
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cpu quantity %s", s)
+	}
+	return int64(math.Ceil(f * 1000)), nil
+}
+
+var memorySuffixes = []struct {
+	suffix string
+	scale  float64
+}{
+	{"Ki", 1 << 10}, {"Mi", 1 << 20}, {"Gi", 1 << 30}, {"Ti", 1 << 40}, {"Pi", 1 << 50}, {"Ei", 1 << 60},
+	{"k", 1e3}, {"M", 1e6}, {"G", 1e9}, {"T", 1e12}, {"P", 1e15}, {"E", 1e18},
+}
+
+func memoryBytes(q Quantity) (int64, error) {
+	s := string(q)
+	scale := float64(1)
+	for _, m := range memorySuffixes {
+		if strings.HasSuffix(s, m.suffix) {
+			s, scale = strings.TrimSuffix(s, m.suffix), m.scale
+			break
+		}
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid memory quantity %s", string(q))
+	}
+	return int64(math.Ceil(f * scale)), nil
+}
+
+// unsupportedFields lists the fields of a decoded Pod which are not
+// converted.
+func unsupportedFields(pod map[string]interface{}) []string {
+	warnings := unknownFields("", pod, podFields)
+	if metadata, ok := pod["metadata"].(map[string]interface{}); ok {
+		warnings = append(warnings, unknownFields("metadata", metadata, metadataFields)...)
+	}
+	spec, ok := pod["spec"].(map[string]interface{})
+	if !ok {
+		return warnings
+	}
+	warnings = append(warnings, unknownFields("spec", spec, specFields)...)
+	if containers, ok := spec["containers"].([]interface{}); ok {
+		for i, c := range containers {
+			if c, ok := c.(map[string]interface{}); ok {
+				warnings = append(warnings, unknownFields(fmt.Sprintf("spec.containers[%d]", i), c, containerFields)...)
+			}
+		}
+	}
+	return warnings
+}
+
+func unknownFields(prefix string, obj map[string]interface{}, known []string) []string {
+	var (
+		warnings = []string{}
+		keys     = []string{}
+		fields   = make(map[string]bool)
+	)
+	for _, f := range known {
+		fields[f] = true
+	}
+	for k := range obj {
+		if !fields[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if prefix != "" {
+			k = prefix + "." + k
+		}
+		warnings = append(warnings, fmt.Sprintf("%s is not supported, ignored", k))
+	}
+	return warnings
+}
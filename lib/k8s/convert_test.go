@@ -0,0 +1,231 @@
+package k8s
+
+import (
+	"strings"
+	"testing"
+)
+
+const testManifest = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: nginx-conf
+data:
+  nginx.conf: "worker_processes 1;"
+  mime.types: "types {}"
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web
+  labels:
+    app: web
+spec:
+  restartPolicy: OnFailure
+  dnsPolicy: None
+  dnsConfig:
+    nameservers: ["8.8.8.8"]
+    options:
+    - name: ndots
+      value: "2"
+  nodeSelector:
+    disk: ssd
+  volumes:
+  - name: cache
+    emptyDir: {}
+  - name: data
+    hostPath:
+      path: /var/lib/web
+  - name: conf
+    configMap:
+      name: nginx-conf
+      defaultMode: 0600
+  - name: secret
+    secret:
+      secretName: web
+  containers:
+  - name: nginx
+    image: nginx
+    args: ["-g", "daemon off;"]
+    ports:
+    - containerPort: 80
+      hostPort: 8080
+    - containerPort: 443
+    env:
+    - name: MODE
+      value: prod
+    - name: NODE
+      valueFrom:
+        fieldRef:
+          fieldPath: spec.nodeName
+    resources:
+      limits:
+        cpu: 1500m
+        memory: 256Mi
+    volumeMounts:
+    - name: cache
+      mountPath: /var/cache/nginx
+    - name: data
+      mountPath: /data
+      readOnly: true
+    - name: conf
+      mountPath: /etc/nginx
+    - name: secret
+      mountPath: /etc/secret
+    livenessProbe:
+      tcpSocket:
+        port: 80
+  - name: sidecar
+    image: busybox
+    command: ["sh", "-c"]
+    resources:
+      requests:
+        cpu: 1
+        memory: 128Mi
+    volumeMounts:
+    - name: conf
+      mountPath: /etc/nginx.conf
+      subPath: nginx.conf
+`
+
+func TestConvert(t *testing.T) {
+	spec, warnings, err := Convert([]byte(testManifest))
+	if err != nil {
+		t.Fatalf("failed to convert manifest: %v", err)
+	}
+
+	if spec.Id != "web" || spec.Labels["app"] != "web" || spec.RestartPolicy != "onFailure" {
+		t.Fatalf("unexpected pod: %s/%v/%s", spec.Id, spec.Labels, spec.RestartPolicy)
+	}
+	if len(spec.Dns) != 1 || len(spec.DnsOptions) != 1 || spec.DnsOptions[0] != "ndots:2" {
+		t.Fatalf("unexpected dns: %v %v", spec.Dns, spec.DnsOptions)
+	}
+	if spec.Resource == nil || spec.Resource.Vcpu != 3 || spec.Resource.Memory != 384 {
+		t.Fatalf("unexpected resource: %v", spec.Resource)
+	}
+	if len(spec.Volumes) != 2 || spec.Volumes[0].Source != "" || spec.Volumes[1].Source != "/var/lib/web" {
+		t.Fatalf("unexpected volumes: %v", spec.Volumes)
+	}
+	if len(spec.Files) != 2 {
+		t.Fatalf("unexpected files: %v", spec.Files)
+	}
+	if len(spec.Portmappings) != 1 || spec.Portmappings[0].HostPort != "8080" || spec.Portmappings[0].Protocol != "tcp" {
+		t.Fatalf("unexpected portmappings: %v", spec.Portmappings)
+	}
+
+	nginx := spec.Containers[0]
+	if len(nginx.Command) != 2 || len(nginx.Entrypoint) != 0 {
+		t.Fatalf("unexpected command: %v %v", nginx.Entrypoint, nginx.Command)
+	}
+	if len(nginx.Envs) != 1 || nginx.Envs[0].Env != "MODE" {
+		t.Fatalf("unexpected envs: %v", nginx.Envs)
+	}
+	if len(nginx.Volumes) != 2 || !nginx.Volumes[1].ReadOnly {
+		t.Fatalf("unexpected volume mounts: %v", nginx.Volumes)
+	}
+	if len(nginx.Files) != 2 || nginx.Files[1].Path != "/etc/nginx/nginx.conf" || nginx.Files[1].Perm != "0600" {
+		t.Fatalf("unexpected files: %v", nginx.Files)
+	}
+
+	sidecar := spec.Containers[1]
+	if len(sidecar.Files) != 1 || sidecar.Files[0].Path != "/etc/nginx.conf" || sidecar.Files[0].Filename != "conf-nginx.conf" {
+		t.Fatalf("unexpected subPath files: %v", sidecar.Files)
+	}
+
+	expected := []string{
+		"spec.nodeSelector",
+		"spec.containers[0].livenessProbe",
+		"volume secret",
+		"spec.containers[0].env[NODE].valueFrom",
+		"spec.containers[0].volumeMounts[secret]",
+	}
+	all := strings.Join(warnings, "\n")
+	for _, w := range expected {
+		if !strings.Contains(all, w) {
+			t.Fatalf("expected warning about %s, got:\n%s", w, all)
+		}
+	}
+	if len(warnings) != len(expected) {
+		t.Fatalf("unexpected warnings:\n%s", all)
+	}
+}
+
+func TestConvertJSON(t *testing.T) {
+	spec, warnings, err := Convert([]byte(`{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "test"},
+	"spec": {"containers": [{"name": "ubuntu", "image": "ubuntu", "args": ["sleep", "10"]}], "restartPolicy": "Never"}}`))
+	if err != nil {
+		t.Fatalf("failed to convert manifest: %v", err)
+	}
+	if len(warnings) != 0 {
+		t.Fatalf("unexpected warnings: %v", warnings)
+	}
+	if spec.RestartPolicy != "never" || spec.Resource != nil || len(spec.Containers) != 1 {
+		t.Fatalf("unexpected pod: %v", spec)
+	}
+
+	t.Log("> testing missing configMap")
+	_, _, err = Convert([]byte(`{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "test"},
+	"spec": {"containers": [], "volumes": [{"name": "conf", "configMap": {"name": "absent"}}]}}`))
+	if err == nil {
+		t.Fatalf("missing configMap should fail")
+	}
+
+	t.Log("> testing legacy manifest")
+	_, _, err = Convert([]byte(`{"apiVersion": "V1beta1", "kind": "Pod", "spec": {"containers": []}}`))
+	if err == nil {
+		t.Fatalf("V1beta1 manifest should fail")
+	}
+}
+
+func TestDocuments(t *testing.T) {
+	docs, err := Documents([]byte("a: 1\n---\n\n--- \nb: [x, z]\nc:\n  1: true\n---\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(docs) != 2 || string(docs[0]) != `{"a":1}` || string(docs[1]) != `{"b":["x","z"],"c":{"1":true}}` {
+		t.Fatalf("unexpected documents %q", docs)
+	}
+
+	json := []byte(`{"kind": "Pod"}`)
+	if docs, err := Documents(json); err != nil || len(docs) != 1 || string(docs[0]) != string(json) {
+		t.Fatalf("unexpected documents %q, %v", docs, err)
+	}
+
+	if _, err := Documents([]byte("a: [1\n")); err == nil {
+		t.Fatal("split an invalid document")
+	}
+}
+
+func TestConvertPods(t *testing.T) {
+	manifest := testManifest + `---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: conf
+spec:
+  volumes:
+  - name: conf
+    configMap:
+      name: nginx-conf
+  containers:
+  - name: cat
+    image: busybox
+    volumeMounts:
+    - name: conf
+      mountPath: /etc/nginx
+`
+	specs, warnings, err := ConvertPods([]byte(manifest))
+	if err != nil {
+		t.Fatalf("failed to convert manifest: %v", err)
+	}
+	if len(specs) != 2 || specs[0].Id != "web" || specs[1].Id != "conf" || len(specs[1].Files) != 2 {
+		t.Fatalf("unexpected pods %v", specs)
+	}
+	if len(warnings) != 5 || !strings.HasPrefix(warnings[0], "pod web: ") {
+		t.Fatalf("unexpected warnings:\n%s", strings.Join(warnings, "\n"))
+	}
+
+	if _, _, err := Convert([]byte(manifest)); err == nil {
+		t.Fatal("converted several pods into one")
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+
+	"github.com/hyperhq/hyperd/daemon/pod"
 )
 
 // BoolValue transforms a form value in different formats into a boolean type.
@@ -46,6 +48,12 @@ func Int64ValueOrDefault(r *http.Request, field string, def int64) (int64, error
 	return def, nil
 }
 
+// ScopedName qualifies the pod or container name referred by the request
+// with the namespace of the request, if any, see pod.ScopedName.
+func ScopedName(r *http.Request, name string) (string, error) {
+	return pod.ScopedName(r.Form.Get("namespace"), name)
+}
+
 // ArchiveOptions stores archive information for different operations.
 type ArchiveOptions struct {
 	Name string
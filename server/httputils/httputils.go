@@ -92,6 +92,14 @@ func WriteError(w http.ResponseWriter, err error) {
 		return
 	}
 
+	statusCode, errMsg := ErrorStatus(err)
+
+	http.Error(w, errMsg, statusCode)
+}
+
+// ErrorStatus returns the http status code and the message of an error, the
+// same status is used for the errors returned over gRPC.
+func ErrorStatus(err error) (int, string) {
 	statusCode := http.StatusInternalServerError
 	errMsg := err.Error()
 
@@ -154,7 +162,7 @@ func WriteError(w http.ResponseWriter, err error) {
 		statusCode = http.StatusInternalServerError
 	}
 
-	http.Error(w, errMsg, statusCode)
+	return statusCode, errMsg
 }
 
 // WriteJSON writes the value v to the http response stream as json with standard json encoding.
@@ -13,13 +13,9 @@ import (
 
 	"github.com/docker/docker/api/server/httputils"
 	"github.com/docker/docker/builder"
-	"github.com/docker/docker/builder/dockerfile"
-	"github.com/docker/docker/pkg/archive"
-	"github.com/docker/docker/pkg/chrootarchive"
 	"github.com/docker/docker/pkg/ioutils"
 	"github.com/docker/docker/pkg/progress"
 	"github.com/docker/docker/pkg/streamformatter"
-	"github.com/docker/docker/reference"
 	"github.com/docker/docker/utils"
 	"github.com/docker/engine-api/types"
 	"github.com/docker/engine-api/types/container"
@@ -29,45 +25,6 @@ import (
 	"golang.org/x/net/context"
 )
 
-// sanitizeRepoAndTags parses the raw "t" parameter received from the client
-// to a slice of repoAndTag.
-// It also validates each repoName and tag.
-func sanitizeRepoAndTags(names []string) ([]reference.Named, error) {
-	var (
-		repoAndTags []reference.Named
-		// This map is used for deduplicating the "-t" parameter.
-		uniqNames = make(map[string]struct{})
-	)
-	for _, repo := range names {
-		if repo == "" {
-			continue
-		}
-
-		ref, err := reference.ParseNamed(repo)
-		if err != nil {
-			return nil, err
-		}
-
-		ref = reference.WithDefaultTag(ref)
-
-		if _, isCanonical := ref.(reference.Canonical); isCanonical {
-			return nil, errors.New("build tag cannot contain a digest")
-		}
-
-		if _, isTagged := ref.(reference.NamedTagged); !isTagged {
-			ref, err = reference.WithTag(ref, reference.DefaultTag)
-		}
-
-		nameWithTag := ref.String()
-
-		if _, exists := uniqNames[nameWithTag]; !exists {
-			uniqNames[nameWithTag] = struct{}{}
-			repoAndTags = append(repoAndTags, ref)
-		}
-	}
-	return repoAndTags, nil
-}
-
 func newImageBuildOptions(ctx context.Context, r *http.Request) (*types.ImageBuildOptions, error) {
 	//version := httputils.VersionFromContext(ctx)
 	options := &types.ImageBuildOptions{}
@@ -177,7 +134,7 @@ func (br *buildRouter) postBuild(ctx context.Context, w http.ResponseWriter, r *
 		return errf(err)
 	}
 
-	repoAndTags, err := sanitizeRepoAndTags(r.Form["name"])
+	repoAndTags, err := daemonbuilder.SanitizeRepoAndTags(r.Form["name"])
 	if err != nil {
 		return errf(err)
 	}
@@ -211,42 +168,14 @@ func (br *buildRouter) postBuild(ctx context.Context, w http.ResponseWriter, r *
 		buildOptions.Dockerfile = dockerfileName
 	}
 
-	uidMaps, gidMaps := br.backend.GetUIDGIDMaps()
-	defaultArchiver := &archive.Archiver{
-		Untar:   chrootarchive.Untar,
-		UIDMaps: uidMaps,
-		GIDMaps: gidMaps,
-	}
-
-	docker := &daemonbuilder.Docker{
-		Daemon:      br.backend,
-		OutOld:      output,
-		AuthConfigs: authConfigs,
-		Archiver:    defaultArchiver,
-	}
+	out := io.Writer(output)
 	if buildOptions.SuppressOutput {
-		docker.OutOld = notVerboseBuffer
-	}
-
-	docker.InitHyper()
-	defer docker.Cleanup()
-
-	b, err := dockerfile.NewBuilder(
-		buildOptions, // result of newBuildConfig
-		docker,
-		builder.DockerIgnoreContext{ModifiableContext: context},
-		nil)
-	if err != nil {
-		return errf(err)
-	}
-	b.Stdout = &streamformatter.StdoutFormatter{Writer: output, StreamFormatter: sf}
-	b.Stderr = &streamformatter.StderrFormatter{Writer: output, StreamFormatter: sf}
-	if buildOptions.SuppressOutput {
-		b.Stdout = &streamformatter.StdoutFormatter{Writer: notVerboseBuffer, StreamFormatter: sf}
-		b.Stderr = &streamformatter.StderrFormatter{Writer: notVerboseBuffer, StreamFormatter: sf}
+		out = notVerboseBuffer
 	}
 
+	var cancel chan struct{}
 	if closeNotifier, ok := w.(http.CloseNotifier); ok {
+		cancel = make(chan struct{})
 		finished := make(chan struct{})
 		defer close(finished)
 		clientGone := closeNotifier.CloseNotify()
@@ -254,23 +183,16 @@ func (br *buildRouter) postBuild(ctx context.Context, w http.ResponseWriter, r *
 			select {
 			case <-finished:
 			case <-clientGone:
-				glog.Infof("Client disconnected, cancelling job: build")
-				b.Cancel()
+				close(cancel)
 			}
 		}()
 	}
 
-	imgID, err := b.Build()
+	imgID, err := daemonbuilder.Build(br.backend, buildOptions, context, repoAndTags, authConfigs, out, cancel)
 	if err != nil {
 		return errf(err)
 	}
 
-	for _, rt := range repoAndTags {
-		if err := br.backend.TagImage(rt, imgID); err != nil {
-			return errf(err)
-		}
-	}
-
 	// Everything worked so if -q was provided the output from the daemon
 	// should be just the image ID and we'll print that to stdout.
 	if buildOptions.SuppressOutput {
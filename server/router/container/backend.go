@@ -9,7 +9,8 @@ import (
 )
 
 type Backend interface {
-	CmdGetContainerInfo(container string) (interface{}, error)
+	CmdGetContainerInfo(namespace, container string) (interface{}, error)
+	CmdGetExecInfo(execId string) (interface{}, error)
 	CmdGetContainerLogs(name string, c *daemon.ContainerLogsConfig) error
 	CmdExitCode(container, tag string) (int, error)
 	CmdCreateContainer(podId string, containerArgs []byte) (string, error)
@@ -32,6 +32,7 @@ func (r *containerRouter) initRoutes() {
 		local.NewGetRoute("/container/info", r.getContainerInfo),
 		local.NewGetRoute("/container/logs", r.getContainerLogs),
 		local.NewGetRoute("/exitcode", r.getExitCode),
+		local.NewGetRoute("/exec/info", r.getExecInfo),
 		// POST
 		local.NewPostRoute("/container/create", r.postContainerCreate),
 		local.NewPostRoute("/container/start", r.postContainerStart),
@@ -26,7 +26,20 @@ func (c *containerRouter) getContainerInfo(ctx context.Context, w http.ResponseW
 		return err
 	}
 
-	data, err := c.backend.CmdGetContainerInfo(r.Form.Get("container"))
+	data, err := c.backend.CmdGetContainerInfo(r.Form.Get("namespace"), r.Form.Get("container"))
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusOK, data)
+}
+
+func (c *containerRouter) getExecInfo(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	data, err := c.backend.CmdGetExecInfo(r.Form.Get("exec"))
 	if err != nil {
 		return err
 	}
@@ -63,7 +76,10 @@ func (c *containerRouter) getContainerLogs(ctx context.Context, w http.ResponseW
 		closeNotifier = notifier.CloseNotify()
 	}
 
-	containerName := r.Form.Get("container")
+	containerName, err := httputils.ScopedName(r, r.Form.Get("container"))
+	if err != nil {
+		return err
+	}
 	/*
 		if !s.backend.Exists(containerName) {
 			return derr.ErrorCodeNoSuchContainer.WithArgs(containerName)
@@ -110,7 +126,10 @@ func (c *containerRouter) postContainerCreate(ctx context.Context, w http.Respon
 		return err
 	}
 
-	podId := r.Form.Get("podId")
+	podId, err := httputils.ScopedName(r, r.Form.Get("podId"))
+	if err != nil {
+		return err
+	}
 	if podId == "" {
 		return fmt.Errorf("podId is required to create a new container")
 	}
@@ -137,7 +156,10 @@ func (c *containerRouter) postContainerStart(ctx context.Context, w http.Respons
 		return err
 	}
 
-	cname := r.Form.Get("container")
+	cname, err := httputils.ScopedName(r, r.Form.Get("container"))
+	if err != nil {
+		return err
+	}
 	env, err := c.backend.CmdStartContainer(cname)
 	if err != nil {
 		return err
@@ -152,7 +174,10 @@ func (c *containerRouter) postContainerKill(ctx context.Context, w http.Response
 	}
 
 	sigterm := int64(15)
-	cname := r.Form.Get("container")
+	cname, err := httputils.ScopedName(r, r.Form.Get("container"))
+	if err != nil {
+		return err
+	}
 	signal, err := httputils.Int64ValueOrDefault(r, "signal", sigterm)
 	if err != nil {
 		signal = sigterm
@@ -172,7 +197,10 @@ func (c *containerRouter) postContainerStop(ctx context.Context, w http.Response
 		return err
 	}
 
-	cname := r.Form.Get("container")
+	cname, err := httputils.ScopedName(r, r.Form.Get("container"))
+	if err != nil {
+		return err
+	}
 	env, err := c.backend.CmdStopContainer(cname)
 	if err != nil {
 		return err
@@ -186,7 +214,10 @@ func (c *containerRouter) postContainerRemove(ctx context.Context, w http.Respon
 		return err
 	}
 
-	cname := r.Form.Get("container")
+	cname, err := httputils.ScopedName(r, r.Form.Get("container"))
+	if err != nil {
+		return err
+	}
 	env, err := c.backend.CmdRemoveContainer(cname)
 	if err != nil {
 		return err
@@ -200,7 +231,10 @@ func (c *containerRouter) postContainerCommit(ctx context.Context, w http.Respon
 		return err
 	}
 
-	cname := r.Form.Get("container")
+	cname, err := httputils.ScopedName(r, r.Form.Get("container"))
+	if err != nil {
+		return err
+	}
 	pause := httputils.BoolValue(r, "pause")
 
 	config, _, _, err := runconfig.DecodeContainerConfig(r.Body)
@@ -240,7 +274,10 @@ func (c *containerRouter) postContainerRename(ctx context.Context, w http.Respon
 	}
 
 	newName := r.Form.Get("newName")
-	oldName := r.Form.Get("oldName")
+	oldName, err := httputils.ScopedName(r, r.Form.Get("oldName"))
+	if err != nil {
+		return err
+	}
 	env, err := c.backend.CmdContainerRename(oldName, newName)
 	if err != nil {
 		return err
@@ -6,7 +6,7 @@ import (
 	"net/http"
 	"strconv"
 
-	"github.com/docker/docker/api/server/httputils"
+	"github.com/hyperhq/hyperd/server/httputils"
 	"golang.org/x/net/context"
 )
 
@@ -15,7 +15,12 @@ func (s *containerRouter) getExitCode(ctx context.Context, w http.ResponseWriter
 		return err
 	}
 
-	code, err := s.backend.CmdExitCode(r.Form.Get("container"), r.Form.Get("exec"))
+	container, err := httputils.ScopedName(r, r.Form.Get("container"))
+	if err != nil {
+		return err
+	}
+
+	code, err := s.backend.CmdExitCode(container, r.Form.Get("exec"))
 	if err != nil {
 		return err
 	}
@@ -28,7 +33,10 @@ func (s *containerRouter) postContainerExecCreate(ctx context.Context, w http.Re
 		return err
 	}
 
-	id := r.Form.Get("container")
+	id, err := httputils.ScopedName(r, r.Form.Get("container"))
+	if err != nil {
+		return err
+	}
 	command := r.Form.Get("command")
 	tty := r.Form.Get("tty")
 	terminal := tty == "yes" || tty == "true" || tty == "on"
@@ -46,7 +54,10 @@ func (s *containerRouter) postExecVM(ctx context.Context, w http.ResponseWriter,
 		return err
 	}
 
-	id := r.Form.Get("pod")
+	id, err := httputils.ScopedName(r, r.Form.Get("pod"))
+	if err != nil {
+		return err
+	}
 	command := r.Form.Get("command")
 
 	// Setting up the streaming http interface.
@@ -66,7 +77,10 @@ func (s *containerRouter) postContainerExecStart(ctx context.Context, w http.Res
 		return err
 	}
 
-	id := r.Form.Get("container")
+	id, err := httputils.ScopedName(r, r.Form.Get("container"))
+	if err != nil {
+		return err
+	}
 	execId := r.Form.Get("exec")
 
 	// Setting up the streaming http interface.
@@ -85,7 +99,10 @@ func (s *containerRouter) postContainerAttach(ctx context.Context, w http.Respon
 		return err
 	}
 
-	container := r.Form.Get("container")
+	container, err := httputils.ScopedName(r, r.Form.Get("container"))
+	if err != nil {
+		return err
+	}
 	// Setting up the streaming http interface.
 	inStream, outStream, err := httputils.HijackConnection(w)
 	if err != nil {
@@ -111,7 +128,10 @@ func (s *containerRouter) postTtyResize(ctx context.Context, w http.ResponseWrit
 		return err
 	}
 
-	containerId := r.Form.Get("container")
+	containerId, err := httputils.ScopedName(r, r.Form.Get("container"))
+	if err != nil {
+		return err
+	}
 	execId := r.Form.Get("exec")
 
 	return s.backend.CmdTtyResize(containerId, execId, height, width)
@@ -93,6 +93,7 @@ func (r *router) initRoutes() {
 		NewGetRoute("/images/get", r.getImagesJSON),
 		// /images/get in docker
 		NewGetRoute("/images/save", r.getImagesSave),
+		NewGetRoute("/image/info", r.getImageInfo),
 		// POST
 		NewPostRoute("/image/create", r.postImagesCreate),
 		NewPostRoute("/image/load", r.postImagesLoad),
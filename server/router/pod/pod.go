@@ -21,8 +21,10 @@ func NewRouter(b Backend) router.Router {
 		// GET
 		local.NewGetRoute("/pod/info", r.getPodInfo),
 		local.NewGetRoute("/pod/stats", r.getPodStats),
+		local.NewGetRoute("/pod/export", r.getPodExport),
 		local.NewGetRoute("/pod/{id}/portmappings", r.getPortMappings),
 		local.NewGetRoute("/list", r.getList),
+		local.NewGetRoute("/vm/list", r.getVMList),
 		// POST
 		local.NewPostRoute("/pod/create", r.postPodCreate),
 		local.NewPostRoute("/pod/labels", r.postPodLabels),
@@ -31,10 +33,12 @@ func NewRouter(b Backend) router.Router {
 		local.NewPostRoute("/pod/kill", r.postPodKill),
 		local.NewPostRoute("/pod/pause", r.postPodPause),
 		local.NewPostRoute("/pod/unpause", r.postPodUnpause),
+		local.NewPostRoute("/vm/create", r.postVmCreate),
 		// PUT
 		local.NewPutRoute("/pod/{id}/portmappings/{action}", r.putPortMappings),
 		// DELETE
 		local.NewDeleteRoute("/pod", r.deletePod),
+		local.NewDeleteRoute("/vm", r.deleteVm),
 	}
 
 	return r
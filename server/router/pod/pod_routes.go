@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/engine"
 	"github.com/hyperhq/hyperd/server/httputils"
 	"golang.org/x/net/context"
 )
@@ -15,7 +16,7 @@ func (p *podRouter) getPodInfo(ctx context.Context, w http.ResponseWriter, r *ht
 		return err
 	}
 
-	data, err := p.backend.CmdGetPodInfo(r.Form.Get("podName"))
+	data, err := p.backend.CmdGetPodInfo(r.Form.Get("namespace"), r.Form.Get("podName"))
 	if err != nil {
 		return err
 	}
@@ -28,7 +29,25 @@ func (p *podRouter) getPodStats(ctx context.Context, w http.ResponseWriter, r *h
 		return err
 	}
 
-	data, err := p.backend.CmdGetPodStats(r.Form.Get("podId"))
+	podId, err := httputils.ScopedName(r, r.Form.Get("podId"))
+	if err != nil {
+		return err
+	}
+
+	data, err := p.backend.CmdGetPodStats(podId)
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusOK, data)
+}
+
+func (p *podRouter) getPodExport(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	data, err := p.backend.CmdExportPodSpec(r.Form.Get("namespace"), r.Form.Get("podName"))
 	if err != nil {
 		return err
 	}
@@ -44,10 +63,11 @@ func (p *podRouter) getList(ctx context.Context, w http.ResponseWriter, r *http.
 	item := r.Form.Get("item")
 	pod := r.Form.Get("pod")
 	vm := r.Form.Get("vm")
+	namespace := r.Form.Get("namespace")
 
-	glog.V(1).Infof("List type is %s, specified pod: [%s], specified vm: [%s]", item, pod, vm)
+	glog.V(1).Infof("List type is %s, specified namespace: [%s], specified pod: [%s], specified vm: [%s]", item, namespace, pod, vm)
 
-	env, err := p.backend.CmdList(item, pod, vm)
+	env, err := p.backend.CmdList(item, namespace, pod, vm)
 	if err != nil {
 		return err
 	}
@@ -60,14 +80,26 @@ func (p *podRouter) postPodCreate(ctx context.Context, w http.ResponseWriter, r
 		return err
 	}
 
-	if err := httputils.CheckForJSON(r); err != nil {
-		return err
+	// a Kubernetes manifest could be in YAML
+	k8s := httputils.BoolValue(r, "k8s")
+	if !k8s {
+		if err := httputils.CheckForJSON(r); err != nil {
+			return err
+		}
 	}
 
 	podArgs, _ := ioutil.ReadAll(r.Body)
 	glog.V(1).Infof("Args string is %s", string(podArgs))
 
-	env, err := p.backend.CmdCreatePod(string(podArgs))
+	var (
+		env *engine.Env
+		err error
+	)
+	if k8s {
+		env, err = p.backend.CmdCreateKubernetesPod(string(podArgs))
+	} else {
+		env, err = p.backend.CmdCreatePod(string(podArgs))
+	}
 	if err != nil {
 		return err
 	}
@@ -80,7 +112,10 @@ func (p *podRouter) postPodLabels(ctx context.Context, w http.ResponseWriter, r
 		return err
 	}
 
-	podId := r.Form.Get("podId")
+	podId, err := httputils.ScopedName(r, r.Form.Get("podId"))
+	if err != nil {
+		return err
+	}
 	labels := make(map[string]string)
 
 	if err := json.Unmarshal([]byte(r.Form.Get("labels")), &labels); err != nil {
@@ -105,7 +140,10 @@ func (p *podRouter) postPodStart(ctx context.Context, w http.ResponseWriter, r *
 		return err
 	}
 
-	podId := r.Form.Get("podId")
+	podId, err := httputils.ScopedName(r, r.Form.Get("podId"))
+	if err != nil {
+		return err
+	}
 
 	env, err := p.backend.CmdStartPod(podId)
 	if err != nil {
@@ -120,7 +158,10 @@ func (p *podRouter) postPodStop(ctx context.Context, w http.ResponseWriter, r *h
 		return err
 	}
 
-	podId := r.Form.Get("podId")
+	podId, err := httputils.ScopedName(r, r.Form.Get("podId"))
+	if err != nil {
+		return err
+	}
 	stopVm := r.Form.Get("stopVm")
 
 	env, err := p.backend.CmdStopPod(podId, stopVm)
@@ -136,10 +177,11 @@ func (p *podRouter) postPodKill(ctx context.Context, w http.ResponseWriter, r *h
 		return err
 	}
 
-	var (
-		podId     = r.Form.Get("podName")
-		container = r.Form.Get("container")
-	)
+	podId, err := httputils.ScopedName(r, r.Form.Get("podName"))
+	if err != nil {
+		return err
+	}
+	container := r.Form.Get("container")
 
 	sigterm := int64(15)
 	signal, err := httputils.Int64ValueOrDefault(r, "signal", sigterm)
@@ -160,7 +202,10 @@ func (p *podRouter) postPodPause(ctx context.Context, w http.ResponseWriter, r *
 		return err
 	}
 
-	podId := r.Form.Get("podId")
+	podId, err := httputils.ScopedName(r, r.Form.Get("podId"))
+	if err != nil {
+		return err
+	}
 
 	if err := p.backend.CmdPausePod(podId); err != nil {
 		return err
@@ -175,7 +220,10 @@ func (p *podRouter) postPodUnpause(ctx context.Context, w http.ResponseWriter, r
 		return err
 	}
 
-	podId := r.Form.Get("podId")
+	podId, err := httputils.ScopedName(r, r.Form.Get("podId"))
+	if err != nil {
+		return err
+	}
 	if err := p.backend.CmdUnpausePod(podId); err != nil {
 		return err
 	}
@@ -189,7 +237,10 @@ func (p *podRouter) deletePod(ctx context.Context, w http.ResponseWriter, r *htt
 		return err
 	}
 
-	podId := r.Form.Get("podId")
+	podId, err := httputils.ScopedName(r, r.Form.Get("podId"))
+	if err != nil {
+		return err
+	}
 	env, err := p.backend.CmdCleanPod(podId)
 	if err != nil {
 		return err
@@ -200,7 +251,16 @@ func (p *podRouter) deletePod(ctx context.Context, w http.ResponseWriter, r *htt
 
 // port mappings
 func (p *podRouter) getPortMappings(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
-	env, err := p.backend.CmdListPortMappings(vars["id"])
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	podId, err := httputils.ScopedName(r, vars["id"])
+	if err != nil {
+		return err
+	}
+
+	env, err := p.backend.CmdListPortMappings(podId)
 	if err != nil {
 		return err
 	}
@@ -209,16 +269,25 @@ func (p *podRouter) getPortMappings(ctx context.Context, w http.ResponseWriter,
 }
 
 func (p *podRouter) putPortMappings(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	podId, err := httputils.ScopedName(r, vars["id"])
+	if err != nil {
+		return err
+	}
+
 	pms, _ := ioutil.ReadAll(r.Body)
 	switch vars["action"] {
 	case "add":
-		_, err := p.backend.CmdAddPortMappings(vars["id"], pms)
+		_, err := p.backend.CmdAddPortMappings(podId, pms)
 		if err != nil {
 			return err
 		}
 		w.WriteHeader(http.StatusNoContent)
 	case "delete":
-		_, err := p.backend.CmdDeletePortMappings(vars["id"], pms)
+		_, err := p.backend.CmdDeletePortMappings(podId, pms)
 		if err != nil {
 			return err
 		}
@@ -230,3 +299,45 @@ func (p *podRouter) putPortMappings(ctx context.Context, w http.ResponseWriter,
 	}
 	return nil
 }
+
+// vm
+func (p *podRouter) getVMList(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	vms, err := p.backend.CmdListVMs(r.Form.Get("pod"), r.Form.Get("vm"))
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusOK, vms)
+}
+
+func (p *podRouter) postVmCreate(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	cpu := httputils.Int64ValueOrZero(r, "cpu")
+	mem := httputils.Int64ValueOrZero(r, "mem")
+	env, err := p.backend.CmdCreateVm(int(cpu), int(mem), httputils.BoolValue(r, "async"))
+	if err != nil {
+		return err
+	}
+
+	return env.WriteJSON(w, http.StatusCreated)
+}
+
+func (p *podRouter) deleteVm(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	env, err := p.backend.CmdRemoveVm(r.Form.Get("vm"))
+	if err != nil {
+		return err
+	}
+
+	return env.WriteJSON(w, http.StatusOK)
+}
@@ -8,7 +8,7 @@ import (
 // Backend is the methods that need to be implemented to provide
 // system specific functionality.
 type Backend interface {
-	CmdGetServices(podId string) ([]*apitypes.UserService, error)
+	CmdGetServices(podId string, live bool) ([]*apitypes.UserService, error)
 	CmdAddService(podId, data string) (*engine.Env, error)
 	CmdUpdateService(podId, services string) (*engine.Env, error)
 	CmdDeleteService(podId, services string) (*engine.Env, error)
@@ -12,7 +12,12 @@ func (s *serviceRouter) getServices(ctx context.Context, w http.ResponseWriter,
 		return err
 	}
 
-	data, err := s.backend.CmdGetServices(r.Form.Get("podId"))
+	podId, err := httputils.ScopedName(r, r.Form.Get("podId"))
+	if err != nil {
+		return err
+	}
+
+	data, err := s.backend.CmdGetServices(podId, httputils.BoolValue(r, "live"))
 	if err != nil {
 		return err
 	}
@@ -25,7 +30,10 @@ func (s *serviceRouter) postServiceAdd(ctx context.Context, w http.ResponseWrite
 		return err
 	}
 
-	podId := r.Form.Get("podId")
+	podId, err := httputils.ScopedName(r, r.Form.Get("podId"))
+	if err != nil {
+		return err
+	}
 	services := r.Form.Get("services")
 
 	data, err := s.backend.CmdAddService(podId, services)
@@ -41,7 +49,10 @@ func (s *serviceRouter) postServiceUpdate(ctx context.Context, w http.ResponseWr
 		return err
 	}
 
-	podId := r.Form.Get("podId")
+	podId, err := httputils.ScopedName(r, r.Form.Get("podId"))
+	if err != nil {
+		return err
+	}
 	services := r.Form.Get("services")
 
 	data, err := s.backend.CmdUpdateService(podId, services)
@@ -57,7 +68,10 @@ func (s *serviceRouter) deleteService(ctx context.Context, w http.ResponseWriter
 		return err
 	}
 
-	podId := r.Form.Get("podId")
+	podId, err := httputils.ScopedName(r, r.Form.Get("podId"))
+	if err != nil {
+		return err
+	}
 	services := r.Form.Get("services")
 
 	data, err := s.backend.CmdDeleteService(podId, services)
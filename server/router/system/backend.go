@@ -12,4 +12,6 @@ type Backend interface {
 	CmdSystemInfo() (*apitypes.InfoResponse, error)
 	CmdSystemVersion() *engine.Env
 	CmdAuthenticateToRegistry(authConfig *types.AuthConfig) (string, error)
+	CmdSystemGC(dryRun bool) ([]*apitypes.StorageOrphan, error)
+	CmdSystemDBBackup(path string) (*apitypes.SystemDBBackupResponse, error)
 }
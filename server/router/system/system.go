@@ -24,6 +24,8 @@ func NewRouter(b Backend) router.Router {
 		local.NewGetRoute("/info", r.getInfo),
 		local.NewGetRoute("/version", r.getVersion),
 		local.NewPostRoute("/auth", r.postAuth),
+		local.NewPostRoute("/system/gc", r.postSystemGC),
+		local.NewPostRoute("/system/db/backup", r.postSystemDBBackup),
 	}
 
 	return r
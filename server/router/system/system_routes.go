@@ -66,3 +66,29 @@ func (s *systemRouter) postAuth(ctx context.Context, w http.ResponseWriter, r *h
 
 	return httputils.WriteJSON(w, http.StatusOK, &types.AuthResponse{Status: status})
 }
+
+func (s *systemRouter) postSystemGC(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	data, err := s.backend.CmdSystemGC(httputils.BoolValue(r, "dryRun"))
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusOK, data)
+}
+
+func (s *systemRouter) postSystemDBBackup(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	data, err := s.backend.CmdSystemDBBackup(r.Form.Get("path"))
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusOK, data)
+}
@@ -0,0 +1,23 @@
+package volume
+
+import (
+	"io"
+
+	"github.com/hyperhq/hyperd/engine"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+// Backend is the methods that need to be implemented to provide
+// volume specific functionality.
+type Backend interface {
+	CmdCreateVolume(name, labels, size string) (*apitypes.VolumeInfo, error)
+	CmdListVolumes() ([]*apitypes.VolumeInfo, error)
+	CmdGetVolume(name string) (*apitypes.VolumeInfo, error)
+	CmdRemoveVolume(name string) (*engine.Env, error)
+	CmdSnapshotVolume(podId, volume, name string, pause bool) (*apitypes.VolumeSnapshotInfo, error)
+	CmdListVolumeSnapshots(podId string) ([]*apitypes.VolumeSnapshotInfo, error)
+	CmdRemoveVolumeSnapshot(name string) (*engine.Env, error)
+	CmdCloneVolume(snapshot, podId, volume string) (*engine.Env, error)
+	CmdExportVolume(podId, volume string, out io.Writer) error
+	CmdImportVolume(podId, volume string, in io.Reader) (*engine.Env, error)
+}
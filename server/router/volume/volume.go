@@ -0,0 +1,42 @@
+package volume
+
+import (
+	"github.com/hyperhq/hyperd/server/router"
+	"github.com/hyperhq/hyperd/server/router/local"
+)
+
+// volumeRouter is a router to talk with the named volumes.
+type volumeRouter struct {
+	backend Backend
+	routes  []router.Route
+}
+
+// NewRouter initializes a new volumeRouter
+func NewRouter(b Backend) router.Router {
+	r := &volumeRouter{
+		backend: b,
+	}
+
+	r.routes = []router.Route{
+		// GET
+		local.NewGetRoute("/volume/list", r.getVolumes),
+		local.NewGetRoute("/volume/info", r.getVolumeInfo),
+		local.NewGetRoute("/volume/snapshot/list", r.getVolumeSnapshots),
+		local.NewGetRoute("/volume/export", r.getVolumeExport),
+		// POST
+		local.NewPostRoute("/volume/create", r.postVolumeCreate),
+		local.NewPostRoute("/volume/snapshot", r.postVolumeSnapshot),
+		local.NewPostRoute("/volume/clone", r.postVolumeClone),
+		local.NewPostRoute("/volume/import", r.postVolumeImport),
+		// DELETE
+		local.NewDeleteRoute("/volume", r.deleteVolume),
+		local.NewDeleteRoute("/volume/snapshot", r.deleteVolumeSnapshot),
+	}
+
+	return r
+}
+
+// Routes return all the API routes dedicated to the named volumes.
+func (s *volumeRouter) Routes() []router.Route {
+	return s.routes
+}
@@ -0,0 +1,167 @@
+package volume
+
+import (
+	"net/http"
+
+	"github.com/docker/docker/pkg/ioutils"
+	"github.com/docker/docker/pkg/streamformatter"
+	"github.com/hyperhq/hyperd/server/httputils"
+	"golang.org/x/net/context"
+)
+
+func (s *volumeRouter) getVolumes(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	data, err := s.backend.CmdListVolumes()
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusOK, data)
+}
+
+func (s *volumeRouter) getVolumeInfo(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	data, err := s.backend.CmdGetVolume(r.Form.Get("name"))
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusOK, data)
+}
+
+func (s *volumeRouter) postVolumeCreate(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	data, err := s.backend.CmdCreateVolume(r.Form.Get("name"), r.Form.Get("labels"), r.Form.Get("size"))
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusCreated, data)
+}
+
+func (s *volumeRouter) deleteVolume(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	data, err := s.backend.CmdRemoveVolume(r.Form.Get("name"))
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusOK, data)
+}
+
+func (s *volumeRouter) getVolumeSnapshots(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	podId, err := httputils.ScopedName(r, r.Form.Get("podId"))
+	if err != nil {
+		return err
+	}
+
+	data, err := s.backend.CmdListVolumeSnapshots(podId)
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusOK, data)
+}
+
+func (s *volumeRouter) postVolumeSnapshot(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	podId, err := httputils.ScopedName(r, r.Form.Get("podId"))
+	if err != nil {
+		return err
+	}
+
+	data, err := s.backend.CmdSnapshotVolume(podId, r.Form.Get("volume"), r.Form.Get("name"), httputils.BoolValue(r, "pause"))
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusCreated, data)
+}
+
+func (s *volumeRouter) deleteVolumeSnapshot(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	data, err := s.backend.CmdRemoveVolumeSnapshot(r.Form.Get("name"))
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusOK, data)
+}
+
+func (s *volumeRouter) postVolumeClone(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	podId, err := httputils.ScopedName(r, r.Form.Get("podId"))
+	if err != nil {
+		return err
+	}
+
+	data, err := s.backend.CmdCloneVolume(r.Form.Get("snapshot"), podId, r.Form.Get("volume"))
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusCreated, data)
+}
+
+func (s *volumeRouter) getVolumeExport(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	podId, err := httputils.ScopedName(r, r.Form.Get("podId"))
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/x-tar")
+	output := ioutils.NewWriteFlusher(w)
+	defer output.Close()
+
+	if err := s.backend.CmdExportVolume(podId, r.Form.Get("volume"), output); err != nil {
+		if !output.Flushed() {
+			return err
+		}
+		sf := streamformatter.NewJSONStreamFormatter()
+		output.Write(sf.FormatError(err))
+	}
+	return nil
+}
+
+func (s *volumeRouter) postVolumeImport(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	podId, err := httputils.ScopedName(r, r.Form.Get("podId"))
+	if err != nil {
+		return err
+	}
+
+	data, err := s.backend.CmdImportVolume(podId, r.Form.Get("volume"), r.Body)
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusOK, data)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/hyperhq/hyperd/server/router/pod"
 	"github.com/hyperhq/hyperd/server/router/service"
 	"github.com/hyperhq/hyperd/server/router/system"
+	"github.com/hyperhq/hyperd/server/router/volume"
 
 	"github.com/gorilla/mux"
 	"golang.org/x/net/context"
@@ -178,6 +179,7 @@ func (s *Server) InitRouters(d *daemon.Daemon) {
 	s.addRouter(container.NewRouter(d))
 	s.addRouter(pod.NewRouter(d))
 	s.addRouter(service.NewRouter(d))
+	s.addRouter(volume.NewRouter(d))
 	s.addRouter(local.NewRouter(d))
 	s.addRouter(system.NewRouter(d))
 	s.addRouter(build.NewRouter(d))
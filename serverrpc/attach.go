@@ -3,6 +3,7 @@ package serverrpc
 import (
 	"fmt"
 	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/daemon/pod"
 	"github.com/hyperhq/hyperd/types"
 	"io"
 )
@@ -16,6 +17,10 @@ func (s *ServerRPC) Attach(stream types.PublicAPI_AttachServer) error {
 		return fmt.Errorf("stream.Recv error: %v", err)
 	}
 	glog.V(3).Infof("Attach with ServerStream %s request %s", stream, req.String())
+	container, err := pod.ScopedName(req.Namespace, req.ContainerID)
+	if err != nil {
+		return err
+	}
 
 	ir, iw := io.Pipe()
 	or, ow := io.Pipe()
@@ -65,7 +70,7 @@ func (s *ServerRPC) Attach(stream types.PublicAPI_AttachServer) error {
 		}
 	}()
 
-	err = s.daemon.Attach(ir, ow, req.ContainerID)
+	err = s.daemon.Attach(ir, ow, container)
 	if err != nil {
 		return fmt.Errorf("s.daemon.Attach with request %s error: %v", req.String(), err)
 	}
@@ -1,13 +1,21 @@
 package serverrpc
 
 import (
+	"github.com/docker/docker/builder/dockerfile"
+	enginetypes "github.com/docker/engine-api/types"
+	"github.com/docker/engine-api/types/container"
+	"github.com/hyperhq/hyperd/daemon/pod"
 	"github.com/hyperhq/hyperd/types"
 	"golang.org/x/net/context"
 )
 
 // ContainerCreate creates a container by UserContainer spec
 func (s *ServerRPC) ContainerCreate(ctx context.Context, req *types.ContainerCreateRequest) (*types.ContainerCreateResponse, error) {
-	containerID, err := s.daemon.CreateContainerInPod(req.PodID, req.ContainerSpec)
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return nil, err
+	}
+	containerID, err := s.daemon.CreateContainerInPod(podID, req.ContainerSpec)
 	if err != nil {
 		return nil, err
 	}
@@ -18,7 +26,11 @@ func (s *ServerRPC) ContainerCreate(ctx context.Context, req *types.ContainerCre
 }
 
 func (s *ServerRPC) ContainerStart(ctx context.Context, req *types.ContainerStartRequest) (*types.ContainerStartResponse, error) {
-	err := s.daemon.StartContainer(req.ContainerId)
+	container, err := pod.ScopedName(req.Namespace, req.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+	err = s.daemon.StartContainer(container)
 	if err != nil {
 		return nil, err
 	}
@@ -28,7 +40,11 @@ func (s *ServerRPC) ContainerStart(ctx context.Context, req *types.ContainerStar
 
 // ContainerStop implements POST /container/stop
 func (s *ServerRPC) ContainerStop(c context.Context, req *types.ContainerStopRequest) (*types.ContainerStopResponse, error) {
-	err := s.daemon.StopContainer(req.ContainerID, int(req.Timeout))
+	container, err := pod.ScopedName(req.Namespace, req.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.daemon.StopContainer(container, int(req.Timeout))
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +54,11 @@ func (s *ServerRPC) ContainerStop(c context.Context, req *types.ContainerStopReq
 
 // ContainerRename rename a container
 func (s *ServerRPC) ContainerRename(c context.Context, req *types.ContainerRenameRequest) (*types.ContainerRenameResponse, error) {
-	err := s.daemon.ContainerRename(req.OldContainerName, req.NewContainerName)
+	container, err := pod.ScopedName(req.Namespace, req.OldContainerName)
+	if err != nil {
+		return nil, err
+	}
+	err = s.daemon.ContainerRename(container, req.NewContainerName)
 	if err != nil {
 		return nil, err
 	}
@@ -46,8 +66,41 @@ func (s *ServerRPC) ContainerRename(c context.Context, req *types.ContainerRenam
 	return &types.ContainerRenameResponse{}, nil
 }
 
+// ContainerCommit implements POST /container/commit
+func (s *ServerRPC) ContainerCommit(c context.Context, req *types.ContainerCommitRequest) (*types.ContainerCommitResponse, error) {
+	name, err := pod.ScopedName(req.Namespace, req.Container)
+	if err != nil {
+		return nil, err
+	}
+	config, err := dockerfile.BuildFromConfig(&container.Config{}, req.Changes)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.daemon.CommitContainer(name, &enginetypes.ContainerCommitConfig{
+		Pause:        req.Pause,
+		Repo:         req.Repo,
+		Tag:          req.Tag,
+		Author:       req.Author,
+		Comment:      req.Message,
+		Config:       config,
+		MergeConfigs: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ContainerCommitResponse{
+		Id: id,
+	}, nil
+}
+
 func (s *ServerRPC) ContainerRemove(ctx context.Context, req *types.ContainerRemoveRequest) (*types.ContainerRemoveResponse, error) {
-	err := s.daemon.RemoveContainer(req.ContainerId)
+	container, err := pod.ScopedName(req.Namespace, req.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+	err = s.daemon.RemoveContainer(container)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +110,11 @@ func (s *ServerRPC) ContainerRemove(ctx context.Context, req *types.ContainerRem
 
 // ContainerSignal sends a singal to specified container of specified pod
 func (s *ServerRPC) ContainerSignal(ctx context.Context, req *types.ContainerSignalRequest) (*types.ContainerSignalResponse, error) {
-	err := s.daemon.KillPodContainers(req.PodID, req.ContainerID, req.Signal)
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.daemon.KillPodContainers(podID, req.ContainerID, req.Signal)
 	if err != nil {
 		return nil, err
 	}
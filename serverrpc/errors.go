@@ -0,0 +1,34 @@
+package serverrpc
+
+import (
+	"net/http"
+
+	"github.com/hyperhq/hyperd/server/httputils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// httpStatusCodes maps the http status of the REST API to the gRPC codes.
+var httpStatusCodes = map[int]codes.Code{
+	http.StatusBadRequest:         codes.InvalidArgument,
+	http.StatusUnauthorized:       codes.Unauthenticated,
+	http.StatusForbidden:          codes.PermissionDenied,
+	http.StatusNotFound:           codes.NotFound,
+	http.StatusNotAcceptable:      codes.FailedPrecondition,
+	http.StatusConflict:           codes.AlreadyExists,
+	http.StatusPreconditionFailed: codes.FailedPrecondition,
+}
+
+// rpcError converts an error of the daemon into a gRPC error, whose code is
+// the one matching the http status returned by the REST API for the error.
+func rpcError(err error) error {
+	if err == nil || grpc.Code(err) != codes.Unknown {
+		return err
+	}
+	status, msg := httputils.ErrorStatus(err)
+	code, ok := httpStatusCodes[status]
+	if !ok {
+		return err
+	}
+	return grpc.Errorf(code, "%s", msg)
+}
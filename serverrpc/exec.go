@@ -6,6 +6,7 @@ import (
 
 	"fmt"
 	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/daemon/pod"
 	"github.com/hyperhq/hyperd/lib/promise"
 	"github.com/hyperhq/hyperd/types"
 	"golang.org/x/net/context"
@@ -17,7 +18,11 @@ func (s *ServerRPC) ExecCreate(ctx context.Context, req *types.ExecCreateRequest
 		return nil, fmt.Errorf("json.Marshal error: %v", err)
 	}
 
-	execId, err := s.daemon.CreateExec(req.ContainerID, string(cmd), req.Tty)
+	container, err := pod.ScopedName(req.Namespace, req.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	execId, err := s.daemon.CreateExec(container, string(cmd), req.Tty)
 	if err != nil {
 		return nil, fmt.Errorf("s.daemon.CreateExec error: %v", err)
 	}
@@ -33,6 +38,10 @@ func (s *ServerRPC) ExecStart(stream types.PublicAPI_ExecStartServer) error {
 		return fmt.Errorf("stream.Recv error: %v", err)
 	}
 	glog.V(3).Infof("ExecStart with ServerStream %s request %s", stream, req.String())
+	container, err := pod.ScopedName(req.Namespace, req.ContainerID)
+	if err != nil {
+		return err
+	}
 
 	inReader, inWriter := io.Pipe()
 	outReader, outWriter := io.Pipe()
@@ -79,7 +88,7 @@ func (s *ServerRPC) ExecStart(stream types.PublicAPI_ExecStartServer) error {
 		}
 	}()
 
-	err = s.daemon.StartExec(inReader, outWriter, req.ContainerID, req.ExecID)
+	err = s.daemon.StartExec(inReader, outWriter, container, req.ExecID)
 	if err != nil {
 		return fmt.Errorf("s.daemon.StartExec with request %s error: %v", req.String(), err)
 	}
@@ -90,7 +99,11 @@ func (s *ServerRPC) ExecStart(stream types.PublicAPI_ExecStartServer) error {
 // Wait gets exitcode by container and processId
 func (s *ServerRPC) Wait(c context.Context, req *types.WaitRequest) (*types.WaitResponse, error) {
 	//FIXME need update if param NoHang is enabled
-	code, err := s.daemon.ExitCode(req.Container, req.ProcessId)
+	container, err := pod.ScopedName(req.Namespace, req.Container)
+	if err != nil {
+		return nil, err
+	}
+	code, err := s.daemon.ExitCode(container, req.ProcessId)
 	if err != nil {
 		return nil, err
 	}
@@ -100,9 +113,25 @@ func (s *ServerRPC) Wait(c context.Context, req *types.WaitRequest) (*types.Wait
 	}, nil
 }
 
+// ExecInfo gets ExecInfo by ID of exec
+func (s *ServerRPC) ExecInfo(ctx context.Context, req *types.ExecInfoRequest) (*types.ExecInfoResponse, error) {
+	info, err := s.daemon.GetExecInfo(req.ExecID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ExecInfoResponse{
+		ExecInfo: info,
+	}, nil
+}
+
 // ExecSignal sends a singal to specified exec of specified container
 func (s *ServerRPC) ExecSignal(ctx context.Context, req *types.ExecSignalRequest) (*types.ExecSignalResponse, error) {
-	err := s.daemon.KillExec(req.ContainerID, req.ExecID, req.Signal)
+	container, err := pod.ScopedName(req.Namespace, req.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.daemon.KillExec(container, req.ExecID, req.Signal)
 	if err != nil {
 		return nil, err
 	}
@@ -116,6 +145,10 @@ func (s *ServerRPC) ExecVM(stream types.PublicAPI_ExecVMServer) error {
 		return fmt.Errorf("stream.Recv error: %v", err)
 	}
 	glog.V(3).Infof("ExecVM with ServerStream %s request %s", stream, req.String())
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return err
+	}
 
 	cmd, err := json.Marshal(req.Command)
 	if err != nil {
@@ -172,7 +205,7 @@ func (s *ServerRPC) ExecVM(stream types.PublicAPI_ExecVMServer) error {
 		}
 	}()
 
-	code, err := s.daemon.ExecVM(req.PodID, string(cmd), inReader, outWriter, outWriter)
+	code, err := s.daemon.ExecVM(podID, string(cmd), inReader, outWriter, outWriter)
 	if err != nil {
 		return fmt.Errorf("s.daemon.ExecVM with request %s error: %v", req.String(), err)
 	}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	enginetypes "github.com/docker/engine-api/types"
 	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/daemon/daemonbuilder"
 	"github.com/hyperhq/hyperd/types"
 	"golang.org/x/net/context"
 )
@@ -37,6 +38,18 @@ func (s *ServerRPC) ImageList(ctx context.Context, req *types.ImageListRequest)
 	}, nil
 }
 
+// ImageInfo gets ImageInfo by ID or name of image
+func (s *ServerRPC) ImageInfo(ctx context.Context, req *types.ImageInfoRequest) (*types.ImageInfoResponse, error) {
+	info, err := s.daemon.GetImageInfo(req.Image)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ImageInfoResponse{
+		ImageInfo: info,
+	}, nil
+}
+
 // ImagePull pulls a image from registry
 func (s *ServerRPC) ImagePull(req *types.ImagePullRequest, stream types.PublicAPI_ImagePullServer) error {
 	authConfig := &enginetypes.AuthConfig{}
@@ -150,3 +163,99 @@ func (s *ServerRPC) ImageRemove(ctx context.Context, req *types.ImageRemoveReque
 		Images: resp,
 	}, nil
 }
+
+// ImageBuild implements POST /image/build
+func (s *ServerRPC) ImageBuild(stream types.PublicAPI_ImageBuildServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	repoAndTags, err := daemonbuilder.SanitizeRepoAndTags([]string{req.Name})
+	if err != nil {
+		return err
+	}
+
+	r := recvStream(req.Data, func() ([]byte, error) {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return req.Data, nil
+	})
+	// stop receiving if the build context ends before the end of the stream
+	defer r.CloseWithError(io.ErrClosedPipe)
+
+	context, dockerfileName, err := daemonbuilder.DetectContextFromRemoteURL(r, "", nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := context.Close(); err != nil {
+			glog.Infof("[BUILDER] failed to remove temporary context: %v", err)
+		}
+	}()
+
+	options := &enginetypes.ImageBuildOptions{
+		Remove:     true,
+		Dockerfile: dockerfileName,
+	}
+	output := streamWriter(func(data []byte) error {
+		return stream.Send(&types.ImageBuildResponse{Data: data})
+	})
+	_, err = daemonbuilder.Build(s.daemon, options, context, repoAndTags, map[string]enginetypes.AuthConfig{}, output, stream.Context().Done())
+	return err
+}
+
+// ImageLoad implements POST /image/load
+func (s *ServerRPC) ImageLoad(stream types.PublicAPI_ImageLoadServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	r := recvStream(req.Data, func() ([]byte, error) {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return req.Data, nil
+	})
+
+	err = s.daemon.LoadImage(r, req.Name, req.Refs, streamWriter(func(data []byte) error {
+		return stream.Send(&types.ImageLoadResponse{Data: data})
+	}))
+	// stop receiving if the load returns before the end of the stream
+	r.CloseWithError(io.ErrClosedPipe)
+	return err
+}
+
+// ImageSave implements GET /images/save
+func (s *ServerRPC) ImageSave(req *types.ImageSaveRequest, stream types.PublicAPI_ImageSaveServer) error {
+	return s.daemon.ExportImage(req.Names, req.Format, req.Refs, streamWriter(func(data []byte) error {
+		return stream.Send(&types.ImageSaveResponse{Data: data})
+	}))
+}
+
+// Auth auths a user to the specified docker registry
+func (s *ServerRPC) Auth(ctx context.Context, req *types.AuthRequest) (*types.AuthResponse, error) {
+	authConfig := &enginetypes.AuthConfig{}
+	if req.Auth != nil {
+		authConfig = &enginetypes.AuthConfig{
+			Username:      req.Auth.Username,
+			Password:      req.Auth.Password,
+			Auth:          req.Auth.Auth,
+			Email:         req.Auth.Email,
+			ServerAddress: req.Auth.Serveraddress,
+			RegistryToken: req.Auth.Registrytoken,
+		}
+	}
+
+	status, err := s.daemon.CmdAuthenticateToRegistry(authConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AuthResponse{
+		Status: status,
+	}, nil
+}
@@ -12,7 +12,7 @@ const (
 
 // PodInfo gets PodInfo by podID
 func (s *ServerRPC) PodInfo(c context.Context, req *types.PodInfoRequest) (*types.PodInfoResponse, error) {
-	info, err := s.daemon.GetPodInfo(req.PodID)
+	info, err := s.daemon.GetPodInfo(req.Namespace, req.PodID)
 	if err != nil {
 		return nil, err
 	}
@@ -22,9 +22,21 @@ func (s *ServerRPC) PodInfo(c context.Context, req *types.PodInfoRequest) (*type
 	}, nil
 }
 
+// PodExport gets the reusable spec of a pod by podID
+func (s *ServerRPC) PodExport(c context.Context, req *types.PodExportRequest) (*types.PodExportResponse, error) {
+	spec, err := s.daemon.ExportPodSpec(req.Namespace, req.PodID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.PodExportResponse{
+		PodSpec: spec,
+	}, nil
+}
+
 // ContainerInfo gets ContainerInfo by ID or name of container
 func (s *ServerRPC) ContainerInfo(c context.Context, req *types.ContainerInfoRequest) (*types.ContainerInfoResponse, error) {
-	info, err := s.daemon.GetContainerInfo(req.Container)
+	info, err := s.daemon.GetContainerInfo(req.Namespace, req.Container)
 	if err != nil {
 		return nil, err
 	}
@@ -56,3 +68,28 @@ func (s *ServerRPC) Version(c context.Context, req *types.VersionRequest) (*type
 func (s *ServerRPC) Ping(c context.Context, req *types.PingRequest) (*types.PingResponse, error) {
 	return &types.PingResponse{HyperdStats: "OK"}, nil
 }
+
+// ConfigReload reloads the config file of hyperd
+func (s *ServerRPC) ConfigReload(c context.Context, req *types.ConfigReloadRequest) (*types.ConfigReloadResponse, error) {
+	return s.daemon.ReloadConfig()
+}
+
+// SystemGC removes the storage left by the pods which no longer exist
+func (s *ServerRPC) SystemGC(c context.Context, req *types.SystemGCRequest) (*types.SystemGCResponse, error) {
+	orphans, err := s.daemon.StorageGC(req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SystemGCResponse{Orphans: orphans}, nil
+}
+
+// SystemDBBackup backs up the daemon db while hyperd is running
+func (s *ServerRPC) SystemDBBackup(c context.Context, req *types.SystemDBBackupRequest) (*types.SystemDBBackupResponse, error) {
+	n, err := s.daemon.BackupDB(req.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SystemDBBackupResponse{Path: req.Path, Records: int64(n)}, nil
+}
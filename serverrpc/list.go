@@ -7,7 +7,7 @@ import (
 
 // ContainerList implements GET /list?item=container
 func (s *ServerRPC) ContainerList(ctx context.Context, req *types.ContainerListRequest) (*types.ContainerListResponse, error) {
-	containerList, err := s.daemon.ListContainers(req.PodID, req.VmID)
+	containerList, err := s.daemon.ListContainers(req.Namespace, req.PodID, req.VmID)
 	if err != nil {
 		return nil, err
 	}
@@ -19,7 +19,7 @@ func (s *ServerRPC) ContainerList(ctx context.Context, req *types.ContainerListR
 
 // PodList implements GET /list?item=pod
 func (s *ServerRPC) PodList(ctx context.Context, req *types.PodListRequest) (*types.PodListResponse, error) {
-	podList, err := s.daemon.ListPods(req.PodID, req.VmID)
+	podList, err := s.daemon.ListPods(req.Namespace, req.PodID, req.VmID)
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +31,7 @@ func (s *ServerRPC) PodList(ctx context.Context, req *types.PodListRequest) (*ty
 
 // VMList implements GET /list?item=vm
 func (s *ServerRPC) VMList(ctx context.Context, req *types.VMListRequest) (*types.VMListResponse, error) {
-	vmList, err := s.daemon.ListVMs(req.PodID, req.VmID)
+	vmList, err := s.daemon.ListVMs(req.Namespace, req.PodID, req.VmID)
 	if err != nil {
 		return nil, err
 	}
@@ -9,11 +9,16 @@ import (
 	timetypes "github.com/docker/engine-api/types/time"
 	"github.com/golang/glog"
 	"github.com/hyperhq/hyperd/daemon"
+	"github.com/hyperhq/hyperd/daemon/pod"
 	"github.com/hyperhq/hyperd/types"
 )
 
 func (s *ServerRPC) ContainerLogs(req *types.ContainerLogsRequest, stream types.PublicAPI_ContainerLogsServer) error {
 	glog.V(3).Infof("ContainerLogs with ServerStream %s request %s", stream, req.String())
+	container, err := pod.ScopedName(req.Namespace, req.Container)
+	if err != nil {
+		return err
+	}
 
 	var since time.Time
 	if req.Since != "" {
@@ -40,9 +45,9 @@ func (s *ServerRPC) ContainerLogs(req *types.ContainerLogsRequest, stream types.
 	}
 
 	if logsConfig.Follow == true {
-		go s.daemon.GetContainerLogs(req.Container, logsConfig)
+		go s.daemon.GetContainerLogs(container, logsConfig)
 	} else {
-		err := s.daemon.GetContainerLogs(req.Container, logsConfig)
+		err := s.daemon.GetContainerLogs(container, logsConfig)
 		if err != nil {
 			return fmt.Errorf("s.daemon.GetContainerLogs with request %s error: %v", req.String(), err)
 		}
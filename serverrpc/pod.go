@@ -3,13 +3,26 @@ package serverrpc
 import (
 	"fmt"
 
+	"github.com/hyperhq/hyperd/daemon/pod"
 	"github.com/hyperhq/hyperd/types"
 	runvtypes "github.com/hyperhq/runv/hypervisor/types"
 	"golang.org/x/net/context"
 )
 
-// PodCreate creates a pod by PodSpec
+// PodCreate creates a pod by PodSpec or from a Kubernetes Pod manifest
 func (s *ServerRPC) PodCreate(ctx context.Context, req *types.PodCreateRequest) (*types.PodCreateResponse, error) {
+	if len(req.KubernetesPod) > 0 {
+		p, warnings, err := s.daemon.CreateKubernetesPod(req.PodID, req.KubernetesPod)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.PodCreateResponse{
+			PodID:    p.Id(),
+			Warnings: warnings,
+		}, nil
+	}
+
 	p, err := s.daemon.CreatePod(req.PodID, req.PodSpec)
 	if err != nil {
 		return nil, err
@@ -22,7 +35,11 @@ func (s *ServerRPC) PodCreate(ctx context.Context, req *types.PodCreateRequest)
 
 // PodStart starts a pod by podID
 func (s *ServerRPC) PodStart(ctx context.Context, req *types.PodStartRequest) (*types.PodStartResponse, error) {
-	err := s.daemon.StartPod(req.PodID)
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.daemon.StartPod(podID)
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +53,11 @@ func (s *ServerRPC) PodRemove(ctx context.Context, req *types.PodRemoveRequest)
 		return nil, fmt.Errorf("PodRemove failed PodID is required for PodRemove")
 	}
 
-	code, cause, err := s.daemon.RemovePod(req.PodID)
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return nil, err
+	}
+	code, cause, err := s.daemon.RemovePod(podID)
 	if err != nil {
 		return nil, fmt.Errorf("s.daemon.RemovePod error: %v", err)
 	}
@@ -49,7 +70,11 @@ func (s *ServerRPC) PodRemove(ctx context.Context, req *types.PodRemoveRequest)
 
 // PodStop stops a pod
 func (s *ServerRPC) PodStop(ctx context.Context, req *types.PodStopRequest) (*types.PodStopResponse, error) {
-	code, cause, err := s.daemon.StopPod(req.PodID)
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return nil, err
+	}
+	code, cause, err := s.daemon.StopPod(podID)
 	if err != nil {
 		return nil, err
 	}
@@ -62,7 +87,11 @@ func (s *ServerRPC) PodStop(ctx context.Context, req *types.PodStopRequest) (*ty
 
 // PodSignal sends a singal to all containers of specified pod
 func (s *ServerRPC) PodSignal(ctx context.Context, req *types.PodSignalRequest) (*types.PodSignalResponse, error) {
-	err := s.daemon.KillPodContainers(req.PodID, "", req.Signal)
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.daemon.KillPodContainers(podID, "", req.Signal)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +101,11 @@ func (s *ServerRPC) PodSignal(ctx context.Context, req *types.PodSignalRequest)
 
 // PodPause pauses a pod
 func (s *ServerRPC) PodPause(ctx context.Context, req *types.PodPauseRequest) (*types.PodPauseResponse, error) {
-	err := s.daemon.PausePod(req.PodID)
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.daemon.PausePod(podID)
 	if err != nil {
 		return nil, err
 	}
@@ -82,7 +115,11 @@ func (s *ServerRPC) PodPause(ctx context.Context, req *types.PodPauseRequest) (*
 
 // PodUnpause unpauses a pod
 func (s *ServerRPC) PodUnpause(ctx context.Context, req *types.PodUnpauseRequest) (*types.PodUnpauseResponse, error) {
-	err := s.daemon.UnpausePod(req.PodID)
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.daemon.UnpausePod(podID)
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +129,11 @@ func (s *ServerRPC) PodUnpause(ctx context.Context, req *types.PodUnpauseRequest
 
 // PodLabels sets the labels of Pod
 func (s *ServerRPC) SetPodLabels(c context.Context, req *types.PodLabelsRequest) (*types.PodLabelsResponse, error) {
-	err := s.daemon.SetPodLabels(req.PodID, req.Override, req.Labels)
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.daemon.SetPodLabels(podID, req.Override, req.Labels)
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +143,11 @@ func (s *ServerRPC) SetPodLabels(c context.Context, req *types.PodLabelsRequest)
 
 // PodStats get stats (runvtypes.PodStats) of Pod
 func (s *ServerRPC) PodStats(c context.Context, req *types.PodStatsRequest) (*types.PodStatsResponse, error) {
-	statsObject, err := s.daemon.GetPodStats(req.PodID)
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return nil, err
+	}
+	statsObject, err := s.daemon.GetPodStats(podID)
 	if err != nil {
 		return nil, err
 	}
@@ -262,3 +307,62 @@ func convertRunvFsToGrpcType(fs runvtypes.FsStats) *types.FsStats {
 	grpcFs.WeightedIoTime = fs.WeightedIoTime
 	return grpcFs
 }
+
+// PodWatch streams the changes of pods and their containers
+func (s *ServerRPC) PodWatch(req *types.PodWatchRequest, stream types.PublicAPI_PodWatchServer) error {
+	w, err := s.daemon.WatchPods(req.ResourceVersion)
+	if err != nil {
+		return err
+	}
+	defer w.Stop()
+
+	last := req.ResourceVersion
+	for {
+		select {
+		case ev, ok := <-w.C:
+			if !ok {
+				return fmt.Errorf("watcher is closed, resume from resource version %d", last)
+			}
+			if ev = filterWatchEvent(ev, req.Namespace); ev == nil {
+				continue
+			}
+			if err := stream.Send(ev); err != nil {
+				return fmt.Errorf("stream.Send with request %s error: %v", req.String(), err)
+			}
+			last = ev.ResourceVersion
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// filterWatchEvent drops the parts of the event out of the namespace
+func filterWatchEvent(ev *types.PodWatchEvent, namespace string) *types.PodWatchEvent {
+	if namespace == "" {
+		return ev
+	}
+	namespace = pod.NormalizeNamespace(namespace)
+	switch {
+	case ev.Pod != nil:
+		if ev.Pod.Pod.Namespace != namespace {
+			return nil
+		}
+	case ev.Container != nil:
+		if ns, _ := pod.SplitQualifiedName(ev.Container.PodID); ns != namespace {
+			return nil
+		}
+	case ev.Type == pod.WATCH_SNAPSHOT:
+		snapshot := []*types.PodWatchObject{}
+		for _, obj := range ev.Snapshot {
+			if obj.Pod.Namespace == namespace {
+				snapshot = append(snapshot, obj)
+			}
+		}
+		return &types.PodWatchEvent{
+			Type:            ev.Type,
+			ResourceVersion: ev.ResourceVersion,
+			Snapshot:        snapshot,
+		}
+	}
+	return ev
+}
@@ -2,13 +2,18 @@ package serverrpc
 
 import (
 	"fmt"
+	"github.com/hyperhq/hyperd/daemon/pod"
 	"github.com/hyperhq/hyperd/types"
 	"golang.org/x/net/context"
 )
 
 // PortMappingList get a list of PortMappings
 func (s *ServerRPC) PortMappingList(ctx context.Context, req *types.PortMappingListRequest) (*types.PortMappingListResponse, error) {
-	p, ok := s.daemon.PodList.Get(req.PodID)
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := s.daemon.PodList.Get(podID)
 	if !ok {
 		return nil, fmt.Errorf("Pod not found")
 	}
@@ -20,12 +25,16 @@ func (s *ServerRPC) PortMappingList(ctx context.Context, req *types.PortMappingL
 
 // PortMappingAdd add a list of PortMapping rules to a Pod
 func (s *ServerRPC) PortMappingAdd(ctx context.Context, req *types.PortMappingModifyRequest) (*types.PortMappingModifyResponse, error) {
-	p, ok := s.daemon.PodList.Get(req.PodID)
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := s.daemon.PodList.Get(podID)
 	if !ok {
 		return nil, fmt.Errorf("Pod not found")
 	}
 
-	err := p.AddPortMapping(req.PortMappings)
+	err = p.AddPortMapping(req.PortMappings)
 	if err != nil {
 		return nil, fmt.Errorf("p.AddPortMapping error: %v", err)
 	}
@@ -35,12 +44,16 @@ func (s *ServerRPC) PortMappingAdd(ctx context.Context, req *types.PortMappingMo
 
 // PortMappingDel remove a list of PortMapping rules from a Pod
 func (s *ServerRPC) PortMappingDel(ctx context.Context, req *types.PortMappingModifyRequest) (*types.PortMappingModifyResponse, error) {
-	p, ok := s.daemon.PodList.Get(req.PodID)
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := s.daemon.PodList.Get(podID)
 	if !ok {
 		return nil, fmt.Errorf("Pod not found")
 	}
 
-	err := p.RemovePortMappingByDest(req.PortMappings)
+	err = p.RemovePortMappingByDest(req.PortMappings)
 	if err != nil {
 		return nil, fmt.Errorf("p.RemovePortMappingByDest error: %v", err)
 	}
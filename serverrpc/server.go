@@ -36,7 +36,7 @@ func unaryLoger(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo
 		glog.Errorf("%s elapsed %s failed %v with request %s", info.FullMethod, elapsed, err, reqMsg)
 	}
 
-	return resp, err
+	return resp, rpcError(err)
 }
 
 func streamLoger(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
@@ -52,7 +52,7 @@ func streamLoger(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerI
 		glog.Errorf("%s elapsed %s failed %v with ServerStream %v", info.FullMethod, elapsed, err, ss)
 	}
 
-	return err
+	return rpcError(err)
 }
 
 // NewServerRPC creates a new ServerRPC
@@ -1,13 +1,23 @@
 package serverrpc
 
 import (
+	"github.com/hyperhq/hyperd/daemon/pod"
 	"github.com/hyperhq/hyperd/types"
 	"golang.org/x/net/context"
 )
 
 // ServiceList implements GET /service/list
 func (s *ServerRPC) ServiceList(ctx context.Context, req *types.ServiceListRequest) (*types.ServiceListResponse, error) {
-	services, err := s.daemon.GetServices(req.PodID)
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return nil, err
+	}
+	var services []*types.UserService
+	if req.Live {
+		services, err = s.daemon.GetLiveServices(podID)
+	} else {
+		services, err = s.daemon.GetServices(podID)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -19,7 +29,11 @@ func (s *ServerRPC) ServiceList(ctx context.Context, req *types.ServiceListReque
 
 // ServiceAdd implements POST /service/list
 func (s *ServerRPC) ServiceAdd(ctx context.Context, req *types.ServiceAddRequest) (*types.ServiceAddResponse, error) {
-	err := s.daemon.AddService(req.PodID, req.Services)
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.daemon.AddService(podID, req.Services)
 	if err != nil {
 		return nil, err
 	}
@@ -29,7 +43,11 @@ func (s *ServerRPC) ServiceAdd(ctx context.Context, req *types.ServiceAddRequest
 
 // ServiceDelete implements DELETE /service
 func (s *ServerRPC) ServiceDelete(ctx context.Context, req *types.ServiceDelRequest) (*types.ServiceDelResponse, error) {
-	err := s.daemon.DeleteService(req.PodID, req.Services)
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.daemon.DeleteService(podID, req.Services)
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +57,11 @@ func (s *ServerRPC) ServiceDelete(ctx context.Context, req *types.ServiceDelRequ
 
 // ServiceUpdate implements UPDATE /service
 func (s *ServerRPC) ServiceUpdate(ctx context.Context, req *types.ServiceUpdateRequest) (*types.ServiceUpdateResponse, error) {
-	err := s.daemon.UpdateService(req.PodID, req.Services)
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.daemon.UpdateService(podID, req.Services)
 	if err != nil {
 		return nil, err
 	}
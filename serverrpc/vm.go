@@ -1 +1,33 @@
 package serverrpc
+
+import (
+	"github.com/hyperhq/hyperd/types"
+	runvtypes "github.com/hyperhq/runv/hypervisor/types"
+	"golang.org/x/net/context"
+)
+
+// VMCreate creates a VM which is not associated with any pods
+func (s *ServerRPC) VMCreate(ctx context.Context, req *types.VMCreateRequest) (*types.VMCreateResponse, error) {
+	vmID, err := s.daemon.CreateVm(int(req.Cpu), int(req.Memory), req.Async)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.VMCreateResponse{
+		VmID: vmID,
+	}, nil
+}
+
+// VMRemove removes a VM which is not associated with any pods
+func (s *ServerRPC) VMRemove(ctx context.Context, req *types.VMRemoveRequest) (*types.VMRemoveResponse, error) {
+	if err := s.daemon.RemoveVm(req.VmID); err != nil {
+		return &types.VMRemoveResponse{
+			Code:  runvtypes.E_FAILED,
+			Cause: err.Error(),
+		}, nil
+	}
+
+	return &types.VMRemoveResponse{
+		Code: runvtypes.E_OK,
+	}, nil
+}
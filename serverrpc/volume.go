@@ -0,0 +1,184 @@
+package serverrpc
+
+import (
+	"io"
+
+	"github.com/hyperhq/hyperd/daemon/pod"
+	"github.com/hyperhq/hyperd/types"
+	"golang.org/x/net/context"
+)
+
+// VolumeCreate implements POST /volume/create
+func (s *ServerRPC) VolumeCreate(ctx context.Context, req *types.VolumeCreateRequest) (*types.VolumeCreateResponse, error) {
+	vol, err := s.daemon.CreateVolume(req.Name, req.Labels, req.Size_)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.VolumeCreateResponse{
+		Volume: vol,
+	}, nil
+}
+
+// VolumeList implements GET /volume/list
+func (s *ServerRPC) VolumeList(ctx context.Context, req *types.VolumeListRequest) (*types.VolumeListResponse, error) {
+	vols, err := s.daemon.ListVolumes()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.VolumeListResponse{
+		Volumes: vols,
+	}, nil
+}
+
+// VolumeInspect implements GET /volume/info
+func (s *ServerRPC) VolumeInspect(ctx context.Context, req *types.VolumeInspectRequest) (*types.VolumeInspectResponse, error) {
+	vol, err := s.daemon.GetVolume(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.VolumeInspectResponse{
+		Volume: vol,
+	}, nil
+}
+
+// VolumeRemove implements DELETE /volume
+func (s *ServerRPC) VolumeRemove(ctx context.Context, req *types.VolumeRemoveRequest) (*types.VolumeRemoveResponse, error) {
+	if err := s.daemon.RemoveVolume(req.Name); err != nil {
+		return nil, err
+	}
+
+	return &types.VolumeRemoveResponse{}, nil
+}
+
+// VolumeSnapshot implements POST /volume/snapshot
+func (s *ServerRPC) VolumeSnapshot(ctx context.Context, req *types.VolumeSnapshotRequest) (*types.VolumeSnapshotResponse, error) {
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := s.daemon.SnapshotVolume(podID, req.Volume, req.Name, req.Pause)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.VolumeSnapshotResponse{
+		Snapshot: snap,
+	}, nil
+}
+
+// VolumeSnapshotList implements GET /volume/snapshot/list
+func (s *ServerRPC) VolumeSnapshotList(ctx context.Context, req *types.VolumeSnapshotListRequest) (*types.VolumeSnapshotListResponse, error) {
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return nil, err
+	}
+	snaps, err := s.daemon.ListVolumeSnapshots(podID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.VolumeSnapshotListResponse{
+		Snapshots: snaps,
+	}, nil
+}
+
+// VolumeSnapshotRemove implements DELETE /volume/snapshot
+func (s *ServerRPC) VolumeSnapshotRemove(ctx context.Context, req *types.VolumeSnapshotRemoveRequest) (*types.VolumeSnapshotRemoveResponse, error) {
+	if err := s.daemon.RemoveVolumeSnapshot(req.Name); err != nil {
+		return nil, err
+	}
+
+	return &types.VolumeSnapshotRemoveResponse{}, nil
+}
+
+// VolumeClone implements POST /volume/clone
+func (s *ServerRPC) VolumeClone(ctx context.Context, req *types.VolumeCloneRequest) (*types.VolumeCloneResponse, error) {
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.daemon.CloneVolume(req.Snapshot, podID, req.Volume); err != nil {
+		return nil, err
+	}
+
+	return &types.VolumeCloneResponse{}, nil
+}
+
+// streamWriter sends what is written as the chunks of a stream
+type streamWriter func(data []byte) error
+
+func (w streamWriter) Write(p []byte) (int, error) {
+	if err := w(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// recvStream returns a pipe to read the chunks of a stream, starting with
+// the data of the first message which has been received by the caller.
+// The caller closes the pipe to stop receiving.
+func recvStream(data []byte, recv func() ([]byte, error)) *io.PipeReader {
+	r, w := io.Pipe()
+	go func() {
+		for {
+			if len(data) > 0 {
+				if _, err := w.Write(data); err != nil {
+					return
+				}
+			}
+			var err error
+			data, err = recv()
+			if err == io.EOF {
+				w.Close()
+				return
+			}
+			if err != nil {
+				w.CloseWithError(err)
+				return
+			}
+		}
+	}()
+	return r
+}
+
+// VolumeExport implements GET /volume/export
+func (s *ServerRPC) VolumeExport(req *types.VolumeExportRequest, stream types.PublicAPI_VolumeExportServer) error {
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return err
+	}
+	return s.daemon.ExportVolume(podID, req.Volume, streamWriter(func(data []byte) error {
+		return stream.Send(&types.VolumeExportResponse{Data: data})
+	}))
+}
+
+// VolumeImport implements POST /volume/import
+func (s *ServerRPC) VolumeImport(stream types.PublicAPI_VolumeImportServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	podID, err := pod.ScopedName(req.Namespace, req.PodID)
+	if err != nil {
+		return err
+	}
+
+	r := recvStream(req.Data, func() ([]byte, error) {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return req.Data, nil
+	})
+
+	err = s.daemon.ImportVolume(podID, req.Volume, r)
+	// stop receiving if the import returns before the end of the stream
+	r.CloseWithError(io.ErrClosedPipe)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(&types.VolumeImportResponse{})
+}
@@ -10,6 +10,7 @@ import (
 	"os"
 	"os/exec"
 	"path"
+	"strconv"
 	"strings"
 	"syscall"
 
@@ -67,6 +68,96 @@ func CreateNewDevice(containerId, devPrefix, rootPath string) error {
 	return nil
 }
 
+// BaseDeviceSize returns the size of the base device recorded by the graph
+// driver, which the devices of the containers are made from.
+func BaseDeviceSize(rootPath string) (int64, error) {
+	jsonData, err := ioutil.ReadFile(path.Join(rootPath, "metadata", "base"))
+	if err != nil {
+		return 0, err
+	}
+	var dat jsonMetadata
+	if err := json.Unmarshal(jsonData, &dat); err != nil {
+		return 0, err
+	}
+	return int64(dat.Size), nil
+}
+
+// saveDeviceSize records the new size of the device in the metadata of the
+// graph driver, so that the device is activated with the size again. The
+// other fields of the metadata are kept as is.
+func saveDeviceSize(containerId, rootPath string, size int64) error {
+	metadataFile := path.Join(rootPath, "metadata", containerId)
+	jsonData, err := ioutil.ReadFile(metadataFile)
+	if err != nil {
+		return err
+	}
+	dat := map[string]json.RawMessage{}
+	if err := json.Unmarshal(jsonData, &dat); err != nil {
+		return err
+	}
+	dat["size"] = json.RawMessage(strconv.FormatInt(size, 10))
+	if jsonData, err = json.Marshal(dat); err != nil {
+		return err
+	}
+	tmp := metadataFile + ".tmp"
+	if err := ioutil.WriteFile(tmp, jsonData, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, metadataFile); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+// ResizeDevice grows the active device of the container to size bytes, along
+// with its filesystem. The thin device allocates the space on demand, so only
+// the table of the device is reloaded with the new size. The size is recorded
+// in the metadata before the filesystem grows, a device activated later with
+// the old size would cut the filesystem.
+func ResizeDevice(containerId, devPrefix, rootPath string, size int64) error {
+	devName := fmt.Sprintf("%s-%s", devPrefix, containerId)
+	res, err := exec.Command("dmsetup", "table", devName).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to get table of %s: %s", devName, strings.TrimSpace(string(res)))
+	}
+	// 0 20971520 thin 253:2 1
+	fields := strings.Fields(string(res))
+	if len(fields) < 5 || fields[2] != "thin" {
+		return fmt.Errorf("unexpected table of %s: %s", devName, strings.TrimSpace(string(res)))
+	}
+	sectors := (size + 511) / 512
+	if current, _ := strconv.ParseInt(fields[1], 10, 64); current >= sectors {
+		return nil
+	}
+
+	if err := saveDeviceSize(containerId, rootPath, sectors*512); err != nil {
+		return fmt.Errorf("failed to save size of %s: %v", devName, err)
+	}
+	fields[1] = strconv.FormatInt(sectors, 10)
+	for _, args := range [][]string{
+		{"suspend", devName},
+		{"reload", devName, "--table", strings.Join(fields, " ")},
+		{"resume", devName},
+	} {
+		if res, err := exec.Command("dmsetup", args...).CombinedOutput(); err != nil {
+			exec.Command("dmsetup", "resume", devName).Run()
+			return fmt.Errorf("failed to %s %s: %v: %s", args[0], devName, err, string(res))
+		}
+	}
+
+	device := filepath.Join("/dev/mapper", devName)
+	fstype, err := ProbeFsType(device)
+	if err != nil {
+		return err
+	}
+	if err := storage.GrowFs(device, fstype); err != nil {
+		return err
+	}
+	glog.Infof("device %s is resized to %d bytes", devName, sectors*512)
+	return nil
+}
+
 func InjectFile(src io.Reader, containerId, devPrefix, target, basePath string, perm, uid, gid int) error {
 	if containerId == "" {
 		return fmt.Errorf("Please make sure the arguments are not NULL!\n")
@@ -243,7 +334,8 @@ func CreateVolume(poolName, volName, dev_id, mkfs string, size int, restore bool
 		return fmt.Errorf(string(res))
 	}
 
-	if restore == false {
+	// the filesystem of the encrypted volumes is made in the decrypted device
+	if restore == false && mkfs != "" {
 		parms = fmt.Sprintf("%s \"/dev/mapper/%s\"", mkfs, volName)
 		if res, err := exec.Command("/bin/sh", "-c", parms).CombinedOutput(); err != nil {
 			glog.Error(string(res))
@@ -280,6 +372,206 @@ func UnmapVolume(deviceFullPath string) error {
 	return nil
 }
 
+// PoolStatus is the space usage of a thin pool in bytes.
+type PoolStatus struct {
+	DataUsed      int64
+	DataTotal     int64
+	MetadataUsed  int64
+	MetadataTotal int64
+}
+
+// GetPoolStatus reads the usage of the thin pool from `dmsetup status`, which
+// reports the data in blocks of the pool and the metadata in 4k blocks.
+func GetPoolStatus(poolName string) (*PoolStatus, error) {
+	table, err := exec.Command("dmsetup", "table", poolName).CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get table of pool %s: %v: %s", poolName, err, string(table))
+	}
+	// 0 41943040 thin-pool 7:1 7:0 128 0
+	fields := strings.Fields(string(table))
+	if len(fields) < 6 || fields[2] != "thin-pool" {
+		return nil, fmt.Errorf("%s is not a thin pool: %s", poolName, string(table))
+	}
+	blockSectors, err := strconv.ParseInt(fields[5], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid block size of pool %s: %s", poolName, fields[5])
+	}
+
+	res, err := exec.Command("dmsetup", "status", poolName).CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status of pool %s: %v: %s", poolName, err, string(res))
+	}
+	// 0 41943040 thin-pool 1 203/32768 1251/163840 - rw discard_passdown
+	fields = strings.Fields(string(res))
+	if len(fields) < 6 {
+		return nil, fmt.Errorf("invalid status of pool %s: %s", poolName, string(res))
+	}
+	parse := func(field string, blockSize int64) (int64, int64, error) {
+		var used, total int64
+		if _, err := fmt.Sscanf(field, "%d/%d", &used, &total); err != nil {
+			return 0, 0, fmt.Errorf("invalid status of pool %s: %s", poolName, string(res))
+		}
+		return used * blockSize, total * blockSize, nil
+	}
+	status := &PoolStatus{}
+	if status.MetadataUsed, status.MetadataTotal, err = parse(fields[4], 4096); err != nil {
+		return nil, err
+	}
+	if status.DataUsed, status.DataTotal, err = parse(fields[5], blockSectors*512); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// checkBackingSpace returns an error if the filesystem of the sparse file
+// could not hold the file grown to size bytes, the blocks already allocated to
+// the file are not counted again.
+func checkBackingSpace(file string, size int64) error {
+	var st syscall.Stat_t
+	if err := syscall.Stat(file, &st); err != nil {
+		return err
+	}
+	var fs syscall.Statfs_t
+	if err := syscall.Statfs(filepath.Dir(file), &fs); err != nil {
+		return err
+	}
+	need := size - st.Blocks*512
+	avail := int64(fs.Bavail) * int64(fs.Bsize)
+	if need > avail {
+		return fmt.Errorf("the filesystem of %s has %d bytes available, %d bytes are needed for %d bytes", file, avail, need, size)
+	}
+	return nil
+}
+
+// ExtendPool grows the loopback-backed pool to size bytes. The data file and
+// its loop device are grown first, then the table of the pool is reloaded.
+// The pool is not extended beyond what the filesystem of the data file could
+// hold, otherwise the pool would fail when the thin devices write to it.
+func ExtendPool(dm *DeviceMapper, size int) error {
+	table, err := exec.Command("dmsetup", "table", dm.PoolName).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to get table of pool %s: %v: %s", dm.PoolName, err, string(table))
+	}
+	fields := strings.Fields(string(table))
+	if len(fields) < 3 || fields[2] != "thin-pool" {
+		return fmt.Errorf("%s is not a thin pool: %s", dm.PoolName, string(table))
+	}
+	if current, _ := strconv.Atoi(fields[1]); current >= size/512 {
+		return nil
+	}
+
+	if err := checkBackingSpace(dm.Datafile, int64(size)); err != nil {
+		return err
+	}
+	if err := os.Truncate(dm.Datafile, int64(size)); err != nil {
+		return err
+	}
+	if res, err := exec.Command("losetup", "-c", dm.DataLoopFile).CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to resize %s: %v: %s", dm.DataLoopFile, err, string(res))
+	}
+	fields[1] = strconv.Itoa(size / 512)
+	for _, args := range [][]string{
+		{"suspend", dm.PoolName},
+		{"reload", dm.PoolName, "--table", strings.Join(fields, " ")},
+		{"resume", dm.PoolName},
+	} {
+		if res, err := exec.Command("dmsetup", args...).CombinedOutput(); err != nil {
+			// never leave the pool suspended
+			exec.Command("dmsetup", "resume", dm.PoolName).Run()
+			return fmt.Errorf("failed to %s pool %s: %v: %s", args[0], dm.PoolName, err, string(res))
+		}
+	}
+	dm.Size = size
+	glog.Infof("pool %s extended to %d bytes", dm.PoolName, size)
+	return nil
+}
+
+// ListVolumes returns the names of the active thin devices of the pool.
+func ListVolumes(poolName string) ([]string, error) {
+	res, err := exec.Command("dmsetup", "ls", "--target", "thin").CombinedOutput()
+	if err != nil {
+		glog.Error(string(res))
+		return nil, fmt.Errorf(string(res))
+	}
+	volumes := []string{}
+	for _, line := range strings.Split(string(res), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || !strings.HasPrefix(fields[0], poolName+"-") {
+			continue
+		}
+		volumes = append(volumes, fields[0])
+	}
+	return volumes, nil
+}
+
+// CreateSnapshot creates a thin snapshot of the thin device in the pool. The
+// volume of the device, if given and active, is suspended during the snapshot,
+// so that the data are flushed.
+func CreateSnapshot(poolName, volName, origin_id, snap_id string) error {
+	active := false
+	if _, err := os.Stat("/dev/mapper/" + volName); volName != "" && err == nil {
+		active = true
+		if res, err := exec.Command("dmsetup", "suspend", volName).CombinedOutput(); err != nil {
+			glog.Error(string(res))
+			return fmt.Errorf(string(res))
+		}
+		defer func() {
+			if res, err := exec.Command("dmsetup", "resume", volName).CombinedOutput(); err != nil {
+				glog.Errorf("failed to resume %s: %s", volName, string(res))
+			}
+		}()
+	}
+	glog.V(1).Infof("create snapshot %s of %s (active: %v)", snap_id, origin_id, active)
+
+	parms := fmt.Sprintf("dmsetup message /dev/mapper/%s 0 \"create_snap %s %s\"", poolName, snap_id, origin_id)
+	if res, err := exec.Command("/bin/sh", "-c", parms).CombinedOutput(); err != nil {
+		glog.Error(string(res))
+		return fmt.Errorf(string(res))
+	}
+	return nil
+}
+
+// VolumeSize returns the size of the active thin volume, in bytes.
+func VolumeSize(volName string) (int, error) {
+	// 0 20971520 thin 253:2 1
+	res, err := exec.Command("dmsetup", "table", volName).CombinedOutput()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get table of %s: %s", volName, strings.TrimSpace(string(res)))
+	}
+	fields := strings.Fields(string(res))
+	if len(fields) < 3 || fields[2] != "thin" {
+		return 0, fmt.Errorf("unexpected table of %s: %s", volName, strings.TrimSpace(string(res)))
+	}
+	sectors, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, err
+	}
+	return sectors * 512, nil
+}
+
+// VolumeUsage returns the space of the thin pool mapped by the thin volume,
+// in bytes.
+func VolumeUsage(volName string) (int64, error) {
+	// 0 20971520 thin 1024 20971519
+	res, err := exec.Command("dmsetup", "status", volName).CombinedOutput()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get status of %s: %s", volName, strings.TrimSpace(string(res)))
+	}
+	fields := strings.Fields(string(res))
+	if len(fields) < 4 || fields[2] != "thin" {
+		return 0, fmt.Errorf("unexpected status of %s: %s", volName, strings.TrimSpace(string(res)))
+	}
+	if fields[3] == "-" {
+		// the thin device has failed
+		return 0, fmt.Errorf("thin device %s is failed", volName)
+	}
+	mapped, err := strconv.ParseInt(fields[3], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return mapped * 512, nil
+}
+
 func DeleteVolume(dm *DeviceMapper, dev_id int) error {
 	var parms string
 	// Delete the thin pool for test
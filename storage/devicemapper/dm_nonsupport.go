@@ -23,6 +23,14 @@ func CreateNewDevice(containerId, devPrefix, rootPath string) error {
 	return nil
 }
 
+func BaseDeviceSize(rootPath string) (int64, error) {
+	return 0, nil
+}
+
+func ResizeDevice(containerId, devPrefix, rootPath string, size int64) error {
+	return nil
+}
+
 func AttachFiles(containerId, devPrefix, fromFile, toDir, rootPath, perm, uid, gid string) error {
 	return nil
 }
@@ -64,6 +72,37 @@ func CreateVolume(poolName, volName, dev_id string, size int, restore bool) erro
 	return nil
 }
 
+type PoolStatus struct {
+	DataUsed      int64
+	DataTotal     int64
+	MetadataUsed  int64
+	MetadataTotal int64
+}
+
+func GetPoolStatus(poolName string) (*PoolStatus, error) {
+	return nil, fmt.Errorf("devicemapper is not supported")
+}
+
+func ExtendPool(dm *DeviceMapper, size int) error {
+	return nil
+}
+
+func ListVolumes(poolName string) ([]string, error) {
+	return nil, nil
+}
+
+func CreateSnapshot(poolName, volName, origin_id, snap_id string) error {
+	return nil
+}
+
+func VolumeSize(volName string) (int, error) {
+	return 0, nil
+}
+
+func VolumeUsage(volName string) (int64, error) {
+	return 0, nil
+}
+
 func DeleteVolume(dm *DeviceMapper, dev_id int) error {
 	return nil
 }
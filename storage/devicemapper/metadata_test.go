@@ -0,0 +1,56 @@
+// +build linux
+
+package devicemapper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDeviceSizeMetadata(t *testing.T) {
+	root, err := ioutil.TempDir("", "dm-metadata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	if err := os.MkdirAll(filepath.Join(root, "metadata"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	base := `{"device_id":1,"size":10737418240,"transaction_id":1,"initialized":true,"deleted":false}`
+	if err := ioutil.WriteFile(filepath.Join(root, "metadata", "base"), []byte(base), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(root, "metadata", "c1"), []byte(base), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if size, err := BaseDeviceSize(root); err != nil || size != 10<<30 {
+		t.Fatalf("expect base size %d, got %d, %v", int64(10<<30), size, err)
+	}
+
+	if err := saveDeviceSize("c1", root, 20<<30); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(root, "metadata", "c1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dat := map[string]interface{}{}
+	if err := json.Unmarshal(data, &dat); err != nil {
+		t.Fatal(err)
+	}
+	if dat["size"] != float64(20<<30) || dat["device_id"] != float64(1) || dat["deleted"] != false {
+		t.Fatalf("unexpected metadata after resize: %s", data)
+	}
+	if _, err := os.Stat(filepath.Join(root, "metadata", "c1.tmp")); !os.IsNotExist(err) {
+		t.Fatalf("the temporary metadata is left: %v", err)
+	}
+
+	if err := saveDeviceSize("missing", root, 20<<30); err == nil {
+		t.Fatal("the size of an unknown device is saved")
+	}
+}
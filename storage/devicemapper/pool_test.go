@@ -0,0 +1,90 @@
+// +build linux
+
+package devicemapper
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// fakeDmsetup puts a dmsetup in PATH, which prints the table and the status
+// of a pool.
+func fakeDmsetup(t *testing.T, dir, table, status string) func() {
+	script := fmt.Sprintf("#!/bin/sh\ncase $1 in table) echo '%s';; status) echo '%s';; *) exit 1;; esac\n", table, status)
+	if err := ioutil.WriteFile(filepath.Join(dir, "dmsetup"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := os.Getenv("PATH")
+	os.Setenv("PATH", dir+":"+path)
+	return func() { os.Setenv("PATH", path) }
+}
+
+func TestGetPoolStatus(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dm-pool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// 128 sectors blocks, metadata in 4k blocks
+	restore := fakeDmsetup(t, dir, "0 41943040 thin-pool 7:1 7:0 128 0", "0 41943040 thin-pool 1 203/32768 1251/163840 - rw discard_passdown")
+	st, err := GetPoolStatus("hyper-volume-pool")
+	restore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := PoolStatus{
+		DataUsed:      1251 * 128 * 512,
+		DataTotal:     163840 * 128 * 512,
+		MetadataUsed:  203 * 4096,
+		MetadataTotal: 32768 * 4096,
+	}
+	if *st != expected {
+		t.Fatalf("expect %+v, got %+v", expected, *st)
+	}
+
+	for _, c := range [][2]string{
+		{"0 41943040 linear 7:1 0", "0 41943040 thin-pool 1 203/32768 1251/163840 -"},
+		{"0 41943040 thin-pool 7:1 7:0 128 0", "0 41943040 thin-pool 1 Fail"},
+		{"0 41943040 thin-pool 7:1 7:0 128 0", "0 41943040 thin-pool 1 203 1251/163840 -"},
+	} {
+		restore := fakeDmsetup(t, dir, c[0], c[1])
+		_, err := GetPoolStatus("hyper-volume-pool")
+		restore()
+		if err == nil {
+			t.Fatalf("expect table %q and status %q refused", c[0], c[1])
+		}
+	}
+}
+
+func TestCheckBackingSpace(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dm-pool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	data := filepath.Join(dir, "data")
+	if err := ioutil.WriteFile(data, make([]byte, 4096), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkBackingSpace(data, 8192); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkBackingSpace(data, 1<<62); err == nil {
+		t.Fatal("the pool is extended beyond the filesystem")
+	}
+
+	restore := fakeDmsetup(t, dir, "0 8 thin-pool 7:1 7:0 128 0", "")
+	defer restore()
+	if err := ExtendPool(&DeviceMapper{PoolName: "hyper-volume-pool", Datafile: data}, 1<<62); err == nil || !strings.Contains(err.Error(), "bytes available") {
+		t.Fatalf("expect the pool not extended beyond the filesystem, got %v", err)
+	}
+	if fi, _ := os.Stat(data); fi.Size() != 4096 {
+		t.Fatalf("the data file is grown to %d", fi.Size())
+	}
+}
@@ -1,7 +1,10 @@
 package rawblock
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -13,6 +16,7 @@ import (
 	"github.com/docker/docker/pkg/parsers"
 	"github.com/docker/go-units"
 	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/storage"
 	"github.com/opencontainers/runc/libcontainer/label"
 	//"github.com/docker/docker/pkg/mount"
 )
@@ -21,14 +25,21 @@ func init() {
 	graphdriver.Register("rawblock", Init)
 }
 
+const (
+	FormatRaw   = "raw"
+	FormatQcow2 = "qcow2"
+)
+
 // Driver holds information about the driver, home directory of the driver.
 // Driver implements graphdriver.ProtoDriver. It uses only basic vfs operations.
-// In order to support layering, the block is created via reflink with the the parent layer
+// In order to support layering, the block is created via reflink with the the parent layer,
+// or as a qcow2 image backed by the parent layer in the qcow2 format.
 // Driver must be wrapped in NaiveDiffDriver to be used as a graphdriver.Driver
 type Driver struct {
 	home      string
 	backingFs string // host filesystem of the storage
 	cow       bool
+	format    string // format of the block, raw or qcow2
 	blockFs   string // filesystem inside the block
 	blockSize uint64 // block size in GB
 	uid       int
@@ -53,11 +64,23 @@ func testReflinkSupport(home string) bool {
 	return true
 }
 
+// qcow2Supported tests if the qemu tools to create and mount the qcow2 images
+// are available.
+func qcow2Supported() bool {
+	for _, tool := range []string{"qemu-img", "qemu-nbd"} {
+		if _, err := exec.LookPath(tool); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
 // Init returns a new Raw Block driver.
 // This sets the home directory for the driver and returns NaiveDiffDriver.
 func Init(home string, options []string, uidMaps, gidMaps []idtools.IDMap) (graphdriver.Driver, error) {
 	backingFs := "<unknown>"
-	blockFs := "xfs" // TODO: make it configurable
+	blockFs := "xfs"
+	format := FormatRaw
 	cow := true
 	supported := "supported"
 
@@ -105,15 +128,39 @@ func Init(home string, options []string, uidMaps, gidMaps []idtools.IDMap) (grap
 				return nil, err
 			}
 			blockSize = uint64(size)
+		case "rawblock.fs":
+			if val != "xfs" && val != "ext4" {
+				return nil, fmt.Errorf("rawblock: Unsupported filesystem for the block: %s", val)
+			}
+			blockFs = val
+		case "rawblock.format":
+			if val != FormatRaw && val != FormatQcow2 {
+				return nil, fmt.Errorf("rawblock: Unsupported format for the block: %s", val)
+			}
+			format = val
 		default:
 			return nil, fmt.Errorf("rawblock: Unknown option %s\n", key)
 		}
 	}
 
+	// qcow2 is only used when asked for, the existing layers of the upgraded
+	// hosts are raw blocks, and the qcow2 layers are backed by their parents
+	// in the formats of the parents
+	if format == FormatQcow2 {
+		if !qcow2Supported() {
+			return nil, fmt.Errorf("rawblock: qemu-img and qemu-nbd are required by the qcow2 format")
+		}
+		if out, err := exec.Command("modprobe", "nbd").CombinedOutput(); err != nil {
+			glog.Warningf("RawBlock: failed to load the nbd module: %v: %s", err, string(out))
+		}
+	}
+	glog.Infof("RawBlock: the blocks are in %s format with %s", format, blockFs)
+
 	d := &Driver{
 		home:      home,
 		backingFs: backingFs,
 		cow:       cow,
+		format:    format,
 		blockFs:   blockFs,
 		blockSize: blockSize,
 		uid:       rootUID,
@@ -132,6 +179,7 @@ func (d *Driver) Status() [][2]string {
 	return [][2]string{
 		{"Backing Filesystem", d.backingFs},
 		{"Support Copy-On-Write", fmt.Sprintf("%v", d.cow)},
+		{"New Layer Format", d.format},
 		{"Block Filesystem", d.blockFs},
 		{"Block Size", fmt.Sprintf("%s", units.HumanSize(float64(d.blockSize)))},
 	}
@@ -152,6 +200,13 @@ func (d *Driver) Create(id, parent, mountLabel string) error {
 	if err := idtools.MkdirAllAs(filepath.Dir(d.block(id)), 0700, d.uid, d.gid); err != nil {
 		return err
 	}
+	if d.format == FormatQcow2 {
+		backing := ""
+		if parent != "" {
+			backing = d.block(parent)
+		}
+		return CreateQcow2Block(d.block(id), backing, d.blockFs, mountLabel, d.blockSize)
+	}
 	if parent == "" {
 		return CreateBlock(d.block(id), d.blockFs, mountLabel, d.blockSize)
 	}
@@ -221,6 +276,8 @@ func CreateBlock(block, fstype, mountLabel string, size uint64) error {
 		return fmt.Errorf("Failed to create block:%v:%s", err, string(out))
 	}
 	switch fstype {
+	case "":
+		// the filesystem is made later, e.g. in the encrypted device
 	case "xfs":
 		if out, err := exec.Command("mkfs.xfs", "-f", block).CombinedOutput(); err != nil {
 			os.RemoveAll(block)
@@ -238,6 +295,150 @@ func CreateBlock(block, fstype, mountLabel string, size uint64) error {
 	return nil
 }
 
+// CreateQcow2Block creates a qcow2 image backed by the parent image, which is
+// either a raw block or a qcow2 image, or a new qcow2 image with the filesystem
+// if there is no parent.
+func CreateQcow2Block(block, parent, fstype, mountLabel string, size uint64) error {
+	if parent != "" {
+		backingFmt := FormatRaw
+		if IsQcow2(parent) {
+			backingFmt = FormatQcow2
+		}
+		opts := fmt.Sprintf("backing_file=%s,backing_fmt=%s", parent, backingFmt)
+		if out, err := exec.Command("qemu-img", "create", "-f", FormatQcow2, "-o", opts, block).CombinedOutput(); err != nil {
+			os.RemoveAll(block)
+			return fmt.Errorf("Failed to create qcow2 block:%v:%s", err, string(out))
+		}
+		return nil
+	}
+
+	// make the filesystem in a sparse raw block, which is much easier than in
+	// the qcow2 image, and converting the sparse block is cheap
+	raw := block + ".raw"
+	if err := CreateBlock(raw, fstype, mountLabel, size); err != nil {
+		return err
+	}
+	defer os.RemoveAll(raw)
+	if out, err := exec.Command("qemu-img", "convert", "-f", FormatRaw, "-O", FormatQcow2, raw, block).CombinedOutput(); err != nil {
+		os.RemoveAll(block)
+		return fmt.Errorf("Failed to convert block to qcow2:%v:%s", err, string(out))
+	}
+	return nil
+}
+
+// IsQcow2 tests if the block is a qcow2 image by its magic.
+func IsQcow2(block string) bool {
+	f, err := os.Open(block)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	magic := make([]byte, 4)
+	if _, err := io.ReadFull(f, magic); err != nil {
+		return false
+	}
+	return string(magic) == "QFI\xfb"
+}
+
+// BlockSize returns the virtual size of the block in bytes.
+func BlockSize(block string) (int64, error) {
+	if !IsQcow2(block) {
+		fi, err := os.Stat(block)
+		if err != nil {
+			return 0, err
+		}
+		return fi.Size(), nil
+	}
+	out, err := exec.Command("qemu-img", "info", "--output=json", block).CombinedOutput()
+	if err != nil {
+		return 0, fmt.Errorf("Failed to get info of block %s:%v:%s", block, err, string(out))
+	}
+	var info struct {
+		VirtualSize int64 `json:"virtual-size"`
+	}
+	if err := json.Unmarshal(out, &info); err != nil {
+		return 0, err
+	}
+	return info.VirtualSize, nil
+}
+
+// ResizeBlock grows the block and its filesystem to size bytes, the qcow2
+// images are exported as nbd devices to grow their filesystems.
+func ResizeBlock(block, fstype string, size int64) error {
+	current, err := BlockSize(block)
+	if err != nil {
+		return err
+	}
+	if current >= size {
+		return nil
+	}
+
+	if !IsQcow2(block) {
+		if err := os.Truncate(block, size); err != nil {
+			return err
+		}
+		return storage.GrowFs(block, fstype)
+	}
+
+	if out, err := exec.Command("qemu-img", "resize", "-f", FormatQcow2, block, fmt.Sprintf("%d", size)).CombinedOutput(); err != nil {
+		return fmt.Errorf("Failed to resize block %s:%v:%s", block, err, string(out))
+	}
+	nbd, err := ConnectNbd(block)
+	if err != nil {
+		return err
+	}
+	defer DisconnectNbd(nbd)
+	return storage.GrowFs(nbd, fstype)
+}
+
+// nbdLock serializes the lookup and the connection of the nbd devices.
+var nbdLock sync.Mutex
+
+// ConnectNbd exports the qcow2 image as a free nbd device.
+func ConnectNbd(block string) (string, error) {
+	nbdLock.Lock()
+	defer nbdLock.Unlock()
+
+	devs, _ := filepath.Glob("/sys/block/nbd*")
+	for _, dev := range devs {
+		// the pid file exists while the device is connected
+		if _, err := os.Stat(filepath.Join(dev, "pid")); err == nil {
+			continue
+		}
+		nbd := filepath.Join("/dev", filepath.Base(dev))
+		out, err := exec.Command("qemu-nbd", "-c", nbd, "-f", FormatQcow2, block).CombinedOutput()
+		if err != nil {
+			glog.V(1).Infof("failed to connect %s to %s: %v: %s", block, nbd, err, string(out))
+			continue
+		}
+		return nbd, nil
+	}
+	return "", fmt.Errorf("Failed to find a free nbd device for %s, is the nbd module loaded?", block)
+}
+
+// DisconnectNbd stops exporting the image of the nbd device.
+func DisconnectNbd(nbd string) error {
+	if out, err := exec.Command("qemu-nbd", "-d", nbd).CombinedOutput(); err != nil {
+		return fmt.Errorf("Failed to disconnect %s:%v:%s", nbd, err, string(out))
+	}
+	return nil
+}
+
+// mountSource returns the source of the mount point from /proc/self/mounts.
+func mountSource(mnt string) string {
+	data, err := ioutil.ReadFile("/proc/self/mounts")
+	if err != nil {
+		return ""
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 1 && fields[1] == mnt {
+			return fields[0]
+		}
+	}
+	return ""
+}
+
 func joinMountOptions(a, b string) string {
 	if a == "" {
 		return b
@@ -249,8 +450,21 @@ func joinMountOptions(a, b string) string {
 }
 
 func mount(block, mnt, fstype string, mountLabel string) error {
-	options := "loop"
+	if IsQcow2(block) {
+		nbd, err := ConnectNbd(block)
+		if err != nil {
+			return err
+		}
+		if err := mountBlock(nbd, mnt, fstype, mountLabel, ""); err != nil {
+			DisconnectNbd(nbd)
+			return err
+		}
+		return nil
+	}
+	return mountBlock(block, mnt, fstype, mountLabel, "loop")
+}
 
+func mountBlock(block, mnt, fstype, mountLabel, options string) error {
 	if fstype == "xfs" {
 		// XFS needs nouuid or it can't mount filesystems with the same fs
 		options = joinMountOptions(options, "nouuid")
@@ -258,7 +472,11 @@ func mount(block, mnt, fstype string, mountLabel string) error {
 
 	options = joinMountOptions(options, label.FormatMountLabel("", mountLabel))
 
-	if out, err := exec.Command("mount", "-t", fstype, "-o", options, block, mnt).CombinedOutput(); err != nil {
+	args := []string{"-t", fstype}
+	if options != "" {
+		args = append(args, "-o", options)
+	}
+	if out, err := exec.Command("mount", append(args, block, mnt)...).CombinedOutput(); err != nil {
 		return fmt.Errorf("Failed to mount block:%v:%s", err, string(out))
 	}
 	return nil
@@ -289,9 +507,15 @@ func GetImage(blockBase, mntBase, id, fstype, mountLabel string, uid, gid int) e
 
 func PutImage(mntBase, id string) error {
 	mnt := filepath.Join(mntBase, id)
+	source := mountSource(mnt)
 	if out, err := exec.Command("umount", "-d", mnt).CombinedOutput(); err != nil {
 		return fmt.Errorf("Failed to umount block:%v:%s", err, string(out))
 	}
+	if strings.HasPrefix(source, "/dev/nbd") {
+		if err := DisconnectNbd(source); err != nil {
+			glog.Error(err)
+		}
+	}
 	os.RemoveAll(mnt)
 	return nil
 }
@@ -0,0 +1,119 @@
+package rawblock
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// fakeQemuImg puts a qemu-img in PATH which logs its arguments and writes
+// the qcow2 magic to the image, i.e. the last argument.
+func fakeQemuImg(t *testing.T, dir string) (string, func()) {
+	bin := filepath.Join(dir, "bin")
+	if err := os.MkdirAll(bin, 0755); err != nil {
+		t.Fatal(err)
+	}
+	log := filepath.Join(dir, "qemu-img.log")
+	script := fmt.Sprintf("#!/bin/sh\necho \"$@\" >> %s\nfor last; do :; done\nprintf 'QFI\\373' > \"$last\"\n", log)
+	if err := ioutil.WriteFile(filepath.Join(bin, "qemu-img"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := os.Getenv("PATH")
+	os.Setenv("PATH", bin+":"+path)
+	return log, func() { os.Setenv("PATH", path) }
+}
+
+func newTestDriver(home, format string) *Driver {
+	return &Driver{
+		home:      home,
+		format:    format,
+		blockSize: 1024 * 1024,
+		uid:       os.Getuid(),
+		gid:       os.Getgid(),
+		active:    map[string]int{},
+	}
+}
+
+func lastLine(t *testing.T, log string) string {
+	data, err := ioutil.ReadFile(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	return lines[len(lines)-1]
+}
+
+func TestCreateQcow2(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rawblock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	log, restore := fakeQemuImg(t, dir)
+	defer restore()
+
+	d := newTestDriver(dir, FormatQcow2)
+
+	// the base layer has no backing file
+	if err := d.Create("base", "", ""); err != nil {
+		t.Fatal(err)
+	}
+	line := lastLine(t, log)
+	if strings.Contains(line, "backing_file") || !strings.HasPrefix(line, "convert ") || !strings.HasSuffix(line, d.block("base")) {
+		t.Fatalf("unexpected qemu-img call for the base layer: %s", line)
+	}
+	if _, err := os.Stat(d.block("base") + ".raw"); !os.IsNotExist(err) {
+		t.Fatalf("the raw block of the base layer is left: %v", err)
+	}
+	if !IsQcow2(d.block("base")) {
+		t.Fatal("the base layer is not qcow2")
+	}
+
+	if err := d.Create("child", "base", ""); err != nil {
+		t.Fatal(err)
+	}
+	expect := fmt.Sprintf("create -f qcow2 -o backing_file=%s,backing_fmt=qcow2 %s", d.block("base"), d.block("child"))
+	if line := lastLine(t, log); line != expect {
+		t.Fatalf("expect %q, got %q", expect, line)
+	}
+
+	// the raw layers created before switching to qcow2
+	if err := newTestDriver(dir, FormatRaw).Create("rawbase", "", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Create("rawchild", "rawbase", ""); err != nil {
+		t.Fatal(err)
+	}
+	expect = fmt.Sprintf("create -f qcow2 -o backing_file=%s,backing_fmt=raw %s", d.block("rawbase"), d.block("rawchild"))
+	if line := lastLine(t, log); line != expect {
+		t.Fatalf("expect %q, got %q", expect, line)
+	}
+}
+
+func TestCreateRaw(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rawblock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	d := newTestDriver(dir, FormatRaw)
+	if err := d.Create("base", "", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Create("child", "base", ""); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"base", "child"} {
+		size, err := BlockSize(d.block(id))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if size != int64(d.blockSize) || IsQcow2(d.block(id)) {
+			t.Fatalf("unexpected block %s of size %d", id, size)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// GrowFs grows the filesystem on the device or the image file to fill it. The
+// ext filesystems are resized offline, and xfs is mounted to a temporary
+// directory as it could only be grown online.
+func GrowFs(source, fstype string) error {
+	switch fstype {
+	case "ext2", "ext3", "ext4":
+		// resize2fs requires a clean filesystem, the exit code of e2fsck is
+		// 1 if errors are corrected
+		if err := exec.Command("e2fsck", "-f", "-y", source).Run(); err != nil {
+			if ee, ok := err.(*exec.ExitError); !ok || ee.Sys().(syscall.WaitStatus).ExitStatus() > 1 {
+				return fmt.Errorf("failed to check the filesystem of %s: %v", source, err)
+			}
+		}
+		if out, err := exec.Command("resize2fs", source).CombinedOutput(); err != nil {
+			return fmt.Errorf("failed to resize the filesystem of %s: %v: %s", source, err, string(out))
+		}
+	case "xfs":
+		mnt, err := ioutil.TempDir("", "hyper-growfs")
+		if err != nil {
+			return err
+		}
+		defer os.Remove(mnt)
+		// the layers of the same image share the uuid of the filesystem
+		opts := "nouuid"
+		if fi, err := os.Stat(source); err == nil && fi.Mode().IsRegular() {
+			opts += ",loop"
+		}
+		if out, err := exec.Command("mount", "-t", "xfs", "-o", opts, source, mnt).CombinedOutput(); err != nil {
+			return fmt.Errorf("failed to mount %s: %v: %s", source, err, string(out))
+		}
+		defer exec.Command("umount", "-d", mnt).Run()
+		if out, err := exec.Command("xfs_growfs", mnt).CombinedOutput(); err != nil {
+			return fmt.Errorf("failed to grow the filesystem of %s: %v: %s", source, err, string(out))
+		}
+	default:
+		return fmt.Errorf("growing %s filesystem is not supported", fstype)
+	}
+	return nil
+}
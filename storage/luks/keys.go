@@ -0,0 +1,87 @@
+package luks
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// KeyProvider gives the keys of the encrypted volumes by their names.
+type KeyProvider interface {
+	Key(name string) ([]byte, error)
+}
+
+// DefaultKeyProvider is used when the key reference has no provider.
+const DefaultKeyProvider = "file"
+
+var (
+	providersLock sync.RWMutex
+	providers     = make(map[string]KeyProvider)
+)
+
+// RegisterKeyProvider registers the key provider, or replaces the provider
+// registered with the same name.
+func RegisterKeyProvider(name string, p KeyProvider) {
+	providersLock.Lock()
+	defer providersLock.Unlock()
+	providers[name] = p
+}
+
+// ParseKeyRef splits the key reference in the format "[provider:]name".
+func ParseKeyRef(ref string) (provider, name string, err error) {
+	provider, name = DefaultKeyProvider, ref
+	if i := strings.Index(ref, ":"); i >= 0 {
+		provider, name = ref[:i], ref[i+1:]
+	}
+	if provider == "" || name == "" {
+		return "", "", fmt.Errorf("invalid key reference %q, format: [provider:]name", ref)
+	}
+	return provider, name, nil
+}
+
+// GetKey returns the key of the reference from its provider.
+func GetKey(ref string) ([]byte, error) {
+	provider, name, err := ParseKeyRef(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	providersLock.RLock()
+	p, ok := providers[provider]
+	providersLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown key provider %q", provider)
+	}
+	return p.Key(name)
+}
+
+// FileKeyProvider reads the keys from the files in the directory, the keys
+// are named after the files. The key files should only be accessible by root.
+type FileKeyProvider struct {
+	Dir string
+}
+
+func (p *FileKeyProvider) Key(name string) ([]byte, error) {
+	if name != filepath.Base(name) || name == "." || name == ".." {
+		return nil, fmt.Errorf("invalid key name %q", name)
+	}
+	keyFile := filepath.Join(p.Dir, name)
+	fi, err := os.Stat(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find key %s: %v", name, err)
+	}
+	if fi.Mode().Perm()&0077 != 0 {
+		return nil, fmt.Errorf("key file %s should not be accessible by group or others", keyFile)
+	}
+	key, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("key file %s is empty", keyFile)
+	}
+	return key, nil
+}
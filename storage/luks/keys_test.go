@@ -0,0 +1,51 @@
+package luks
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseKeyRef(t *testing.T) {
+	for ref, expected := range map[string][2]string{
+		"tenant-a":      {"file", "tenant-a"},
+		"file:tenant-a": {"file", "tenant-a"},
+		"vault:a:b":     {"vault", "a:b"},
+	} {
+		provider, name, err := ParseKeyRef(ref)
+		if err != nil || provider != expected[0] || name != expected[1] {
+			t.Fatalf("ParseKeyRef(%q) = %q, %q, %v, expect %v", ref, provider, name, err, expected)
+		}
+	}
+	for _, ref := range []string{"", "file:", ":tenant-a"} {
+		if _, _, err := ParseKeyRef(ref); err == nil {
+			t.Fatalf("ParseKeyRef(%q) should fail", ref)
+		}
+	}
+}
+
+func TestFileKeyProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hyper-keys")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "good"), []byte("secret"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "open"), []byte("secret"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	RegisterKeyProvider("test", &FileKeyProvider{Dir: dir})
+
+	if key, err := GetKey("test:good"); err != nil || string(key) != "secret" {
+		t.Fatalf("failed to get key: %q, %v", key, err)
+	}
+	for _, ref := range []string{"test:open", "test:missing", "test:../good", "unknown:good"} {
+		if _, err := GetKey(ref); err == nil {
+			t.Fatalf("GetKey(%q) should fail", ref)
+		}
+	}
+}
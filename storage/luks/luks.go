@@ -0,0 +1,162 @@
+// +build linux
+
+package luks
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/storage/graphdriver/rawblock"
+)
+
+// DefaultCipher is the cipher of the volumes formatted without a cipher.
+const DefaultCipher = "aes-xts-plain64"
+
+// MappingName returns the name of the decrypted device of the volume, which
+// is fixed for the source so that an opened volume could be found again.
+func MappingName(source string) string {
+	sum := sha256.Sum256([]byte(source))
+	return "hyper-crypt-" + hex.EncodeToString(sum[:16])
+}
+
+// Open opens the LUKS volume and returns the decrypted device. The image
+// files are attached as loop devices, or nbd devices for qcow2. A blank
+// volume created by hyperd, i.e. create is set, is formatted with LUKS and the
+// filesystem first, while the other volumes without the LUKS header are
+// refused.
+func Open(source, format, fstype string, key []byte, cipher string, create bool) (dev string, err error) {
+	name := MappingName(source)
+	dev = filepath.Join("/dev/mapper", name)
+	if _, err := os.Stat(dev); err == nil {
+		return dev, nil
+	}
+
+	backing, err := attach(source, format)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err != nil {
+			detach(source, backing)
+		}
+	}()
+
+	formatted := false
+	if exec.Command("cryptsetup", "isLuks", backing).Run() != nil {
+		if !create {
+			return "", fmt.Errorf("%s is not a LUKS volume", source)
+		}
+		if !isBlank(backing) {
+			return "", fmt.Errorf("%s has data but is not a LUKS volume", source)
+		}
+		if cipher == "" {
+			cipher = DefaultCipher
+		}
+		if err = cryptsetup(key, "luksFormat", "--batch-mode", "--type", "luks", "--cipher", cipher, "--key-file=-", backing); err != nil {
+			return "", err
+		}
+		glog.Infof("%s is formatted with LUKS (%s)", source, cipher)
+		formatted = true
+	}
+
+	if err = cryptsetup(key, "open", "--type", "luks", "--key-file=-", backing, name); err != nil {
+		return "", err
+	}
+	if formatted {
+		if out, err := exec.Command("mkfs."+fstype, mkfsForce(fstype), dev).CombinedOutput(); err != nil {
+			exec.Command("cryptsetup", "close", name).Run()
+			return "", fmt.Errorf("failed to mkfs %s: %v: %s", dev, err, string(out))
+		}
+	}
+	return dev, nil
+}
+
+// Close closes the decrypted device, and detaches the loop or nbd device of
+// the volume if there is. It is a no-op if the device is not opened.
+func Close(name string) error {
+	if _, err := os.Stat(filepath.Join("/dev/mapper", name)); err != nil {
+		return nil
+	}
+	out, err := exec.Command("cryptsetup", "status", name).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to get status of %s: %v: %s", name, err, string(out))
+	}
+	backing := ""
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == "device:" {
+			backing = fields[1]
+		}
+	}
+	if out, err := exec.Command("cryptsetup", "close", name).CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to close %s: %v: %s", name, err, string(out))
+	}
+	detach("", backing)
+	return nil
+}
+
+func cryptsetup(key []byte, args ...string) error {
+	cmd := exec.Command("cryptsetup", args...)
+	cmd.Stdin = bytes.NewReader(key)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("cryptsetup %s failed: %v: %s", args[0], err, string(out))
+	}
+	return nil
+}
+
+func attach(source, format string) (string, error) {
+	fi, err := os.Stat(source)
+	if err != nil {
+		return "", err
+	}
+	if fi.Mode()&os.ModeDevice != 0 {
+		return source, nil
+	}
+	if format == rawblock.FormatQcow2 {
+		return rawblock.ConnectNbd(source)
+	}
+	out, err := exec.Command("losetup", "-f", "--show", source).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("failed to attach %s to a loop device: %v: %s", source, err, string(out))
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+func detach(source, backing string) {
+	switch {
+	case backing == source:
+	case strings.HasPrefix(backing, "/dev/nbd"):
+		if err := rawblock.DisconnectNbd(backing); err != nil {
+			glog.Error(err)
+		}
+	case strings.HasPrefix(backing, "/dev/loop"):
+		if out, err := exec.Command("losetup", "-d", backing).CombinedOutput(); err != nil {
+			glog.Errorf("failed to detach %s: %v: %s", backing, err, string(out))
+		}
+	}
+}
+
+// isBlank tests if there is no filesystem or partition table on the device,
+// blkid exits with 2 if nothing is found.
+func isBlank(dev string) bool {
+	err := exec.Command("blkid", "-p", dev).Run()
+	if ee, ok := err.(*exec.ExitError); ok {
+		return ee.Sys().(syscall.WaitStatus).ExitStatus() == 2
+	}
+	return false
+}
+
+func mkfsForce(fstype string) string {
+	if fstype == "xfs" {
+		return "-f"
+	}
+	return "-F"
+}
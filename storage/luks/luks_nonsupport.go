@@ -0,0 +1,21 @@
+// +build !linux
+
+package luks
+
+import (
+	"fmt"
+)
+
+const DefaultCipher = "aes-xts-plain64"
+
+func MappingName(source string) string {
+	return ""
+}
+
+func Open(source, format, fstype string, key []byte, cipher string, create bool) (string, error) {
+	return "", fmt.Errorf("encrypted volume is not supported")
+}
+
+func Close(name string) error {
+	return nil
+}
@@ -0,0 +1,71 @@
+// +build linux
+
+package luks
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// fakeTools puts the cryptsetup, losetup and blkid in PATH, which log their
+// arguments. No volume is a LUKS volume, and all the volumes are blank.
+func fakeTools(t *testing.T, dir string) (string, func()) {
+	bin := filepath.Join(dir, "bin")
+	if err := os.MkdirAll(bin, 0755); err != nil {
+		t.Fatal(err)
+	}
+	log := filepath.Join(dir, "tools.log")
+	for name, exit := range map[string]string{
+		"cryptsetup": "case $1 in isLuks) exit 1;; open) exit 1;; esac",
+		"losetup":    "echo /dev/loop9",
+		"blkid":      "exit 2",
+	} {
+		script := fmt.Sprintf("#!/bin/sh\necho %s \"$@\" >> %s\n%s\n", name, log, exit)
+		if err := ioutil.WriteFile(filepath.Join(bin, name), []byte(script), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	path := os.Getenv("PATH")
+	os.Setenv("PATH", bin+":"+path)
+	return log, func() { os.Setenv("PATH", path) }
+}
+
+func TestOpenFormatsCreatedVolumesOnly(t *testing.T) {
+	dir, err := ioutil.TempDir("", "luks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	log, restore := fakeTools(t, dir)
+	defer restore()
+
+	source := filepath.Join(dir, "volume")
+	if err := ioutil.WriteFile(source, make([]byte, 4096), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// a blank volume of the user is refused rather than formatted
+	if _, err := Open(source, "raw", "ext4", []byte("key"), "", false); err == nil || !strings.Contains(err.Error(), "is not a LUKS volume") {
+		t.Fatalf("expect the user volume refused, got %v", err)
+	}
+	data, _ := ioutil.ReadFile(log)
+	if strings.Contains(string(data), "luksFormat") {
+		t.Fatalf("the user volume is formatted:\n%s", data)
+	}
+	if !strings.Contains(string(data), "losetup -d /dev/loop9") {
+		t.Fatalf("the loop device is not detached:\n%s", data)
+	}
+
+	// the volume created by hyperd is formatted, the fake open fails then
+	if _, err := Open(source, "raw", "ext4", []byte("key"), "", true); err == nil {
+		t.Fatal("expect the fake open to fail")
+	}
+	data, _ = ioutil.ReadFile(log)
+	if !strings.Contains(string(data), "cryptsetup luksFormat --batch-mode --type luks --cipher "+DefaultCipher) {
+		t.Fatalf("the created volume is not formatted:\n%s", data)
+	}
+}
@@ -0,0 +1,349 @@
+package storage
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/golang/glog"
+)
+
+// the XFS project ids of the vfs volumes are allocated from a counter persisted
+// in projectIdFile, and start beyond the ids set by hand, which are usually
+// small.
+const firstProjectId = 0x10000
+
+var (
+	projectIdFile = "/var/tmp/hyper/.projects"
+	projectIdLock sync.Mutex
+)
+
+type projectIds struct {
+	Next uint32            `json:"next"`
+	Dirs map[string]uint32 `json:"dirs"`
+}
+
+func loadProjectIds() (*projectIds, error) {
+	ids := &projectIds{Next: firstProjectId, Dirs: make(map[string]uint32)}
+	data, err := ioutil.ReadFile(projectIdFile)
+	if os.IsNotExist(err) {
+		return ids, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, ids); err != nil {
+		return nil, fmt.Errorf("invalid project ids in %s: %v", projectIdFile, err)
+	}
+	if ids.Dirs == nil {
+		ids.Dirs = make(map[string]uint32)
+	}
+	return ids, nil
+}
+
+func (ids *projectIds) save() error {
+	data, err := json.Marshal(ids)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(projectIdFile), 0755); err != nil {
+		return err
+	}
+	tmp := projectIdFile + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, projectIdFile)
+}
+
+// allocProjectId returns the project id of the directory, a new id is taken
+// from the counter if the directory has none.
+func allocProjectId(dir string) (uint32, error) {
+	projectIdLock.Lock()
+	defer projectIdLock.Unlock()
+
+	ids, err := loadProjectIds()
+	if err != nil {
+		return 0, err
+	}
+	if id, ok := ids.Dirs[dir]; ok {
+		return id, nil
+	}
+	id := ids.Next
+	ids.Next++
+	ids.Dirs[dir] = id
+	return id, ids.save()
+}
+
+// releaseProjectId forgets the project id of the directory, the id is not
+// reused. It returns false if the directory has no project id.
+func releaseProjectId(dir string) (uint32, bool, error) {
+	projectIdLock.Lock()
+	defer projectIdLock.Unlock()
+
+	ids, err := loadProjectIds()
+	if err != nil {
+		return 0, false, err
+	}
+	id, ok := ids.Dirs[dir]
+	if !ok {
+		return 0, false, nil
+	}
+	delete(ids.Dirs, dir)
+	return id, true, ids.save()
+}
+
+type mountEntry struct {
+	mountpoint string
+	fstype     string
+	options    []string
+}
+
+// findMount returns the mount containing the path, according to
+// /proc/self/mountinfo.
+func findMount(path string) (*mountEntry, error) {
+	path, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open("/proc/self/mountinfo")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var found *mountEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// 36 35 98:0 /mnt1 /mnt2 rw,noatime master:1 - ext3 /dev/root rw,errors=continue
+		fields := strings.Fields(scanner.Text())
+		sep := 0
+		for i, field := range fields {
+			if field == "-" {
+				sep = i
+				break
+			}
+		}
+		if len(fields) < 6 || sep == 0 || len(fields) < sep+4 {
+			continue
+		}
+		mnt := fields[4]
+		if path != mnt && !strings.HasPrefix(path, strings.TrimSuffix(mnt, "/")+"/") {
+			continue
+		}
+		if found == nil || len(mnt) >= len(found.mountpoint) {
+			found = &mountEntry{
+				mountpoint: mnt,
+				fstype:     fields[sep+1],
+				options:    strings.Split(fields[5]+","+fields[sep+3], ","),
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, fmt.Errorf("cannot find the mount of %s", path)
+	}
+	return found, nil
+}
+
+// MountPointsUnder returns the mount points under the directory, according to
+// /proc/self/mountinfo.
+func MountPointsUnder(dir string) ([]string, error) {
+	f, err := os.Open("/proc/self/mountinfo")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	mounts := []string{}
+	prefix := strings.TrimSuffix(dir, "/") + "/"
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) > 4 && strings.HasPrefix(fields[4], prefix) {
+			mounts = append(mounts, fields[4])
+		}
+	}
+	return mounts, scanner.Err()
+}
+
+func (m *mountEntry) hasOption(opts ...string) bool {
+	for _, o := range m.options {
+		for _, opt := range opts {
+			if o == opt {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func loopImage(dir string) string {
+	return dir + ".img"
+}
+
+// SetVFSQuota limits the size of the vfs volume directory. The XFS project
+// quota is used if the directory is on a XFS mounted with prjquota, otherwise
+// a loopback-backed filesystem of the size is mounted on the directory.
+func SetVFSQuota(dir string, size int64) error {
+	if size <= 0 {
+		return nil
+	}
+
+	m, err := findMount(dir)
+	if err != nil {
+		return err
+	}
+	if m.fstype == "xfs" && m.hasOption("prjquota", "pquota") {
+		return setProjectQuota(m.mountpoint, dir, size)
+	}
+
+	img := loopImage(dir)
+	if out, err := exec.Command("truncate", fmt.Sprintf("--size=%d", size), img).CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to create image for volume %s: %v: %s", dir, err, string(out))
+	}
+	if out, err := exec.Command(DEFAULT_VOL_MKFS, "-F", "-q", img).CombinedOutput(); err != nil {
+		os.Remove(img)
+		return fmt.Errorf("failed to mkfs the image of volume %s: %v: %s", dir, err, string(out))
+	}
+	if err := MountVFSQuota(dir); err != nil {
+		os.Remove(img)
+		return err
+	}
+	return os.Chmod(dir, os.FileMode(0777))
+}
+
+func setProjectQuota(mountpoint, dir string, size int64) error {
+	id, err := allocProjectId(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, cmd := range []string{
+		fmt.Sprintf("project -s -p %s %d", dir, id),
+		fmt.Sprintf("limit -p bhard=%dk %d", (size+1023)/1024, id),
+	} {
+		if out, err := exec.Command("xfs_quota", "-x", "-c", cmd, mountpoint).CombinedOutput(); err != nil {
+			return fmt.Errorf("failed to set project quota of %s: %v: %s", dir, err, string(out))
+		}
+	}
+	glog.V(1).Infof("project quota %d of %s set to %d bytes", id, dir, size)
+	return nil
+}
+
+// MountVFSQuota mounts the loopback-backed filesystem of the vfs volume, if it
+// has one and it is not mounted yet, e.g. after the host reboots.
+func MountVFSQuota(dir string) error {
+	img := loopImage(dir)
+	if _, err := os.Stat(img); err != nil {
+		return nil
+	}
+	if m, err := findMount(dir); err == nil && m.mountpoint == dir {
+		return nil
+	}
+	if out, err := exec.Command("mount", "-o", "loop", img, dir).CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to mount the image of volume %s: %v: %s", dir, err, string(out))
+	}
+	return nil
+}
+
+// clearProjectQuota removes the limit and the project id of the directory.
+func clearProjectQuota(dir string) error {
+	projectIdLock.Lock()
+	ids, err := loadProjectIds()
+	projectIdLock.Unlock()
+	if err != nil {
+		return err
+	}
+	id, ok := ids.Dirs[dir]
+	if !ok {
+		return nil
+	}
+
+	if m, err := findMount(dir); err == nil {
+		for _, cmd := range []string{
+			fmt.Sprintf("limit -p bhard=0 %d", id),
+			fmt.Sprintf("project -C -p %s %d", dir, id),
+		} {
+			if out, err := exec.Command("xfs_quota", "-x", "-c", cmd, m.mountpoint).CombinedOutput(); err != nil {
+				return fmt.Errorf("failed to clear project quota of %s: %v: %s", dir, err, string(out))
+			}
+		}
+	}
+	_, _, err = releaseProjectId(dir)
+	return err
+}
+
+// loopDevices returns the loop devices attached to the file.
+func loopDevices(file string) ([]string, error) {
+	out, err := exec.Command("losetup", "-j", file).CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the loop devices of %s: %v: %s", file, err, string(out))
+	}
+	// /dev/loop0: [2049]:1835012 (/var/tmp/hyper/pod/data.img)
+	devices := []string{}
+	for _, line := range strings.Split(string(out), "\n") {
+		if i := strings.Index(line, ":"); i > 0 {
+			devices = append(devices, line[:i])
+		}
+	}
+	return devices, nil
+}
+
+// RemoveVFSVolume removes the vfs volume directory, along with its
+// loopback-backed filesystem and its project quota if there is.
+func RemoveVFSVolume(dir string) error {
+	img := loopImage(dir)
+	if _, err := os.Stat(img); err == nil {
+		if m, err := findMount(dir); err == nil && m.mountpoint == dir {
+			if err := syscall.Unmount(dir, 0); err != nil {
+				return err
+			}
+		}
+		// the loop device is detached on unmount unless it is attached
+		// by hand or the mount failed halfway
+		if devices, err := loopDevices(img); err == nil {
+			for _, dev := range devices {
+				if out, err := exec.Command("losetup", "-d", dev).CombinedOutput(); err != nil {
+					glog.Warningf("failed to detach %s of volume %s: %v: %s", dev, dir, err, string(out))
+				}
+			}
+		}
+		if err := os.Remove(img); err != nil {
+			return err
+		}
+	} else if err := clearProjectQuota(dir); err != nil {
+		return err
+	}
+	return os.RemoveAll(dir)
+}
+
+// DirUsage returns the disk space used by the files in the directory.
+func DirUsage(dir string) (int64, error) {
+	var usage int64
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			// the files may be removed during the walk
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if st, ok := info.Sys().(*syscall.Stat_t); ok {
+			usage += st.Blocks * 512
+		} else {
+			usage += info.Size()
+		}
+		return nil
+	})
+	return usage, err
+}
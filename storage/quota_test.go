@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestProjectIds(t *testing.T) {
+	dir, err := ioutil.TempDir("", "quota")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(file string) { projectIdFile = file }(projectIdFile)
+	projectIdFile = filepath.Join(dir, ".projects")
+
+	a, err := allocProjectId("/var/tmp/hyper/web/data")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := allocProjectId("/var/tmp/hyper/web-2/data")
+	if a != firstProjectId || b != firstProjectId+1 {
+		t.Fatalf("unexpected project ids %d and %d", a, b)
+	}
+	if id, _ := allocProjectId("/var/tmp/hyper/web/data"); id != a {
+		t.Fatalf("the directory gets a new project id %d", id)
+	}
+
+	if id, ok, err := releaseProjectId("/var/tmp/hyper/web/data"); err != nil || !ok || id != a {
+		t.Fatalf("unexpected release %d, %v, %v", id, ok, err)
+	}
+	if _, ok, _ := releaseProjectId("/var/tmp/hyper/web/data"); ok {
+		t.Fatal("the project id is released twice")
+	}
+	// the released ids are not reused
+	if id, _ := allocProjectId("/var/tmp/hyper/web/data"); id != firstProjectId+2 {
+		t.Fatalf("unexpected project id %d after release", id)
+	}
+}
+
+func TestRemoveVFSVolume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "quota")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(file string) { projectIdFile = file }(projectIdFile)
+	projectIdFile = filepath.Join(dir, ".projects")
+
+	// a fake xfs_quota logging its arguments
+	log := filepath.Join(dir, "xfs_quota.log")
+	script := fmt.Sprintf("#!/bin/sh\necho \"$@\" >> %s\n", log)
+	if err := ioutil.WriteFile(filepath.Join(dir, "xfs_quota"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := os.Getenv("PATH")
+	os.Setenv("PATH", dir+":"+path)
+	defer os.Setenv("PATH", path)
+
+	vol := filepath.Join(dir, "pod", "data")
+	if err := os.MkdirAll(vol, 0755); err != nil {
+		t.Fatal(err)
+	}
+	id, err := allocProjectId(vol)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := RemoveVFSVolume(vol); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(vol); !os.IsNotExist(err) {
+		t.Fatal("the volume is not removed")
+	}
+	data, _ := ioutil.ReadFile(log)
+	for _, cmd := range []string{fmt.Sprintf("limit -p bhard=0 %d", id), fmt.Sprintf("project -C -p %s %d", vol, id)} {
+		if !strings.Contains(string(data), cmd) {
+			t.Fatalf("xfs_quota %s is not called:\n%s", cmd, data)
+		}
+	}
+	if _, ok, _ := releaseProjectId(vol); ok {
+		t.Fatal("the project id is not released")
+	}
+
+	// the volumes without quota are removed without xfs_quota
+	os.Remove(log)
+	os.MkdirAll(vol, 0755)
+	if err := RemoveVFSVolume(vol); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(log); err == nil {
+		t.Fatal("xfs_quota is called for the volume without quota")
+	}
+}
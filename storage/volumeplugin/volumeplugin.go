@@ -0,0 +1,175 @@
+// Package volumeplugin talks to the external volume drivers in the protocol of
+// the docker volume plugins. The plugins are discovered as docker plugins, i.e.
+// by the sockets in /run/docker/plugins or the spec files in /etc/docker/plugins,
+// and implement "VolumeDriver".
+//
+// Besides the mountpoint, the mount response of a plugin may tell the format
+// and the filesystem of the volume, so that the plugin could give a block
+// device or an image file to the sandbox instead of a directory:
+//
+//	{"Mountpoint": "/dev/rbd0", "Format": "raw", "Fstype": "xfs", "Err": ""}
+package volumeplugin
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/docker/docker/pkg/plugins"
+)
+
+// VolumeDriver is what the volume plugins implement.
+const VolumeDriver = "VolumeDriver"
+
+type client interface {
+	Call(string, interface{}, interface{}) error
+}
+
+// Driver is the client of a volume plugin.
+type Driver struct {
+	name string
+	client
+}
+
+// Volume is a volume of the plugin.
+type Volume struct {
+	Name       string
+	Mountpoint string `json:",omitempty"`
+}
+
+// MountPoint is where the plugin mounts the volume and how hyperd should
+// insert it into the sandbox.
+type MountPoint struct {
+	Mountpoint string
+	// raw or qcow2 for the block devices and the image files, and vfs for the
+	// directories, guessed from the mountpoint if the plugin does not tell
+	Format string
+	Fstype string
+}
+
+type volumeRequest struct {
+	Name string
+}
+
+type createRequest struct {
+	Name string
+	Opts map[string]string
+}
+
+type mountRequest struct {
+	Name string
+	ID   string
+}
+
+type errResponse struct {
+	Err string
+}
+
+type mountResponse struct {
+	Mountpoint string
+	Format     string
+	Fstype     string
+	Err        string
+}
+
+type getResponse struct {
+	Volume *Volume
+	Err    string
+}
+
+type listResponse struct {
+	Volumes []*Volume
+	Err     string
+}
+
+// Get returns the driver of the volume plugin, the plugin is activated when
+// it is got for the first time.
+func Get(name string) (*Driver, error) {
+	p, err := plugins.Get(name, VolumeDriver)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get volume plugin %s: %v", name, err)
+	}
+	return &Driver{name: name, client: p.Client}, nil
+}
+
+func (d *Driver) Name() string {
+	return d.name
+}
+
+func (d *Driver) call(method string, args interface{}, ret interface{}, retErr *string) error {
+	if err := d.Call(VolumeDriver+"."+method, args, ret); err != nil {
+		return fmt.Errorf("volume plugin %s: %s failed: %v", d.name, method, err)
+	}
+	if *retErr != "" {
+		return fmt.Errorf("volume plugin %s: %s failed: %s", d.name, method, *retErr)
+	}
+	return nil
+}
+
+// Create creates the volume with the options, it succeeds if the volume exists.
+func (d *Driver) Create(name string, opts map[string]string) error {
+	var ret errResponse
+	return d.call("Create", &createRequest{Name: name, Opts: opts}, &ret, &ret.Err)
+}
+
+func (d *Driver) Remove(name string) error {
+	var ret errResponse
+	return d.call("Remove", &volumeRequest{Name: name}, &ret, &ret.Err)
+}
+
+// Mount asks the plugin to make the volume available to the pod of the id.
+func (d *Driver) Mount(name, id string) (*MountPoint, error) {
+	var ret mountResponse
+	if err := d.call("Mount", &mountRequest{Name: name, ID: id}, &ret, &ret.Err); err != nil {
+		return nil, err
+	}
+	if ret.Mountpoint == "" {
+		return nil, fmt.Errorf("volume plugin %s: no mountpoint of volume %s", d.name, name)
+	}
+
+	mp := &MountPoint{
+		Mountpoint: ret.Mountpoint,
+		Format:     ret.Format,
+		Fstype:     ret.Fstype,
+	}
+	if mp.Format == "" {
+		fi, err := os.Stat(mp.Mountpoint)
+		if err != nil {
+			return nil, err
+		}
+		mp.Format = "raw"
+		if fi.IsDir() {
+			mp.Format = "vfs"
+		}
+	}
+	switch mp.Format {
+	case "raw", "qcow2", "vfs":
+	default:
+		return nil, fmt.Errorf("volume plugin %s: unsupported format %s of volume %s", d.name, mp.Format, name)
+	}
+	return mp, nil
+}
+
+// Unmount tells the plugin that the pod of the id does not use the volume.
+func (d *Driver) Unmount(name, id string) error {
+	var ret errResponse
+	return d.call("Unmount", &mountRequest{Name: name, ID: id}, &ret, &ret.Err)
+}
+
+func (d *Driver) Get(name string) (*Volume, error) {
+	var ret getResponse
+	if err := d.call("Get", &volumeRequest{Name: name}, &ret, &ret.Err); err != nil {
+		return nil, err
+	}
+	if ret.Volume == nil {
+		return nil, fmt.Errorf("volume plugin %s: volume %s not found", d.name, name)
+	}
+	return ret.Volume, nil
+}
+
+func (d *Driver) List() ([]*Volume, error) {
+	var ret listResponse
+	if err := d.call("List", struct{}{}, &ret, &ret.Err); err != nil {
+		return nil, err
+	}
+	return ret.Volumes, nil
+}
@@ -0,0 +1,109 @@
+package volumeplugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+// fakePlugin answers the calls with the JSON responses of the methods, the
+// requests are passed through JSON as well to check the protocol fields.
+type fakePlugin struct {
+	responses map[string]string
+	requests  map[string]map[string]interface{}
+}
+
+func (f *fakePlugin) Call(method string, args interface{}, ret interface{}) error {
+	resp, ok := f.responses[method]
+	if !ok {
+		return fmt.Errorf("%s not implemented", method)
+	}
+	data, err := json.Marshal(args)
+	if err != nil {
+		return err
+	}
+	req := map[string]interface{}{}
+	if err := json.Unmarshal(data, &req); err != nil {
+		return err
+	}
+	f.requests[method] = req
+	return json.Unmarshal([]byte(resp), ret)
+}
+
+func newFakeDriver(responses map[string]string) (*Driver, *fakePlugin) {
+	f := &fakePlugin{
+		responses: responses,
+		requests:  make(map[string]map[string]interface{}),
+	}
+	return &Driver{name: "fake", client: f}, f
+}
+
+func TestCreateAndRemove(t *testing.T) {
+	d, f := newFakeDriver(map[string]string{
+		"VolumeDriver.Create": `{"Err": ""}`,
+		"VolumeDriver.Remove": `{"Err": "volume is busy"}`,
+	})
+
+	if err := d.Create("vol1", map[string]string{"size": "1G"}); err != nil {
+		t.Fatal(err)
+	}
+	req := f.requests["VolumeDriver.Create"]
+	if req["Name"] != "vol1" || req["Opts"].(map[string]interface{})["size"] != "1G" {
+		t.Fatalf("unexpected create request %v", req)
+	}
+	if err := d.Remove("vol1"); err == nil {
+		t.Fatalf("the error of the plugin should be returned")
+	}
+}
+
+func TestMount(t *testing.T) {
+	dir, err := ioutil.TempDir("", "volumeplugin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	d, f := newFakeDriver(map[string]string{
+		"VolumeDriver.Mount": `{"Mountpoint": "` + dir + `"}`,
+	})
+	mp, err := d.Mount("vol1", "pod-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mp.Mountpoint != dir || mp.Format != "vfs" {
+		t.Fatalf("directory should be mounted as vfs: %v", mp)
+	}
+	if req := f.requests["VolumeDriver.Mount"]; req["Name"] != "vol1" || req["ID"] != "pod-1" {
+		t.Fatalf("unexpected mount request %v", req)
+	}
+
+	f.responses["VolumeDriver.Mount"] = `{"Mountpoint": "/dev/rbd0", "Format": "raw", "Fstype": "xfs"}`
+	if mp, err = d.Mount("vol1", "pod-1"); err != nil || mp.Format != "raw" || mp.Fstype != "xfs" {
+		t.Fatalf("unexpected mountpoint %v: %v", mp, err)
+	}
+
+	for _, resp := range []string{`{"Mountpoint": ""}`, `{"Mountpoint": "/dev/rbd0", "Format": "nfs"}`} {
+		f.responses["VolumeDriver.Mount"] = resp
+		if _, err := d.Mount("vol1", "pod-1"); err == nil {
+			t.Fatalf("mount response %s should be refused", resp)
+		}
+	}
+}
+
+func TestGetAndList(t *testing.T) {
+	d, _ := newFakeDriver(map[string]string{
+		"VolumeDriver.Get":  `{"Volume": {"Name": "vol1", "Mountpoint": "/mnt/vol1"}}`,
+		"VolumeDriver.List": `{"Volumes": [{"Name": "vol1"}, {"Name": "vol2"}]}`,
+	})
+
+	vol, err := d.Get("vol1")
+	if err != nil || vol.Name != "vol1" || vol.Mountpoint != "/mnt/vol1" {
+		t.Fatalf("unexpected volume %v: %v", vol, err)
+	}
+	vols, err := d.List()
+	if err != nil || len(vols) != 2 || vols[1].Name != "vol2" {
+		t.Fatalf("unexpected volumes %v: %v", vols, err)
+	}
+}
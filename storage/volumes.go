@@ -11,12 +11,23 @@ import (
 	"github.com/hyperhq/hyperd/utils"
 )
 
-func CreateVFSVolume(podId, shortName string) (string, error) {
-	volName := path.Join("/var/tmp/hyper", podId, shortName)
+// VFSVolumePath returns the directory of a vfs volume created for the pod.
+func VFSVolumePath(podId, shortName string) string {
+	return path.Join("/var/tmp/hyper", podId, shortName)
+}
+
+// CreateVFSVolume creates the directory of a vfs volume, the size of the
+// volume is limited if size is positive.
+func CreateVFSVolume(podId, shortName string, size int64) (string, error) {
+	volName := VFSVolumePath(podId, shortName)
 	if _, err := os.Stat(volName); err != nil && os.IsNotExist(err) {
 		if err := os.MkdirAll(volName, os.FileMode(0777)); err != nil {
 			return "", err
 		}
+		if err := SetVFSQuota(volName, size); err != nil {
+			os.RemoveAll(volName)
+			return "", err
+		}
 	}
 	return volName, nil
 }
@@ -28,6 +39,11 @@ func MountVFSVolume(src, sharedDir string) (string, error) {
 	targetDir := path.Join(sharedDir, mountSharedDir)
 	glog.V(1).Infof("trying to bind dir %s to %s", src, targetDir)
 
+	if err := MountVFSQuota(src); err != nil {
+		glog.Errorf("failed to mount the filesystem of volume %s: %v", src, err)
+		return "", err
+	}
+
 	stat, err := os.Stat(src)
 	if err != nil {
 		glog.Error("Cannot stat volume Source ", err.Error())
@@ -0,0 +1,158 @@
+// +build linux
+
+package zfs
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"syscall"
+
+	"github.com/golang/glog"
+	gozfs "github.com/mistifyio/go-zfs"
+)
+
+// DatasetName converts the name to a valid component of the zfs dataset
+// names, which allow only alphanumeric characters and "_-:.". The "@" of the
+// namespaced pod ids is converted to ":", and the other characters to "_".
+func DatasetName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case r == '_' || r == '-' || r == ':' || r == '.':
+			return r
+		case r == '@':
+			return ':'
+		}
+		return '_'
+	}, name)
+}
+
+// MountContainerToSharedDir mounts the dataset of the container, which is a
+// zfs clone created by the graph driver with a legacy mountpoint, to the
+// rootfs of the container in the shared dir.
+func MountContainerToSharedDir(containerId, dataset, sharedDir string, readonly bool) (string, error) {
+	mountPoint := path.Join(sharedDir, containerId, "rootfs")
+	if _, err := os.Stat(mountPoint); err != nil {
+		if err = os.MkdirAll(mountPoint, 0755); err != nil {
+			return "", err
+		}
+	}
+
+	var flags uintptr
+	if readonly {
+		flags |= syscall.MS_RDONLY
+	}
+	if err := syscall.Mount(dataset, mountPoint, "zfs", flags, ""); err != nil {
+		return "", fmt.Errorf("failed to mount %s to %s: %v", dataset, mountPoint, err)
+	}
+	return mountPoint, nil
+}
+
+// SetQuota limits the size of the dataset of the container.
+func SetQuota(dataset string, size int64) error {
+	ds, err := gozfs.GetDataset(dataset)
+	if err != nil {
+		return err
+	}
+	return ds.SetProperty("quota", fmt.Sprintf("%d", size))
+}
+
+// Quota returns the quota of the dataset, 0 means no limit.
+func Quota(dataset string) (int64, error) {
+	ds, err := gozfs.GetDataset(dataset)
+	if err != nil {
+		return 0, err
+	}
+	return int64(ds.Quota), nil
+}
+
+// Unmount unmounts the rootfs of the container in the shared dir.
+func Unmount(mountPoint string) error {
+	return syscall.Unmount(mountPoint, 0)
+}
+
+// InitVolumeDataset creates the parent dataset of the volumes, the datasets of
+// the volumes inherit the mountpoint from it.
+func InitVolumeDataset(dataset, mountpoint string) error {
+	if _, err := gozfs.GetDataset(dataset); err == nil {
+		return nil
+	}
+	if _, err := gozfs.CreateFilesystem(dataset, map[string]string{"mountpoint": mountpoint}); err != nil {
+		return fmt.Errorf("failed to create dataset %s: %v", dataset, err)
+	}
+	return nil
+}
+
+// CreateVolume creates the dataset of the volume under the dataset of the pod,
+// the size of the volume is limited by the quota if size is positive. The
+// mountpoint of the volume is returned.
+func CreateVolume(podDataset, name string, size int64) (string, error) {
+	if _, err := gozfs.GetDataset(podDataset); err != nil {
+		if _, err := gozfs.CreateFilesystem(podDataset, nil); err != nil {
+			return "", fmt.Errorf("failed to create dataset %s: %v", podDataset, err)
+		}
+	}
+
+	dataset := podDataset + "/" + name
+	ds, err := gozfs.GetDataset(dataset)
+	if err != nil {
+		props := map[string]string{}
+		if size > 0 {
+			props["quota"] = fmt.Sprintf("%d", size)
+		}
+		if ds, err = gozfs.CreateFilesystem(dataset, props); err != nil {
+			return "", fmt.Errorf("failed to create dataset %s: %v", dataset, err)
+		}
+		if err := os.Chmod(ds.Mountpoint, os.FileMode(0777)); err != nil {
+			glog.Warningf("failed to chmod the volume %s: %v", ds.Mountpoint, err)
+		}
+	}
+	return ds.Mountpoint, nil
+}
+
+// DestroyVolume destroys the dataset of the volume, and the dataset of the pod
+// if it has no more volumes.
+func DestroyVolume(dataset string) error {
+	ds, err := gozfs.GetDataset(dataset)
+	if err == nil {
+		if err := ds.Destroy(gozfs.DestroyRecursive); err != nil {
+			return fmt.Errorf("failed to destroy dataset %s: %v", dataset, err)
+		}
+	}
+
+	podDataset := path.Dir(dataset)
+	if pds, err := gozfs.GetDataset(podDataset); err == nil {
+		if children, err := pds.Children(1); err == nil && len(children) == 0 {
+			if err := pds.Destroy(gozfs.DestroyDefault); err != nil {
+				glog.Warningf("failed to destroy dataset %s: %v", podDataset, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Status returns the usage of the dataset and the health of its pool.
+func Status(dataset string) [][2]string {
+	status := [][2]string{{"Volume Dataset", dataset}}
+	ds, err := gozfs.GetDataset(dataset)
+	if err != nil {
+		glog.Warningf("failed to get dataset %s: %v", dataset, err)
+		return status
+	}
+	status = append(status,
+		[2]string{"Space Used By Volumes", fmt.Sprintf("%d", ds.Used)},
+		[2]string{"Space Available For Volumes", fmt.Sprintf("%d", ds.Avail)},
+	)
+	pool, err := gozfs.GetZpool(strings.SplitN(dataset, "/", 2)[0])
+	if err != nil {
+		glog.Warningf("failed to get pool of %s: %v", dataset, err)
+		return status
+	}
+	return append(status,
+		[2]string{"Volume Pool Health", pool.Health},
+		[2]string{"Volume Pool Free", fmt.Sprintf("%d", pool.Free)},
+	)
+}
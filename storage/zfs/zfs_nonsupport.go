@@ -0,0 +1,43 @@
+// +build !linux
+
+package zfs
+
+import (
+	"fmt"
+)
+
+func DatasetName(name string) string {
+	return name
+}
+
+func MountContainerToSharedDir(containerId, dataset, sharedDir string, readonly bool) (string, error) {
+	return "", fmt.Errorf("zfs is not supported")
+}
+
+func SetQuota(dataset string, size int64) error {
+	return fmt.Errorf("zfs is not supported")
+}
+
+func Quota(dataset string) (int64, error) {
+	return 0, nil
+}
+
+func Unmount(mountPoint string) error {
+	return nil
+}
+
+func InitVolumeDataset(dataset, mountpoint string) error {
+	return fmt.Errorf("zfs is not supported")
+}
+
+func CreateVolume(podDataset, name string, size int64) (string, error) {
+	return "", fmt.Errorf("zfs is not supported")
+}
+
+func DestroyVolume(dataset string) error {
+	return nil
+}
+
+func Status(dataset string) [][2]string {
+	return nil
+}
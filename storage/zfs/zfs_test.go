@@ -0,0 +1,21 @@
+// +build linux
+
+package zfs
+
+import (
+	"testing"
+)
+
+func TestDatasetName(t *testing.T) {
+	cases := map[string]string{
+		"pod-1":          "pod-1",
+		"_volumes":       "_volumes",
+		"web@team-a":     "web:team-a",
+		"data/log files": "data_log_files",
+	}
+	for name, expected := range cases {
+		if got := DatasetName(name); got != expected {
+			t.Fatalf("expect dataset name %q of %q, got %q", expected, name, got)
+		}
+	}
+}
@@ -19,7 +19,20 @@ type HyperConfig struct {
 	GRPCHost        string
 	StorageDriver   string
 	StorageBaseSize string
+	RawBlockFs      string
+	RawBlockFormat  string
 	VmFactoryPolicy string
+
+	// the usage thresholds of the devicemapper thin pools in percent, and
+	// the size to extend the loopback-backed volume pool by when the warning
+	// threshold is crossed, empty to disable auto-extend
+	DmPoolWarnThreshold int
+	DmPoolFullThreshold int
+	DmPoolAutoExtend    string
+
+	// the directory of the keys of the encrypted volumes
+	VolumeKeyDir string
+
 	Driver          string
 	Kernel          string
 	Initrd          string
@@ -35,9 +48,44 @@ type HyperConfig struct {
 
 	BufferGoroutinesMax uint64
 	BufferChannelSize   uint64
+
+	Mirrors            []string
+	InsecureRegistries []string
+
+	NamespaceQuotas map[string]*NamespaceQuota
 }
 
+// NamespaceQuota caps the resources of the pods in a namespace, it is
+// configured in section [Namespace.<namespace>] with keys Pods, Vcpu and
+// Memory (in MB). 0 means no limit.
+type NamespaceQuota struct {
+	Pods   int
+	Vcpu   int
+	Memory int
+}
+
+const NAMESPACE_SECTION_PREFIX = "Namespace."
+
+const (
+	DEFAULT_DM_POOL_WARN_THRESHOLD = 80
+	DEFAULT_DM_POOL_FULL_THRESHOLD = 95
+
+	DEFAULT_VOLUME_KEY_DIR = "/etc/hyper/keys"
+)
+
 func NewHyperConfig(config string) *HyperConfig {
+	c, err := ParseHyperConfig(config)
+	if err != nil {
+		hlog.Log(hlog.ERROR, "read config file failed: %v", err)
+		return nil
+	}
+	c.Log(hlog.INFO, "config items: %#v", c)
+	return c
+}
+
+// ParseHyperConfig reads the config file, it is used on start and also when
+// the daemon reloads its configuration.
+func ParseHyperConfig(config string) (*HyperConfig, error) {
 	if config == "" {
 		config = "/etc/hyper/config"
 	}
@@ -47,12 +95,20 @@ func NewHyperConfig(config string) *HyperConfig {
 		ConfigFile: config,
 		Root:       "/var/lib/hyper",
 		logPrefix:  fmt.Sprintf("[%s] ", config),
+
+		DmPoolWarnThreshold: DEFAULT_DM_POOL_WARN_THRESHOLD,
+		DmPoolFullThreshold: DEFAULT_DM_POOL_FULL_THRESHOLD,
+		VolumeKeyDir:        DEFAULT_VOLUME_KEY_DIR,
 	}
 
 	cfg, err := goconfig.LoadConfigFile(config)
 	if err != nil {
-		c.Log(hlog.ERROR, "read config file failed: %v", err)
-		return nil
+		return nil, fmt.Errorf("%s: %v", config, err)
+	}
+	// the unknown or invalid items are ignored, or fail below if they could
+	// not be parsed, run hyperd --validate-config to check them all
+	for _, p := range validateConfig(cfg) {
+		c.Log(hlog.WARNING, "config problem: %v", p)
 	}
 
 	hyperRoot, _ := cfg.GetValue(goconfig.DEFAULT_SECTION, "Root")
@@ -62,6 +118,17 @@ func NewHyperConfig(config string) *HyperConfig {
 
 	c.StorageDriver, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "StorageDriver")
 	c.StorageBaseSize, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "StorageBaseSize")
+	c.RawBlockFs, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "RawBlockFs")
+	c.RawBlockFormat, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "RawBlockFormat")
+	c.DmPoolWarnThreshold = cfg.MustInt(goconfig.DEFAULT_SECTION, "DmPoolWarnThreshold", DEFAULT_DM_POOL_WARN_THRESHOLD)
+	c.DmPoolFullThreshold = cfg.MustInt(goconfig.DEFAULT_SECTION, "DmPoolFullThreshold", DEFAULT_DM_POOL_FULL_THRESHOLD)
+	if c.DmPoolWarnThreshold > c.DmPoolFullThreshold {
+		return nil, fmt.Errorf("DmPoolWarnThreshold %d is above DmPoolFullThreshold %d", c.DmPoolWarnThreshold, c.DmPoolFullThreshold)
+	}
+	c.DmPoolAutoExtend, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "DmPoolAutoExtend")
+	if keyDir, _ := cfg.GetValue(goconfig.DEFAULT_SECTION, "VolumeKeyDir"); keyDir != "" {
+		c.VolumeKeyDir = keyDir
+	}
 	c.Kernel, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "Kernel")
 	c.Initrd, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "Initrd")
 	c.Bridge, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "Bridge")
@@ -75,12 +142,15 @@ func NewHyperConfig(config string) *HyperConfig {
 	c.DefaultLogOpt, _ = cfg.GetSection("Log")
 	c.VmFactoryPolicy, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "VmFactoryPolicy")
 	c.GRPCHost, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "gRPCHost")
+	mirrors, _ := cfg.GetValue(goconfig.DEFAULT_SECTION, "RegistryMirror")
+	c.Mirrors = SplitList(mirrors)
+	insecure, _ := cfg.GetValue(goconfig.DEFAULT_SECTION, "InsecureRegistry")
+	c.InsecureRegistries = SplitList(insecure)
 	port, _ := cfg.GetValue(goconfig.DEFAULT_SECTION, "GDBTCPPort")
 	if port != "" {
 		c.GDBTCPPort, err = strconv.Atoi(port)
 		if err != nil {
-			c.Log(hlog.ERROR, "read config file GDBTCPPort %s failed: %v", port, err)
-			return nil
+			return nil, fmt.Errorf("read config file GDBTCPPort %s failed: %v", port, err)
 		}
 	}
 
@@ -89,8 +159,7 @@ func NewHyperConfig(config string) *HyperConfig {
 		var err error
 		c.BufferGoroutinesMax, err = strconv.ParseUint(max, 0, 64)
 		if err != nil {
-			c.Log(hlog.ERROR, "read config file BufferGoroutinesMax failed: %v", err)
-			return nil
+			return nil, fmt.Errorf("read config file BufferGoroutinesMax failed: %v", err)
 		}
 	}
 	size, _ := cfg.GetValue(goconfig.DEFAULT_SECTION, "BufferChannelSize")
@@ -98,13 +167,71 @@ func NewHyperConfig(config string) *HyperConfig {
 		var err error
 		c.BufferChannelSize, err = strconv.ParseUint(size, 0, 64)
 		if err != nil {
-			c.Log(hlog.ERROR, "read config file BufferChannelSize failed: %v", err)
-			return nil
+			return nil, fmt.Errorf("read config file BufferChannelSize failed: %v", err)
 		}
 	}
 
-	c.Log(hlog.INFO, "config items: %#v", c)
-	return c
+	c.NamespaceQuotas = make(map[string]*NamespaceQuota)
+	for _, section := range cfg.GetSectionList() {
+		if !strings.HasPrefix(section, NAMESPACE_SECTION_PREFIX) {
+			continue
+		}
+		ns := strings.TrimPrefix(section, NAMESPACE_SECTION_PREFIX)
+		q := &NamespaceQuota{}
+		for key, val := range map[string]*int{"Pods": &q.Pods, "Vcpu": &q.Vcpu, "Memory": &q.Memory} {
+			v, _ := cfg.GetValue(section, key)
+			if v == "" {
+				continue
+			}
+			*val, err = strconv.Atoi(v)
+			if err != nil || *val < 0 {
+				return nil, fmt.Errorf("read config file %s %s %s failed: %v", section, key, v, err)
+			}
+		}
+		c.NamespaceQuotas[ns] = q
+	}
+
+	return c, nil
+}
+
+// SplitList splits a comma separated list and drops the empty items.
+func SplitList(list string) []string {
+	items := []string{}
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// RestartRequired returns the items which are different in the new config but
+// could not be changed without restarting the daemon.
+func (c *HyperConfig) RestartRequired(n *HyperConfig) []string {
+	items := []string{}
+	check := func(name string, changed bool) {
+		if changed {
+			items = append(items, name)
+		}
+	}
+	check("Root", c.Root != n.Root)
+	check("Host", c.Host != n.Host)
+	check("gRPCHost", c.GRPCHost != n.GRPCHost)
+	check("StorageDriver", c.StorageDriver != n.StorageDriver)
+	check("StorageBaseSize", c.StorageBaseSize != n.StorageBaseSize)
+	check("RawBlockFs", c.RawBlockFs != n.RawBlockFs)
+	check("RawBlockFormat", c.RawBlockFormat != n.RawBlockFormat)
+	check("Hypervisor", c.Driver != n.Driver)
+	check("Kernel", c.Kernel != n.Kernel)
+	check("Initrd", c.Initrd != n.Initrd)
+	check("Bridge", c.Bridge != n.Bridge)
+	check("BridgeIP", c.BridgeIP != n.BridgeIP)
+	check("DisableIptables", c.DisableIptables != n.DisableIptables)
+	check("EnableVsock", c.EnableVsock != n.EnableVsock)
+	check("GDBTCPPort", c.GDBTCPPort != n.GDBTCPPort)
+	// the buffer could be resized, but not enabled or disabled
+	check("BufferGoroutinesMax", (c.BufferGoroutinesMax == 0) != (n.BufferGoroutinesMax == 0))
+	return items
 }
 
 func (c *HyperConfig) AdvertiseEnv() {
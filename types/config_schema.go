@@ -0,0 +1,347 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Unknwon/goconfig"
+	"github.com/docker/docker/opts"
+	"github.com/docker/go-units"
+	"github.com/hyperhq/hyperd/utils"
+)
+
+var (
+	// the values accepted by driverloader.Probe
+	configHypervisors = []string{"", "libvirt", "qemu", "qemu-kvm", "kvm", "xen", "xenpv", "kvmtool", "vbox"}
+	// the drivers in daemon.StorageDrivers
+	configStorageDrivers = []string{"", "devicemapper", "aufs", "overlay", "btrfs", "rawblock", "vbox", "zfs"}
+	// the options of the rawblock graph driver
+	configRawBlockFs      = []string{"", "xfs", "ext4"}
+	configRawBlockFormats = []string{"", "raw", "qcow2"}
+	// the log drivers registered by the docker daemon
+	configLoggers = []string{"", "json-file", "awslogs", "fluentd", "gelf", "journald", "splunk", "syslog", "none"}
+)
+
+// ConfigProblem is an invalid item found in the config file.
+type ConfigProblem struct {
+	Section string
+	Key     string
+	Message string
+}
+
+func (p *ConfigProblem) Error() string {
+	if p.Key == "" {
+		return fmt.Sprintf("[%s]: %s", p.Section, p.Message)
+	}
+	return fmt.Sprintf("[%s] %s: %s", p.Section, p.Key, p.Message)
+}
+
+// ConfigProblems is the list of the problems found in the config file.
+type ConfigProblems []*ConfigProblem
+
+func (ps ConfigProblems) Error() string {
+	msgs := make([]string, 0, len(ps))
+	for _, p := range ps {
+		msgs = append(msgs, p.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+type configChecker func(value string) error
+
+// configSchema lists the keys of the DEFAULT section and their checkers.
+var configSchema = map[string]configChecker{
+	"Root":                checkAbsPath,
+	"Hypervisor":          checkEnum(configHypervisors, true),
+	"Kernel":              checkFile,
+	"Initrd":              checkFile,
+	"Bios":                checkFile,
+	"Cbfs":                checkFile,
+	"Vbox":                checkFile,
+	"StorageDriver":       checkEnum(configStorageDrivers, false),
+	"StorageBaseSize":     checkSize,
+	"RawBlockFs":          checkEnum(configRawBlockFs, false),
+	"RawBlockFormat":      checkEnum(configRawBlockFormats, false),
+	"DmPoolWarnThreshold": checkPercent,
+	"DmPoolFullThreshold": checkPercent,
+	"DmPoolAutoExtend":    checkSize,
+	"VolumeKeyDir":        checkAbsPath,
+	"Bridge":              checkBridge,
+	"BridgeIP":            checkCIDR,
+	"Host":                checkHost,
+	"gRPCHost":            checkHostPort,
+	"DisableIptables":     checkBool,
+	"EnableVsock":         checkBool,
+	"Logger":              checkEnum(configLoggers, false),
+	"VmFactoryPolicy":     checkVmFactoryPolicy,
+	"GDBTCPPort":          checkPort,
+	"BufferGoroutinesMax": checkUint,
+	"BufferChannelSize":   checkUint,
+	"RegistryMirror":      checkList(checkMirror),
+	"InsecureRegistry":    checkList(checkNonEmpty),
+}
+
+// logSchema lists the keys of the [Log] section handled by hyperd, the other
+// keys are passed to the log driver as its options.
+var logSchema = map[string]configChecker{
+	"PodLogPrefix": checkAbsPath,
+	"PodIdInPath":  checkBool,
+}
+
+var namespaceSchema = map[string]configChecker{
+	"Pods":   checkUint,
+	"Vcpu":   checkUint,
+	"Memory": checkUint,
+}
+
+// ValidateConfigFile checks every item of the config file, and returns all
+// the problems found. It is stricter than ParseHyperConfig(), which only warns
+// about the problems so that hyperd still starts with an old config file, a
+// reload of the config is rejected if any problem is found.
+func ValidateConfigFile(config string) (ConfigProblems, error) {
+	if config == "" {
+		config = "/etc/hyper/config"
+	}
+	cfg, err := goconfig.LoadConfigFile(config)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", config, err)
+	}
+	return validateConfig(cfg), nil
+}
+
+func validateConfig(cfg *goconfig.ConfigFile) ConfigProblems {
+	var problems ConfigProblems
+	check := func(section string, schema map[string]configChecker, strict bool) {
+		for _, key := range cfg.GetKeyList(section) {
+			checker, ok := schema[key]
+			if !ok {
+				if strict {
+					problems = append(problems, &ConfigProblem{section, key, "unknown key"})
+				}
+				continue
+			}
+			value, _ := cfg.GetValue(section, key)
+			if err := checker(value); err != nil {
+				problems = append(problems, &ConfigProblem{section, key, err.Error()})
+			}
+		}
+	}
+
+	for _, section := range cfg.GetSectionList() {
+		switch {
+		case section == goconfig.DEFAULT_SECTION:
+			check(section, configSchema, true)
+		case section == "Log":
+			check(section, logSchema, false)
+		case strings.HasPrefix(section, NAMESPACE_SECTION_PREFIX):
+			ns := strings.TrimPrefix(section, NAMESPACE_SECTION_PREFIX)
+			if !utils.IsDNSLabel(ns) {
+				problems = append(problems, &ConfigProblem{section, "", fmt.Sprintf("namespace should fullfil the pattern: %s", utils.Dns1123LabelFmt)})
+			}
+			check(section, namespaceSchema, true)
+		default:
+			problems = append(problems, &ConfigProblem{section, "", "unknown section"})
+		}
+	}
+	return problems
+}
+
+func checkEnum(values []string, ignoreCase bool) configChecker {
+	return func(value string) error {
+		if ignoreCase {
+			value = strings.ToLower(value)
+		}
+		for _, v := range values {
+			if v == value {
+				return nil
+			}
+		}
+		valid := append([]string{}, values[1:]...)
+		sort.Strings(valid)
+		return fmt.Errorf("invalid value %q, should be one of %s", value, strings.Join(valid, ", "))
+	}
+}
+
+func checkAbsPath(value string) error {
+	if value != "" && !filepath.IsAbs(value) {
+		return fmt.Errorf("%q is not an absolute path", value)
+	}
+	return nil
+}
+
+func checkFile(value string) error {
+	if value == "" {
+		return nil
+	}
+	fi, err := os.Stat(value)
+	if err != nil {
+		return err
+	}
+	if fi.IsDir() {
+		return fmt.Errorf("%q is a directory", value)
+	}
+	return nil
+}
+
+func checkSize(value string) error {
+	if value == "" {
+		return nil
+	}
+	if _, err := units.RAMInBytes(value); err != nil {
+		return err
+	}
+	return nil
+}
+
+func checkBridge(value string) error {
+	// IFNAMSIZ includes the trailing NUL
+	if len(value) > 15 {
+		return fmt.Errorf("interface name %q is longer than 15 characters", value)
+	}
+	if strings.ContainsAny(value, "/ \t") {
+		return fmt.Errorf("invalid interface name %q", value)
+	}
+	return nil
+}
+
+func checkCIDR(value string) error {
+	if value == "" {
+		return nil
+	}
+	if _, _, err := net.ParseCIDR(value); err != nil {
+		return fmt.Errorf("%q is not in CIDR notation, such as 192.168.123.1/24", value)
+	}
+	return nil
+}
+
+func checkHost(value string) error {
+	if value == "" {
+		return nil
+	}
+	if _, err := opts.ParseHost("unix:///var/run/hyper.sock", value); err != nil {
+		return err
+	}
+	return nil
+}
+
+func checkHostPort(value string) error {
+	if value == "" {
+		return nil
+	}
+	_, port, err := net.SplitHostPort(value)
+	if err != nil {
+		return err
+	}
+	return checkPort(port)
+}
+
+func checkBool(value string) error {
+	// an empty value means the default, as MustBool() does
+	if value == "" {
+		return nil
+	}
+	if _, err := strconv.ParseBool(value); err != nil {
+		return fmt.Errorf("invalid bool value %q", value)
+	}
+	return nil
+}
+
+func checkUint(value string) error {
+	if value == "" {
+		return nil
+	}
+	if _, err := strconv.ParseUint(value, 0, 64); err != nil {
+		return fmt.Errorf("invalid unsigned integer %q", value)
+	}
+	return nil
+}
+
+func checkPercent(value string) error {
+	if value == "" {
+		return nil
+	}
+	percent, err := strconv.Atoi(value)
+	if err != nil || percent <= 0 || percent > 100 {
+		return fmt.Errorf("invalid percentage %q", value)
+	}
+	return nil
+}
+
+func checkPort(value string) error {
+	if value == "" {
+		return nil
+	}
+	port, err := strconv.Atoi(value)
+	if err != nil || port <= 0 || port > 65535 {
+		return fmt.Errorf("invalid port %q", value)
+	}
+	return nil
+}
+
+func checkNonEmpty(value string) error {
+	if value == "" {
+		return fmt.Errorf("empty value")
+	}
+	return nil
+}
+
+func checkMirror(value string) error {
+	u, err := url.Parse(value)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme of mirror %q", value)
+	}
+	return nil
+}
+
+func checkList(checker configChecker) configChecker {
+	return func(value string) error {
+		for _, item := range SplitList(value) {
+			if err := checker(item); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// vmFactoryConfig is the same as factory.FactoryConfig of runv.
+type vmFactoryConfig struct {
+	Cache    *int  `json:"cache"`
+	Template *bool `json:"template"`
+	Cpu      *int  `json:"cpu"`
+	Memory   *int  `json:"memory"`
+}
+
+func checkVmFactoryPolicy(value string) error {
+	if value == "" || value == "none" {
+		return nil
+	}
+	var configs []vmFactoryConfig
+	decoder := json.NewDecoder(strings.NewReader("[" + value + "]"))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&configs); err != nil {
+		return fmt.Errorf("invalid policy: %v", err)
+	}
+	for i, c := range configs {
+		if c.Cpu == nil || *c.Cpu <= 0 {
+			return fmt.Errorf("factory %d: cpu should be a positive number", i)
+		}
+		if c.Memory == nil || *c.Memory <= 0 {
+			return fmt.Errorf("factory %d: memory should be a positive number", i)
+		}
+		if c.Cache != nil && *c.Cache < 0 {
+			return fmt.Errorf("factory %d: cache should not be negative", i)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package types
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Unknwon/goconfig"
+)
+
+func loadTestConfig(t *testing.T, content string) *goconfig.ConfigFile {
+	cfg, err := goconfig.LoadFromData([]byte(content))
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	return cfg
+}
+
+func TestValidateConfig(t *testing.T) {
+	kernel, err := ioutil.TempFile("", "kernel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(kernel.Name())
+	kernel.Close()
+
+	t.Log("> testing valid config")
+	cfg := loadTestConfig(t, `
+Hypervisor=Qemu
+Kernel=`+kernel.Name()+`
+StorageDriver=overlay
+StorageBaseSize=10GB
+RawBlockFormat=qcow2
+DmPoolFullThreshold=90
+DmPoolAutoExtend=10G
+VolumeKeyDir=/etc/hyper/keys
+BridgeIP=192.168.123.1/24
+gRPCHost=127.0.0.1:22318
+EnableVsock=
+VmFactoryPolicy={"cache":10, "cpu":1, "memory":128}
+
+[Log]
+PodLogPrefix=/var/run/hyper/Pods
+max-size=10m
+
+[Namespace.team-a]
+Pods=10
+`)
+	if problems := validateConfig(cfg); len(problems) != 0 {
+		t.Fatalf("valid config got problems: %v", problems)
+	}
+
+	t.Log("> testing invalid config")
+	cfg = loadTestConfig(t, `
+Hypervisor=qmeu
+Kernel=/non-existing/kernel
+StorageDriver=zfs2
+BridgeIP=192.168.123.1
+VmFactoryPolicy={"cache":10, "cpus":1, "memory":128}
+DisableIptables=maybe
+DmPoolWarnThreshold=120
+Hypervsior=qemu
+
+[Log]
+PodIdInPath=sometimes
+
+[Namespace.Team_A]
+Pods=-1
+Disks=1
+
+[Unknown]
+`)
+	problems := validateConfig(cfg)
+	expected := []string{
+		"[DEFAULT] Hypervisor:",
+		"[DEFAULT] Kernel:",
+		"[DEFAULT] StorageDriver:",
+		"[DEFAULT] BridgeIP:",
+		"[DEFAULT] VmFactoryPolicy:",
+		"[DEFAULT] DisableIptables:",
+		"[DEFAULT] DmPoolWarnThreshold:",
+		"[DEFAULT] Hypervsior: unknown key",
+		"[Log] PodIdInPath:",
+		"[Namespace.Team_A]: namespace",
+		"[Namespace.Team_A] Pods:",
+		"[Namespace.Team_A] Disks: unknown key",
+		"[Unknown]: unknown section",
+	}
+	if len(problems) != len(expected) {
+		t.Fatalf("expect %d problems, got %d: %v", len(expected), len(problems), problems)
+	}
+	for i, p := range problems {
+		if !strings.HasPrefix(p.Error(), expected[i]) {
+			t.Fatalf("expect problem %q, got %q", expected[i], p.Error())
+		}
+	}
+}
+
+func TestParseHyperConfigProblems(t *testing.T) {
+	file, err := ioutil.TempFile("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	file.WriteString(`
+Root=/data/hyper
+Hypervsior=qemu
+DisableIptables=maybe
+EnableVsock=
+
+[Unknown]
+`)
+	file.Close()
+
+	// hyperd starts with the problems, which are reported by --validate-config
+	c, err := ParseHyperConfig(file.Name())
+	if err != nil {
+		t.Fatalf("failed to parse config with problems: %v", err)
+	}
+	if c.Root != "/data/hyper" || c.DisableIptables || c.EnableVsock {
+		t.Fatalf("unexpected config %#v", c)
+	}
+	problems, err := ValidateConfigFile(file.Name())
+	if err != nil || len(problems) != 3 {
+		t.Fatalf("unexpected problems %v, %v", problems, err)
+	}
+}
+
+func TestRestartRequired(t *testing.T) {
+	old := &HyperConfig{Root: "/var/lib/hyper", Driver: "qemu", BufferGoroutinesMax: 2, Mirrors: []string{"a"}}
+
+	same := *old
+	same.BufferGoroutinesMax = 8
+	same.Mirrors = []string{"b"}
+	same.VmFactoryPolicy = "2*1*512"
+	if items := old.RestartRequired(&same); len(items) != 0 {
+		t.Fatalf("expect the runtime items applied, got %v", items)
+	}
+
+	changed := same
+	changed.Root = "/data/hyper"
+	changed.Driver = "kvmtool"
+	changed.BufferGoroutinesMax = 0
+	items := old.RestartRequired(&changed)
+	expected := []string{"Root", "Hypervisor", "BufferGoroutinesMax"}
+	if strings.Join(items, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expect %v, got %v", expected, items)
+	}
+}
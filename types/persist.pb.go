@@ -66,6 +66,7 @@ type PersistPodMeta struct {
 	Id        string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Services  []*UserService    `protobuf:"bytes,11,rep,name=services" json:"services,omitempty"`
 	Labels    map[string]string `protobuf:"bytes,12,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Namespace string            `protobuf:"bytes,13,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	CreatedAt int64             `protobuf:"varint,21,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 }
 
@@ -95,6 +96,13 @@ func (m *PersistPodMeta) GetLabels() map[string]string {
 	return nil
 }
 
+func (m *PersistPodMeta) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 func (m *PersistPodMeta) GetCreatedAt() int64 {
 	if m != nil {
 		return m.CreatedAt
@@ -291,38 +299,38 @@ func init() {
 func init() { proto.RegisterFile("persist.proto", fileDescriptorPersist) }
 
 var fileDescriptorPersist = []byte{
-	// 514 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x5d, 0x6f, 0xd3, 0x3e,
-	0x14, 0xc6, 0x95, 0x76, 0xdb, 0x7f, 0x3b, 0xe9, 0xa6, 0xfe, 0xcd, 0x36, 0x4c, 0x85, 0x50, 0xa8,
-	0x84, 0xd4, 0xab, 0x54, 0x2a, 0x02, 0x31, 0xee, 0x10, 0x2f, 0x52, 0xa5, 0x4d, 0xaa, 0x52, 0xc1,
-	0xbd, 0x93, 0x78, 0xad, 0x45, 0x6a, 0x1b, 0xdb, 0xa9, 0xc8, 0x25, 0xdf, 0x80, 0x1b, 0xee, 0xf8,
-	0x78, 0x7c, 0x10, 0x14, 0xc7, 0x79, 0x29, 0xad, 0xc4, 0x05, 0x77, 0xf6, 0x73, 0x9e, 0xf3, 0xf4,
-	0x97, 0x63, 0xbb, 0x70, 0x2e, 0xa9, 0xd2, 0x4c, 0x9b, 0x50, 0x2a, 0x61, 0x04, 0x3a, 0x36, 0x85,
-	0xa4, 0x7a, 0x14, 0xae, 0x98, 0x59, 0xe7, 0x71, 0x98, 0x88, 0xcd, 0x74, 0x5d, 0x48, 0xaa, 0xd6,
-	0x5f, 0xa6, 0x2a, 0xe7, 0xdb, 0x29, 0x91, 0x6c, 0x9a, 0x52, 0x9d, 0x28, 0x26, 0x0d, 0x13, 0x5c,
-	0x57, 0x6d, 0x23, 0xdf, 0xb6, 0x55, 0x9b, 0xf1, 0x4f, 0x0f, 0x86, 0x8b, 0x2a, 0x75, 0x21, 0xd2,
-	0x5b, 0x52, 0x88, 0xdc, 0xa0, 0x0b, 0xe8, 0xb1, 0x14, 0x7b, 0x81, 0x37, 0x39, 0x8b, 0x7a, 0x2c,
-	0x45, 0x4f, 0x00, 0x56, 0x99, 0x88, 0x49, 0xb6, 0x94, 0x34, 0xc1, 0xbe, 0xd5, 0x3b, 0x4a, 0x59,
-	0x4f, 0x04, 0x37, 0x84, 0x71, 0xaa, 0x34, 0xbe, 0x0a, 0xfa, 0x65, 0xbd, 0x55, 0x10, 0x86, 0xff,
-	0xb6, 0x22, 0xcb, 0x37, 0x54, 0xe3, 0x6b, 0x5b, 0xac, 0xb7, 0x65, 0x27, 0xe3, 0x86, 0xaa, 0x7b,
-	0x92, 0x50, 0x8d, 0x1f, 0x56, 0x9d, 0xad, 0x32, 0xfe, 0xe5, 0xc1, 0x45, 0x8b, 0x77, 0x47, 0x0d,
-	0xd9, 0x83, 0x0b, 0xe1, 0x54, 0x53, 0xb5, 0x65, 0x65, 0x80, 0x1f, 0xf4, 0x27, 0xfe, 0x0c, 0x85,
-	0xd5, 0x17, 0x7e, 0xd4, 0x54, 0x2d, 0xab, 0x52, 0xd4, 0x78, 0xd0, 0x0d, 0x9c, 0x64, 0x24, 0xa6,
-	0x99, 0xc6, 0x03, 0xeb, 0x7e, 0xea, 0xdc, 0xbb, 0x3f, 0x13, 0xde, 0x5a, 0xcf, 0x7b, 0x6e, 0x54,
-	0x11, 0xb9, 0x06, 0xf4, 0x18, 0xce, 0x12, 0x45, 0x89, 0xa1, 0xe9, 0x1b, 0x83, 0xaf, 0x02, 0x6f,
-	0xd2, 0x8f, 0x5a, 0x61, 0x74, 0x03, 0x7e, 0xa7, 0x09, 0x0d, 0xa1, 0xff, 0x99, 0x16, 0x0e, 0xb4,
-	0x5c, 0xa2, 0x4b, 0x38, 0xde, 0x92, 0x2c, 0xa7, 0xb8, 0x67, 0xb5, 0x6a, 0xf3, 0xba, 0xf7, 0xca,
-	0x1b, 0x7f, 0x00, 0xb4, 0x24, 0x3c, 0x8d, 0xc5, 0x57, 0x47, 0x31, 0xe7, 0xf7, 0x62, 0xef, 0x4b,
-	0x03, 0xf0, 0x3b, 0x65, 0x9b, 0x32, 0x88, 0xba, 0xd2, 0xf8, 0x47, 0x7b, 0x9a, 0x6f, 0xeb, 0xf1,
-	0xef, 0xc5, 0x0c, 0xa1, 0x2f, 0x45, 0xea, 0x20, 0xca, 0x25, 0x9a, 0xc0, 0x91, 0xae, 0x4f, 0xd6,
-	0x9f, 0x5d, 0x76, 0xc6, 0xd7, 0xa4, 0x44, 0xd6, 0x81, 0x5e, 0xc0, 0x69, 0x7d, 0xa3, 0xf0, 0xc0,
-	0xba, 0x1f, 0x85, 0x44, 0xb2, 0xb0, 0xf1, 0xbd, 0x6b, 0xef, 0x5b, 0xd4, 0x58, 0xc7, 0xdf, 0x3d,
-	0x38, 0x77, 0x5c, 0x9f, 0xec, 0xc9, 0x23, 0x04, 0x47, 0x9c, 0x6c, 0xa8, 0xc3, 0xb2, 0xeb, 0x03,
-	0x60, 0xcf, 0x76, 0xc0, 0xfe, 0xef, 0x80, 0x55, 0x31, 0x8e, 0x6a, 0xb6, 0x47, 0x75, 0x6d, 0xa9,
-	0x2a, 0xd3, 0x61, 0xa4, 0xce, 0xa8, 0xe6, 0xf5, 0x7d, 0xfb, 0xa7, 0x51, 0x35, 0x29, 0x7f, 0x19,
-	0x55, 0xe3, 0x3b, 0xcc, 0xf5, 0xcd, 0x83, 0x07, 0xcd, 0x55, 0x54, 0x66, 0x43, 0xa4, 0x64, 0x7c,
-	0xa5, 0x6b, 0x14, 0xaf, 0x45, 0x09, 0xc0, 0x6f, 0xde, 0xd8, 0x7c, 0xe1, 0x20, 0xbb, 0x12, 0x7a,
-	0x09, 0x03, 0x29, 0x94, 0xb9, 0x73, 0x19, 0x7f, 0x3c, 0x8f, 0x45, 0x5b, 0x8a, 0x76, 0x7c, 0xf1,
-	0x89, 0xfd, 0x6f, 0x78, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x65, 0x89, 0x38, 0x67, 0x70, 0x04,
-	0x00, 0x00,
+	// 521 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x95, 0x76, 0x1b, 0xdb, 0x4b, 0x3b, 0x15, 0xb3, 0x0d, 0x53, 0x21, 0x14, 0x2a, 0x21,
+	0xf5, 0x94, 0x4a, 0x45, 0x20, 0xc6, 0x0d, 0xf1, 0x43, 0xaa, 0xb4, 0x49, 0x55, 0x2a, 0xb8, 0xbb,
+	0x89, 0xd7, 0x5a, 0xa4, 0xb6, 0xb1, 0x9d, 0x8a, 0x1c, 0xe1, 0x2f, 0xe0, 0xc2, 0x8d, 0x3f, 0x16,
+	0xc5, 0x71, 0x7e, 0x94, 0x56, 0xe2, 0xb0, 0x5b, 0xfc, 0x7d, 0xdf, 0xf7, 0xed, 0x27, 0xcf, 0x2f,
+	0x85, 0xbe, 0xa4, 0x4a, 0x33, 0x6d, 0x42, 0xa9, 0x84, 0x11, 0xe8, 0xd8, 0xe4, 0x92, 0xea, 0x61,
+	0xb8, 0x62, 0x66, 0x9d, 0x2d, 0xc3, 0x58, 0x6c, 0x26, 0xeb, 0x5c, 0x52, 0xb5, 0xfe, 0x36, 0x51,
+	0x19, 0xdf, 0x4e, 0x88, 0x64, 0x93, 0x84, 0xea, 0x58, 0x31, 0x69, 0x98, 0xe0, 0xba, 0x6c, 0x1b,
+	0xfa, 0xb6, 0xad, 0x3c, 0x8c, 0xfe, 0x78, 0x30, 0x98, 0x97, 0xa9, 0x73, 0x91, 0xdc, 0x90, 0x5c,
+	0x64, 0x06, 0x9d, 0x43, 0x87, 0x25, 0xd8, 0x0b, 0xbc, 0xf1, 0x59, 0xd4, 0x61, 0x09, 0x7a, 0x06,
+	0xb0, 0x4a, 0xc5, 0x92, 0xa4, 0x0b, 0x49, 0x63, 0xec, 0x5b, 0xbd, 0xa5, 0x14, 0xf5, 0x58, 0x70,
+	0x43, 0x18, 0xa7, 0x4a, 0xe3, 0xcb, 0xa0, 0x5b, 0xd4, 0x1b, 0x05, 0x61, 0x78, 0xb0, 0x15, 0x69,
+	0xb6, 0xa1, 0x1a, 0x5f, 0xd9, 0x62, 0x75, 0x2c, 0x3a, 0x19, 0x37, 0x54, 0xdd, 0x91, 0x98, 0x6a,
+	0xfc, 0xb8, 0xec, 0x6c, 0x94, 0xd1, 0xcf, 0x0e, 0x9c, 0x37, 0x78, 0xb7, 0xd4, 0x90, 0x3d, 0xb8,
+	0x10, 0x4e, 0x35, 0x55, 0x5b, 0x56, 0x04, 0xf8, 0x41, 0x77, 0xec, 0x4f, 0x51, 0x58, 0xbe, 0xe1,
+	0x67, 0x4d, 0xd5, 0xa2, 0x2c, 0x45, 0xb5, 0x07, 0x5d, 0xc3, 0x49, 0x4a, 0x96, 0x34, 0xd5, 0xb8,
+	0x67, 0xdd, 0xcf, 0x9d, 0x7b, 0xf7, 0x67, 0xc2, 0x1b, 0xeb, 0xf9, 0xc8, 0x8d, 0xca, 0x23, 0xd7,
+	0x80, 0x9e, 0xc2, 0x19, 0x27, 0x1b, 0xaa, 0x25, 0x89, 0x29, 0xee, 0x5b, 0x82, 0x46, 0x28, 0xaa,
+	0xb1, 0xa2, 0xc4, 0xd0, 0xe4, 0x9d, 0xc1, 0x97, 0x81, 0x37, 0xee, 0x46, 0x8d, 0x30, 0xbc, 0x06,
+	0xbf, 0x15, 0x89, 0x06, 0xd0, 0xfd, 0x4a, 0x73, 0xf7, 0x1a, 0xc5, 0x23, 0xba, 0x80, 0xe3, 0x2d,
+	0x49, 0x33, 0x8a, 0x3b, 0x56, 0x2b, 0x0f, 0x6f, 0x3b, 0x6f, 0xbc, 0xd1, 0x27, 0x40, 0x0b, 0xc2,
+	0x93, 0xa5, 0xf8, 0xee, 0x18, 0x67, 0xfc, 0x4e, 0xec, 0xcd, 0x21, 0x00, 0xbf, 0x55, 0xb6, 0x29,
+	0xbd, 0xa8, 0x2d, 0x8d, 0x7e, 0x37, 0x77, 0xfd, 0xbe, 0xba, 0x9c, 0xbd, 0x98, 0x01, 0x74, 0xa5,
+	0x48, 0x1c, 0x44, 0xf1, 0x88, 0xc6, 0x70, 0xa4, 0xab, 0x7b, 0xf7, 0xa7, 0x17, 0xad, 0xe1, 0xd6,
+	0x29, 0x91, 0x75, 0xa0, 0x57, 0x70, 0x5a, 0xed, 0x1b, 0xee, 0x59, 0xf7, 0x93, 0x90, 0x48, 0x16,
+	0xd6, 0xbe, 0x0f, 0xcd, 0x36, 0x46, 0xb5, 0x75, 0xf4, 0xcb, 0x83, 0xbe, 0xe3, 0xfa, 0x62, 0xf7,
+	0x02, 0x21, 0x38, 0x2a, 0xe6, 0xea, 0xb0, 0xec, 0xf3, 0x01, 0xb0, 0x17, 0x3b, 0x60, 0x0f, 0x5b,
+	0x60, 0x65, 0x8c, 0xa3, 0x9a, 0xee, 0x51, 0x5d, 0x59, 0xaa, 0xd2, 0x74, 0x18, 0xa9, 0x35, 0xaa,
+	0x59, 0xb5, 0x8d, 0xf7, 0x1a, 0x55, 0x9d, 0xf2, 0x9f, 0x51, 0xd5, 0xbe, 0xc3, 0x5c, 0x3f, 0x3c,
+	0x78, 0x54, 0x2f, 0xaa, 0x32, 0x1b, 0x22, 0x25, 0xe3, 0x2b, 0x5d, 0xa1, 0x78, 0x0d, 0x4a, 0x00,
+	0x7e, 0xfd, 0x05, 0xce, 0xe6, 0x0e, 0xb2, 0x2d, 0xa1, 0xd7, 0xd0, 0x93, 0x42, 0x99, 0x5b, 0x97,
+	0xf1, 0xcf, 0xc7, 0x33, 0x6f, 0x4a, 0xd1, 0x8e, 0x6f, 0x79, 0x62, 0xff, 0x39, 0x5e, 0xfe, 0x1d,
+	0x00, 0x79, 0x18, 0xcb, 0x66, 0x8e, 0x04, 0x00, 0x00,
 }
@@ -25,6 +25,7 @@ It has these top-level messages:
 	PodStatus
 	PodInfo
 	ImageInfo
+	ExecInfo
 	PodStats
 	CpuStats
 	CpuUsage
@@ -39,14 +40,24 @@ It has these top-level messages:
 	ContainersStats
 	PodInfoRequest
 	PodInfoResponse
+	PodExportRequest
+	PodExportResponse
 	PodListRequest
 	PodListResult
 	PodListResponse
+	PodWatchRequest
+	PodWatchObject
+	PodWatchEvent
+	StoragePoolStatus
 	ContainerListRequest
 	ContainerListResult
 	ContainerListResponse
 	ContainerInfoRequest
 	ContainerInfoResponse
+	ImageInfoRequest
+	ImageInfoResponse
+	ExecInfoRequest
+	ExecInfoResponse
 	VMListResult
 	VMListRequest
 	VMListResponse
@@ -66,6 +77,7 @@ It has these top-level messages:
 	UserFile
 	UserVolumeOption
 	UserVolume
+	UserVolumeEncryption
 	UserInterface
 	UserServiceBackend
 	UserService
@@ -101,6 +113,8 @@ It has these top-level messages:
 	ContainerStartResponse
 	ContainerRenameRequest
 	ContainerRenameResponse
+	ContainerCommitRequest
+	ContainerCommitResponse
 	ContainerRemoveRequest
 	ContainerRemoveResponse
 	AuthConfig
@@ -108,9 +122,17 @@ It has these top-level messages:
 	ImagePullResponse
 	ImagePushRequest
 	ImagePushResponse
+	ImageBuildRequest
+	ImageBuildResponse
+	ImageLoadRequest
+	ImageLoadResponse
+	ImageSaveRequest
+	ImageSaveResponse
 	ImageRemoveRequest
 	ImageDelete
 	ImageRemoveResponse
+	AuthRequest
+	AuthResponse
 	ContainerStopRequest
 	ContainerStopResponse
 	VersionRequest
@@ -127,6 +149,33 @@ It has these top-level messages:
 	PortMappingListResponse
 	PortMappingModifyRequest
 	PortMappingModifyResponse
+	VolumeInfo
+	VolumeCreateRequest
+	VolumeCreateResponse
+	VolumeListRequest
+	VolumeListResponse
+	VolumeInspectRequest
+	VolumeInspectResponse
+	VolumeRemoveRequest
+	VolumeRemoveResponse
+	VolumeSnapshotInfo
+	VolumeSnapshotRequest
+	VolumeSnapshotResponse
+	VolumeSnapshotListRequest
+	VolumeSnapshotListResponse
+	VolumeCloneRequest
+	VolumeCloneResponse
+	VolumeSnapshotRemoveRequest
+	VolumeSnapshotRemoveResponse
+	VolumeExportRequest
+	VolumeExportResponse
+	VolumeImportRequest
+	VolumeImportResponse
+	StorageOrphan
+	SystemGCRequest
+	SystemGCResponse
+	SystemDBBackupRequest
+	SystemDBBackupResponse
 	PodStopRequest
 	PodStopResponse
 	PodSignalRequest
@@ -139,6 +188,8 @@ It has these top-level messages:
 	PodLabelsResponse
 	PodStatsRequest
 	PodStatsResponse
+	ConfigReloadRequest
+	ConfigReloadResponse
 	PingRequest
 	PingResponse
 	ContainerSignalRequest
@@ -471,6 +522,9 @@ type Container struct {
 	Env             []*EnvironmentVar `protobuf:"bytes,12,rep,name=env" json:"env,omitempty"`
 	VolumeMounts    []*VolumeMount    `protobuf:"bytes,13,rep,name=volumeMounts" json:"volumeMounts,omitempty"`
 	Labels          map[string]string `protobuf:"bytes,14,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// rootfsSize is the effective size of the rootfs in bytes, 0 if unknown
+	// or not limited
+	RootfsSize int64 `protobuf:"varint,15,opt,name=rootfsSize,proto3" json:"rootfsSize,omitempty"`
 }
 
 func (m *Container) Reset()                    { *m = Container{} }
@@ -576,6 +630,13 @@ func (m *Container) GetLabels() map[string]string {
 	return nil
 }
 
+func (m *Container) GetRootfsSize() int64 {
+	if m != nil {
+		return m.RootfsSize
+	}
+	return 0
+}
+
 type RBDVolumeSource struct {
 	Monitors []string `protobuf:"bytes,1,rep,name=monitors" json:"monitors,omitempty"`
 	Image    string   `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
@@ -645,6 +706,9 @@ type PodVolume struct {
 	Source string           `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
 	Driver string           `protobuf:"bytes,3,opt,name=driver,proto3" json:"driver,omitempty"`
 	Rbd    *RBDVolumeSource `protobuf:"bytes,4,opt,name=rbd" json:"rbd,omitempty"`
+	// size is the size limit in bytes, usage is the disk space used in bytes
+	Size_ int64 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Usage int64 `protobuf:"varint,6,opt,name=usage,proto3" json:"usage,omitempty"`
 }
 
 func (m *PodVolume) Reset()                    { *m = PodVolume{} }
@@ -680,6 +744,20 @@ func (m *PodVolume) GetRbd() *RBDVolumeSource {
 	return nil
 }
 
+func (m *PodVolume) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *PodVolume) GetUsage() int64 {
+	if m != nil {
+		return m.Usage
+	}
+	return 0
+}
+
 type PodSpec struct {
 	Volumes    []*PodVolume      `protobuf:"bytes,1,rep,name=volumes" json:"volumes,omitempty"`
 	Containers []*Container      `protobuf:"bytes,2,rep,name=containers" json:"containers,omitempty"`
@@ -936,6 +1014,71 @@ func (m *ImageInfo) GetLabels() map[string]string {
 	return nil
 }
 
+type ExecInfo struct {
+	ExecID      string   `protobuf:"bytes,1,opt,name=execID,proto3" json:"execID,omitempty"`
+	ContainerID string   `protobuf:"bytes,2,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	PodID       string   `protobuf:"bytes,3,opt,name=podID,proto3" json:"podID,omitempty"`
+	Command     []string `protobuf:"bytes,4,rep,name=command" json:"command,omitempty"`
+	Tty         bool     `protobuf:"varint,5,opt,name=tty,proto3" json:"tty,omitempty"`
+	// status is one of created, running and exited
+	Status   string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	ExitCode int32  `protobuf:"varint,7,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
+}
+
+func (m *ExecInfo) Reset()                    { *m = ExecInfo{} }
+func (m *ExecInfo) String() string            { return proto.CompactTextString(m) }
+func (*ExecInfo) ProtoMessage()               {}
+func (*ExecInfo) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{15} }
+
+func (m *ExecInfo) GetExecID() string {
+	if m != nil {
+		return m.ExecID
+	}
+	return ""
+}
+
+func (m *ExecInfo) GetContainerID() string {
+	if m != nil {
+		return m.ContainerID
+	}
+	return ""
+}
+
+func (m *ExecInfo) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *ExecInfo) GetCommand() []string {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *ExecInfo) GetTty() bool {
+	if m != nil {
+		return m.Tty
+	}
+	return false
+}
+
+func (m *ExecInfo) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ExecInfo) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
 type PodStats struct {
 	Cpu             *CpuStats          `protobuf:"bytes,1,opt,name=cpu" json:"cpu,omitempty"`
 	Block           *BlkioStats        `protobuf:"bytes,2,opt,name=block" json:"block,omitempty"`
@@ -949,7 +1092,7 @@ type PodStats struct {
 func (m *PodStats) Reset()                    { *m = PodStats{} }
 func (m *PodStats) String() string            { return proto.CompactTextString(m) }
 func (*PodStats) ProtoMessage()               {}
-func (*PodStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{15} }
+func (*PodStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{16} }
 
 func (m *PodStats) GetCpu() *CpuStats {
 	if m != nil {
@@ -1008,7 +1151,7 @@ type CpuStats struct {
 func (m *CpuStats) Reset()                    { *m = CpuStats{} }
 func (m *CpuStats) String() string            { return proto.CompactTextString(m) }
 func (*CpuStats) ProtoMessage()               {}
-func (*CpuStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{16} }
+func (*CpuStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{17} }
 
 func (m *CpuStats) GetUsage() *CpuUsage {
 	if m != nil {
@@ -1034,7 +1177,7 @@ type CpuUsage struct {
 func (m *CpuUsage) Reset()                    { *m = CpuUsage{} }
 func (m *CpuUsage) String() string            { return proto.CompactTextString(m) }
 func (*CpuUsage) ProtoMessage()               {}
-func (*CpuUsage) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{17} }
+func (*CpuUsage) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{18} }
 
 func (m *CpuUsage) GetTotal() uint64 {
 	if m != nil {
@@ -1078,7 +1221,7 @@ type BlkioStats struct {
 func (m *BlkioStats) Reset()                    { *m = BlkioStats{} }
 func (m *BlkioStats) String() string            { return proto.CompactTextString(m) }
 func (*BlkioStats) ProtoMessage()               {}
-func (*BlkioStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{18} }
+func (*BlkioStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{19} }
 
 func (m *BlkioStats) GetIoServiceBytesRecursive() []*BlkioStatEntry {
 	if m != nil {
@@ -1148,7 +1291,7 @@ type BlkioStatEntry struct {
 func (m *BlkioStatEntry) Reset()                    { *m = BlkioStatEntry{} }
 func (m *BlkioStatEntry) String() string            { return proto.CompactTextString(m) }
 func (*BlkioStatEntry) ProtoMessage()               {}
-func (*BlkioStatEntry) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{19} }
+func (*BlkioStatEntry) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{20} }
 
 func (m *BlkioStatEntry) GetName() string {
 	if m != nil {
@@ -1203,7 +1346,7 @@ type MemoryStats struct {
 func (m *MemoryStats) Reset()                    { *m = MemoryStats{} }
 func (m *MemoryStats) String() string            { return proto.CompactTextString(m) }
 func (*MemoryStats) ProtoMessage()               {}
-func (*MemoryStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{20} }
+func (*MemoryStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{21} }
 
 func (m *MemoryStats) GetUsage() uint64 {
 	if m != nil {
@@ -1248,7 +1391,7 @@ type MemoryStatsMemoryData struct {
 func (m *MemoryStatsMemoryData) Reset()                    { *m = MemoryStatsMemoryData{} }
 func (m *MemoryStatsMemoryData) String() string            { return proto.CompactTextString(m) }
 func (*MemoryStatsMemoryData) ProtoMessage()               {}
-func (*MemoryStatsMemoryData) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{21} }
+func (*MemoryStatsMemoryData) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{22} }
 
 func (m *MemoryStatsMemoryData) GetPgfault() uint64 {
 	if m != nil {
@@ -1273,7 +1416,7 @@ type NetworkStats struct {
 func (m *NetworkStats) Reset()                    { *m = NetworkStats{} }
 func (m *NetworkStats) String() string            { return proto.CompactTextString(m) }
 func (*NetworkStats) ProtoMessage()               {}
-func (*NetworkStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{22} }
+func (*NetworkStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{23} }
 
 func (m *NetworkStats) GetInterfaces() []*InterfaceStats {
 	if m != nil {
@@ -1313,7 +1456,7 @@ type TcpStat struct {
 func (m *TcpStat) Reset()                    { *m = TcpStat{} }
 func (m *TcpStat) String() string            { return proto.CompactTextString(m) }
 func (*TcpStat) ProtoMessage()               {}
-func (*TcpStat) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{23} }
+func (*TcpStat) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{24} }
 
 func (m *TcpStat) GetEstablished() uint64 {
 	if m != nil {
@@ -1407,7 +1550,7 @@ type InterfaceStats struct {
 func (m *InterfaceStats) Reset()                    { *m = InterfaceStats{} }
 func (m *InterfaceStats) String() string            { return proto.CompactTextString(m) }
 func (*InterfaceStats) ProtoMessage()               {}
-func (*InterfaceStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{24} }
+func (*InterfaceStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{25} }
 
 func (m *InterfaceStats) GetName() string {
 	if m != nil {
@@ -1493,7 +1636,7 @@ type FsStats struct {
 func (m *FsStats) Reset()                    { *m = FsStats{} }
 func (m *FsStats) String() string            { return proto.CompactTextString(m) }
 func (*FsStats) ProtoMessage()               {}
-func (*FsStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{25} }
+func (*FsStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{26} }
 
 func (m *FsStats) GetDevice() string {
 	if m != nil {
@@ -1613,7 +1756,7 @@ type ContainersStats struct {
 func (m *ContainersStats) Reset()                    { *m = ContainersStats{} }
 func (m *ContainersStats) String() string            { return proto.CompactTextString(m) }
 func (*ContainersStats) ProtoMessage()               {}
-func (*ContainersStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{26} }
+func (*ContainersStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{27} }
 
 func (m *ContainersStats) GetContainerID() string {
 	if m != nil {
@@ -1665,13 +1808,14 @@ func (m *ContainersStats) GetTimestamp() int64 {
 }
 
 type PodInfoRequest struct {
-	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	PodID     string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *PodInfoRequest) Reset()                    { *m = PodInfoRequest{} }
 func (m *PodInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodInfoRequest) ProtoMessage()               {}
-func (*PodInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{27} }
+func (*PodInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{28} }
 
 func (m *PodInfoRequest) GetPodID() string {
 	if m != nil {
@@ -1680,6 +1824,13 @@ func (m *PodInfoRequest) GetPodID() string {
 	return ""
 }
 
+func (m *PodInfoRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type PodInfoResponse struct {
 	PodInfo *PodInfo `protobuf:"bytes,1,opt,name=podInfo" json:"podInfo,omitempty"`
 }
@@ -1687,7 +1838,7 @@ type PodInfoResponse struct {
 func (m *PodInfoResponse) Reset()                    { *m = PodInfoResponse{} }
 func (m *PodInfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodInfoResponse) ProtoMessage()               {}
-func (*PodInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{28} }
+func (*PodInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{29} }
 
 func (m *PodInfoResponse) GetPodInfo() *PodInfo {
 	if m != nil {
@@ -1696,15 +1847,56 @@ func (m *PodInfoResponse) GetPodInfo() *PodInfo {
 	return nil
 }
 
+type PodExportRequest struct {
+	PodID     string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *PodExportRequest) Reset()                    { *m = PodExportRequest{} }
+func (m *PodExportRequest) String() string            { return proto.CompactTextString(m) }
+func (*PodExportRequest) ProtoMessage()               {}
+func (*PodExportRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{30} }
+
+func (m *PodExportRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *PodExportRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type PodExportResponse struct {
+	PodSpec *UserPod `protobuf:"bytes,1,opt,name=podSpec" json:"podSpec,omitempty"`
+}
+
+func (m *PodExportResponse) Reset()                    { *m = PodExportResponse{} }
+func (m *PodExportResponse) String() string            { return proto.CompactTextString(m) }
+func (*PodExportResponse) ProtoMessage()               {}
+func (*PodExportResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{31} }
+
+func (m *PodExportResponse) GetPodSpec() *UserPod {
+	if m != nil {
+		return m.PodSpec
+	}
+	return nil
+}
+
 type PodListRequest struct {
-	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
-	VmID  string `protobuf:"bytes,2,opt,name=vmID,proto3" json:"vmID,omitempty"`
+	PodID     string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	VmID      string `protobuf:"bytes,2,opt,name=vmID,proto3" json:"vmID,omitempty"`
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *PodListRequest) Reset()                    { *m = PodListRequest{} }
 func (m *PodListRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodListRequest) ProtoMessage()               {}
-func (*PodListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{29} }
+func (*PodListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{32} }
 
 func (m *PodListRequest) GetPodID() string {
 	if m != nil {
@@ -1720,6 +1912,13 @@ func (m *PodListRequest) GetVmID() string {
 	return ""
 }
 
+func (m *PodListRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type PodListResult struct {
 	PodID     string            `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
 	PodName   string            `protobuf:"bytes,2,opt,name=podName,proto3" json:"podName,omitempty"`
@@ -1727,12 +1926,13 @@ type PodListResult struct {
 	Status    string            `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
 	CreatedAt int64             `protobuf:"varint,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	Labels    map[string]string `protobuf:"bytes,6,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Namespace string            `protobuf:"bytes,7,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *PodListResult) Reset()                    { *m = PodListResult{} }
 func (m *PodListResult) String() string            { return proto.CompactTextString(m) }
 func (*PodListResult) ProtoMessage()               {}
-func (*PodListResult) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{30} }
+func (*PodListResult) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{33} }
 
 func (m *PodListResult) GetPodID() string {
 	if m != nil {
@@ -1776,6 +1976,13 @@ func (m *PodListResult) GetLabels() map[string]string {
 	return nil
 }
 
+func (m *PodListResult) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type PodListResponse struct {
 	PodList []*PodListResult `protobuf:"bytes,1,rep,name=podList" json:"podList,omitempty"`
 }
@@ -1783,7 +1990,7 @@ type PodListResponse struct {
 func (m *PodListResponse) Reset()                    { *m = PodListResponse{} }
 func (m *PodListResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodListResponse) ProtoMessage()               {}
-func (*PodListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{31} }
+func (*PodListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{34} }
 
 func (m *PodListResponse) GetPodList() []*PodListResult {
 	if m != nil {
@@ -1792,15 +1999,207 @@ func (m *PodListResponse) GetPodList() []*PodListResult {
 	return nil
 }
 
+type PodWatchRequest struct {
+	// resourceVersion is the last version seen by the client, 0 means starting
+	// from a full snapshot
+	ResourceVersion uint64 `protobuf:"varint,1,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	// namespace limits the events to the given namespace, all namespaces if empty
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *PodWatchRequest) Reset()                    { *m = PodWatchRequest{} }
+func (m *PodWatchRequest) String() string            { return proto.CompactTextString(m) }
+func (*PodWatchRequest) ProtoMessage()               {}
+func (*PodWatchRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{35} }
+
+func (m *PodWatchRequest) GetResourceVersion() uint64 {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return 0
+}
+
+func (m *PodWatchRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type PodWatchObject struct {
+	Pod          *PodListResult         `protobuf:"bytes,1,opt,name=pod" json:"pod,omitempty"`
+	Containers   []*ContainerListResult `protobuf:"bytes,2,rep,name=containers" json:"containers,omitempty"`
+	Portmappings []*PortMapping         `protobuf:"bytes,3,rep,name=portmappings" json:"portmappings,omitempty"`
+	Services     []*UserService         `protobuf:"bytes,4,rep,name=services" json:"services,omitempty"`
+}
+
+func (m *PodWatchObject) Reset()                    { *m = PodWatchObject{} }
+func (m *PodWatchObject) String() string            { return proto.CompactTextString(m) }
+func (*PodWatchObject) ProtoMessage()               {}
+func (*PodWatchObject) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{36} }
+
+func (m *PodWatchObject) GetPod() *PodListResult {
+	if m != nil {
+		return m.Pod
+	}
+	return nil
+}
+
+func (m *PodWatchObject) GetContainers() []*ContainerListResult {
+	if m != nil {
+		return m.Containers
+	}
+	return nil
+}
+
+func (m *PodWatchObject) GetPortmappings() []*PortMapping {
+	if m != nil {
+		return m.Portmappings
+	}
+	return nil
+}
+
+func (m *PodWatchObject) GetServices() []*UserService {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+type PodWatchEvent struct {
+	// type is one of SNAPSHOT, ADDED, MODIFIED and DELETED
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// kind is the kind of the changed object, pod, container or storage-pool
+	Kind            string               `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	ResourceVersion uint64               `protobuf:"varint,3,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	Pod             *PodWatchObject      `protobuf:"bytes,4,opt,name=pod" json:"pod,omitempty"`
+	Container       *ContainerListResult `protobuf:"bytes,5,opt,name=container" json:"container,omitempty"`
+	Snapshot        []*PodWatchObject    `protobuf:"bytes,6,rep,name=snapshot" json:"snapshot,omitempty"`
+	Pool            *StoragePoolStatus   `protobuf:"bytes,7,opt,name=pool" json:"pool,omitempty"`
+}
+
+func (m *PodWatchEvent) Reset()                    { *m = PodWatchEvent{} }
+func (m *PodWatchEvent) String() string            { return proto.CompactTextString(m) }
+func (*PodWatchEvent) ProtoMessage()               {}
+func (*PodWatchEvent) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{37} }
+
+func (m *PodWatchEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *PodWatchEvent) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *PodWatchEvent) GetResourceVersion() uint64 {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return 0
+}
+
+func (m *PodWatchEvent) GetPod() *PodWatchObject {
+	if m != nil {
+		return m.Pod
+	}
+	return nil
+}
+
+func (m *PodWatchEvent) GetContainer() *ContainerListResult {
+	if m != nil {
+		return m.Container
+	}
+	return nil
+}
+
+func (m *PodWatchEvent) GetSnapshot() []*PodWatchObject {
+	if m != nil {
+		return m.Snapshot
+	}
+	return nil
+}
+
+func (m *PodWatchEvent) GetPool() *StoragePoolStatus {
+	if m != nil {
+		return m.Pool
+	}
+	return nil
+}
+
+type StoragePoolStatus struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the space usage in bytes
+	DataUsed      int64 `protobuf:"varint,2,opt,name=dataUsed,proto3" json:"dataUsed,omitempty"`
+	DataTotal     int64 `protobuf:"varint,3,opt,name=dataTotal,proto3" json:"dataTotal,omitempty"`
+	MetadataUsed  int64 `protobuf:"varint,4,opt,name=metadataUsed,proto3" json:"metadataUsed,omitempty"`
+	MetadataTotal int64 `protobuf:"varint,5,opt,name=metadataTotal,proto3" json:"metadataTotal,omitempty"`
+	// level is one of ok, warning and full
+	Level string `protobuf:"bytes,6,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (m *StoragePoolStatus) Reset()                    { *m = StoragePoolStatus{} }
+func (m *StoragePoolStatus) String() string            { return proto.CompactTextString(m) }
+func (*StoragePoolStatus) ProtoMessage()               {}
+func (*StoragePoolStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{38} }
+
+func (m *StoragePoolStatus) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StoragePoolStatus) GetDataUsed() int64 {
+	if m != nil {
+		return m.DataUsed
+	}
+	return 0
+}
+
+func (m *StoragePoolStatus) GetDataTotal() int64 {
+	if m != nil {
+		return m.DataTotal
+	}
+	return 0
+}
+
+func (m *StoragePoolStatus) GetMetadataUsed() int64 {
+	if m != nil {
+		return m.MetadataUsed
+	}
+	return 0
+}
+
+func (m *StoragePoolStatus) GetMetadataTotal() int64 {
+	if m != nil {
+		return m.MetadataTotal
+	}
+	return 0
+}
+
+func (m *StoragePoolStatus) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
 type ContainerListRequest struct {
-	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
-	VmID  string `protobuf:"bytes,2,opt,name=vmID,proto3" json:"vmID,omitempty"`
+	PodID     string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	VmID      string `protobuf:"bytes,2,opt,name=vmID,proto3" json:"vmID,omitempty"`
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *ContainerListRequest) Reset()                    { *m = ContainerListRequest{} }
 func (m *ContainerListRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerListRequest) ProtoMessage()               {}
-func (*ContainerListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{32} }
+func (*ContainerListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{39} }
 
 func (m *ContainerListRequest) GetPodID() string {
 	if m != nil {
@@ -1816,6 +2215,13 @@ func (m *ContainerListRequest) GetVmID() string {
 	return ""
 }
 
+func (m *ContainerListRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type ContainerListResult struct {
 	ContainerID   string `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
 	ContainerName string `protobuf:"bytes,2,opt,name=containerName,proto3" json:"containerName,omitempty"`
@@ -1826,7 +2232,7 @@ type ContainerListResult struct {
 func (m *ContainerListResult) Reset()                    { *m = ContainerListResult{} }
 func (m *ContainerListResult) String() string            { return proto.CompactTextString(m) }
 func (*ContainerListResult) ProtoMessage()               {}
-func (*ContainerListResult) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{33} }
+func (*ContainerListResult) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{40} }
 
 func (m *ContainerListResult) GetContainerID() string {
 	if m != nil {
@@ -1863,7 +2269,7 @@ type ContainerListResponse struct {
 func (m *ContainerListResponse) Reset()                    { *m = ContainerListResponse{} }
 func (m *ContainerListResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerListResponse) ProtoMessage()               {}
-func (*ContainerListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{34} }
+func (*ContainerListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{41} }
 
 func (m *ContainerListResponse) GetContainerList() []*ContainerListResult {
 	if m != nil {
@@ -1875,12 +2281,13 @@ func (m *ContainerListResponse) GetContainerList() []*ContainerListResult {
 type ContainerInfoRequest struct {
 	// container is the name or id of specified container
 	Container string `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *ContainerInfoRequest) Reset()                    { *m = ContainerInfoRequest{} }
 func (m *ContainerInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerInfoRequest) ProtoMessage()               {}
-func (*ContainerInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{35} }
+func (*ContainerInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{42} }
 
 func (m *ContainerInfoRequest) GetContainer() string {
 	if m != nil {
@@ -1889,6 +2296,13 @@ func (m *ContainerInfoRequest) GetContainer() string {
 	return ""
 }
 
+func (m *ContainerInfoRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type ContainerInfoResponse struct {
 	ContainerInfo *ContainerInfo `protobuf:"bytes,1,opt,name=containerInfo" json:"containerInfo,omitempty"`
 }
@@ -1896,7 +2310,7 @@ type ContainerInfoResponse struct {
 func (m *ContainerInfoResponse) Reset()                    { *m = ContainerInfoResponse{} }
 func (m *ContainerInfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerInfoResponse) ProtoMessage()               {}
-func (*ContainerInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{36} }
+func (*ContainerInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{43} }
 
 func (m *ContainerInfoResponse) GetContainerInfo() *ContainerInfo {
 	if m != nil {
@@ -1905,47 +2319,138 @@ func (m *ContainerInfoResponse) GetContainerInfo() *ContainerInfo {
 	return nil
 }
 
-type VMListResult struct {
-	VmID   string `protobuf:"bytes,1,opt,name=vmID,proto3" json:"vmID,omitempty"`
-	PodID  string `protobuf:"bytes,2,opt,name=podID,proto3" json:"podID,omitempty"`
-	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+type ImageInfoRequest struct {
+	// image is the name or id of specified image
+	Image string `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
 }
 
-func (m *VMListResult) Reset()                    { *m = VMListResult{} }
-func (m *VMListResult) String() string            { return proto.CompactTextString(m) }
-func (*VMListResult) ProtoMessage()               {}
-func (*VMListResult) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{37} }
+func (m *ImageInfoRequest) Reset()                    { *m = ImageInfoRequest{} }
+func (m *ImageInfoRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImageInfoRequest) ProtoMessage()               {}
+func (*ImageInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{44} }
 
-func (m *VMListResult) GetVmID() string {
+func (m *ImageInfoRequest) GetImage() string {
 	if m != nil {
-		return m.VmID
+		return m.Image
 	}
 	return ""
 }
 
-func (m *VMListResult) GetPodID() string {
+type ImageInfoResponse struct {
+	ImageInfo *ImageInfo `protobuf:"bytes,1,opt,name=imageInfo" json:"imageInfo,omitempty"`
+}
+
+func (m *ImageInfoResponse) Reset()                    { *m = ImageInfoResponse{} }
+func (m *ImageInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*ImageInfoResponse) ProtoMessage()               {}
+func (*ImageInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{45} }
+
+func (m *ImageInfoResponse) GetImageInfo() *ImageInfo {
 	if m != nil {
-		return m.PodID
+		return m.ImageInfo
 	}
-	return ""
+	return nil
 }
 
-func (m *VMListResult) GetStatus() string {
+type ExecInfoRequest struct {
+	ExecID string `protobuf:"bytes,1,opt,name=execID,proto3" json:"execID,omitempty"`
+}
+
+func (m *ExecInfoRequest) Reset()                    { *m = ExecInfoRequest{} }
+func (m *ExecInfoRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExecInfoRequest) ProtoMessage()               {}
+func (*ExecInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{46} }
+
+func (m *ExecInfoRequest) GetExecID() string {
 	if m != nil {
-		return m.Status
+		return m.ExecID
 	}
 	return ""
 }
 
-type VMListRequest struct {
-	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
-	VmID  string `protobuf:"bytes,2,opt,name=vmID,proto3" json:"vmID,omitempty"`
+type ExecInfoResponse struct {
+	ExecInfo *ExecInfo `protobuf:"bytes,1,opt,name=execInfo" json:"execInfo,omitempty"`
 }
 
-func (m *VMListRequest) Reset()                    { *m = VMListRequest{} }
+func (m *ExecInfoResponse) Reset()                    { *m = ExecInfoResponse{} }
+func (m *ExecInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExecInfoResponse) ProtoMessage()               {}
+func (*ExecInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{47} }
+
+func (m *ExecInfoResponse) GetExecInfo() *ExecInfo {
+	if m != nil {
+		return m.ExecInfo
+	}
+	return nil
+}
+
+type VMListResult struct {
+	VmID   string `protobuf:"bytes,1,opt,name=vmID,proto3" json:"vmID,omitempty"`
+	PodID  string `protobuf:"bytes,2,opt,name=podID,proto3" json:"podID,omitempty"`
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Cpu    int32  `protobuf:"varint,4,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory int32  `protobuf:"varint,5,opt,name=memory,proto3" json:"memory,omitempty"`
+	// createdAt is the unix time the VM was created, 0 if unknown
+	CreatedAt int64 `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (m *VMListResult) Reset()                    { *m = VMListResult{} }
+func (m *VMListResult) String() string            { return proto.CompactTextString(m) }
+func (*VMListResult) ProtoMessage()               {}
+func (*VMListResult) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{48} }
+
+func (m *VMListResult) GetVmID() string {
+	if m != nil {
+		return m.VmID
+	}
+	return ""
+}
+
+func (m *VMListResult) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *VMListResult) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *VMListResult) GetCpu() int32 {
+	if m != nil {
+		return m.Cpu
+	}
+	return 0
+}
+
+func (m *VMListResult) GetMemory() int32 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *VMListResult) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type VMListRequest struct {
+	PodID     string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	VmID      string `protobuf:"bytes,2,opt,name=vmID,proto3" json:"vmID,omitempty"`
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *VMListRequest) Reset()                    { *m = VMListRequest{} }
 func (m *VMListRequest) String() string            { return proto.CompactTextString(m) }
 func (*VMListRequest) ProtoMessage()               {}
-func (*VMListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{38} }
+func (*VMListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{49} }
 
 func (m *VMListRequest) GetPodID() string {
 	if m != nil {
@@ -1961,6 +2466,13 @@ func (m *VMListRequest) GetVmID() string {
 	return ""
 }
 
+func (m *VMListRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type VMListResponse struct {
 	VmList []*VMListResult `protobuf:"bytes,1,rep,name=vmList" json:"vmList,omitempty"`
 }
@@ -1968,7 +2480,7 @@ type VMListResponse struct {
 func (m *VMListResponse) Reset()                    { *m = VMListResponse{} }
 func (m *VMListResponse) String() string            { return proto.CompactTextString(m) }
 func (*VMListResponse) ProtoMessage()               {}
-func (*VMListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{39} }
+func (*VMListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{50} }
 
 func (m *VMListResponse) GetVmList() []*VMListResult {
 	if m != nil {
@@ -1989,7 +2501,7 @@ type ImageListRequest struct {
 func (m *ImageListRequest) Reset()                    { *m = ImageListRequest{} }
 func (m *ImageListRequest) String() string            { return proto.CompactTextString(m) }
 func (*ImageListRequest) ProtoMessage()               {}
-func (*ImageListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{40} }
+func (*ImageListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{51} }
 
 func (m *ImageListRequest) GetFilterArgs() string {
 	if m != nil {
@@ -2019,7 +2531,7 @@ type ImageListResponse struct {
 func (m *ImageListResponse) Reset()                    { *m = ImageListResponse{} }
 func (m *ImageListResponse) String() string            { return proto.CompactTextString(m) }
 func (*ImageListResponse) ProtoMessage()               {}
-func (*ImageListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{41} }
+func (*ImageListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{52} }
 
 func (m *ImageListResponse) GetImageList() []*ImageInfo {
 	if m != nil {
@@ -2031,12 +2543,14 @@ func (m *ImageListResponse) GetImageList() []*ImageInfo {
 type VMCreateRequest struct {
 	Cpu    int32 `protobuf:"varint,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
 	Memory int32 `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	// async returns without waiting for the VM to start
+	Async bool `protobuf:"varint,3,opt,name=async,proto3" json:"async,omitempty"`
 }
 
 func (m *VMCreateRequest) Reset()                    { *m = VMCreateRequest{} }
 func (m *VMCreateRequest) String() string            { return proto.CompactTextString(m) }
 func (*VMCreateRequest) ProtoMessage()               {}
-func (*VMCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{42} }
+func (*VMCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{53} }
 
 func (m *VMCreateRequest) GetCpu() int32 {
 	if m != nil {
@@ -2052,6 +2566,13 @@ func (m *VMCreateRequest) GetMemory() int32 {
 	return 0
 }
 
+func (m *VMCreateRequest) GetAsync() bool {
+	if m != nil {
+		return m.Async
+	}
+	return false
+}
+
 type VMCreateResponse struct {
 	VmID string `protobuf:"bytes,1,opt,name=vmID,proto3" json:"vmID,omitempty"`
 }
@@ -2059,7 +2580,7 @@ type VMCreateResponse struct {
 func (m *VMCreateResponse) Reset()                    { *m = VMCreateResponse{} }
 func (m *VMCreateResponse) String() string            { return proto.CompactTextString(m) }
 func (*VMCreateResponse) ProtoMessage()               {}
-func (*VMCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{43} }
+func (*VMCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{54} }
 
 func (m *VMCreateResponse) GetVmID() string {
 	if m != nil {
@@ -2075,7 +2596,7 @@ type VMRemoveRequest struct {
 func (m *VMRemoveRequest) Reset()                    { *m = VMRemoveRequest{} }
 func (m *VMRemoveRequest) String() string            { return proto.CompactTextString(m) }
 func (*VMRemoveRequest) ProtoMessage()               {}
-func (*VMRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{44} }
+func (*VMRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{55} }
 
 func (m *VMRemoveRequest) GetVmID() string {
 	if m != nil {
@@ -2092,7 +2613,7 @@ type VMRemoveResponse struct {
 func (m *VMRemoveResponse) Reset()                    { *m = VMRemoveResponse{} }
 func (m *VMRemoveResponse) String() string            { return proto.CompactTextString(m) }
 func (*VMRemoveResponse) ProtoMessage()               {}
-func (*VMRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{45} }
+func (*VMRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{56} }
 
 func (m *VMRemoveResponse) GetCode() int32 {
 	if m != nil {
@@ -2118,7 +2639,7 @@ type UserContainerPort struct {
 func (m *UserContainerPort) Reset()                    { *m = UserContainerPort{} }
 func (m *UserContainerPort) String() string            { return proto.CompactTextString(m) }
 func (*UserContainerPort) ProtoMessage()               {}
-func (*UserContainerPort) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{46} }
+func (*UserContainerPort) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{57} }
 
 func (m *UserContainerPort) GetHostPort() int32 {
 	if m != nil {
@@ -2158,7 +2679,7 @@ type UserVolumeReference struct {
 func (m *UserVolumeReference) Reset()                    { *m = UserVolumeReference{} }
 func (m *UserVolumeReference) String() string            { return proto.CompactTextString(m) }
 func (*UserVolumeReference) ProtoMessage()               {}
-func (*UserVolumeReference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{47} }
+func (*UserVolumeReference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{58} }
 
 func (m *UserVolumeReference) GetPath() string {
 	if m != nil {
@@ -2200,7 +2721,7 @@ type UserFileReference struct {
 func (m *UserFileReference) Reset()                    { *m = UserFileReference{} }
 func (m *UserFileReference) String() string            { return proto.CompactTextString(m) }
 func (*UserFileReference) ProtoMessage()               {}
-func (*UserFileReference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{48} }
+func (*UserFileReference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{59} }
 
 func (m *UserFileReference) GetPath() string {
 	if m != nil {
@@ -2253,7 +2774,7 @@ type UserUser struct {
 func (m *UserUser) Reset()                    { *m = UserUser{} }
 func (m *UserUser) String() string            { return proto.CompactTextString(m) }
 func (*UserUser) ProtoMessage()               {}
-func (*UserUser) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{49} }
+func (*UserUser) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{60} }
 
 func (m *UserUser) GetName() string {
 	if m != nil {
@@ -2285,7 +2806,7 @@ type Ulimit struct {
 func (m *Ulimit) Reset()                    { *m = Ulimit{} }
 func (m *Ulimit) String() string            { return proto.CompactTextString(m) }
 func (*Ulimit) ProtoMessage()               {}
-func (*Ulimit) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{50} }
+func (*Ulimit) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{61} }
 
 func (m *Ulimit) GetName() string {
 	if m != nil {
@@ -2329,12 +2850,15 @@ type UserContainer struct {
 	LogPath       string                 `protobuf:"bytes,19,opt,name=logPath,proto3" json:"logPath,omitempty"`
 	ReadOnly      bool                   `protobuf:"varint,20,opt,name=readOnly,proto3" json:"readOnly,omitempty"`
 	Cache         string                 `protobuf:"bytes,21,opt,name=cache,proto3" json:"cache,omitempty"`
+	// rootfsSize overrides StorageBaseSize for the rootfs of the container in
+	// bytes, it could only grow the rootfs
+	RootfsSize int64 `protobuf:"varint,22,opt,name=rootfsSize,proto3" json:"rootfsSize,omitempty"`
 }
 
 func (m *UserContainer) Reset()                    { *m = UserContainer{} }
 func (m *UserContainer) String() string            { return proto.CompactTextString(m) }
 func (*UserContainer) ProtoMessage()               {}
-func (*UserContainer) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{51} }
+func (*UserContainer) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{62} }
 
 func (m *UserContainer) GetName() string {
 	if m != nil {
@@ -2476,6 +3000,13 @@ func (m *UserContainer) GetCache() string {
 	return ""
 }
 
+func (m *UserContainer) GetRootfsSize() int64 {
+	if m != nil {
+		return m.RootfsSize
+	}
+	return 0
+}
+
 type UserResource struct {
 	Vcpu   int32 `protobuf:"varint,1,opt,name=vcpu,proto3" json:"vcpu,omitempty"`
 	Memory int32 `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
@@ -2484,7 +3015,7 @@ type UserResource struct {
 func (m *UserResource) Reset()                    { *m = UserResource{} }
 func (m *UserResource) String() string            { return proto.CompactTextString(m) }
 func (*UserResource) ProtoMessage()               {}
-func (*UserResource) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{52} }
+func (*UserResource) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{63} }
 
 func (m *UserResource) GetVcpu() int32 {
 	if m != nil {
@@ -2510,7 +3041,7 @@ type UserFile struct {
 func (m *UserFile) Reset()                    { *m = UserFile{} }
 func (m *UserFile) String() string            { return proto.CompactTextString(m) }
 func (*UserFile) ProtoMessage()               {}
-func (*UserFile) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{53} }
+func (*UserFile) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{64} }
 
 func (m *UserFile) GetName() string {
 	if m != nil {
@@ -2549,7 +3080,7 @@ type UserVolumeOption struct {
 func (m *UserVolumeOption) Reset()                    { *m = UserVolumeOption{} }
 func (m *UserVolumeOption) String() string            { return proto.CompactTextString(m) }
 func (*UserVolumeOption) ProtoMessage()               {}
-func (*UserVolumeOption) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{54} }
+func (*UserVolumeOption) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{65} }
 
 func (m *UserVolumeOption) GetUser() string {
 	if m != nil {
@@ -2579,12 +3110,19 @@ type UserVolume struct {
 	Option *UserVolumeOption `protobuf:"bytes,4,opt,name=option" json:"option,omitempty"`
 	Fstype string            `protobuf:"bytes,5,opt,name=fstype,proto3" json:"fstype,omitempty"`
 	Cache  string            `protobuf:"bytes,6,opt,name=cache,proto3" json:"cache,omitempty"`
+	// size limits the volume created by the storage driver, in bytes
+	Size_ int64 `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
+	// encryption opens the raw or qcow2 volume with LUKS
+	Encryption *UserVolumeEncryption `protobuf:"bytes,8,opt,name=encryption" json:"encryption,omitempty"`
+	// driverOpts are passed to the volume plugin named by the format, when
+	// the plugin creates the volume
+	DriverOpts map[string]string `protobuf:"bytes,9,rep,name=driverOpts" json:"driverOpts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *UserVolume) Reset()                    { *m = UserVolume{} }
 func (m *UserVolume) String() string            { return proto.CompactTextString(m) }
 func (*UserVolume) ProtoMessage()               {}
-func (*UserVolume) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{55} }
+func (*UserVolume) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{66} }
 
 func (m *UserVolume) GetName() string {
 	if m != nil {
@@ -2628,6 +3166,64 @@ func (m *UserVolume) GetCache() string {
 	return ""
 }
 
+func (m *UserVolume) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *UserVolume) GetEncryption() *UserVolumeEncryption {
+	if m != nil {
+		return m.Encryption
+	}
+	return nil
+}
+
+func (m *UserVolume) GetDriverOpts() map[string]string {
+	if m != nil {
+		return m.DriverOpts
+	}
+	return nil
+}
+
+type UserVolumeEncryption struct {
+	// keyRef is the key in the format "[provider:]name"
+	KeyRef string `protobuf:"bytes,1,opt,name=keyRef,proto3" json:"keyRef,omitempty"`
+	// cipher of a new volume, aes-xts-plain64 by default
+	Cipher string `protobuf:"bytes,2,opt,name=cipher,proto3" json:"cipher,omitempty"`
+	// created is set by the storage on the volumes it creates, which are
+	// formatted with LUKS when they are opened for the first time. The
+	// volumes of the users are never formatted.
+	Created bool `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (m *UserVolumeEncryption) Reset()                    { *m = UserVolumeEncryption{} }
+func (m *UserVolumeEncryption) String() string            { return proto.CompactTextString(m) }
+func (*UserVolumeEncryption) ProtoMessage()               {}
+func (*UserVolumeEncryption) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{67} }
+
+func (m *UserVolumeEncryption) GetKeyRef() string {
+	if m != nil {
+		return m.KeyRef
+	}
+	return ""
+}
+
+func (m *UserVolumeEncryption) GetCipher() string {
+	if m != nil {
+		return m.Cipher
+	}
+	return ""
+}
+
+func (m *UserVolumeEncryption) GetCreated() bool {
+	if m != nil {
+		return m.Created
+	}
+	return false
+}
+
 type UserInterface struct {
 	Bridge  string `protobuf:"bytes,1,opt,name=bridge,proto3" json:"bridge,omitempty"`
 	Ip      string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
@@ -2641,7 +3237,7 @@ type UserInterface struct {
 func (m *UserInterface) Reset()                    { *m = UserInterface{} }
 func (m *UserInterface) String() string            { return proto.CompactTextString(m) }
 func (*UserInterface) ProtoMessage()               {}
-func (*UserInterface) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{56} }
+func (*UserInterface) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{68} }
 
 func (m *UserInterface) GetBridge() string {
 	if m != nil {
@@ -2700,7 +3296,7 @@ type UserServiceBackend struct {
 func (m *UserServiceBackend) Reset()                    { *m = UserServiceBackend{} }
 func (m *UserServiceBackend) String() string            { return proto.CompactTextString(m) }
 func (*UserServiceBackend) ProtoMessage()               {}
-func (*UserServiceBackend) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{57} }
+func (*UserServiceBackend) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{69} }
 
 func (m *UserServiceBackend) GetHostIP() string {
 	if m != nil {
@@ -2726,7 +3322,7 @@ type UserService struct {
 func (m *UserService) Reset()                    { *m = UserService{} }
 func (m *UserService) String() string            { return proto.CompactTextString(m) }
 func (*UserService) ProtoMessage()               {}
-func (*UserService) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{58} }
+func (*UserService) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{70} }
 
 func (m *UserService) GetServiceIP() string {
 	if m != nil {
@@ -2764,7 +3360,7 @@ type PodLogConfig struct {
 func (m *PodLogConfig) Reset()                    { *m = PodLogConfig{} }
 func (m *PodLogConfig) String() string            { return proto.CompactTextString(m) }
 func (*PodLogConfig) ProtoMessage()               {}
-func (*PodLogConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{59} }
+func (*PodLogConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{71} }
 
 func (m *PodLogConfig) GetType() string {
 	if m != nil {
@@ -2789,7 +3385,7 @@ type PortMapping struct {
 func (m *PortMapping) Reset()                    { *m = PortMapping{} }
 func (m *PortMapping) String() string            { return proto.CompactTextString(m) }
 func (*PortMapping) ProtoMessage()               {}
-func (*PortMapping) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{60} }
+func (*PortMapping) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{72} }
 
 func (m *PortMapping) GetContainerPort() string {
 	if m != nil {
@@ -2824,7 +3420,7 @@ type PortmappingWhiteList struct {
 func (m *PortmappingWhiteList) Reset()                    { *m = PortmappingWhiteList{} }
 func (m *PortmappingWhiteList) String() string            { return proto.CompactTextString(m) }
 func (*PortmappingWhiteList) ProtoMessage()               {}
-func (*PortmappingWhiteList) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{61} }
+func (*PortmappingWhiteList) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{73} }
 
 func (m *PortmappingWhiteList) GetInternalNetworks() []string {
 	if m != nil {
@@ -2860,12 +3456,15 @@ type UserPod struct {
 	Portmappings          []*PortMapping        `protobuf:"bytes,16,rep,name=portmappings" json:"portmappings,omitempty"`
 	DnsOptions            []string              `protobuf:"bytes,17,rep,name=dnsOptions" json:"dnsOptions,omitempty"`
 	DnsSearch             []string              `protobuf:"bytes,18,rep,name=dnsSearch" json:"dnsSearch,omitempty"`
+	// namespace is the tenancy namespace of the pod, names of pods and
+	// containers are unique in a namespace
+	Namespace string `protobuf:"bytes,19,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *UserPod) Reset()                    { *m = UserPod{} }
 func (m *UserPod) String() string            { return proto.CompactTextString(m) }
 func (*UserPod) ProtoMessage()               {}
-func (*UserPod) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{62} }
+func (*UserPod) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{74} }
 
 func (m *UserPod) GetId() string {
 	if m != nil {
@@ -2993,15 +3592,25 @@ func (m *UserPod) GetDnsSearch() []string {
 	return nil
 }
 
+func (m *UserPod) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type PodCreateRequest struct {
 	PodSpec *UserPod `protobuf:"bytes,1,opt,name=podSpec" json:"podSpec,omitempty"`
 	PodID   string   `protobuf:"bytes,2,opt,name=podID,proto3" json:"podID,omitempty"`
+	// kubernetesPod is a Kubernetes v1 Pod manifest in YAML or JSON, it is
+	// converted into the podSpec if set
+	KubernetesPod []byte `protobuf:"bytes,3,opt,name=kubernetesPod,proto3" json:"kubernetesPod,omitempty"`
 }
 
 func (m *PodCreateRequest) Reset()                    { *m = PodCreateRequest{} }
 func (m *PodCreateRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodCreateRequest) ProtoMessage()               {}
-func (*PodCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{63} }
+func (*PodCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{75} }
 
 func (m *PodCreateRequest) GetPodSpec() *UserPod {
 	if m != nil {
@@ -3017,14 +3626,23 @@ func (m *PodCreateRequest) GetPodID() string {
 	return ""
 }
 
+func (m *PodCreateRequest) GetKubernetesPod() []byte {
+	if m != nil {
+		return m.KubernetesPod
+	}
+	return nil
+}
+
 type PodCreateResponse struct {
 	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	// warnings lists the fields of kubernetesPod which are not supported
+	Warnings []string `protobuf:"bytes,2,rep,name=warnings" json:"warnings,omitempty"`
 }
 
 func (m *PodCreateResponse) Reset()                    { *m = PodCreateResponse{} }
 func (m *PodCreateResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodCreateResponse) ProtoMessage()               {}
-func (*PodCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{64} }
+func (*PodCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{76} }
 
 func (m *PodCreateResponse) GetPodID() string {
 	if m != nil {
@@ -3033,14 +3651,22 @@ func (m *PodCreateResponse) GetPodID() string {
 	return ""
 }
 
+func (m *PodCreateResponse) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
 type PodRemoveRequest struct {
-	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	PodID     string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *PodRemoveRequest) Reset()                    { *m = PodRemoveRequest{} }
 func (m *PodRemoveRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodRemoveRequest) ProtoMessage()               {}
-func (*PodRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{65} }
+func (*PodRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{77} }
 
 func (m *PodRemoveRequest) GetPodID() string {
 	if m != nil {
@@ -3049,6 +3675,13 @@ func (m *PodRemoveRequest) GetPodID() string {
 	return ""
 }
 
+func (m *PodRemoveRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type PodRemoveResponse struct {
 	Code  int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Cause string `protobuf:"bytes,2,opt,name=cause,proto3" json:"cause,omitempty"`
@@ -3057,7 +3690,7 @@ type PodRemoveResponse struct {
 func (m *PodRemoveResponse) Reset()                    { *m = PodRemoveResponse{} }
 func (m *PodRemoveResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodRemoveResponse) ProtoMessage()               {}
-func (*PodRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{66} }
+func (*PodRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{78} }
 
 func (m *PodRemoveResponse) GetCode() int32 {
 	if m != nil {
@@ -3081,12 +3714,13 @@ type ContainerLogsRequest struct {
 	Since      string `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`
 	Stdout     bool   `protobuf:"varint,6,opt,name=stdout,proto3" json:"stdout,omitempty"`
 	Stderr     bool   `protobuf:"varint,7,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	Namespace  string `protobuf:"bytes,8,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *ContainerLogsRequest) Reset()                    { *m = ContainerLogsRequest{} }
 func (m *ContainerLogsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerLogsRequest) ProtoMessage()               {}
-func (*ContainerLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{67} }
+func (*ContainerLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{79} }
 
 func (m *ContainerLogsRequest) GetContainer() string {
 	if m != nil {
@@ -3137,6 +3771,13 @@ func (m *ContainerLogsRequest) GetStderr() bool {
 	return false
 }
 
+func (m *ContainerLogsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type ContainerLogsResponse struct {
 	Log []byte `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
 }
@@ -3144,7 +3785,7 @@ type ContainerLogsResponse struct {
 func (m *ContainerLogsResponse) Reset()                    { *m = ContainerLogsResponse{} }
 func (m *ContainerLogsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerLogsResponse) ProtoMessage()               {}
-func (*ContainerLogsResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{68} }
+func (*ContainerLogsResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{80} }
 
 func (m *ContainerLogsResponse) GetLog() []byte {
 	if m != nil {
@@ -3161,7 +3802,7 @@ type DriverStatus struct {
 func (m *DriverStatus) Reset()                    { *m = DriverStatus{} }
 func (m *DriverStatus) String() string            { return proto.CompactTextString(m) }
 func (*DriverStatus) ProtoMessage()               {}
-func (*DriverStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{69} }
+func (*DriverStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{81} }
 
 func (m *DriverStatus) GetName() string {
 	if m != nil {
@@ -3183,7 +3824,7 @@ type InfoRequest struct {
 func (m *InfoRequest) Reset()                    { *m = InfoRequest{} }
 func (m *InfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*InfoRequest) ProtoMessage()               {}
-func (*InfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{70} }
+func (*InfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{82} }
 
 type InfoResponse struct {
 	ID                 string          `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
@@ -3203,7 +3844,7 @@ type InfoResponse struct {
 func (m *InfoResponse) Reset()                    { *m = InfoResponse{} }
 func (m *InfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*InfoResponse) ProtoMessage()               {}
-func (*InfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{71} }
+func (*InfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{83} }
 
 func (m *InfoResponse) GetID() string {
 	if m != nil {
@@ -3293,12 +3934,13 @@ type ExecCreateRequest struct {
 	ContainerID string   `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
 	Command     []string `protobuf:"bytes,2,rep,name=command" json:"command,omitempty"`
 	Tty         bool     `protobuf:"varint,3,opt,name=tty,proto3" json:"tty,omitempty"`
+	Namespace   string   `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *ExecCreateRequest) Reset()                    { *m = ExecCreateRequest{} }
 func (m *ExecCreateRequest) String() string            { return proto.CompactTextString(m) }
 func (*ExecCreateRequest) ProtoMessage()               {}
-func (*ExecCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{72} }
+func (*ExecCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{84} }
 
 func (m *ExecCreateRequest) GetContainerID() string {
 	if m != nil {
@@ -3321,6 +3963,13 @@ func (m *ExecCreateRequest) GetTty() bool {
 	return false
 }
 
+func (m *ExecCreateRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type ExecCreateResponse struct {
 	ExecID string `protobuf:"bytes,1,opt,name=execID,proto3" json:"execID,omitempty"`
 }
@@ -3328,7 +3977,7 @@ type ExecCreateResponse struct {
 func (m *ExecCreateResponse) Reset()                    { *m = ExecCreateResponse{} }
 func (m *ExecCreateResponse) String() string            { return proto.CompactTextString(m) }
 func (*ExecCreateResponse) ProtoMessage()               {}
-func (*ExecCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{73} }
+func (*ExecCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{85} }
 
 func (m *ExecCreateResponse) GetExecID() string {
 	if m != nil {
@@ -3341,12 +3990,13 @@ type ExecStartRequest struct {
 	ContainerID string `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
 	ExecID      string `protobuf:"bytes,2,opt,name=execID,proto3" json:"execID,omitempty"`
 	Stdin       []byte `protobuf:"bytes,3,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	Namespace   string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *ExecStartRequest) Reset()                    { *m = ExecStartRequest{} }
 func (m *ExecStartRequest) String() string            { return proto.CompactTextString(m) }
 func (*ExecStartRequest) ProtoMessage()               {}
-func (*ExecStartRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{74} }
+func (*ExecStartRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{86} }
 
 func (m *ExecStartRequest) GetContainerID() string {
 	if m != nil {
@@ -3369,6 +4019,13 @@ func (m *ExecStartRequest) GetStdin() []byte {
 	return nil
 }
 
+func (m *ExecStartRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type ExecStartResponse struct {
 	Stdout []byte `protobuf:"bytes,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
 }
@@ -3376,7 +4033,7 @@ type ExecStartResponse struct {
 func (m *ExecStartResponse) Reset()                    { *m = ExecStartResponse{} }
 func (m *ExecStartResponse) String() string            { return proto.CompactTextString(m) }
 func (*ExecStartResponse) ProtoMessage()               {}
-func (*ExecStartResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{75} }
+func (*ExecStartResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{87} }
 
 func (m *ExecStartResponse) GetStdout() []byte {
 	if m != nil {
@@ -3386,15 +4043,16 @@ func (m *ExecStartResponse) GetStdout() []byte {
 }
 
 type ExecVMRequest struct {
-	PodID   string   `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
-	Command []string `protobuf:"bytes,2,rep,name=command" json:"command,omitempty"`
-	Stdin   []byte   `protobuf:"bytes,3,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	PodID     string   `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Command   []string `protobuf:"bytes,2,rep,name=command" json:"command,omitempty"`
+	Stdin     []byte   `protobuf:"bytes,3,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	Namespace string   `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *ExecVMRequest) Reset()                    { *m = ExecVMRequest{} }
 func (m *ExecVMRequest) String() string            { return proto.CompactTextString(m) }
 func (*ExecVMRequest) ProtoMessage()               {}
-func (*ExecVMRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{76} }
+func (*ExecVMRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{88} }
 
 func (m *ExecVMRequest) GetPodID() string {
 	if m != nil {
@@ -3417,6 +4075,13 @@ func (m *ExecVMRequest) GetStdin() []byte {
 	return nil
 }
 
+func (m *ExecVMRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type ExecVMResponse struct {
 	Stdout   []byte `protobuf:"bytes,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
 	ExitCode int32  `protobuf:"varint,2,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
@@ -3425,7 +4090,7 @@ type ExecVMResponse struct {
 func (m *ExecVMResponse) Reset()                    { *m = ExecVMResponse{} }
 func (m *ExecVMResponse) String() string            { return proto.CompactTextString(m) }
 func (*ExecVMResponse) ProtoMessage()               {}
-func (*ExecVMResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{77} }
+func (*ExecVMResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{89} }
 
 func (m *ExecVMResponse) GetStdout() []byte {
 	if m != nil {
@@ -3445,12 +4110,13 @@ type ExecSignalRequest struct {
 	ContainerID string `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
 	ExecID      string `protobuf:"bytes,2,opt,name=execID,proto3" json:"execID,omitempty"`
 	Signal      int64  `protobuf:"varint,3,opt,name=signal,proto3" json:"signal,omitempty"`
+	Namespace   string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *ExecSignalRequest) Reset()                    { *m = ExecSignalRequest{} }
 func (m *ExecSignalRequest) String() string            { return proto.CompactTextString(m) }
 func (*ExecSignalRequest) ProtoMessage()               {}
-func (*ExecSignalRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{78} }
+func (*ExecSignalRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{90} }
 
 func (m *ExecSignalRequest) GetContainerID() string {
 	if m != nil {
@@ -3473,22 +4139,30 @@ func (m *ExecSignalRequest) GetSignal() int64 {
 	return 0
 }
 
+func (m *ExecSignalRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type ExecSignalResponse struct {
 }
 
 func (m *ExecSignalResponse) Reset()                    { *m = ExecSignalResponse{} }
 func (m *ExecSignalResponse) String() string            { return proto.CompactTextString(m) }
 func (*ExecSignalResponse) ProtoMessage()               {}
-func (*ExecSignalResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{79} }
+func (*ExecSignalResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{91} }
 
 type PodStartRequest struct {
-	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	PodID     string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *PodStartRequest) Reset()                    { *m = PodStartRequest{} }
 func (m *PodStartRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodStartRequest) ProtoMessage()               {}
-func (*PodStartRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{80} }
+func (*PodStartRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{92} }
 
 func (m *PodStartRequest) GetPodID() string {
 	if m != nil {
@@ -3497,24 +4171,32 @@ func (m *PodStartRequest) GetPodID() string {
 	return ""
 }
 
+func (m *PodStartRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type PodStartResponse struct {
 }
 
 func (m *PodStartResponse) Reset()                    { *m = PodStartResponse{} }
 func (m *PodStartResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodStartResponse) ProtoMessage()               {}
-func (*PodStartResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{81} }
+func (*PodStartResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{93} }
 
 type WaitRequest struct {
 	Container string `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
 	ProcessId string `protobuf:"bytes,2,opt,name=processId,proto3" json:"processId,omitempty"`
 	NoHang    bool   `protobuf:"varint,3,opt,name=noHang,proto3" json:"noHang,omitempty"`
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *WaitRequest) Reset()                    { *m = WaitRequest{} }
 func (m *WaitRequest) String() string            { return proto.CompactTextString(m) }
 func (*WaitRequest) ProtoMessage()               {}
-func (*WaitRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{82} }
+func (*WaitRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{94} }
 
 func (m *WaitRequest) GetContainer() string {
 	if m != nil {
@@ -3537,6 +4219,13 @@ func (m *WaitRequest) GetNoHang() bool {
 	return false
 }
 
+func (m *WaitRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type WaitResponse struct {
 	ExitCode int32 `protobuf:"varint,1,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
 }
@@ -3544,7 +4233,7 @@ type WaitResponse struct {
 func (m *WaitResponse) Reset()                    { *m = WaitResponse{} }
 func (m *WaitResponse) String() string            { return proto.CompactTextString(m) }
 func (*WaitResponse) ProtoMessage()               {}
-func (*WaitResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{83} }
+func (*WaitResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{95} }
 
 func (m *WaitResponse) GetExitCode() int32 {
 	if m != nil {
@@ -3556,12 +4245,13 @@ func (m *WaitResponse) GetExitCode() int32 {
 type AttachMessage struct {
 	ContainerID string `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
 	Data        []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Namespace   string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *AttachMessage) Reset()                    { *m = AttachMessage{} }
 func (m *AttachMessage) String() string            { return proto.CompactTextString(m) }
 func (*AttachMessage) ProtoMessage()               {}
-func (*AttachMessage) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{84} }
+func (*AttachMessage) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{96} }
 
 func (m *AttachMessage) GetContainerID() string {
 	if m != nil {
@@ -3577,15 +4267,23 @@ func (m *AttachMessage) GetData() []byte {
 	return nil
 }
 
+func (m *AttachMessage) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type ContainerCreateRequest struct {
 	ContainerSpec *UserContainer `protobuf:"bytes,1,opt,name=containerSpec" json:"containerSpec,omitempty"`
 	PodID         string         `protobuf:"bytes,2,opt,name=podID,proto3" json:"podID,omitempty"`
+	Namespace     string         `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *ContainerCreateRequest) Reset()                    { *m = ContainerCreateRequest{} }
 func (m *ContainerCreateRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerCreateRequest) ProtoMessage()               {}
-func (*ContainerCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{85} }
+func (*ContainerCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{97} }
 
 func (m *ContainerCreateRequest) GetContainerSpec() *UserContainer {
 	if m != nil {
@@ -3601,6 +4299,13 @@ func (m *ContainerCreateRequest) GetPodID() string {
 	return ""
 }
 
+func (m *ContainerCreateRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type ContainerCreateResponse struct {
 	ContainerID string `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
 }
@@ -3608,7 +4313,7 @@ type ContainerCreateResponse struct {
 func (m *ContainerCreateResponse) Reset()                    { *m = ContainerCreateResponse{} }
 func (m *ContainerCreateResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerCreateResponse) ProtoMessage()               {}
-func (*ContainerCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{86} }
+func (*ContainerCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{98} }
 
 func (m *ContainerCreateResponse) GetContainerID() string {
 	if m != nil {
@@ -3620,12 +4325,13 @@ func (m *ContainerCreateResponse) GetContainerID() string {
 type ContainerStartRequest struct {
 	// ID of the container to start.
 	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Namespace   string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *ContainerStartRequest) Reset()                    { *m = ContainerStartRequest{} }
 func (m *ContainerStartRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerStartRequest) ProtoMessage()               {}
-func (*ContainerStartRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{87} }
+func (*ContainerStartRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{99} }
 
 func (m *ContainerStartRequest) GetContainerId() string {
 	if m != nil {
@@ -3634,23 +4340,31 @@ func (m *ContainerStartRequest) GetContainerId() string {
 	return ""
 }
 
+func (m *ContainerStartRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type ContainerStartResponse struct {
 }
 
 func (m *ContainerStartResponse) Reset()                    { *m = ContainerStartResponse{} }
 func (m *ContainerStartResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerStartResponse) ProtoMessage()               {}
-func (*ContainerStartResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{88} }
+func (*ContainerStartResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{100} }
 
 type ContainerRenameRequest struct {
 	OldContainerName string `protobuf:"bytes,1,opt,name=oldContainerName,proto3" json:"oldContainerName,omitempty"`
 	NewContainerName string `protobuf:"bytes,2,opt,name=newContainerName,proto3" json:"newContainerName,omitempty"`
+	Namespace        string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *ContainerRenameRequest) Reset()                    { *m = ContainerRenameRequest{} }
 func (m *ContainerRenameRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerRenameRequest) ProtoMessage()               {}
-func (*ContainerRenameRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{89} }
+func (*ContainerRenameRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{101} }
 
 func (m *ContainerRenameRequest) GetOldContainerName() string {
 	if m != nil {
@@ -3666,22 +4380,119 @@ func (m *ContainerRenameRequest) GetNewContainerName() string {
 	return ""
 }
 
+func (m *ContainerRenameRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type ContainerRenameResponse struct {
 }
 
 func (m *ContainerRenameResponse) Reset()                    { *m = ContainerRenameResponse{} }
 func (m *ContainerRenameResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerRenameResponse) ProtoMessage()               {}
-func (*ContainerRenameResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{90} }
+func (*ContainerRenameResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{102} }
+
+type ContainerCommitRequest struct {
+	Container string `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
+	Repo      string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Tag       string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	Author    string `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	Message   string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// changes are the Dockerfile instructions applied to the image config
+	Changes   []string `protobuf:"bytes,6,rep,name=changes" json:"changes,omitempty"`
+	Pause     bool     `protobuf:"varint,7,opt,name=pause,proto3" json:"pause,omitempty"`
+	Namespace string   `protobuf:"bytes,8,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *ContainerCommitRequest) Reset()                    { *m = ContainerCommitRequest{} }
+func (m *ContainerCommitRequest) String() string            { return proto.CompactTextString(m) }
+func (*ContainerCommitRequest) ProtoMessage()               {}
+func (*ContainerCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{103} }
+
+func (m *ContainerCommitRequest) GetContainer() string {
+	if m != nil {
+		return m.Container
+	}
+	return ""
+}
+
+func (m *ContainerCommitRequest) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *ContainerCommitRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *ContainerCommitRequest) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *ContainerCommitRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ContainerCommitRequest) GetChanges() []string {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *ContainerCommitRequest) GetPause() bool {
+	if m != nil {
+		return m.Pause
+	}
+	return false
+}
+
+func (m *ContainerCommitRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type ContainerCommitResponse struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *ContainerCommitResponse) Reset()                    { *m = ContainerCommitResponse{} }
+func (m *ContainerCommitResponse) String() string            { return proto.CompactTextString(m) }
+func (*ContainerCommitResponse) ProtoMessage()               {}
+func (*ContainerCommitResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{104} }
+
+func (m *ContainerCommitResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
 
 type ContainerRemoveRequest struct {
 	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Namespace   string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *ContainerRemoveRequest) Reset()                    { *m = ContainerRemoveRequest{} }
 func (m *ContainerRemoveRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerRemoveRequest) ProtoMessage()               {}
-func (*ContainerRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{91} }
+func (*ContainerRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{105} }
 
 func (m *ContainerRemoveRequest) GetContainerId() string {
 	if m != nil {
@@ -3690,13 +4501,20 @@ func (m *ContainerRemoveRequest) GetContainerId() string {
 	return ""
 }
 
+func (m *ContainerRemoveRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type ContainerRemoveResponse struct {
 }
 
 func (m *ContainerRemoveResponse) Reset()                    { *m = ContainerRemoveResponse{} }
 func (m *ContainerRemoveResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerRemoveResponse) ProtoMessage()               {}
-func (*ContainerRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{92} }
+func (*ContainerRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{106} }
 
 type AuthConfig struct {
 	Username      string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
@@ -3710,7 +4528,7 @@ type AuthConfig struct {
 func (m *AuthConfig) Reset()                    { *m = AuthConfig{} }
 func (m *AuthConfig) String() string            { return proto.CompactTextString(m) }
 func (*AuthConfig) ProtoMessage()               {}
-func (*AuthConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{93} }
+func (*AuthConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{107} }
 
 func (m *AuthConfig) GetUsername() string {
 	if m != nil {
@@ -3763,7 +4581,7 @@ type ImagePullRequest struct {
 func (m *ImagePullRequest) Reset()                    { *m = ImagePullRequest{} }
 func (m *ImagePullRequest) String() string            { return proto.CompactTextString(m) }
 func (*ImagePullRequest) ProtoMessage()               {}
-func (*ImagePullRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{94} }
+func (*ImagePullRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{108} }
 
 func (m *ImagePullRequest) GetImage() string {
 	if m != nil {
@@ -3793,7 +4611,7 @@ type ImagePullResponse struct {
 func (m *ImagePullResponse) Reset()                    { *m = ImagePullResponse{} }
 func (m *ImagePullResponse) String() string            { return proto.CompactTextString(m) }
 func (*ImagePullResponse) ProtoMessage()               {}
-func (*ImagePullResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{95} }
+func (*ImagePullResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{109} }
 
 func (m *ImagePullResponse) GetData() []byte {
 	if m != nil {
@@ -3811,7 +4629,7 @@ type ImagePushRequest struct {
 func (m *ImagePushRequest) Reset()                    { *m = ImagePushRequest{} }
 func (m *ImagePushRequest) String() string            { return proto.CompactTextString(m) }
 func (*ImagePushRequest) ProtoMessage()               {}
-func (*ImagePushRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{96} }
+func (*ImagePushRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{110} }
 
 func (m *ImagePushRequest) GetRepo() string {
 	if m != nil {
@@ -3841,7 +4659,7 @@ type ImagePushResponse struct {
 func (m *ImagePushResponse) Reset()                    { *m = ImagePushResponse{} }
 func (m *ImagePushResponse) String() string            { return proto.CompactTextString(m) }
 func (*ImagePushResponse) ProtoMessage()               {}
-func (*ImagePushResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{97} }
+func (*ImagePushResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{111} }
 
 func (m *ImagePushResponse) GetData() []byte {
 	if m != nil {
@@ -3850,20 +4668,161 @@ func (m *ImagePushResponse) GetData() []byte {
 	return nil
 }
 
-type ImageRemoveRequest struct {
-	Image string `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	Force bool   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
-	Prune bool   `protobuf:"varint,3,opt,name=prune,proto3" json:"prune,omitempty"`
+type ImageBuildRequest struct {
+	// name is only read from the first message
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// a chunk of the tar stream of the build context
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (m *ImageRemoveRequest) Reset()                    { *m = ImageRemoveRequest{} }
-func (m *ImageRemoveRequest) String() string            { return proto.CompactTextString(m) }
-func (*ImageRemoveRequest) ProtoMessage()               {}
-func (*ImageRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{98} }
+func (m *ImageBuildRequest) Reset()                    { *m = ImageBuildRequest{} }
+func (m *ImageBuildRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImageBuildRequest) ProtoMessage()               {}
+func (*ImageBuildRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{112} }
 
-func (m *ImageRemoveRequest) GetImage() string {
+func (m *ImageBuildRequest) GetName() string {
 	if m != nil {
-		return m.Image
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ImageBuildRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ImageBuildResponse struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ImageBuildResponse) Reset()                    { *m = ImageBuildResponse{} }
+func (m *ImageBuildResponse) String() string            { return proto.CompactTextString(m) }
+func (*ImageBuildResponse) ProtoMessage()               {}
+func (*ImageBuildResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{113} }
+
+func (m *ImageBuildResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ImageLoadRequest struct {
+	// name and refs are only read from the first message
+	Name string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Refs map[string]string `protobuf:"bytes,2,rep,name=refs" json:"refs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// a chunk of the image tarball
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ImageLoadRequest) Reset()                    { *m = ImageLoadRequest{} }
+func (m *ImageLoadRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImageLoadRequest) ProtoMessage()               {}
+func (*ImageLoadRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{114} }
+
+func (m *ImageLoadRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ImageLoadRequest) GetRefs() map[string]string {
+	if m != nil {
+		return m.Refs
+	}
+	return nil
+}
+
+func (m *ImageLoadRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ImageLoadResponse struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ImageLoadResponse) Reset()                    { *m = ImageLoadResponse{} }
+func (m *ImageLoadResponse) String() string            { return proto.CompactTextString(m) }
+func (*ImageLoadResponse) ProtoMessage()               {}
+func (*ImageLoadResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{115} }
+
+func (m *ImageLoadResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ImageSaveRequest struct {
+	Names  []string          `protobuf:"bytes,1,rep,name=names" json:"names,omitempty"`
+	Format string            `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	Refs   map[string]string `protobuf:"bytes,3,rep,name=refs" json:"refs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *ImageSaveRequest) Reset()                    { *m = ImageSaveRequest{} }
+func (m *ImageSaveRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImageSaveRequest) ProtoMessage()               {}
+func (*ImageSaveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{116} }
+
+func (m *ImageSaveRequest) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+func (m *ImageSaveRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ImageSaveRequest) GetRefs() map[string]string {
+	if m != nil {
+		return m.Refs
+	}
+	return nil
+}
+
+type ImageSaveResponse struct {
+	// a chunk of the image tarball
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ImageSaveResponse) Reset()                    { *m = ImageSaveResponse{} }
+func (m *ImageSaveResponse) String() string            { return proto.CompactTextString(m) }
+func (*ImageSaveResponse) ProtoMessage()               {}
+func (*ImageSaveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{117} }
+
+func (m *ImageSaveResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ImageRemoveRequest struct {
+	Image string `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Force bool   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	Prune bool   `protobuf:"varint,3,opt,name=prune,proto3" json:"prune,omitempty"`
+}
+
+func (m *ImageRemoveRequest) Reset()                    { *m = ImageRemoveRequest{} }
+func (m *ImageRemoveRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImageRemoveRequest) ProtoMessage()               {}
+func (*ImageRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{118} }
+
+func (m *ImageRemoveRequest) GetImage() string {
+	if m != nil {
+		return m.Image
 	}
 	return ""
 }
@@ -3890,7 +4849,7 @@ type ImageDelete struct {
 func (m *ImageDelete) Reset()                    { *m = ImageDelete{} }
 func (m *ImageDelete) String() string            { return proto.CompactTextString(m) }
 func (*ImageDelete) ProtoMessage()               {}
-func (*ImageDelete) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{99} }
+func (*ImageDelete) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{119} }
 
 func (m *ImageDelete) GetUntaged() string {
 	if m != nil {
@@ -3913,7 +4872,7 @@ type ImageRemoveResponse struct {
 func (m *ImageRemoveResponse) Reset()                    { *m = ImageRemoveResponse{} }
 func (m *ImageRemoveResponse) String() string            { return proto.CompactTextString(m) }
 func (*ImageRemoveResponse) ProtoMessage()               {}
-func (*ImageRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{100} }
+func (*ImageRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{120} }
 
 func (m *ImageRemoveResponse) GetImages() []*ImageDelete {
 	if m != nil {
@@ -3922,15 +4881,48 @@ func (m *ImageRemoveResponse) GetImages() []*ImageDelete {
 	return nil
 }
 
+type AuthRequest struct {
+	Auth *AuthConfig `protobuf:"bytes,1,opt,name=auth" json:"auth,omitempty"`
+}
+
+func (m *AuthRequest) Reset()                    { *m = AuthRequest{} }
+func (m *AuthRequest) String() string            { return proto.CompactTextString(m) }
+func (*AuthRequest) ProtoMessage()               {}
+func (*AuthRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{121} }
+
+func (m *AuthRequest) GetAuth() *AuthConfig {
+	if m != nil {
+		return m.Auth
+	}
+	return nil
+}
+
+type AuthResponse struct {
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *AuthResponse) Reset()                    { *m = AuthResponse{} }
+func (m *AuthResponse) String() string            { return proto.CompactTextString(m) }
+func (*AuthResponse) ProtoMessage()               {}
+func (*AuthResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{122} }
+
+func (m *AuthResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
 type ContainerStopRequest struct {
 	ContainerID string `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
 	Timeout     int64  `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Namespace   string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *ContainerStopRequest) Reset()                    { *m = ContainerStopRequest{} }
 func (m *ContainerStopRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerStopRequest) ProtoMessage()               {}
-func (*ContainerStopRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{101} }
+func (*ContainerStopRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{123} }
 
 func (m *ContainerStopRequest) GetContainerID() string {
 	if m != nil {
@@ -3946,13 +4938,20 @@ func (m *ContainerStopRequest) GetTimeout() int64 {
 	return 0
 }
 
+func (m *ContainerStopRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type ContainerStopResponse struct {
 }
 
 func (m *ContainerStopResponse) Reset()                    { *m = ContainerStopResponse{} }
 func (m *ContainerStopResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerStopResponse) ProtoMessage()               {}
-func (*ContainerStopResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{102} }
+func (*ContainerStopResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{124} }
 
 type VersionRequest struct {
 }
@@ -3960,7 +4959,7 @@ type VersionRequest struct {
 func (m *VersionRequest) Reset()                    { *m = VersionRequest{} }
 func (m *VersionRequest) String() string            { return proto.CompactTextString(m) }
 func (*VersionRequest) ProtoMessage()               {}
-func (*VersionRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{103} }
+func (*VersionRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{125} }
 
 type VersionResponse struct {
 	// Version is the version of hyperd
@@ -3972,7 +4971,7 @@ type VersionResponse struct {
 func (m *VersionResponse) Reset()                    { *m = VersionResponse{} }
 func (m *VersionResponse) String() string            { return proto.CompactTextString(m) }
 func (*VersionResponse) ProtoMessage()               {}
-func (*VersionResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{104} }
+func (*VersionResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{126} }
 
 func (m *VersionResponse) GetVersion() string {
 	if m != nil {
@@ -3995,7 +4994,7 @@ type ServiceListResponse struct {
 func (m *ServiceListResponse) Reset()                    { *m = ServiceListResponse{} }
 func (m *ServiceListResponse) String() string            { return proto.CompactTextString(m) }
 func (*ServiceListResponse) ProtoMessage()               {}
-func (*ServiceListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{105} }
+func (*ServiceListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{127} }
 
 func (m *ServiceListResponse) GetServices() []*UserService {
 	if m != nil {
@@ -4006,12 +5005,15 @@ func (m *ServiceListResponse) GetServices() []*UserService {
 
 type ServiceListRequest struct {
 	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	// live lists the services applied in the VM instead of the spec
+	Live      bool   `protobuf:"varint,2,opt,name=live,proto3" json:"live,omitempty"`
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *ServiceListRequest) Reset()                    { *m = ServiceListRequest{} }
 func (m *ServiceListRequest) String() string            { return proto.CompactTextString(m) }
 func (*ServiceListRequest) ProtoMessage()               {}
-func (*ServiceListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{106} }
+func (*ServiceListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{128} }
 
 func (m *ServiceListRequest) GetPodID() string {
 	if m != nil {
@@ -4020,15 +5022,30 @@ func (m *ServiceListRequest) GetPodID() string {
 	return ""
 }
 
+func (m *ServiceListRequest) GetLive() bool {
+	if m != nil {
+		return m.Live
+	}
+	return false
+}
+
+func (m *ServiceListRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type ServiceAddRequest struct {
-	PodID    string         `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
-	Services []*UserService `protobuf:"bytes,2,rep,name=services" json:"services,omitempty"`
+	PodID     string         `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Services  []*UserService `protobuf:"bytes,2,rep,name=services" json:"services,omitempty"`
+	Namespace string         `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *ServiceAddRequest) Reset()                    { *m = ServiceAddRequest{} }
 func (m *ServiceAddRequest) String() string            { return proto.CompactTextString(m) }
 func (*ServiceAddRequest) ProtoMessage()               {}
-func (*ServiceAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{107} }
+func (*ServiceAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{129} }
 
 func (m *ServiceAddRequest) GetPodID() string {
 	if m != nil {
@@ -4044,23 +5061,31 @@ func (m *ServiceAddRequest) GetServices() []*UserService {
 	return nil
 }
 
+func (m *ServiceAddRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type ServiceAddResponse struct {
 }
 
 func (m *ServiceAddResponse) Reset()                    { *m = ServiceAddResponse{} }
 func (m *ServiceAddResponse) String() string            { return proto.CompactTextString(m) }
 func (*ServiceAddResponse) ProtoMessage()               {}
-func (*ServiceAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{108} }
+func (*ServiceAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{130} }
 
 type ServiceDelRequest struct {
-	PodID    string         `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
-	Services []*UserService `protobuf:"bytes,2,rep,name=services" json:"services,omitempty"`
+	PodID     string         `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Services  []*UserService `protobuf:"bytes,2,rep,name=services" json:"services,omitempty"`
+	Namespace string         `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *ServiceDelRequest) Reset()                    { *m = ServiceDelRequest{} }
 func (m *ServiceDelRequest) String() string            { return proto.CompactTextString(m) }
 func (*ServiceDelRequest) ProtoMessage()               {}
-func (*ServiceDelRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{109} }
+func (*ServiceDelRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{131} }
 
 func (m *ServiceDelRequest) GetPodID() string {
 	if m != nil {
@@ -4076,23 +5101,31 @@ func (m *ServiceDelRequest) GetServices() []*UserService {
 	return nil
 }
 
+func (m *ServiceDelRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type ServiceDelResponse struct {
 }
 
 func (m *ServiceDelResponse) Reset()                    { *m = ServiceDelResponse{} }
 func (m *ServiceDelResponse) String() string            { return proto.CompactTextString(m) }
 func (*ServiceDelResponse) ProtoMessage()               {}
-func (*ServiceDelResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{110} }
+func (*ServiceDelResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{132} }
 
 type ServiceUpdateRequest struct {
-	PodID    string         `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
-	Services []*UserService `protobuf:"bytes,2,rep,name=services" json:"services,omitempty"`
+	PodID     string         `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Services  []*UserService `protobuf:"bytes,2,rep,name=services" json:"services,omitempty"`
+	Namespace string         `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *ServiceUpdateRequest) Reset()                    { *m = ServiceUpdateRequest{} }
 func (m *ServiceUpdateRequest) String() string            { return proto.CompactTextString(m) }
 func (*ServiceUpdateRequest) ProtoMessage()               {}
-func (*ServiceUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{111} }
+func (*ServiceUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{133} }
 
 func (m *ServiceUpdateRequest) GetPodID() string {
 	if m != nil {
@@ -4108,22 +5141,30 @@ func (m *ServiceUpdateRequest) GetServices() []*UserService {
 	return nil
 }
 
+func (m *ServiceUpdateRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type ServiceUpdateResponse struct {
 }
 
 func (m *ServiceUpdateResponse) Reset()                    { *m = ServiceUpdateResponse{} }
 func (m *ServiceUpdateResponse) String() string            { return proto.CompactTextString(m) }
 func (*ServiceUpdateResponse) ProtoMessage()               {}
-func (*ServiceUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{112} }
+func (*ServiceUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{134} }
 
 type PortMappingListRequest struct {
-	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	PodID     string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *PortMappingListRequest) Reset()                    { *m = PortMappingListRequest{} }
 func (m *PortMappingListRequest) String() string            { return proto.CompactTextString(m) }
 func (*PortMappingListRequest) ProtoMessage()               {}
-func (*PortMappingListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{113} }
+func (*PortMappingListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{135} }
 
 func (m *PortMappingListRequest) GetPodID() string {
 	if m != nil {
@@ -4132,6 +5173,13 @@ func (m *PortMappingListRequest) GetPodID() string {
 	return ""
 }
 
+func (m *PortMappingListRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type PortMappingListResponse struct {
 	PortMappings []*PortMapping `protobuf:"bytes,1,rep,name=portMappings" json:"portMappings,omitempty"`
 }
@@ -4139,7 +5187,7 @@ type PortMappingListResponse struct {
 func (m *PortMappingListResponse) Reset()                    { *m = PortMappingListResponse{} }
 func (m *PortMappingListResponse) String() string            { return proto.CompactTextString(m) }
 func (*PortMappingListResponse) ProtoMessage()               {}
-func (*PortMappingListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{114} }
+func (*PortMappingListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{136} }
 
 func (m *PortMappingListResponse) GetPortMappings() []*PortMapping {
 	if m != nil {
@@ -4151,12 +5199,13 @@ func (m *PortMappingListResponse) GetPortMappings() []*PortMapping {
 type PortMappingModifyRequest struct {
 	PodID        string         `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
 	PortMappings []*PortMapping `protobuf:"bytes,2,rep,name=portMappings" json:"portMappings,omitempty"`
+	Namespace    string         `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *PortMappingModifyRequest) Reset()                    { *m = PortMappingModifyRequest{} }
 func (m *PortMappingModifyRequest) String() string            { return proto.CompactTextString(m) }
 func (*PortMappingModifyRequest) ProtoMessage()               {}
-func (*PortMappingModifyRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{115} }
+func (*PortMappingModifyRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{137} }
 
 func (m *PortMappingModifyRequest) GetPodID() string {
 	if m != nil {
@@ -4172,182 +5221,882 @@ func (m *PortMappingModifyRequest) GetPortMappings() []*PortMapping {
 	return nil
 }
 
+func (m *PortMappingModifyRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type PortMappingModifyResponse struct {
 }
 
 func (m *PortMappingModifyResponse) Reset()                    { *m = PortMappingModifyResponse{} }
 func (m *PortMappingModifyResponse) String() string            { return proto.CompactTextString(m) }
 func (*PortMappingModifyResponse) ProtoMessage()               {}
-func (*PortMappingModifyResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{116} }
+func (*PortMappingModifyResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{138} }
 
-type PodStopRequest struct {
-	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+// VolumeInfo is a named volume, which is created ahead of the pods and
+// outlives them. The pods mount it with a volume of the "named" format.
+type VolumeInfo struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// spec is the volume created by the storage driver
+	Spec   *UserVolume       `protobuf:"bytes,2,opt,name=spec" json:"spec,omitempty"`
+	Labels map[string]string `protobuf:"bytes,3,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// pods are the pods referencing the volume
+	Pods      []string `protobuf:"bytes,4,rep,name=pods" json:"pods,omitempty"`
+	CreatedAt int64    `protobuf:"varint,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// usage is the disk space used by the volume in bytes
+	Usage int64 `protobuf:"varint,6,opt,name=usage,proto3" json:"usage,omitempty"`
 }
 
-func (m *PodStopRequest) Reset()                    { *m = PodStopRequest{} }
-func (m *PodStopRequest) String() string            { return proto.CompactTextString(m) }
-func (*PodStopRequest) ProtoMessage()               {}
-func (*PodStopRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{117} }
+func (m *VolumeInfo) Reset()                    { *m = VolumeInfo{} }
+func (m *VolumeInfo) String() string            { return proto.CompactTextString(m) }
+func (*VolumeInfo) ProtoMessage()               {}
+func (*VolumeInfo) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{139} }
 
-func (m *PodStopRequest) GetPodID() string {
+func (m *VolumeInfo) GetName() string {
 	if m != nil {
-		return m.PodID
+		return m.Name
 	}
 	return ""
 }
 
-type PodStopResponse struct {
-	Code  int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Cause string `protobuf:"bytes,2,opt,name=cause,proto3" json:"cause,omitempty"`
+func (m *VolumeInfo) GetSpec() *UserVolume {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
 }
 
-func (m *PodStopResponse) Reset()                    { *m = PodStopResponse{} }
-func (m *PodStopResponse) String() string            { return proto.CompactTextString(m) }
-func (*PodStopResponse) ProtoMessage()               {}
-func (*PodStopResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{118} }
+func (m *VolumeInfo) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
 
-func (m *PodStopResponse) GetCode() int32 {
+func (m *VolumeInfo) GetPods() []string {
 	if m != nil {
-		return m.Code
+		return m.Pods
+	}
+	return nil
+}
+
+func (m *VolumeInfo) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
 	}
 	return 0
 }
 
-func (m *PodStopResponse) GetCause() string {
+func (m *VolumeInfo) GetUsage() int64 {
 	if m != nil {
-		return m.Cause
+		return m.Usage
 	}
-	return ""
+	return 0
 }
 
-type PodSignalRequest struct {
-	PodID  string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
-	Signal int64  `protobuf:"varint,2,opt,name=signal,proto3" json:"signal,omitempty"`
+type VolumeCreateRequest struct {
+	Name   string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Size_  int64             `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (m *PodSignalRequest) Reset()                    { *m = PodSignalRequest{} }
-func (m *PodSignalRequest) String() string            { return proto.CompactTextString(m) }
-func (*PodSignalRequest) ProtoMessage()               {}
-func (*PodSignalRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{119} }
+func (m *VolumeCreateRequest) Reset()                    { *m = VolumeCreateRequest{} }
+func (m *VolumeCreateRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeCreateRequest) ProtoMessage()               {}
+func (*VolumeCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{140} }
 
-func (m *PodSignalRequest) GetPodID() string {
+func (m *VolumeCreateRequest) GetName() string {
 	if m != nil {
-		return m.PodID
+		return m.Name
 	}
 	return ""
 }
 
-func (m *PodSignalRequest) GetSignal() int64 {
+func (m *VolumeCreateRequest) GetLabels() map[string]string {
 	if m != nil {
-		return m.Signal
+		return m.Labels
 	}
-	return 0
+	return nil
 }
 
-type PodSignalResponse struct {
+func (m *VolumeCreateRequest) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
 }
 
-func (m *PodSignalResponse) Reset()                    { *m = PodSignalResponse{} }
-func (m *PodSignalResponse) String() string            { return proto.CompactTextString(m) }
-func (*PodSignalResponse) ProtoMessage()               {}
-func (*PodSignalResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{120} }
-
-type PodPauseRequest struct {
-	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+type VolumeCreateResponse struct {
+	Volume *VolumeInfo `protobuf:"bytes,1,opt,name=volume" json:"volume,omitempty"`
 }
 
-func (m *PodPauseRequest) Reset()                    { *m = PodPauseRequest{} }
-func (m *PodPauseRequest) String() string            { return proto.CompactTextString(m) }
-func (*PodPauseRequest) ProtoMessage()               {}
-func (*PodPauseRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{121} }
+func (m *VolumeCreateResponse) Reset()                    { *m = VolumeCreateResponse{} }
+func (m *VolumeCreateResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeCreateResponse) ProtoMessage()               {}
+func (*VolumeCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{141} }
 
-func (m *PodPauseRequest) GetPodID() string {
+func (m *VolumeCreateResponse) GetVolume() *VolumeInfo {
 	if m != nil {
-		return m.PodID
+		return m.Volume
 	}
-	return ""
+	return nil
 }
 
-type PodPauseResponse struct {
+type VolumeListRequest struct {
 }
 
-func (m *PodPauseResponse) Reset()                    { *m = PodPauseResponse{} }
-func (m *PodPauseResponse) String() string            { return proto.CompactTextString(m) }
-func (*PodPauseResponse) ProtoMessage()               {}
-func (*PodPauseResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{122} }
+func (m *VolumeListRequest) Reset()                    { *m = VolumeListRequest{} }
+func (m *VolumeListRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeListRequest) ProtoMessage()               {}
+func (*VolumeListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{142} }
 
-type PodUnpauseRequest struct {
-	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+type VolumeListResponse struct {
+	Volumes []*VolumeInfo `protobuf:"bytes,1,rep,name=volumes" json:"volumes,omitempty"`
 }
 
-func (m *PodUnpauseRequest) Reset()                    { *m = PodUnpauseRequest{} }
-func (m *PodUnpauseRequest) String() string            { return proto.CompactTextString(m) }
-func (*PodUnpauseRequest) ProtoMessage()               {}
-func (*PodUnpauseRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{123} }
+func (m *VolumeListResponse) Reset()                    { *m = VolumeListResponse{} }
+func (m *VolumeListResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeListResponse) ProtoMessage()               {}
+func (*VolumeListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{143} }
 
-func (m *PodUnpauseRequest) GetPodID() string {
+func (m *VolumeListResponse) GetVolumes() []*VolumeInfo {
 	if m != nil {
-		return m.PodID
+		return m.Volumes
 	}
-	return ""
-}
-
-type PodUnpauseResponse struct {
+	return nil
 }
 
-func (m *PodUnpauseResponse) Reset()                    { *m = PodUnpauseResponse{} }
-func (m *PodUnpauseResponse) String() string            { return proto.CompactTextString(m) }
-func (*PodUnpauseResponse) ProtoMessage()               {}
-func (*PodUnpauseResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{124} }
-
-type PodLabelsRequest struct {
-	PodID    string            `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
-	Override bool              `protobuf:"varint,2,opt,name=override,proto3" json:"override,omitempty"`
-	Labels   map[string]string `protobuf:"bytes,3,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+type VolumeInspectRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *PodLabelsRequest) Reset()                    { *m = PodLabelsRequest{} }
-func (m *PodLabelsRequest) String() string            { return proto.CompactTextString(m) }
-func (*PodLabelsRequest) ProtoMessage()               {}
-func (*PodLabelsRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{125} }
+func (m *VolumeInspectRequest) Reset()                    { *m = VolumeInspectRequest{} }
+func (m *VolumeInspectRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeInspectRequest) ProtoMessage()               {}
+func (*VolumeInspectRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{144} }
 
-func (m *PodLabelsRequest) GetPodID() string {
+func (m *VolumeInspectRequest) GetName() string {
 	if m != nil {
-		return m.PodID
+		return m.Name
 	}
 	return ""
 }
 
-func (m *PodLabelsRequest) GetOverride() bool {
-	if m != nil {
-		return m.Override
-	}
-	return false
+type VolumeInspectResponse struct {
+	Volume *VolumeInfo `protobuf:"bytes,1,opt,name=volume" json:"volume,omitempty"`
 }
 
-func (m *PodLabelsRequest) GetLabels() map[string]string {
+func (m *VolumeInspectResponse) Reset()                    { *m = VolumeInspectResponse{} }
+func (m *VolumeInspectResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeInspectResponse) ProtoMessage()               {}
+func (*VolumeInspectResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{145} }
+
+func (m *VolumeInspectResponse) GetVolume() *VolumeInfo {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+type VolumeRemoveRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *VolumeRemoveRequest) Reset()                    { *m = VolumeRemoveRequest{} }
+func (m *VolumeRemoveRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeRemoveRequest) ProtoMessage()               {}
+func (*VolumeRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{146} }
+
+func (m *VolumeRemoveRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type VolumeRemoveResponse struct {
+}
+
+func (m *VolumeRemoveResponse) Reset()                    { *m = VolumeRemoveResponse{} }
+func (m *VolumeRemoveResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeRemoveResponse) ProtoMessage()               {}
+func (*VolumeRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{147} }
+
+type VolumeSnapshotInfo struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// podID and volume are the origin of the snapshot
+	PodID  string `protobuf:"bytes,2,opt,name=podID,proto3" json:"podID,omitempty"`
+	Volume string `protobuf:"bytes,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	// size is the size of the origin volume in bytes
+	Size_     int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	CreatedAt int64 `protobuf:"varint,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// deviceID is the id of the thin device in the volume pool
+	DeviceID int32 `protobuf:"varint,6,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
+}
+
+func (m *VolumeSnapshotInfo) Reset()                    { *m = VolumeSnapshotInfo{} }
+func (m *VolumeSnapshotInfo) String() string            { return proto.CompactTextString(m) }
+func (*VolumeSnapshotInfo) ProtoMessage()               {}
+func (*VolumeSnapshotInfo) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{148} }
+
+func (m *VolumeSnapshotInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *VolumeSnapshotInfo) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *VolumeSnapshotInfo) GetVolume() string {
+	if m != nil {
+		return m.Volume
+	}
+	return ""
+}
+
+func (m *VolumeSnapshotInfo) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *VolumeSnapshotInfo) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *VolumeSnapshotInfo) GetDeviceID() int32 {
+	if m != nil {
+		return m.DeviceID
+	}
+	return 0
+}
+
+type VolumeSnapshotRequest struct {
+	PodID  string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Volume string `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	Name   string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// pause the pod during the snapshot for consistency
+	Pause     bool   `protobuf:"varint,4,opt,name=pause,proto3" json:"pause,omitempty"`
+	Namespace string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *VolumeSnapshotRequest) Reset()                    { *m = VolumeSnapshotRequest{} }
+func (m *VolumeSnapshotRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeSnapshotRequest) ProtoMessage()               {}
+func (*VolumeSnapshotRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{149} }
+
+func (m *VolumeSnapshotRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *VolumeSnapshotRequest) GetVolume() string {
+	if m != nil {
+		return m.Volume
+	}
+	return ""
+}
+
+func (m *VolumeSnapshotRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *VolumeSnapshotRequest) GetPause() bool {
+	if m != nil {
+		return m.Pause
+	}
+	return false
+}
+
+func (m *VolumeSnapshotRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type VolumeSnapshotResponse struct {
+	Snapshot *VolumeSnapshotInfo `protobuf:"bytes,1,opt,name=snapshot" json:"snapshot,omitempty"`
+}
+
+func (m *VolumeSnapshotResponse) Reset()                    { *m = VolumeSnapshotResponse{} }
+func (m *VolumeSnapshotResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeSnapshotResponse) ProtoMessage()               {}
+func (*VolumeSnapshotResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{150} }
+
+func (m *VolumeSnapshotResponse) GetSnapshot() *VolumeSnapshotInfo {
+	if m != nil {
+		return m.Snapshot
+	}
+	return nil
+}
+
+type VolumeSnapshotListRequest struct {
+	// podID filters the snapshots of the pod if not empty
+	PodID     string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *VolumeSnapshotListRequest) Reset()                    { *m = VolumeSnapshotListRequest{} }
+func (m *VolumeSnapshotListRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeSnapshotListRequest) ProtoMessage()               {}
+func (*VolumeSnapshotListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{151} }
+
+func (m *VolumeSnapshotListRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *VolumeSnapshotListRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type VolumeSnapshotListResponse struct {
+	Snapshots []*VolumeSnapshotInfo `protobuf:"bytes,1,rep,name=snapshots" json:"snapshots,omitempty"`
+}
+
+func (m *VolumeSnapshotListResponse) Reset()                    { *m = VolumeSnapshotListResponse{} }
+func (m *VolumeSnapshotListResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeSnapshotListResponse) ProtoMessage()               {}
+func (*VolumeSnapshotListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{152} }
+
+func (m *VolumeSnapshotListResponse) GetSnapshots() []*VolumeSnapshotInfo {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+type VolumeCloneRequest struct {
+	Snapshot  string `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	PodID     string `protobuf:"bytes,2,opt,name=podID,proto3" json:"podID,omitempty"`
+	Volume    string `protobuf:"bytes,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *VolumeCloneRequest) Reset()                    { *m = VolumeCloneRequest{} }
+func (m *VolumeCloneRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeCloneRequest) ProtoMessage()               {}
+func (*VolumeCloneRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{153} }
+
+func (m *VolumeCloneRequest) GetSnapshot() string {
+	if m != nil {
+		return m.Snapshot
+	}
+	return ""
+}
+
+func (m *VolumeCloneRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *VolumeCloneRequest) GetVolume() string {
+	if m != nil {
+		return m.Volume
+	}
+	return ""
+}
+
+func (m *VolumeCloneRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type VolumeCloneResponse struct {
+}
+
+func (m *VolumeCloneResponse) Reset()                    { *m = VolumeCloneResponse{} }
+func (m *VolumeCloneResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeCloneResponse) ProtoMessage()               {}
+func (*VolumeCloneResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{154} }
+
+type VolumeSnapshotRemoveRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *VolumeSnapshotRemoveRequest) Reset()                    { *m = VolumeSnapshotRemoveRequest{} }
+func (m *VolumeSnapshotRemoveRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeSnapshotRemoveRequest) ProtoMessage()               {}
+func (*VolumeSnapshotRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{155} }
+
+func (m *VolumeSnapshotRemoveRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type VolumeSnapshotRemoveResponse struct {
+}
+
+func (m *VolumeSnapshotRemoveResponse) Reset()                    { *m = VolumeSnapshotRemoveResponse{} }
+func (m *VolumeSnapshotRemoveResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeSnapshotRemoveResponse) ProtoMessage()               {}
+func (*VolumeSnapshotRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{156} }
+
+// the named volume is exported or imported if podID is empty
+type VolumeExportRequest struct {
+	PodID     string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Volume    string `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *VolumeExportRequest) Reset()                    { *m = VolumeExportRequest{} }
+func (m *VolumeExportRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeExportRequest) ProtoMessage()               {}
+func (*VolumeExportRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{157} }
+
+func (m *VolumeExportRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *VolumeExportRequest) GetVolume() string {
+	if m != nil {
+		return m.Volume
+	}
+	return ""
+}
+
+func (m *VolumeExportRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type VolumeExportResponse struct {
+	// a chunk of the tar stream
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *VolumeExportResponse) Reset()                    { *m = VolumeExportResponse{} }
+func (m *VolumeExportResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeExportResponse) ProtoMessage()               {}
+func (*VolumeExportResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{158} }
+
+func (m *VolumeExportResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type VolumeImportRequest struct {
+	// podID and volume are only read from the first message
+	PodID  string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Volume string `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	// a chunk of the tar stream
+	Data      []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *VolumeImportRequest) Reset()                    { *m = VolumeImportRequest{} }
+func (m *VolumeImportRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeImportRequest) ProtoMessage()               {}
+func (*VolumeImportRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{159} }
+
+func (m *VolumeImportRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *VolumeImportRequest) GetVolume() string {
+	if m != nil {
+		return m.Volume
+	}
+	return ""
+}
+
+func (m *VolumeImportRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *VolumeImportRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type VolumeImportResponse struct {
+}
+
+func (m *VolumeImportResponse) Reset()                    { *m = VolumeImportResponse{} }
+func (m *VolumeImportResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeImportResponse) ProtoMessage()               {}
+func (*VolumeImportResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{160} }
+
+type StorageOrphan struct {
+	// kind is one of volume-record, dm-device, vfs-volume, rawblock-volume,
+	// rawblock-mount and hosts
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// pod is the pod which the orphan belonged to, if known
+	Pod     string `protobuf:"bytes,3,opt,name=pod,proto3" json:"pod,omitempty"`
+	Removed bool   `protobuf:"varint,4,opt,name=removed,proto3" json:"removed,omitempty"`
+	Error   string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *StorageOrphan) Reset()                    { *m = StorageOrphan{} }
+func (m *StorageOrphan) String() string            { return proto.CompactTextString(m) }
+func (*StorageOrphan) ProtoMessage()               {}
+func (*StorageOrphan) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{161} }
+
+func (m *StorageOrphan) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *StorageOrphan) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *StorageOrphan) GetPod() string {
+	if m != nil {
+		return m.Pod
+	}
+	return ""
+}
+
+func (m *StorageOrphan) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
+	}
+	return false
+}
+
+func (m *StorageOrphan) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type SystemGCRequest struct {
+	// dryRun reports the orphans without removing them
+	DryRun bool `protobuf:"varint,1,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (m *SystemGCRequest) Reset()                    { *m = SystemGCRequest{} }
+func (m *SystemGCRequest) String() string            { return proto.CompactTextString(m) }
+func (*SystemGCRequest) ProtoMessage()               {}
+func (*SystemGCRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{162} }
+
+func (m *SystemGCRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type SystemGCResponse struct {
+	Orphans []*StorageOrphan `protobuf:"bytes,1,rep,name=orphans" json:"orphans,omitempty"`
+}
+
+func (m *SystemGCResponse) Reset()                    { *m = SystemGCResponse{} }
+func (m *SystemGCResponse) String() string            { return proto.CompactTextString(m) }
+func (*SystemGCResponse) ProtoMessage()               {}
+func (*SystemGCResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{163} }
+
+func (m *SystemGCResponse) GetOrphans() []*StorageOrphan {
+	if m != nil {
+		return m.Orphans
+	}
+	return nil
+}
+
+type SystemDBBackupRequest struct {
+	// path is where the backup db is created on the host of hyperd
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (m *SystemDBBackupRequest) Reset()                    { *m = SystemDBBackupRequest{} }
+func (m *SystemDBBackupRequest) String() string            { return proto.CompactTextString(m) }
+func (*SystemDBBackupRequest) ProtoMessage()               {}
+func (*SystemDBBackupRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{164} }
+
+func (m *SystemDBBackupRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type SystemDBBackupResponse struct {
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// records is the number of records backed up
+	Records int64 `protobuf:"varint,2,opt,name=records,proto3" json:"records,omitempty"`
+}
+
+func (m *SystemDBBackupResponse) Reset()                    { *m = SystemDBBackupResponse{} }
+func (m *SystemDBBackupResponse) String() string            { return proto.CompactTextString(m) }
+func (*SystemDBBackupResponse) ProtoMessage()               {}
+func (*SystemDBBackupResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{165} }
+
+func (m *SystemDBBackupResponse) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *SystemDBBackupResponse) GetRecords() int64 {
+	if m != nil {
+		return m.Records
+	}
+	return 0
+}
+
+type PodStopRequest struct {
+	PodID     string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *PodStopRequest) Reset()                    { *m = PodStopRequest{} }
+func (m *PodStopRequest) String() string            { return proto.CompactTextString(m) }
+func (*PodStopRequest) ProtoMessage()               {}
+func (*PodStopRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{166} }
+
+func (m *PodStopRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *PodStopRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type PodStopResponse struct {
+	Code  int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Cause string `protobuf:"bytes,2,opt,name=cause,proto3" json:"cause,omitempty"`
+}
+
+func (m *PodStopResponse) Reset()                    { *m = PodStopResponse{} }
+func (m *PodStopResponse) String() string            { return proto.CompactTextString(m) }
+func (*PodStopResponse) ProtoMessage()               {}
+func (*PodStopResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{167} }
+
+func (m *PodStopResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *PodStopResponse) GetCause() string {
+	if m != nil {
+		return m.Cause
+	}
+	return ""
+}
+
+type PodSignalRequest struct {
+	PodID     string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Signal    int64  `protobuf:"varint,2,opt,name=signal,proto3" json:"signal,omitempty"`
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *PodSignalRequest) Reset()                    { *m = PodSignalRequest{} }
+func (m *PodSignalRequest) String() string            { return proto.CompactTextString(m) }
+func (*PodSignalRequest) ProtoMessage()               {}
+func (*PodSignalRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{168} }
+
+func (m *PodSignalRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *PodSignalRequest) GetSignal() int64 {
+	if m != nil {
+		return m.Signal
+	}
+	return 0
+}
+
+func (m *PodSignalRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type PodSignalResponse struct {
+}
+
+func (m *PodSignalResponse) Reset()                    { *m = PodSignalResponse{} }
+func (m *PodSignalResponse) String() string            { return proto.CompactTextString(m) }
+func (*PodSignalResponse) ProtoMessage()               {}
+func (*PodSignalResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{169} }
+
+type PodPauseRequest struct {
+	PodID     string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *PodPauseRequest) Reset()                    { *m = PodPauseRequest{} }
+func (m *PodPauseRequest) String() string            { return proto.CompactTextString(m) }
+func (*PodPauseRequest) ProtoMessage()               {}
+func (*PodPauseRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{170} }
+
+func (m *PodPauseRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *PodPauseRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type PodPauseResponse struct {
+}
+
+func (m *PodPauseResponse) Reset()                    { *m = PodPauseResponse{} }
+func (m *PodPauseResponse) String() string            { return proto.CompactTextString(m) }
+func (*PodPauseResponse) ProtoMessage()               {}
+func (*PodPauseResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{171} }
+
+type PodUnpauseRequest struct {
+	PodID     string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *PodUnpauseRequest) Reset()                    { *m = PodUnpauseRequest{} }
+func (m *PodUnpauseRequest) String() string            { return proto.CompactTextString(m) }
+func (*PodUnpauseRequest) ProtoMessage()               {}
+func (*PodUnpauseRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{172} }
+
+func (m *PodUnpauseRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *PodUnpauseRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type PodUnpauseResponse struct {
+}
+
+func (m *PodUnpauseResponse) Reset()                    { *m = PodUnpauseResponse{} }
+func (m *PodUnpauseResponse) String() string            { return proto.CompactTextString(m) }
+func (*PodUnpauseResponse) ProtoMessage()               {}
+func (*PodUnpauseResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{173} }
+
+type PodLabelsRequest struct {
+	PodID     string            `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Override  bool              `protobuf:"varint,2,opt,name=override,proto3" json:"override,omitempty"`
+	Labels    map[string]string `protobuf:"bytes,3,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Namespace string            `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *PodLabelsRequest) Reset()                    { *m = PodLabelsRequest{} }
+func (m *PodLabelsRequest) String() string            { return proto.CompactTextString(m) }
+func (*PodLabelsRequest) ProtoMessage()               {}
+func (*PodLabelsRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{174} }
+
+func (m *PodLabelsRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *PodLabelsRequest) GetOverride() bool {
+	if m != nil {
+		return m.Override
+	}
+	return false
+}
+
+func (m *PodLabelsRequest) GetLabels() map[string]string {
 	if m != nil {
 		return m.Labels
 	}
 	return nil
 }
 
+func (m *PodLabelsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type PodLabelsResponse struct {
 }
 
 func (m *PodLabelsResponse) Reset()                    { *m = PodLabelsResponse{} }
 func (m *PodLabelsResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodLabelsResponse) ProtoMessage()               {}
-func (*PodLabelsResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{126} }
+func (*PodLabelsResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{175} }
 
 type PodStatsRequest struct {
-	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	PodID     string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *PodStatsRequest) Reset()                    { *m = PodStatsRequest{} }
 func (m *PodStatsRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodStatsRequest) ProtoMessage()               {}
-func (*PodStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{127} }
+func (*PodStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{176} }
 
 func (m *PodStatsRequest) GetPodID() string {
 	if m != nil {
@@ -4356,6 +6105,13 @@ func (m *PodStatsRequest) GetPodID() string {
 	return ""
 }
 
+func (m *PodStatsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type PodStatsResponse struct {
 	PodStats *PodStats `protobuf:"bytes,1,opt,name=podStats" json:"podStats,omitempty"`
 }
@@ -4363,7 +6119,7 @@ type PodStatsResponse struct {
 func (m *PodStatsResponse) Reset()                    { *m = PodStatsResponse{} }
 func (m *PodStatsResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodStatsResponse) ProtoMessage()               {}
-func (*PodStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{128} }
+func (*PodStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{177} }
 
 func (m *PodStatsResponse) GetPodStats() *PodStats {
 	if m != nil {
@@ -4372,13 +6128,47 @@ func (m *PodStatsResponse) GetPodStats() *PodStats {
 	return nil
 }
 
+type ConfigReloadRequest struct {
+}
+
+func (m *ConfigReloadRequest) Reset()                    { *m = ConfigReloadRequest{} }
+func (m *ConfigReloadRequest) String() string            { return proto.CompactTextString(m) }
+func (*ConfigReloadRequest) ProtoMessage()               {}
+func (*ConfigReloadRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{178} }
+
+type ConfigReloadResponse struct {
+	// Applied is the config items which have been changed at runtime
+	Applied []string `protobuf:"bytes,1,rep,name=applied" json:"applied,omitempty"`
+	// RestartRequired is the changed config items which take effect after restart
+	RestartRequired []string `protobuf:"bytes,2,rep,name=restartRequired" json:"restartRequired,omitempty"`
+}
+
+func (m *ConfigReloadResponse) Reset()                    { *m = ConfigReloadResponse{} }
+func (m *ConfigReloadResponse) String() string            { return proto.CompactTextString(m) }
+func (*ConfigReloadResponse) ProtoMessage()               {}
+func (*ConfigReloadResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{179} }
+
+func (m *ConfigReloadResponse) GetApplied() []string {
+	if m != nil {
+		return m.Applied
+	}
+	return nil
+}
+
+func (m *ConfigReloadResponse) GetRestartRequired() []string {
+	if m != nil {
+		return m.RestartRequired
+	}
+	return nil
+}
+
 type PingRequest struct {
 }
 
 func (m *PingRequest) Reset()                    { *m = PingRequest{} }
 func (m *PingRequest) String() string            { return proto.CompactTextString(m) }
 func (*PingRequest) ProtoMessage()               {}
-func (*PingRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{129} }
+func (*PingRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{180} }
 
 type PingResponse struct {
 	HyperdStats string `protobuf:"bytes,1,opt,name=hyperdStats,proto3" json:"hyperdStats,omitempty"`
@@ -4387,7 +6177,7 @@ type PingResponse struct {
 func (m *PingResponse) Reset()                    { *m = PingResponse{} }
 func (m *PingResponse) String() string            { return proto.CompactTextString(m) }
 func (*PingResponse) ProtoMessage()               {}
-func (*PingResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{130} }
+func (*PingResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{181} }
 
 func (m *PingResponse) GetHyperdStats() string {
 	if m != nil {
@@ -4400,12 +6190,13 @@ type ContainerSignalRequest struct {
 	PodID       string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
 	ContainerID string `protobuf:"bytes,2,opt,name=containerID,proto3" json:"containerID,omitempty"`
 	Signal      int64  `protobuf:"varint,3,opt,name=signal,proto3" json:"signal,omitempty"`
+	Namespace   string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *ContainerSignalRequest) Reset()                    { *m = ContainerSignalRequest{} }
 func (m *ContainerSignalRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerSignalRequest) ProtoMessage()               {}
-func (*ContainerSignalRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{131} }
+func (*ContainerSignalRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{182} }
 
 func (m *ContainerSignalRequest) GetPodID() string {
 	if m != nil {
@@ -4428,25 +6219,33 @@ func (m *ContainerSignalRequest) GetSignal() int64 {
 	return 0
 }
 
+func (m *ContainerSignalRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type ContainerSignalResponse struct {
 }
 
 func (m *ContainerSignalResponse) Reset()                    { *m = ContainerSignalResponse{} }
 func (m *ContainerSignalResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerSignalResponse) ProtoMessage()               {}
-func (*ContainerSignalResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{132} }
+func (*ContainerSignalResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{183} }
 
 type TTYResizeRequest struct {
 	ContainerID string `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
 	ExecID      string `protobuf:"bytes,2,opt,name=execID,proto3" json:"execID,omitempty"`
 	Width       int32  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
 	Height      int32  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Namespace   string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *TTYResizeRequest) Reset()                    { *m = TTYResizeRequest{} }
 func (m *TTYResizeRequest) String() string            { return proto.CompactTextString(m) }
 func (*TTYResizeRequest) ProtoMessage()               {}
-func (*TTYResizeRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{133} }
+func (*TTYResizeRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{184} }
 
 func (m *TTYResizeRequest) GetContainerID() string {
 	if m != nil {
@@ -4476,13 +6275,20 @@ func (m *TTYResizeRequest) GetHeight() int32 {
 	return 0
 }
 
+func (m *TTYResizeRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type TTYResizeResponse struct {
 }
 
 func (m *TTYResizeResponse) Reset()                    { *m = TTYResizeResponse{} }
 func (m *TTYResizeResponse) String() string            { return proto.CompactTextString(m) }
 func (*TTYResizeResponse) ProtoMessage()               {}
-func (*TTYResizeResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{134} }
+func (*TTYResizeResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{185} }
 
 func init() {
 	proto.RegisterType((*ContainerPort)(nil), "types.ContainerPort")
@@ -4500,6 +6306,7 @@ func init() {
 	proto.RegisterType((*PodStatus)(nil), "types.PodStatus")
 	proto.RegisterType((*PodInfo)(nil), "types.PodInfo")
 	proto.RegisterType((*ImageInfo)(nil), "types.ImageInfo")
+	proto.RegisterType((*ExecInfo)(nil), "types.ExecInfo")
 	proto.RegisterType((*PodStats)(nil), "types.PodStats")
 	proto.RegisterType((*CpuStats)(nil), "types.CpuStats")
 	proto.RegisterType((*CpuUsage)(nil), "types.CpuUsage")
@@ -4514,14 +6321,24 @@ func init() {
 	proto.RegisterType((*ContainersStats)(nil), "types.ContainersStats")
 	proto.RegisterType((*PodInfoRequest)(nil), "types.PodInfoRequest")
 	proto.RegisterType((*PodInfoResponse)(nil), "types.PodInfoResponse")
+	proto.RegisterType((*PodExportRequest)(nil), "types.PodExportRequest")
+	proto.RegisterType((*PodExportResponse)(nil), "types.PodExportResponse")
 	proto.RegisterType((*PodListRequest)(nil), "types.PodListRequest")
 	proto.RegisterType((*PodListResult)(nil), "types.PodListResult")
 	proto.RegisterType((*PodListResponse)(nil), "types.PodListResponse")
+	proto.RegisterType((*PodWatchRequest)(nil), "types.PodWatchRequest")
+	proto.RegisterType((*PodWatchObject)(nil), "types.PodWatchObject")
+	proto.RegisterType((*PodWatchEvent)(nil), "types.PodWatchEvent")
+	proto.RegisterType((*StoragePoolStatus)(nil), "types.StoragePoolStatus")
 	proto.RegisterType((*ContainerListRequest)(nil), "types.ContainerListRequest")
 	proto.RegisterType((*ContainerListResult)(nil), "types.ContainerListResult")
 	proto.RegisterType((*ContainerListResponse)(nil), "types.ContainerListResponse")
 	proto.RegisterType((*ContainerInfoRequest)(nil), "types.ContainerInfoRequest")
 	proto.RegisterType((*ContainerInfoResponse)(nil), "types.ContainerInfoResponse")
+	proto.RegisterType((*ImageInfoRequest)(nil), "types.ImageInfoRequest")
+	proto.RegisterType((*ImageInfoResponse)(nil), "types.ImageInfoResponse")
+	proto.RegisterType((*ExecInfoRequest)(nil), "types.ExecInfoRequest")
+	proto.RegisterType((*ExecInfoResponse)(nil), "types.ExecInfoResponse")
 	proto.RegisterType((*VMListResult)(nil), "types.VMListResult")
 	proto.RegisterType((*VMListRequest)(nil), "types.VMListRequest")
 	proto.RegisterType((*VMListResponse)(nil), "types.VMListResponse")
@@ -4541,6 +6358,7 @@ func init() {
 	proto.RegisterType((*UserFile)(nil), "types.UserFile")
 	proto.RegisterType((*UserVolumeOption)(nil), "types.UserVolumeOption")
 	proto.RegisterType((*UserVolume)(nil), "types.UserVolume")
+	proto.RegisterType((*UserVolumeEncryption)(nil), "types.UserVolumeEncryption")
 	proto.RegisterType((*UserInterface)(nil), "types.UserInterface")
 	proto.RegisterType((*UserServiceBackend)(nil), "types.UserServiceBackend")
 	proto.RegisterType((*UserService)(nil), "types.UserService")
@@ -4576,6 +6394,8 @@ func init() {
 	proto.RegisterType((*ContainerStartResponse)(nil), "types.ContainerStartResponse")
 	proto.RegisterType((*ContainerRenameRequest)(nil), "types.ContainerRenameRequest")
 	proto.RegisterType((*ContainerRenameResponse)(nil), "types.ContainerRenameResponse")
+	proto.RegisterType((*ContainerCommitRequest)(nil), "types.ContainerCommitRequest")
+	proto.RegisterType((*ContainerCommitResponse)(nil), "types.ContainerCommitResponse")
 	proto.RegisterType((*ContainerRemoveRequest)(nil), "types.ContainerRemoveRequest")
 	proto.RegisterType((*ContainerRemoveResponse)(nil), "types.ContainerRemoveResponse")
 	proto.RegisterType((*AuthConfig)(nil), "types.AuthConfig")
@@ -4583,9 +6403,17 @@ func init() {
 	proto.RegisterType((*ImagePullResponse)(nil), "types.ImagePullResponse")
 	proto.RegisterType((*ImagePushRequest)(nil), "types.ImagePushRequest")
 	proto.RegisterType((*ImagePushResponse)(nil), "types.ImagePushResponse")
+	proto.RegisterType((*ImageBuildRequest)(nil), "types.ImageBuildRequest")
+	proto.RegisterType((*ImageBuildResponse)(nil), "types.ImageBuildResponse")
+	proto.RegisterType((*ImageLoadRequest)(nil), "types.ImageLoadRequest")
+	proto.RegisterType((*ImageLoadResponse)(nil), "types.ImageLoadResponse")
+	proto.RegisterType((*ImageSaveRequest)(nil), "types.ImageSaveRequest")
+	proto.RegisterType((*ImageSaveResponse)(nil), "types.ImageSaveResponse")
 	proto.RegisterType((*ImageRemoveRequest)(nil), "types.ImageRemoveRequest")
 	proto.RegisterType((*ImageDelete)(nil), "types.ImageDelete")
 	proto.RegisterType((*ImageRemoveResponse)(nil), "types.ImageRemoveResponse")
+	proto.RegisterType((*AuthRequest)(nil), "types.AuthRequest")
+	proto.RegisterType((*AuthResponse)(nil), "types.AuthResponse")
 	proto.RegisterType((*ContainerStopRequest)(nil), "types.ContainerStopRequest")
 	proto.RegisterType((*ContainerStopResponse)(nil), "types.ContainerStopResponse")
 	proto.RegisterType((*VersionRequest)(nil), "types.VersionRequest")
@@ -4602,6 +6430,33 @@ func init() {
 	proto.RegisterType((*PortMappingListResponse)(nil), "types.PortMappingListResponse")
 	proto.RegisterType((*PortMappingModifyRequest)(nil), "types.PortMappingModifyRequest")
 	proto.RegisterType((*PortMappingModifyResponse)(nil), "types.PortMappingModifyResponse")
+	proto.RegisterType((*VolumeInfo)(nil), "types.VolumeInfo")
+	proto.RegisterType((*VolumeCreateRequest)(nil), "types.VolumeCreateRequest")
+	proto.RegisterType((*VolumeCreateResponse)(nil), "types.VolumeCreateResponse")
+	proto.RegisterType((*VolumeListRequest)(nil), "types.VolumeListRequest")
+	proto.RegisterType((*VolumeListResponse)(nil), "types.VolumeListResponse")
+	proto.RegisterType((*VolumeInspectRequest)(nil), "types.VolumeInspectRequest")
+	proto.RegisterType((*VolumeInspectResponse)(nil), "types.VolumeInspectResponse")
+	proto.RegisterType((*VolumeRemoveRequest)(nil), "types.VolumeRemoveRequest")
+	proto.RegisterType((*VolumeRemoveResponse)(nil), "types.VolumeRemoveResponse")
+	proto.RegisterType((*VolumeSnapshotInfo)(nil), "types.VolumeSnapshotInfo")
+	proto.RegisterType((*VolumeSnapshotRequest)(nil), "types.VolumeSnapshotRequest")
+	proto.RegisterType((*VolumeSnapshotResponse)(nil), "types.VolumeSnapshotResponse")
+	proto.RegisterType((*VolumeSnapshotListRequest)(nil), "types.VolumeSnapshotListRequest")
+	proto.RegisterType((*VolumeSnapshotListResponse)(nil), "types.VolumeSnapshotListResponse")
+	proto.RegisterType((*VolumeCloneRequest)(nil), "types.VolumeCloneRequest")
+	proto.RegisterType((*VolumeCloneResponse)(nil), "types.VolumeCloneResponse")
+	proto.RegisterType((*VolumeSnapshotRemoveRequest)(nil), "types.VolumeSnapshotRemoveRequest")
+	proto.RegisterType((*VolumeSnapshotRemoveResponse)(nil), "types.VolumeSnapshotRemoveResponse")
+	proto.RegisterType((*VolumeExportRequest)(nil), "types.VolumeExportRequest")
+	proto.RegisterType((*VolumeExportResponse)(nil), "types.VolumeExportResponse")
+	proto.RegisterType((*VolumeImportRequest)(nil), "types.VolumeImportRequest")
+	proto.RegisterType((*VolumeImportResponse)(nil), "types.VolumeImportResponse")
+	proto.RegisterType((*StorageOrphan)(nil), "types.StorageOrphan")
+	proto.RegisterType((*SystemGCRequest)(nil), "types.SystemGCRequest")
+	proto.RegisterType((*SystemGCResponse)(nil), "types.SystemGCResponse")
+	proto.RegisterType((*SystemDBBackupRequest)(nil), "types.SystemDBBackupRequest")
+	proto.RegisterType((*SystemDBBackupResponse)(nil), "types.SystemDBBackupResponse")
 	proto.RegisterType((*PodStopRequest)(nil), "types.PodStopRequest")
 	proto.RegisterType((*PodStopResponse)(nil), "types.PodStopResponse")
 	proto.RegisterType((*PodSignalRequest)(nil), "types.PodSignalRequest")
@@ -4614,6 +6469,8 @@ func init() {
 	proto.RegisterType((*PodLabelsResponse)(nil), "types.PodLabelsResponse")
 	proto.RegisterType((*PodStatsRequest)(nil), "types.PodStatsRequest")
 	proto.RegisterType((*PodStatsResponse)(nil), "types.PodStatsResponse")
+	proto.RegisterType((*ConfigReloadRequest)(nil), "types.ConfigReloadRequest")
+	proto.RegisterType((*ConfigReloadResponse)(nil), "types.ConfigReloadResponse")
 	proto.RegisterType((*PingRequest)(nil), "types.PingRequest")
 	proto.RegisterType((*PingResponse)(nil), "types.PingResponse")
 	proto.RegisterType((*ContainerSignalRequest)(nil), "types.ContainerSignalRequest")
@@ -4639,6 +6496,8 @@ type PublicAPIClient interface {
 	PodCreate(ctx context.Context, in *PodCreateRequest, opts ...grpc.CallOption) (*PodCreateResponse, error)
 	// PodInfo gets pod's info by podID
 	PodInfo(ctx context.Context, in *PodInfoRequest, opts ...grpc.CallOption) (*PodInfoResponse, error)
+	// PodExport gets the reusable spec of a pod
+	PodExport(ctx context.Context, in *PodExportRequest, opts ...grpc.CallOption) (*PodExportResponse, error)
 	// PodRemove deletes a pod by podID
 	PodRemove(ctx context.Context, in *PodRemoveRequest, opts ...grpc.CallOption) (*PodRemoveResponse, error)
 	// PodStart starts a pod
@@ -4651,16 +6510,26 @@ type PublicAPIClient interface {
 	PodPause(ctx context.Context, in *PodPauseRequest, opts ...grpc.CallOption) (*PodPauseResponse, error)
 	// PodUnpause unpauses a pod
 	PodUnpause(ctx context.Context, in *PodUnpauseRequest, opts ...grpc.CallOption) (*PodUnpauseResponse, error)
+	// PodWatch watches the changes of pods and their containers
+	PodWatch(ctx context.Context, in *PodWatchRequest, opts ...grpc.CallOption) (PublicAPI_PodWatchClient, error)
 	// ExecVM executes a command outside of any containers.
 	ExecVM(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_ExecVMClient, error)
 	// ContainerList gets a list of containers
 	ContainerList(ctx context.Context, in *ContainerListRequest, opts ...grpc.CallOption) (*ContainerListResponse, error)
 	// ContainerInfo gets container's info by container's id or name
 	ContainerInfo(ctx context.Context, in *ContainerInfoRequest, opts ...grpc.CallOption) (*ContainerInfoResponse, error)
+	// ExecInfo gets the info of an exec by its id
+	ExecInfo(ctx context.Context, in *ExecInfoRequest, opts ...grpc.CallOption) (*ExecInfoResponse, error)
 	// ImageList gets a list of images by filters
 	ImageList(ctx context.Context, in *ImageListRequest, opts ...grpc.CallOption) (*ImageListResponse, error)
+	// ImageInfo gets image's info by image's id or name
+	ImageInfo(ctx context.Context, in *ImageInfoRequest, opts ...grpc.CallOption) (*ImageInfoResponse, error)
 	// VMList gets a list of HyperVMs
 	VMList(ctx context.Context, in *VMListRequest, opts ...grpc.CallOption) (*VMListResponse, error)
+	// VMCreate creates a VM which is not associated with any pods
+	VMCreate(ctx context.Context, in *VMCreateRequest, opts ...grpc.CallOption) (*VMCreateResponse, error)
+	// VMRemove removes a VM which is not associated with any pods
+	VMRemove(ctx context.Context, in *VMRemoveRequest, opts ...grpc.CallOption) (*VMRemoveResponse, error)
 	// SetPodLabels sets labels of given pod
 	SetPodLabels(ctx context.Context, in *PodLabelsRequest, opts ...grpc.CallOption) (*PodLabelsResponse, error)
 	// PodStats gets pod stats of a given pod
@@ -4673,7 +6542,8 @@ type PublicAPIClient interface {
 	ContainerStart(ctx context.Context, in *ContainerStartRequest, opts ...grpc.CallOption) (*ContainerStartResponse, error)
 	// ContainerRename renames a container
 	ContainerRename(ctx context.Context, in *ContainerRenameRequest, opts ...grpc.CallOption) (*ContainerRenameResponse, error)
-	// TODO: ContainerCommit commits the changes of the specified container
+	// ContainerCommit commits the changes of the specified container
+	ContainerCommit(ctx context.Context, in *ContainerCommitRequest, opts ...grpc.CallOption) (*ContainerCommitResponse, error)
 	// ContainerSignal sends a signal to specified container
 	ContainerSignal(ctx context.Context, in *ContainerSignalRequest, opts ...grpc.CallOption) (*ContainerSignalResponse, error)
 	// TODO: ContainerLabels updates labels of the specified container
@@ -4707,18 +6577,52 @@ type PublicAPIClient interface {
 	PortMappingAdd(ctx context.Context, in *PortMappingModifyRequest, opts ...grpc.CallOption) (*PortMappingModifyResponse, error)
 	// PortMappingDel remove a list of PortMapping rules from a Pod
 	PortMappingDel(ctx context.Context, in *PortMappingModifyRequest, opts ...grpc.CallOption) (*PortMappingModifyResponse, error)
+	// VolumeCreate creates a named volume
+	VolumeCreate(ctx context.Context, in *VolumeCreateRequest, opts ...grpc.CallOption) (*VolumeCreateResponse, error)
+	// VolumeList gets a list of named volumes
+	VolumeList(ctx context.Context, in *VolumeListRequest, opts ...grpc.CallOption) (*VolumeListResponse, error)
+	// VolumeInspect gets the info of a named volume
+	VolumeInspect(ctx context.Context, in *VolumeInspectRequest, opts ...grpc.CallOption) (*VolumeInspectResponse, error)
+	// VolumeRemove removes a named volume which is not used by any pods
+	VolumeRemove(ctx context.Context, in *VolumeRemoveRequest, opts ...grpc.CallOption) (*VolumeRemoveResponse, error)
+	// VolumeSnapshot takes a snapshot of a volume of the pod
+	VolumeSnapshot(ctx context.Context, in *VolumeSnapshotRequest, opts ...grpc.CallOption) (*VolumeSnapshotResponse, error)
+	// VolumeSnapshotList gets a list of volume snapshots
+	VolumeSnapshotList(ctx context.Context, in *VolumeSnapshotListRequest, opts ...grpc.CallOption) (*VolumeSnapshotListResponse, error)
+	// VolumeClone creates a volume of the pod from a snapshot
+	VolumeClone(ctx context.Context, in *VolumeCloneRequest, opts ...grpc.CallOption) (*VolumeCloneResponse, error)
+	// VolumeSnapshotRemove removes a volume snapshot
+	VolumeSnapshotRemove(ctx context.Context, in *VolumeSnapshotRemoveRequest, opts ...grpc.CallOption) (*VolumeSnapshotRemoveResponse, error)
+	// VolumeExport exports the files in a volume as a tar stream
+	VolumeExport(ctx context.Context, in *VolumeExportRequest, opts ...grpc.CallOption) (PublicAPI_VolumeExportClient, error)
+	// VolumeImport extracts a tar stream into a volume
+	VolumeImport(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_VolumeImportClient, error)
 	// ImagePull pulls a image from registry
 	ImagePull(ctx context.Context, in *ImagePullRequest, opts ...grpc.CallOption) (PublicAPI_ImagePullClient, error)
 	// ImagePush pushes a local image to registry
 	ImagePush(ctx context.Context, in *ImagePushRequest, opts ...grpc.CallOption) (PublicAPI_ImagePushClient, error)
 	// ImageRemove deletes a image from hyperd
 	ImageRemove(ctx context.Context, in *ImageRemoveRequest, opts ...grpc.CallOption) (*ImageRemoveResponse, error)
+	// ImageBuild builds a image from the Dockerfile in a build context
+	ImageBuild(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_ImageBuildClient, error)
+	// ImageLoad loads images from a tarball
+	ImageLoad(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_ImageLoadClient, error)
+	// ImageSave saves images to a tarball
+	ImageSave(ctx context.Context, in *ImageSaveRequest, opts ...grpc.CallOption) (PublicAPI_ImageSaveClient, error)
 	// Ping checks if hyperd is running (returns 'OK' on success)
 	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
 	// Info gets the info of hyperd
 	Info(ctx context.Context, in *InfoRequest, opts ...grpc.CallOption) (*InfoResponse, error)
 	// Version gets the version and apiVersion of hyperd
 	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+	// ConfigReload reloads the config file of hyperd
+	ConfigReload(ctx context.Context, in *ConfigReloadRequest, opts ...grpc.CallOption) (*ConfigReloadResponse, error)
+	// Auth auths a user to the specified docker registry
+	Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	// SystemGC removes the storage left by the pods which no longer exist
+	SystemGC(ctx context.Context, in *SystemGCRequest, opts ...grpc.CallOption) (*SystemGCResponse, error)
+	// SystemDBBackup backs up the daemon db while hyperd is running
+	SystemDBBackup(ctx context.Context, in *SystemDBBackupRequest, opts ...grpc.CallOption) (*SystemDBBackupResponse, error)
 }
 
 type publicAPIClient struct {
@@ -4756,6 +6660,15 @@ func (c *publicAPIClient) PodInfo(ctx context.Context, in *PodInfoRequest, opts
 	return out, nil
 }
 
+func (c *publicAPIClient) PodExport(ctx context.Context, in *PodExportRequest, opts ...grpc.CallOption) (*PodExportResponse, error) {
+	out := new(PodExportResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/PodExport", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *publicAPIClient) PodRemove(ctx context.Context, in *PodRemoveRequest, opts ...grpc.CallOption) (*PodRemoveResponse, error) {
 	out := new(PodRemoveResponse)
 	err := grpc.Invoke(ctx, "/types.PublicAPI/PodRemove", in, out, c.cc, opts...)
@@ -4810,8 +6723,40 @@ func (c *publicAPIClient) PodUnpause(ctx context.Context, in *PodUnpauseRequest,
 	return out, nil
 }
 
+func (c *publicAPIClient) PodWatch(ctx context.Context, in *PodWatchRequest, opts ...grpc.CallOption) (PublicAPI_PodWatchClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[0], c.cc, "/types.PublicAPI/PodWatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publicAPIPodWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PublicAPI_PodWatchClient interface {
+	Recv() (*PodWatchEvent, error)
+	grpc.ClientStream
+}
+
+type publicAPIPodWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *publicAPIPodWatchClient) Recv() (*PodWatchEvent, error) {
+	m := new(PodWatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *publicAPIClient) ExecVM(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_ExecVMClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[0], c.cc, "/types.PublicAPI/ExecVM", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[1], c.cc, "/types.PublicAPI/ExecVM", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4859,6 +6804,15 @@ func (c *publicAPIClient) ContainerInfo(ctx context.Context, in *ContainerInfoRe
 	return out, nil
 }
 
+func (c *publicAPIClient) ExecInfo(ctx context.Context, in *ExecInfoRequest, opts ...grpc.CallOption) (*ExecInfoResponse, error) {
+	out := new(ExecInfoResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ExecInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *publicAPIClient) ImageList(ctx context.Context, in *ImageListRequest, opts ...grpc.CallOption) (*ImageListResponse, error) {
 	out := new(ImageListResponse)
 	err := grpc.Invoke(ctx, "/types.PublicAPI/ImageList", in, out, c.cc, opts...)
@@ -4868,6 +6822,15 @@ func (c *publicAPIClient) ImageList(ctx context.Context, in *ImageListRequest, o
 	return out, nil
 }
 
+func (c *publicAPIClient) ImageInfo(ctx context.Context, in *ImageInfoRequest, opts ...grpc.CallOption) (*ImageInfoResponse, error) {
+	out := new(ImageInfoResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ImageInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *publicAPIClient) VMList(ctx context.Context, in *VMListRequest, opts ...grpc.CallOption) (*VMListResponse, error) {
 	out := new(VMListResponse)
 	err := grpc.Invoke(ctx, "/types.PublicAPI/VMList", in, out, c.cc, opts...)
@@ -4877,6 +6840,24 @@ func (c *publicAPIClient) VMList(ctx context.Context, in *VMListRequest, opts ..
 	return out, nil
 }
 
+func (c *publicAPIClient) VMCreate(ctx context.Context, in *VMCreateRequest, opts ...grpc.CallOption) (*VMCreateResponse, error) {
+	out := new(VMCreateResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VMCreate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) VMRemove(ctx context.Context, in *VMRemoveRequest, opts ...grpc.CallOption) (*VMRemoveResponse, error) {
+	out := new(VMRemoveResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VMRemove", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *publicAPIClient) SetPodLabels(ctx context.Context, in *PodLabelsRequest, opts ...grpc.CallOption) (*PodLabelsResponse, error) {
 	out := new(PodLabelsResponse)
 	err := grpc.Invoke(ctx, "/types.PublicAPI/SetPodLabels", in, out, c.cc, opts...)
@@ -4896,7 +6877,7 @@ func (c *publicAPIClient) PodStats(ctx context.Context, in *PodStatsRequest, opt
 }
 
 func (c *publicAPIClient) ContainerLogs(ctx context.Context, in *ContainerLogsRequest, opts ...grpc.CallOption) (PublicAPI_ContainerLogsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[1], c.cc, "/types.PublicAPI/ContainerLogs", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[2], c.cc, "/types.PublicAPI/ContainerLogs", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4954,6 +6935,15 @@ func (c *publicAPIClient) ContainerRename(ctx context.Context, in *ContainerRena
 	return out, nil
 }
 
+func (c *publicAPIClient) ContainerCommit(ctx context.Context, in *ContainerCommitRequest, opts ...grpc.CallOption) (*ContainerCommitResponse, error) {
+	out := new(ContainerCommitResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ContainerCommit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *publicAPIClient) ContainerSignal(ctx context.Context, in *ContainerSignalRequest, opts ...grpc.CallOption) (*ContainerSignalResponse, error) {
 	out := new(ContainerSignalResponse)
 	err := grpc.Invoke(ctx, "/types.PublicAPI/ContainerSignal", in, out, c.cc, opts...)
@@ -4991,7 +6981,7 @@ func (c *publicAPIClient) ExecCreate(ctx context.Context, in *ExecCreateRequest,
 }
 
 func (c *publicAPIClient) ExecStart(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_ExecStartClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[2], c.cc, "/types.PublicAPI/ExecStart", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[3], c.cc, "/types.PublicAPI/ExecStart", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5031,7 +7021,7 @@ func (c *publicAPIClient) ExecSignal(ctx context.Context, in *ExecSignalRequest,
 }
 
 func (c *publicAPIClient) Attach(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_AttachClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[3], c.cc, "/types.PublicAPI/Attach", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[4], c.cc, "/types.PublicAPI/Attach", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5142,8 +7132,146 @@ func (c *publicAPIClient) PortMappingDel(ctx context.Context, in *PortMappingMod
 	return out, nil
 }
 
+func (c *publicAPIClient) VolumeCreate(ctx context.Context, in *VolumeCreateRequest, opts ...grpc.CallOption) (*VolumeCreateResponse, error) {
+	out := new(VolumeCreateResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VolumeCreate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) VolumeList(ctx context.Context, in *VolumeListRequest, opts ...grpc.CallOption) (*VolumeListResponse, error) {
+	out := new(VolumeListResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VolumeList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) VolumeInspect(ctx context.Context, in *VolumeInspectRequest, opts ...grpc.CallOption) (*VolumeInspectResponse, error) {
+	out := new(VolumeInspectResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VolumeInspect", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) VolumeRemove(ctx context.Context, in *VolumeRemoveRequest, opts ...grpc.CallOption) (*VolumeRemoveResponse, error) {
+	out := new(VolumeRemoveResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VolumeRemove", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) VolumeSnapshot(ctx context.Context, in *VolumeSnapshotRequest, opts ...grpc.CallOption) (*VolumeSnapshotResponse, error) {
+	out := new(VolumeSnapshotResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VolumeSnapshot", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) VolumeSnapshotList(ctx context.Context, in *VolumeSnapshotListRequest, opts ...grpc.CallOption) (*VolumeSnapshotListResponse, error) {
+	out := new(VolumeSnapshotListResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VolumeSnapshotList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) VolumeClone(ctx context.Context, in *VolumeCloneRequest, opts ...grpc.CallOption) (*VolumeCloneResponse, error) {
+	out := new(VolumeCloneResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VolumeClone", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) VolumeSnapshotRemove(ctx context.Context, in *VolumeSnapshotRemoveRequest, opts ...grpc.CallOption) (*VolumeSnapshotRemoveResponse, error) {
+	out := new(VolumeSnapshotRemoveResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VolumeSnapshotRemove", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) VolumeExport(ctx context.Context, in *VolumeExportRequest, opts ...grpc.CallOption) (PublicAPI_VolumeExportClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[5], c.cc, "/types.PublicAPI/VolumeExport", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publicAPIVolumeExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PublicAPI_VolumeExportClient interface {
+	Recv() (*VolumeExportResponse, error)
+	grpc.ClientStream
+}
+
+type publicAPIVolumeExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *publicAPIVolumeExportClient) Recv() (*VolumeExportResponse, error) {
+	m := new(VolumeExportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *publicAPIClient) VolumeImport(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_VolumeImportClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[6], c.cc, "/types.PublicAPI/VolumeImport", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publicAPIVolumeImportClient{stream}
+	return x, nil
+}
+
+type PublicAPI_VolumeImportClient interface {
+	Send(*VolumeImportRequest) error
+	CloseAndRecv() (*VolumeImportResponse, error)
+	grpc.ClientStream
+}
+
+type publicAPIVolumeImportClient struct {
+	grpc.ClientStream
+}
+
+func (x *publicAPIVolumeImportClient) Send(m *VolumeImportRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *publicAPIVolumeImportClient) CloseAndRecv() (*VolumeImportResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(VolumeImportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *publicAPIClient) ImagePull(ctx context.Context, in *ImagePullRequest, opts ...grpc.CallOption) (PublicAPI_ImagePullClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[4], c.cc, "/types.PublicAPI/ImagePull", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[7], c.cc, "/types.PublicAPI/ImagePull", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5175,7 +7303,7 @@ func (x *publicAPIImagePullClient) Recv() (*ImagePullResponse, error) {
 }
 
 func (c *publicAPIClient) ImagePush(ctx context.Context, in *ImagePushRequest, opts ...grpc.CallOption) (PublicAPI_ImagePushClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[5], c.cc, "/types.PublicAPI/ImagePush", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[8], c.cc, "/types.PublicAPI/ImagePush", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5206,13 +7334,107 @@ func (x *publicAPIImagePushClient) Recv() (*ImagePushResponse, error) {
 	return m, nil
 }
 
-func (c *publicAPIClient) ImageRemove(ctx context.Context, in *ImageRemoveRequest, opts ...grpc.CallOption) (*ImageRemoveResponse, error) {
-	out := new(ImageRemoveResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/ImageRemove", in, out, c.cc, opts...)
-	if err != nil {
+func (c *publicAPIClient) ImageRemove(ctx context.Context, in *ImageRemoveRequest, opts ...grpc.CallOption) (*ImageRemoveResponse, error) {
+	out := new(ImageRemoveResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ImageRemove", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) ImageBuild(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_ImageBuildClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[9], c.cc, "/types.PublicAPI/ImageBuild", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publicAPIImageBuildClient{stream}
+	return x, nil
+}
+
+type PublicAPI_ImageBuildClient interface {
+	Send(*ImageBuildRequest) error
+	Recv() (*ImageBuildResponse, error)
+	grpc.ClientStream
+}
+
+type publicAPIImageBuildClient struct {
+	grpc.ClientStream
+}
+
+func (x *publicAPIImageBuildClient) Send(m *ImageBuildRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *publicAPIImageBuildClient) Recv() (*ImageBuildResponse, error) {
+	m := new(ImageBuildResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *publicAPIClient) ImageLoad(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_ImageLoadClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[10], c.cc, "/types.PublicAPI/ImageLoad", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publicAPIImageLoadClient{stream}
+	return x, nil
+}
+
+type PublicAPI_ImageLoadClient interface {
+	Send(*ImageLoadRequest) error
+	Recv() (*ImageLoadResponse, error)
+	grpc.ClientStream
+}
+
+type publicAPIImageLoadClient struct {
+	grpc.ClientStream
+}
+
+func (x *publicAPIImageLoadClient) Send(m *ImageLoadRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *publicAPIImageLoadClient) Recv() (*ImageLoadResponse, error) {
+	m := new(ImageLoadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *publicAPIClient) ImageSave(ctx context.Context, in *ImageSaveRequest, opts ...grpc.CallOption) (PublicAPI_ImageSaveClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[11], c.cc, "/types.PublicAPI/ImageSave", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publicAPIImageSaveClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PublicAPI_ImageSaveClient interface {
+	Recv() (*ImageSaveResponse, error)
+	grpc.ClientStream
+}
+
+type publicAPIImageSaveClient struct {
+	grpc.ClientStream
+}
+
+func (x *publicAPIImageSaveClient) Recv() (*ImageSaveResponse, error) {
+	m := new(ImageSaveResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
 func (c *publicAPIClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
@@ -5242,6 +7464,42 @@ func (c *publicAPIClient) Version(ctx context.Context, in *VersionRequest, opts
 	return out, nil
 }
 
+func (c *publicAPIClient) ConfigReload(ctx context.Context, in *ConfigReloadRequest, opts ...grpc.CallOption) (*ConfigReloadResponse, error) {
+	out := new(ConfigReloadResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ConfigReload", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	out := new(AuthResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/Auth", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) SystemGC(ctx context.Context, in *SystemGCRequest, opts ...grpc.CallOption) (*SystemGCResponse, error) {
+	out := new(SystemGCResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/SystemGC", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) SystemDBBackup(ctx context.Context, in *SystemDBBackupRequest, opts ...grpc.CallOption) (*SystemDBBackupResponse, error) {
+	out := new(SystemDBBackupResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/SystemDBBackup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for PublicAPI service
 
 type PublicAPIServer interface {
@@ -5251,6 +7509,8 @@ type PublicAPIServer interface {
 	PodCreate(context.Context, *PodCreateRequest) (*PodCreateResponse, error)
 	// PodInfo gets pod's info by podID
 	PodInfo(context.Context, *PodInfoRequest) (*PodInfoResponse, error)
+	// PodExport gets the reusable spec of a pod
+	PodExport(context.Context, *PodExportRequest) (*PodExportResponse, error)
 	// PodRemove deletes a pod by podID
 	PodRemove(context.Context, *PodRemoveRequest) (*PodRemoveResponse, error)
 	// PodStart starts a pod
@@ -5263,16 +7523,26 @@ type PublicAPIServer interface {
 	PodPause(context.Context, *PodPauseRequest) (*PodPauseResponse, error)
 	// PodUnpause unpauses a pod
 	PodUnpause(context.Context, *PodUnpauseRequest) (*PodUnpauseResponse, error)
+	// PodWatch watches the changes of pods and their containers
+	PodWatch(*PodWatchRequest, PublicAPI_PodWatchServer) error
 	// ExecVM executes a command outside of any containers.
 	ExecVM(PublicAPI_ExecVMServer) error
 	// ContainerList gets a list of containers
 	ContainerList(context.Context, *ContainerListRequest) (*ContainerListResponse, error)
 	// ContainerInfo gets container's info by container's id or name
 	ContainerInfo(context.Context, *ContainerInfoRequest) (*ContainerInfoResponse, error)
+	// ExecInfo gets the info of an exec by its id
+	ExecInfo(context.Context, *ExecInfoRequest) (*ExecInfoResponse, error)
 	// ImageList gets a list of images by filters
 	ImageList(context.Context, *ImageListRequest) (*ImageListResponse, error)
+	// ImageInfo gets image's info by image's id or name
+	ImageInfo(context.Context, *ImageInfoRequest) (*ImageInfoResponse, error)
 	// VMList gets a list of HyperVMs
 	VMList(context.Context, *VMListRequest) (*VMListResponse, error)
+	// VMCreate creates a VM which is not associated with any pods
+	VMCreate(context.Context, *VMCreateRequest) (*VMCreateResponse, error)
+	// VMRemove removes a VM which is not associated with any pods
+	VMRemove(context.Context, *VMRemoveRequest) (*VMRemoveResponse, error)
 	// SetPodLabels sets labels of given pod
 	SetPodLabels(context.Context, *PodLabelsRequest) (*PodLabelsResponse, error)
 	// PodStats gets pod stats of a given pod
@@ -5285,7 +7555,8 @@ type PublicAPIServer interface {
 	ContainerStart(context.Context, *ContainerStartRequest) (*ContainerStartResponse, error)
 	// ContainerRename renames a container
 	ContainerRename(context.Context, *ContainerRenameRequest) (*ContainerRenameResponse, error)
-	// TODO: ContainerCommit commits the changes of the specified container
+	// ContainerCommit commits the changes of the specified container
+	ContainerCommit(context.Context, *ContainerCommitRequest) (*ContainerCommitResponse, error)
 	// ContainerSignal sends a signal to specified container
 	ContainerSignal(context.Context, *ContainerSignalRequest) (*ContainerSignalResponse, error)
 	// TODO: ContainerLabels updates labels of the specified container
@@ -5319,18 +7590,52 @@ type PublicAPIServer interface {
 	PortMappingAdd(context.Context, *PortMappingModifyRequest) (*PortMappingModifyResponse, error)
 	// PortMappingDel remove a list of PortMapping rules from a Pod
 	PortMappingDel(context.Context, *PortMappingModifyRequest) (*PortMappingModifyResponse, error)
+	// VolumeCreate creates a named volume
+	VolumeCreate(context.Context, *VolumeCreateRequest) (*VolumeCreateResponse, error)
+	// VolumeList gets a list of named volumes
+	VolumeList(context.Context, *VolumeListRequest) (*VolumeListResponse, error)
+	// VolumeInspect gets the info of a named volume
+	VolumeInspect(context.Context, *VolumeInspectRequest) (*VolumeInspectResponse, error)
+	// VolumeRemove removes a named volume which is not used by any pods
+	VolumeRemove(context.Context, *VolumeRemoveRequest) (*VolumeRemoveResponse, error)
+	// VolumeSnapshot takes a snapshot of a volume of the pod
+	VolumeSnapshot(context.Context, *VolumeSnapshotRequest) (*VolumeSnapshotResponse, error)
+	// VolumeSnapshotList gets a list of volume snapshots
+	VolumeSnapshotList(context.Context, *VolumeSnapshotListRequest) (*VolumeSnapshotListResponse, error)
+	// VolumeClone creates a volume of the pod from a snapshot
+	VolumeClone(context.Context, *VolumeCloneRequest) (*VolumeCloneResponse, error)
+	// VolumeSnapshotRemove removes a volume snapshot
+	VolumeSnapshotRemove(context.Context, *VolumeSnapshotRemoveRequest) (*VolumeSnapshotRemoveResponse, error)
+	// VolumeExport exports the files in a volume as a tar stream
+	VolumeExport(*VolumeExportRequest, PublicAPI_VolumeExportServer) error
+	// VolumeImport extracts a tar stream into a volume
+	VolumeImport(PublicAPI_VolumeImportServer) error
 	// ImagePull pulls a image from registry
 	ImagePull(*ImagePullRequest, PublicAPI_ImagePullServer) error
 	// ImagePush pushes a local image to registry
 	ImagePush(*ImagePushRequest, PublicAPI_ImagePushServer) error
 	// ImageRemove deletes a image from hyperd
 	ImageRemove(context.Context, *ImageRemoveRequest) (*ImageRemoveResponse, error)
+	// ImageBuild builds a image from the Dockerfile in a build context
+	ImageBuild(PublicAPI_ImageBuildServer) error
+	// ImageLoad loads images from a tarball
+	ImageLoad(PublicAPI_ImageLoadServer) error
+	// ImageSave saves images to a tarball
+	ImageSave(*ImageSaveRequest, PublicAPI_ImageSaveServer) error
 	// Ping checks if hyperd is running (returns 'OK' on success)
 	Ping(context.Context, *PingRequest) (*PingResponse, error)
 	// Info gets the info of hyperd
 	Info(context.Context, *InfoRequest) (*InfoResponse, error)
 	// Version gets the version and apiVersion of hyperd
 	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+	// ConfigReload reloads the config file of hyperd
+	ConfigReload(context.Context, *ConfigReloadRequest) (*ConfigReloadResponse, error)
+	// Auth auths a user to the specified docker registry
+	Auth(context.Context, *AuthRequest) (*AuthResponse, error)
+	// SystemGC removes the storage left by the pods which no longer exist
+	SystemGC(context.Context, *SystemGCRequest) (*SystemGCResponse, error)
+	// SystemDBBackup backs up the daemon db while hyperd is running
+	SystemDBBackup(context.Context, *SystemDBBackupRequest) (*SystemDBBackupResponse, error)
 }
 
 func RegisterPublicAPIServer(s *grpc.Server, srv PublicAPIServer) {
@@ -5391,6 +7696,24 @@ func _PublicAPI_PodInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicAPI_PodExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).PodExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/PodExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).PodExport(ctx, req.(*PodExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PublicAPI_PodRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PodRemoveRequest)
 	if err := dec(in); err != nil {
@@ -5499,6 +7822,27 @@ func _PublicAPI_PodUnpause_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicAPI_PodWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PodWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PublicAPIServer).PodWatch(m, &publicAPIPodWatchServer{stream})
+}
+
+type PublicAPI_PodWatchServer interface {
+	Send(*PodWatchEvent) error
+	grpc.ServerStream
+}
+
+type publicAPIPodWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *publicAPIPodWatchServer) Send(m *PodWatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _PublicAPI_ExecVM_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(PublicAPIServer).ExecVM(&publicAPIExecVMServer{stream})
 }
@@ -5561,6 +7905,24 @@ func _PublicAPI_ContainerInfo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicAPI_ExecInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).ExecInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/ExecInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).ExecInfo(ctx, req.(*ExecInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PublicAPI_ImageList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ImageListRequest)
 	if err := dec(in); err != nil {
@@ -5579,6 +7941,24 @@ func _PublicAPI_ImageList_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicAPI_ImageInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).ImageInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/ImageInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).ImageInfo(ctx, req.(*ImageInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PublicAPI_VMList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VMListRequest)
 	if err := dec(in); err != nil {
@@ -5597,6 +7977,42 @@ func _PublicAPI_VMList_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicAPI_VMCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).VMCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/VMCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).VMCreate(ctx, req.(*VMCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicAPI_VMRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMRemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).VMRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/VMRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).VMRemove(ctx, req.(*VMRemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PublicAPI_SetPodLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PodLabelsRequest)
 	if err := dec(in); err != nil {
@@ -5708,6 +8124,24 @@ func _PublicAPI_ContainerRename_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicAPI_ContainerCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).ContainerCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/ContainerCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).ContainerCommit(ctx, req.(*ContainerCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PublicAPI_ContainerSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ContainerSignalRequest)
 	if err := dec(in); err != nil {
@@ -5842,176 +8276,367 @@ func (x *publicAPIAttachServer) Send(m *AttachMessage) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func (x *publicAPIAttachServer) Recv() (*AttachMessage, error) {
-	m := new(AttachMessage)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (x *publicAPIAttachServer) Recv() (*AttachMessage, error) {
+	m := new(AttachMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _PublicAPI_Wait_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).Wait(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/Wait",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).Wait(ctx, req.(*WaitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicAPI_TTYResize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TTYResizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).TTYResize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/TTYResize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).TTYResize(ctx, req.(*TTYResizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicAPI_ServiceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).ServiceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/ServiceList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).ServiceList(ctx, req.(*ServiceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicAPI_ServiceAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).ServiceAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/ServiceAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).ServiceAdd(ctx, req.(*ServiceAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicAPI_ServiceDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceDelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).ServiceDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/ServiceDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).ServiceDelete(ctx, req.(*ServiceDelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicAPI_ServiceUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).ServiceUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/ServiceUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).ServiceUpdate(ctx, req.(*ServiceUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicAPI_PortMappingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortMappingListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).PortMappingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/PortMappingList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).PortMappingList(ctx, req.(*PortMappingListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicAPI_PortMappingAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortMappingModifyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(PublicAPIServer).PortMappingAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/PortMappingAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).PortMappingAdd(ctx, req.(*PortMappingModifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_Wait_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WaitRequest)
+func _PublicAPI_PortMappingDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortMappingModifyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).Wait(ctx, in)
+		return srv.(PublicAPIServer).PortMappingDel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/Wait",
+		FullMethod: "/types.PublicAPI/PortMappingDel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).Wait(ctx, req.(*WaitRequest))
+		return srv.(PublicAPIServer).PortMappingDel(ctx, req.(*PortMappingModifyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_TTYResize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TTYResizeRequest)
+func _PublicAPI_VolumeCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeCreateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).TTYResize(ctx, in)
+		return srv.(PublicAPIServer).VolumeCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/TTYResize",
+		FullMethod: "/types.PublicAPI/VolumeCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).TTYResize(ctx, req.(*TTYResizeRequest))
+		return srv.(PublicAPIServer).VolumeCreate(ctx, req.(*VolumeCreateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_ServiceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServiceListRequest)
+func _PublicAPI_VolumeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).ServiceList(ctx, in)
+		return srv.(PublicAPIServer).VolumeList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/ServiceList",
+		FullMethod: "/types.PublicAPI/VolumeList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).ServiceList(ctx, req.(*ServiceListRequest))
+		return srv.(PublicAPIServer).VolumeList(ctx, req.(*VolumeListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_ServiceAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServiceAddRequest)
+func _PublicAPI_VolumeInspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeInspectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).ServiceAdd(ctx, in)
+		return srv.(PublicAPIServer).VolumeInspect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/ServiceAdd",
+		FullMethod: "/types.PublicAPI/VolumeInspect",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).ServiceAdd(ctx, req.(*ServiceAddRequest))
+		return srv.(PublicAPIServer).VolumeInspect(ctx, req.(*VolumeInspectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_ServiceDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServiceDelRequest)
+func _PublicAPI_VolumeRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeRemoveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).ServiceDelete(ctx, in)
+		return srv.(PublicAPIServer).VolumeRemove(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/ServiceDelete",
+		FullMethod: "/types.PublicAPI/VolumeRemove",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).ServiceDelete(ctx, req.(*ServiceDelRequest))
+		return srv.(PublicAPIServer).VolumeRemove(ctx, req.(*VolumeRemoveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_ServiceUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServiceUpdateRequest)
+func _PublicAPI_VolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).ServiceUpdate(ctx, in)
+		return srv.(PublicAPIServer).VolumeSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/ServiceUpdate",
+		FullMethod: "/types.PublicAPI/VolumeSnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).ServiceUpdate(ctx, req.(*ServiceUpdateRequest))
+		return srv.(PublicAPIServer).VolumeSnapshot(ctx, req.(*VolumeSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_PortMappingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PortMappingListRequest)
+func _PublicAPI_VolumeSnapshotList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeSnapshotListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).PortMappingList(ctx, in)
+		return srv.(PublicAPIServer).VolumeSnapshotList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/PortMappingList",
+		FullMethod: "/types.PublicAPI/VolumeSnapshotList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).PortMappingList(ctx, req.(*PortMappingListRequest))
+		return srv.(PublicAPIServer).VolumeSnapshotList(ctx, req.(*VolumeSnapshotListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_PortMappingAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PortMappingModifyRequest)
+func _PublicAPI_VolumeClone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeCloneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).PortMappingAdd(ctx, in)
+		return srv.(PublicAPIServer).VolumeClone(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/PortMappingAdd",
+		FullMethod: "/types.PublicAPI/VolumeClone",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).PortMappingAdd(ctx, req.(*PortMappingModifyRequest))
+		return srv.(PublicAPIServer).VolumeClone(ctx, req.(*VolumeCloneRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_PortMappingDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PortMappingModifyRequest)
+func _PublicAPI_VolumeSnapshotRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeSnapshotRemoveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).PortMappingDel(ctx, in)
+		return srv.(PublicAPIServer).VolumeSnapshotRemove(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/PortMappingDel",
+		FullMethod: "/types.PublicAPI/VolumeSnapshotRemove",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).PortMappingDel(ctx, req.(*PortMappingModifyRequest))
+		return srv.(PublicAPIServer).VolumeSnapshotRemove(ctx, req.(*VolumeSnapshotRemoveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicAPI_VolumeExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VolumeExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PublicAPIServer).VolumeExport(m, &publicAPIVolumeExportServer{stream})
+}
+
+type PublicAPI_VolumeExportServer interface {
+	Send(*VolumeExportResponse) error
+	grpc.ServerStream
+}
+
+type publicAPIVolumeExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *publicAPIVolumeExportServer) Send(m *VolumeExportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PublicAPI_VolumeImport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PublicAPIServer).VolumeImport(&publicAPIVolumeImportServer{stream})
+}
+
+type PublicAPI_VolumeImportServer interface {
+	SendAndClose(*VolumeImportResponse) error
+	Recv() (*VolumeImportRequest, error)
+	grpc.ServerStream
+}
+
+type publicAPIVolumeImportServer struct {
+	grpc.ServerStream
+}
+
+func (x *publicAPIVolumeImportServer) SendAndClose(m *VolumeImportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *publicAPIVolumeImportServer) Recv() (*VolumeImportRequest, error) {
+	m := new(VolumeImportRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _PublicAPI_ImagePull_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ImagePullRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -6072,6 +8697,79 @@ func _PublicAPI_ImageRemove_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicAPI_ImageBuild_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PublicAPIServer).ImageBuild(&publicAPIImageBuildServer{stream})
+}
+
+type PublicAPI_ImageBuildServer interface {
+	Send(*ImageBuildResponse) error
+	Recv() (*ImageBuildRequest, error)
+	grpc.ServerStream
+}
+
+type publicAPIImageBuildServer struct {
+	grpc.ServerStream
+}
+
+func (x *publicAPIImageBuildServer) Send(m *ImageBuildResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *publicAPIImageBuildServer) Recv() (*ImageBuildRequest, error) {
+	m := new(ImageBuildRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _PublicAPI_ImageLoad_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PublicAPIServer).ImageLoad(&publicAPIImageLoadServer{stream})
+}
+
+type PublicAPI_ImageLoadServer interface {
+	Send(*ImageLoadResponse) error
+	Recv() (*ImageLoadRequest, error)
+	grpc.ServerStream
+}
+
+type publicAPIImageLoadServer struct {
+	grpc.ServerStream
+}
+
+func (x *publicAPIImageLoadServer) Send(m *ImageLoadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *publicAPIImageLoadServer) Recv() (*ImageLoadRequest, error) {
+	m := new(ImageLoadRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _PublicAPI_ImageSave_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ImageSaveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PublicAPIServer).ImageSave(m, &publicAPIImageSaveServer{stream})
+}
+
+type PublicAPI_ImageSaveServer interface {
+	Send(*ImageSaveResponse) error
+	grpc.ServerStream
+}
+
+type publicAPIImageSaveServer struct {
+	grpc.ServerStream
+}
+
+func (x *publicAPIImageSaveServer) Send(m *ImageSaveResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _PublicAPI_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PingRequest)
 	if err := dec(in); err != nil {
@@ -6126,6 +8824,78 @@ func _PublicAPI_Version_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicAPI_ConfigReload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigReloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).ConfigReload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/ConfigReload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).ConfigReload(ctx, req.(*ConfigReloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicAPI_Auth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).Auth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/Auth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).Auth(ctx, req.(*AuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicAPI_SystemGC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemGCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).SystemGC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/SystemGC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).SystemGC(ctx, req.(*SystemGCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicAPI_SystemDBBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemDBBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).SystemDBBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/SystemDBBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).SystemDBBackup(ctx, req.(*SystemDBBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.PublicAPI",
 	HandlerType: (*PublicAPIServer)(nil),
@@ -6142,6 +8912,10 @@ var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PodInfo",
 			Handler:    _PublicAPI_PodInfo_Handler,
 		},
+		{
+			MethodName: "PodExport",
+			Handler:    _PublicAPI_PodExport_Handler,
+		},
 		{
 			MethodName: "PodRemove",
 			Handler:    _PublicAPI_PodRemove_Handler,
@@ -6174,14 +8948,30 @@ var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ContainerInfo",
 			Handler:    _PublicAPI_ContainerInfo_Handler,
 		},
+		{
+			MethodName: "ExecInfo",
+			Handler:    _PublicAPI_ExecInfo_Handler,
+		},
 		{
 			MethodName: "ImageList",
 			Handler:    _PublicAPI_ImageList_Handler,
 		},
+		{
+			MethodName: "ImageInfo",
+			Handler:    _PublicAPI_ImageInfo_Handler,
+		},
 		{
 			MethodName: "VMList",
 			Handler:    _PublicAPI_VMList_Handler,
 		},
+		{
+			MethodName: "VMCreate",
+			Handler:    _PublicAPI_VMCreate_Handler,
+		},
+		{
+			MethodName: "VMRemove",
+			Handler:    _PublicAPI_VMRemove_Handler,
+		},
 		{
 			MethodName: "SetPodLabels",
 			Handler:    _PublicAPI_SetPodLabels_Handler,
@@ -6202,6 +8992,10 @@ var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ContainerRename",
 			Handler:    _PublicAPI_ContainerRename_Handler,
 		},
+		{
+			MethodName: "ContainerCommit",
+			Handler:    _PublicAPI_ContainerCommit_Handler,
+		},
 		{
 			MethodName: "ContainerSignal",
 			Handler:    _PublicAPI_ContainerSignal_Handler,
@@ -6258,6 +9052,38 @@ var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PortMappingDel",
 			Handler:    _PublicAPI_PortMappingDel_Handler,
 		},
+		{
+			MethodName: "VolumeCreate",
+			Handler:    _PublicAPI_VolumeCreate_Handler,
+		},
+		{
+			MethodName: "VolumeList",
+			Handler:    _PublicAPI_VolumeList_Handler,
+		},
+		{
+			MethodName: "VolumeInspect",
+			Handler:    _PublicAPI_VolumeInspect_Handler,
+		},
+		{
+			MethodName: "VolumeRemove",
+			Handler:    _PublicAPI_VolumeRemove_Handler,
+		},
+		{
+			MethodName: "VolumeSnapshot",
+			Handler:    _PublicAPI_VolumeSnapshot_Handler,
+		},
+		{
+			MethodName: "VolumeSnapshotList",
+			Handler:    _PublicAPI_VolumeSnapshotList_Handler,
+		},
+		{
+			MethodName: "VolumeClone",
+			Handler:    _PublicAPI_VolumeClone_Handler,
+		},
+		{
+			MethodName: "VolumeSnapshotRemove",
+			Handler:    _PublicAPI_VolumeSnapshotRemove_Handler,
+		},
 		{
 			MethodName: "ImageRemove",
 			Handler:    _PublicAPI_ImageRemove_Handler,
@@ -6274,8 +9100,29 @@ var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Version",
 			Handler:    _PublicAPI_Version_Handler,
 		},
+		{
+			MethodName: "ConfigReload",
+			Handler:    _PublicAPI_ConfigReload_Handler,
+		},
+		{
+			MethodName: "Auth",
+			Handler:    _PublicAPI_Auth_Handler,
+		},
+		{
+			MethodName: "SystemGC",
+			Handler:    _PublicAPI_SystemGC_Handler,
+		},
+		{
+			MethodName: "SystemDBBackup",
+			Handler:    _PublicAPI_SystemDBBackup_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PodWatch",
+			Handler:       _PublicAPI_PodWatch_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ExecVM",
 			Handler:       _PublicAPI_ExecVM_Handler,
@@ -6299,6 +9146,16 @@ var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "VolumeExport",
+			Handler:       _PublicAPI_VolumeExport_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "VolumeImport",
+			Handler:       _PublicAPI_VolumeImport_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "ImagePull",
 			Handler:       _PublicAPI_ImagePull_Handler,
@@ -6309,6 +9166,23 @@ var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 			Handler:       _PublicAPI_ImagePush_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ImageBuild",
+			Handler:       _PublicAPI_ImageBuild_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ImageLoad",
+			Handler:       _PublicAPI_ImageLoad_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ImageSave",
+			Handler:       _PublicAPI_ImageSave_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "types.proto",
 }
@@ -6316,341 +9190,445 @@ var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("types.proto", fileDescriptorTypes) }
 
 var fileDescriptorTypes = []byte{
-	// 5368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3c, 0x4d, 0x6f, 0x1c, 0x47,
-	0x76, 0x9a, 0x2f, 0x0e, 0xe7, 0xf1, 0x53, 0x25, 0x8a, 0x1a, 0xd1, 0x5a, 0x5b, 0xdb, 0x1b, 0x5b,
-	0xb2, 0x1c, 0xd3, 0xb6, 0xd6, 0xb1, 0xbd, 0x72, 0x8c, 0x35, 0x4d, 0xca, 0x6b, 0x22, 0xa2, 0x4d,
-	0x37, 0x25, 0x19, 0x46, 0x16, 0xd8, 0xb4, 0xa6, 0x8b, 0xc3, 0x36, 0x7b, 0xba, 0x27, 0xdd, 0x3d,
-	0x94, 0xe8, 0x5b, 0x72, 0x5a, 0xc0, 0x87, 0x1c, 0x16, 0x08, 0x92, 0x00, 0xb9, 0x24, 0x97, 0x20,
-	0x97, 0x1c, 0x72, 0xca, 0x22, 0x97, 0x5c, 0x72, 0xca, 0x1f, 0x49, 0xf6, 0x92, 0x5b, 0x6e, 0x41,
-	0xf0, 0x5e, 0xbd, 0xaa, 0xae, 0xea, 0x69, 0x0e, 0x29, 0x5b, 0x39, 0x10, 0xea, 0xf7, 0xea, 0x55,
-	0xd5, 0xab, 0x57, 0xaf, 0xde, 0x57, 0xd5, 0x08, 0x16, 0x8a, 0xd3, 0xb1, 0xcc, 0x37, 0xc7, 0x59,
-	0x5a, 0xa4, 0xa2, 0x43, 0x80, 0xf7, 0xd7, 0x0d, 0x58, 0xda, 0x4e, 0x93, 0x22, 0x88, 0x12, 0x99,
-	0xed, 0xa7, 0x59, 0x21, 0x04, 0xb4, 0x93, 0x60, 0x24, 0xfb, 0x8d, 0x9b, 0x8d, 0xdb, 0x3d, 0x9f,
-	0xbe, 0xc5, 0x06, 0xcc, 0x1f, 0xa5, 0x79, 0x81, 0xed, 0xfd, 0xe6, 0xcd, 0xc6, 0xed, 0x8e, 0x6f,
-	0x60, 0xf1, 0x7b, 0xb0, 0x34, 0xb0, 0x07, 0xe8, 0xb7, 0x88, 0xc0, 0x45, 0xe2, 0x08, 0x34, 0xef,
-	0x20, 0x8d, 0xfb, 0x6d, 0x1a, 0xd9, 0xc0, 0x62, 0x1d, 0xe6, 0x70, 0xb4, 0xdd, 0xfd, 0x7e, 0x87,
-	0x5a, 0x18, 0xf2, 0x3e, 0x80, 0xe5, 0xfb, 0xc9, 0x49, 0x94, 0xa5, 0xc9, 0x48, 0x26, 0xc5, 0xe3,
-	0x20, 0x13, 0xab, 0xd0, 0x92, 0xc9, 0x09, 0xb3, 0x86, 0x9f, 0x62, 0x0d, 0x3a, 0x27, 0x41, 0x3c,
-	0x91, 0xc4, 0x56, 0xcf, 0x57, 0x80, 0xf7, 0xc7, 0xb0, 0xf0, 0x38, 0x8d, 0x27, 0x23, 0xb9, 0x97,
-	0x4e, 0x92, 0xfa, 0x25, 0xdd, 0x80, 0xde, 0x08, 0x1b, 0xf7, 0x83, 0xe2, 0x88, 0x3b, 0x97, 0x08,
-	0x64, 0x37, 0x93, 0x41, 0xf8, 0x45, 0x12, 0x9f, 0xd2, 0x7a, 0xe6, 0x7d, 0x03, 0x7b, 0xb7, 0x60,
-	0xe9, 0xab, 0x20, 0x2a, 0xa2, 0x64, 0x78, 0x50, 0x04, 0xc5, 0x24, 0x47, 0xfe, 0x33, 0x19, 0xe4,
-	0x69, 0xc2, 0x13, 0x30, 0xe4, 0xbd, 0x09, 0x4b, 0xfe, 0x24, 0x49, 0x4a, 0xc2, 0x1b, 0xd0, 0xcb,
-	0x8b, 0x20, 0x2b, 0x64, 0xb8, 0x55, 0x30, 0x6d, 0x89, 0xf0, 0xfe, 0xaa, 0x01, 0xf0, 0x50, 0x66,
-	0x23, 0x26, 0xde, 0x80, 0x79, 0xf9, 0x2c, 0x2a, 0xb6, 0xd3, 0x50, 0x31, 0xde, 0xf1, 0x0d, 0x6c,
-	0xcd, 0xd8, 0xb4, 0x67, 0x14, 0x7d, 0xe8, 0x8e, 0x64, 0x9e, 0x07, 0x43, 0x49, 0x5c, 0xf7, 0x7c,
-	0x0d, 0xba, 0x53, 0xb7, 0x2b, 0x53, 0x8b, 0x97, 0x01, 0x0e, 0xa3, 0x24, 0xca, 0x8f, 0xa8, 0x59,
-	0xed, 0x82, 0x85, 0xf1, 0xfe, 0xbb, 0x01, 0x2b, 0x46, 0x4b, 0x98, 0xbf, 0x3a, 0xa1, 0xde, 0x84,
-	0x05, 0xb3, 0xed, 0xbb, 0x3b, 0xcc, 0x9c, 0x8d, 0xc2, 0xfd, 0x1a, 0x1f, 0x05, 0xb9, 0xe6, 0x4f,
-	0x01, 0x62, 0x13, 0xba, 0x4f, 0x95, 0x48, 0x89, 0xb7, 0x85, 0xbb, 0x6b, 0x9b, 0x4a, 0x57, 0x1d,
-	0x41, 0xfb, 0x9a, 0x08, 0xe9, 0x33, 0x25, 0x59, 0x62, 0xb6, 0xa4, 0x77, 0xe4, 0xed, 0x6b, 0x22,
-	0xf1, 0x0e, 0x40, 0x21, 0xb3, 0x51, 0x94, 0x04, 0x85, 0x0c, 0xfb, 0x73, 0xd4, 0xe5, 0x32, 0x77,
-	0x29, 0x45, 0xee, 0x5b, 0x44, 0xde, 0xdf, 0xdb, 0x07, 0x63, 0x37, 0x39, 0x4c, 0xc5, 0x26, 0xf4,
-	0xcc, 0x4a, 0x68, 0xd5, 0x0b, 0x77, 0x57, 0x79, 0x0c, 0x43, 0xe8, 0x97, 0x24, 0x28, 0xf2, 0x41,
-	0x26, 0x03, 0x25, 0x72, 0x14, 0x45, 0xcb, 0x2f, 0x11, 0x24, 0x88, 0x34, 0xdc, 0xdd, 0x31, 0x82,
-	0x40, 0x40, 0x6c, 0xc2, 0x5c, 0x4e, 0xbc, 0xb0, 0x1c, 0xd6, 0xab, 0x13, 0x30, 0xa7, 0x4c, 0xe5,
-	0xfd, 0x45, 0x1b, 0x7a, 0xa6, 0xed, 0xfb, 0x6f, 0x49, 0x34, 0x2a, 0x55, 0x46, 0x01, 0xa8, 0x4a,
-	0xf4, 0xb1, 0xbb, 0xc3, 0xea, 0xa2, 0x41, 0x71, 0x1b, 0x56, 0xe8, 0x73, 0x7f, 0x12, 0xc7, 0xfb,
-	0x69, 0x1c, 0x0d, 0x4e, 0x59, 0x63, 0xaa, 0x68, 0x54, 0xab, 0xa7, 0x69, 0x76, 0x1c, 0x25, 0xc3,
-	0x9d, 0x28, 0x23, 0xb1, 0xf7, 0x7c, 0x0b, 0x83, 0xfc, 0x4e, 0x72, 0x99, 0xf5, 0xbb, 0x8a, 0x5f,
-	0xfc, 0xc6, 0x23, 0x5e, 0x14, 0xa7, 0xfd, 0x79, 0x3a, 0x74, 0xf8, 0x89, 0x07, 0x61, 0x90, 0x8e,
-	0x46, 0x41, 0x12, 0xe6, 0xfd, 0xde, 0xcd, 0x16, 0x9a, 0x0e, 0x0d, 0xe3, 0x08, 0x41, 0x36, 0xcc,
-	0xfb, 0x40, 0x78, 0xfa, 0x16, 0x77, 0x50, 0xb2, 0x59, 0x91, 0xf7, 0x17, 0x6e, 0xb6, 0x2c, 0xd5,
-	0x70, 0xac, 0x9c, 0xaf, 0x48, 0xc4, 0x2d, 0x65, 0x50, 0x16, 0x89, 0xf2, 0x2a, 0x53, 0xba, 0x46,
-	0x47, 0xd9, 0x99, 0xf7, 0x60, 0xf1, 0xa4, 0xb4, 0x28, 0x79, 0x7f, 0x89, 0x7a, 0x08, 0xee, 0x61,
-	0x19, 0x1b, 0xdf, 0xa1, 0x13, 0xef, 0xc2, 0x5c, 0x1c, 0x3c, 0x91, 0x71, 0xde, 0x5f, 0xa6, 0x1e,
-	0x37, 0xaa, 0xdc, 0x6c, 0x3e, 0xa0, 0xe6, 0xfb, 0x49, 0x91, 0x9d, 0xfa, 0x4c, 0xbb, 0xf1, 0x33,
-	0x58, 0xb0, 0xd0, 0x28, 0x93, 0x63, 0x79, 0xaa, 0xcd, 0xde, 0xb1, 0x3c, 0xad, 0x37, 0x7b, 0xf7,
-	0x9a, 0x1f, 0x34, 0xbc, 0x7f, 0x69, 0xc0, 0x8a, 0xff, 0xc9, 0x8e, 0xe2, 0xe8, 0x20, 0x9d, 0x64,
-	0x03, 0x32, 0xdf, 0xa3, 0x34, 0x89, 0x8a, 0x34, 0xcb, 0xfb, 0x0d, 0x25, 0x41, 0x0d, 0x97, 0xbb,
-	0xdf, 0xb4, 0x77, 0x7f, 0x1d, 0xe6, 0x0e, 0xf3, 0x87, 0xa7, 0x63, 0xad, 0x14, 0x0c, 0xa1, 0xbc,
-	0xc7, 0xa9, 0x31, 0xe1, 0xf4, 0x6d, 0x76, 0xb1, 0x63, 0xed, 0x62, 0x1f, 0xba, 0xc7, 0xf2, 0x34,
-	0xc3, 0x03, 0xaa, 0xb6, 0x5d, 0x83, 0x8e, 0x65, 0xed, 0x56, 0x2c, 0xeb, 0x29, 0xf4, 0xf6, 0xd3,
-	0x50, 0xb1, 0x5e, 0xab, 0xcc, 0xeb, 0x30, 0x97, 0xd3, 0x92, 0xb4, 0xdd, 0x53, 0x10, 0xe2, 0xc3,
-	0x2c, 0x3a, 0x91, 0x99, 0x66, 0x57, 0x41, 0xe2, 0x36, 0xb4, 0xb2, 0x27, 0x61, 0xe5, 0x2c, 0x55,
-	0xa4, 0xe3, 0x23, 0x89, 0xf7, 0xe7, 0x4d, 0xe8, 0xee, 0xa7, 0xe1, 0xc1, 0x58, 0x0e, 0xc4, 0x1d,
-	0xe8, 0xaa, 0x3d, 0x54, 0xd2, 0x2a, 0x8f, 0xb9, 0x61, 0xce, 0xd7, 0x04, 0xe2, 0x6d, 0x00, 0x73,
-	0x96, 0xf2, 0x7e, 0xd3, 0x21, 0x2f, 0xad, 0x82, 0x45, 0x23, 0xee, 0x1a, 0x8d, 0x68, 0x11, 0xf5,
-	0x46, 0x39, 0x38, 0xce, 0x5e, 0xa7, 0x0f, 0x28, 0x8b, 0x93, 0xc1, 0x78, 0x42, 0x0b, 0xe9, 0xf8,
-	0xf4, 0x8d, 0x6b, 0x1e, 0xc9, 0x51, 0x9a, 0xa9, 0xd3, 0xd7, 0xf1, 0x19, 0xfa, 0x21, 0xba, 0xf3,
-	0x67, 0x4d, 0xda, 0x00, 0x36, 0xf0, 0xc6, 0x54, 0x37, 0x6c, 0x53, 0x6d, 0xb9, 0x98, 0xa6, 0xeb,
-	0x62, 0x4a, 0xa7, 0xd4, 0x72, 0x9c, 0x52, 0xe9, 0xde, 0xdb, 0xb6, 0x7b, 0xd7, 0x16, 0x10, 0xbd,
-	0x7e, 0x4b, 0x5b, 0xc0, 0x7d, 0xe3, 0xa8, 0x1e, 0x46, 0x23, 0xc9, 0xba, 0x53, 0x22, 0xc4, 0xc7,
-	0xb0, 0x32, 0x70, 0x4d, 0x61, 0xbf, 0x4b, 0x52, 0x3c, 0xcb, 0x50, 0x56, 0xc9, 0x4b, 0x57, 0x47,
-	0x13, 0xcc, 0xdb, 0xae, 0x0e, 0x31, 0xde, 0x7f, 0x36, 0x48, 0x11, 0xc8, 0xe2, 0x1b, 0x1b, 0xdd,
-	0xb0, 0x6d, 0xb4, 0x80, 0xf6, 0x71, 0x94, 0x84, 0xbc, 0x7c, 0xfa, 0xc6, 0x51, 0x83, 0x71, 0xf4,
-	0x58, 0x66, 0x79, 0x64, 0xd6, 0x6f, 0x61, 0xc4, 0x32, 0x34, 0x4f, 0x46, 0xbc, 0xfe, 0xe6, 0xc9,
-	0xc8, 0xf5, 0x0d, 0x9d, 0xaa, 0x6f, 0xf0, 0xa0, 0x9d, 0x8f, 0xe5, 0x80, 0x1d, 0xd5, 0xb2, 0xab,
-	0x20, 0x3e, 0xb5, 0x89, 0xdb, 0xc6, 0x53, 0x74, 0x1d, 0x57, 0x64, 0xf6, 0x4f, 0xfb, 0x08, 0xdc,
-	0xb1, 0x71, 0x1a, 0x7e, 0x1e, 0x98, 0xe5, 0x6a, 0xd0, 0xfb, 0xbb, 0x26, 0xf4, 0x76, 0xc9, 0xaa,
-	0xe3, 0x6a, 0x97, 0xa1, 0x19, 0x85, 0xbc, 0xd4, 0x66, 0x14, 0x52, 0xc8, 0x16, 0x64, 0x32, 0x29,
-	0x8c, 0xdb, 0x30, 0xb0, 0x3a, 0xc5, 0xe3, 0xf4, 0x61, 0x30, 0x54, 0x6a, 0xdc, 0xf3, 0x0d, 0x8c,
-	0x1e, 0x07, 0xbf, 0x77, 0xa2, 0xa1, 0xcc, 0x0b, 0x74, 0x64, 0xd8, 0x6c, 0xa3, 0x90, 0x23, 0x5e,
-	0x2c, 0xaf, 0x5d, 0x83, 0xd8, 0xf7, 0x24, 0xca, 0x8a, 0x49, 0x10, 0x1f, 0x44, 0xdf, 0xaa, 0xfd,
-	0x6f, 0xf9, 0x36, 0xca, 0x32, 0xa8, 0x5d, 0xc7, 0xa0, 0x9a, 0x75, 0xbc, 0x68, 0x83, 0xfa, 0x6f,
-	0x4d, 0x98, 0x67, 0xa1, 0xe6, 0xe2, 0xc7, 0xd0, 0xc2, 0x73, 0xa8, 0xbc, 0xff, 0x8a, 0xd6, 0xb9,
-	0xf1, 0x84, 0x5a, 0x7d, 0x6c, 0x13, 0xb7, 0xa0, 0xf3, 0x24, 0x4e, 0x07, 0xc7, 0x34, 0x52, 0x19,
-	0x66, 0x7c, 0x12, 0x1f, 0x47, 0xa9, 0x22, 0x53, 0xed, 0xe2, 0x8e, 0x39, 0xc0, 0x2d, 0xa2, 0xd4,
-	0xce, 0x64, 0x8f, 0x90, 0x8a, 0x94, 0x29, 0xc4, 0x9b, 0xd0, 0x4d, 0x64, 0x81, 0xae, 0x93, 0x8d,
-	0xd9, 0x15, 0x26, 0xfe, 0x5c, 0x61, 0x15, 0xb5, 0xa6, 0x11, 0x9b, 0xa8, 0xe4, 0xb1, 0xcc, 0x4f,
-	0xf3, 0x42, 0x8e, 0xe8, 0x7c, 0x95, 0x6a, 0xf4, 0x69, 0xae, 0x88, 0x2d, 0x0a, 0x54, 0xc7, 0x22,
-	0x1a, 0xc9, 0xbc, 0x08, 0x46, 0x63, 0x16, 0x7a, 0x89, 0x70, 0x0e, 0x9d, 0xea, 0x7c, 0xd6, 0xa1,
-	0xe3, 0xa1, 0xab, 0xe4, 0xde, 0x01, 0xcc, 0x6b, 0x21, 0x89, 0x57, 0xa1, 0x33, 0x21, 0xf3, 0x31,
-	0x25, 0xc4, 0x47, 0x88, 0xf6, 0x55, 0x2b, 0x6a, 0xc2, 0x83, 0x34, 0x08, 0xb7, 0x4e, 0x64, 0xa6,
-	0x6d, 0x4d, 0xc7, 0xb7, 0x51, 0x5e, 0x48, 0x83, 0x52, 0x27, 0xdc, 0xbe, 0x22, 0x2d, 0x82, 0x98,
-	0x06, 0x6d, 0xfb, 0x0a, 0x40, 0xcb, 0x33, 0x96, 0xd9, 0xf6, 0x78, 0x42, 0x86, 0xb9, 0xed, 0x33,
-	0x64, 0x3c, 0x56, 0x8b, 0x88, 0x95, 0xc7, 0x42, 0xd7, 0xa2, 0xc4, 0xd5, 0x26, 0x2c, 0x43, 0xde,
-	0x7f, 0xb4, 0x01, 0xca, 0xbd, 0x13, 0x5f, 0xc0, 0xb5, 0x28, 0x3d, 0x90, 0xd9, 0x49, 0x34, 0x90,
-	0x9f, 0x9c, 0x16, 0x32, 0xf7, 0xe5, 0x60, 0x92, 0xe5, 0xd1, 0x89, 0x64, 0x5f, 0x71, 0xb5, 0xba,
-	0xdf, 0x4a, 0x11, 0xcf, 0xea, 0x25, 0x7e, 0x01, 0x57, 0x4c, 0x53, 0x58, 0x0e, 0xd6, 0x9c, 0x35,
-	0x58, 0x5d, 0x0f, 0xb1, 0x0d, 0x97, 0xa3, 0xf4, 0xcb, 0x89, 0x9c, 0xd8, 0xc3, 0xb4, 0x66, 0x0d,
-	0x33, 0x4d, 0x2f, 0xf6, 0x60, 0xdd, 0x8c, 0x8d, 0xe6, 0xb0, 0x1c, 0xa9, 0x3d, 0x6b, 0xa4, 0x33,
-	0x3a, 0xa9, 0xc5, 0x61, 0x0c, 0xef, 0x8e, 0xd5, 0x39, 0x67, 0x71, 0x53, 0x3d, 0xd4, 0xe2, 0xf6,
-	0x64, 0x36, 0xb4, 0x17, 0x37, 0x77, 0xce, 0xe2, 0x2a, 0xf4, 0xe2, 0xe7, 0xb0, 0x12, 0xa5, 0x2e,
-	0x27, 0xdd, 0x59, 0x43, 0x54, 0xa9, 0xc5, 0x16, 0xac, 0xe6, 0x72, 0x80, 0x61, 0x53, 0x39, 0xc2,
-	0xfc, 0xac, 0x11, 0xa6, 0xc8, 0xbd, 0xff, 0x6a, 0xc0, 0xb2, 0x4b, 0x54, 0x1b, 0xe8, 0x08, 0x68,
-	0xe3, 0x80, 0xda, 0xc7, 0xe0, 0xb7, 0x15, 0xfc, 0xb4, 0x9c, 0xe0, 0x67, 0x0d, 0x3a, 0xa3, 0xe0,
-	0x9b, 0x34, 0x63, 0xc5, 0x55, 0x00, 0x61, 0xa3, 0x24, 0x55, 0x61, 0x19, 0x62, 0x11, 0x10, 0x3f,
-	0x85, 0x36, 0x7a, 0x05, 0x16, 0xdd, 0x2b, 0xb5, 0x5c, 0x6f, 0x96, 0xfc, 0x13, 0xf1, 0xc6, 0xfb,
-	0xd0, 0x2b, 0xb9, 0x3d, 0xc7, 0x74, 0xb6, 0x6d, 0xd3, 0xf9, 0xbb, 0x06, 0x2c, 0x58, 0xd6, 0x0c,
-	0x29, 0xcb, 0xa3, 0xdf, 0xd6, 0x27, 0xbd, 0xcc, 0x12, 0x0e, 0x64, 0xc1, 0x83, 0x58, 0x18, 0xf4,
-	0x16, 0x87, 0x41, 0x14, 0x0f, 0x92, 0x82, 0x0f, 0xac, 0x06, 0xc5, 0x27, 0x56, 0xe9, 0x61, 0x27,
-	0x28, 0x02, 0xb6, 0x8d, 0x37, 0xa6, 0x0d, 0xa9, 0xfa, 0x44, 0x1a, 0xdf, 0xed, 0x22, 0x3e, 0x83,
-	0xd5, 0xa3, 0x48, 0x66, 0x41, 0x36, 0x38, 0x8a, 0x06, 0x41, 0x4c, 0xc3, 0x74, 0x2e, 0x30, 0xcc,
-	0x54, 0x2f, 0xef, 0x4b, 0xb8, 0x5a, 0x4b, 0x4a, 0x0e, 0x78, 0x78, 0x18, 0x4c, 0xe2, 0x82, 0x17,
-	0xae, 0x41, 0x5c, 0xfa, 0x78, 0x38, 0x0a, 0xbe, 0x51, 0x8d, 0xbc, 0xf4, 0x12, 0xe3, 0x7d, 0xd7,
-	0x80, 0x45, 0xdb, 0xc2, 0x8b, 0x3f, 0x00, 0x88, 0x92, 0x42, 0x66, 0x87, 0xc1, 0xc0, 0x44, 0xa7,
-	0x5a, 0xf7, 0x76, 0x75, 0x03, 0xdb, 0xf7, 0x92, 0x50, 0xdc, 0x84, 0x56, 0x31, 0x18, 0xb3, 0x47,
-	0xd2, 0x8e, 0xe0, 0xe1, 0x60, 0x8c, 0x94, 0x3e, 0x36, 0x61, 0xc8, 0x51, 0x0c, 0xc6, 0xef, 0xb1,
-	0x2b, 0xaa, 0x92, 0x50, 0x9b, 0xf7, 0xcf, 0x4d, 0xe8, 0x32, 0x06, 0xcd, 0x33, 0x7a, 0x87, 0x27,
-	0x31, 0x95, 0x08, 0x78, 0x5d, 0x36, 0x0a, 0x57, 0x9d, 0x9f, 0x26, 0x07, 0x32, 0xd1, 0x0b, 0xd3,
-	0x20, 0xb7, 0xf8, 0x72, 0x70, 0xa2, 0x37, 0x94, 0x41, 0x0c, 0x2b, 0x0e, 0xa3, 0x04, 0x8f, 0xff,
-	0x3b, 0xac, 0xcd, 0x06, 0xb6, 0xda, 0xee, 0xb2, 0x4e, 0x1b, 0x18, 0xdb, 0xd0, 0x5d, 0x21, 0x40,
-	0xee, 0xab, 0xed, 0x1b, 0x18, 0x95, 0x6e, 0x10, 0xa7, 0xb9, 0xa4, 0x38, 0xa9, 0xed, 0x2b, 0x80,
-	0x02, 0x30, 0xfc, 0xa0, 0x2e, 0xf3, 0xd4, 0x52, 0x22, 0x90, 0xc3, 0x38, 0xc8, 0x8b, 0xad, 0xc1,
-	0x71, 0xbf, 0xa7, 0x38, 0x64, 0x10, 0x0f, 0x61, 0x1c, 0xe5, 0x85, 0x4c, 0xfa, 0xa0, 0xdc, 0x84,
-	0x82, 0x28, 0xa4, 0x89, 0xd3, 0x1c, 0x13, 0x9e, 0x05, 0xd5, 0x83, 0x41, 0xef, 0xd7, 0x4d, 0x58,
-	0x76, 0xb7, 0xa6, 0xf6, 0xc4, 0xf7, 0xa1, 0x9b, 0x3d, 0x23, 0xdf, 0xa0, 0xc5, 0xc5, 0x20, 0xb2,
-	0x9a, 0x3d, 0xdb, 0x0f, 0x06, 0xc7, 0xb2, 0xc8, 0x59, 0x60, 0x25, 0x82, 0x22, 0xb1, 0x67, 0xf7,
-	0xb3, 0x0c, 0x73, 0x3b, 0x16, 0x99, 0x86, 0x55, 0xcf, 0x9d, 0x2c, 0x1d, 0x8f, 0x39, 0xd2, 0xa2,
-	0x9e, 0x8c, 0xc0, 0x19, 0x0b, 0x9e, 0x51, 0xc9, 0x4c, 0x83, 0x14, 0x0e, 0x98, 0x19, 0x95, 0xd8,
-	0x4a, 0x04, 0x09, 0x5b, 0xcf, 0x38, 0xcf, 0xc2, 0xb6, 0x66, 0x2c, 0xcc, 0x8c, 0x3d, 0xdd, 0x93,
-	0x11, 0xde, 0xef, 0x5a, 0xd0, 0xe5, 0xf0, 0x83, 0x52, 0x36, 0x89, 0x1e, 0x43, 0x17, 0xcd, 0x14,
-	0x84, 0xdb, 0x15, 0x47, 0xa3, 0x48, 0x2b, 0x8d, 0x02, 0x4a, 0xcb, 0xd1, 0xb2, 0x2d, 0xc7, 0x0d,
-	0xe8, 0x05, 0x27, 0x41, 0x14, 0x07, 0x4f, 0x62, 0xc9, 0x8b, 0x2f, 0x11, 0xe2, 0x35, 0x58, 0xc6,
-	0xcc, 0x32, 0xdf, 0x4e, 0x47, 0xe3, 0x58, 0x16, 0x46, 0x04, 0x15, 0xac, 0x8a, 0x57, 0x83, 0x30,
-	0x57, 0xee, 0x82, 0x65, 0x61, 0xa3, 0x90, 0xc2, 0x18, 0xf2, 0x20, 0x64, 0x89, 0xd8, 0x28, 0x9d,
-	0xd5, 0x9a, 0x9c, 0xa2, 0xed, 0x1b, 0x58, 0xdc, 0x86, 0x95, 0xa7, 0x59, 0x54, 0x48, 0x8b, 0x11,
-	0x25, 0x99, 0x2a, 0x5a, 0x78, 0xb0, 0xa8, 0x50, 0xcc, 0x8a, 0x52, 0x31, 0x07, 0x87, 0xab, 0xe2,
-	0x89, 0xbf, 0xca, 0xa2, 0x02, 0x15, 0x51, 0xe9, 0x5b, 0x05, 0x8b, 0xb2, 0xa1, 0x7e, 0xc4, 0xd2,
-	0xa2, 0x92, 0x8d, 0x41, 0xe0, 0x4c, 0x51, 0xba, 0x9b, 0xec, 0x67, 0xe9, 0x30, 0x93, 0x79, 0xde,
-	0x5f, 0x52, 0x33, 0xd9, 0x38, 0xdc, 0x21, 0xe5, 0x00, 0xfb, 0xcb, 0x4a, 0xd5, 0x15, 0x84, 0x1c,
-	0x3c, 0x95, 0xd1, 0xf0, 0xa8, 0x90, 0xe1, 0xae, 0x6a, 0x5f, 0x51, 0x1c, 0xb8, 0x58, 0xef, 0x1f,
-	0x9a, 0x56, 0xd1, 0x90, 0x77, 0xbd, 0x52, 0x8d, 0x6a, 0x4c, 0x57, 0xa3, 0x38, 0xc2, 0x6e, 0x5e,
-	0x24, 0xc2, 0x6e, 0x5d, 0x38, 0xc2, 0x6e, 0x3f, 0x4f, 0x84, 0xdd, 0x79, 0xee, 0x08, 0x7b, 0xee,
-	0xf9, 0x22, 0xec, 0x6e, 0x25, 0xc2, 0xf6, 0x5e, 0x83, 0x65, 0xce, 0x39, 0x7d, 0xf9, 0xa7, 0x13,
-	0x99, 0x17, 0xf5, 0xa9, 0xa7, 0xf7, 0x21, 0xac, 0x18, 0xba, 0x7c, 0x9c, 0x26, 0x39, 0x6a, 0x17,
-	0xa6, 0x73, 0x88, 0xe2, 0x80, 0xda, 0x4a, 0x17, 0x89, 0x50, 0x37, 0x7b, 0xf7, 0x68, 0x92, 0x07,
-	0x51, 0x5e, 0xcc, 0x9c, 0x84, 0x8a, 0x0d, 0x23, 0x93, 0xf3, 0xd1, 0xb7, 0xf7, 0xbf, 0x0d, 0x58,
-	0x32, 0x9d, 0x73, 0x74, 0x5d, 0xf5, 0x7d, 0xad, 0x5c, 0xb3, 0xe9, 0xe4, 0x9a, 0x66, 0xd4, 0x56,
-	0x39, 0x2a, 0x45, 0x34, 0x65, 0xb5, 0xb3, 0x67, 0x32, 0xd6, 0xd9, 0xd9, 0xf1, 0x07, 0x26, 0x03,
-	0x54, 0x62, 0xbf, 0x59, 0x2e, 0xb8, 0xe4, 0xef, 0x45, 0x67, 0x81, 0x5b, 0x24, 0x79, 0x1e, 0x5f,
-	0x49, 0x7e, 0x93, 0xd6, 0x8a, 0x28, 0x76, 0xc4, 0x6b, 0x75, 0x8c, 0xf8, 0x9a, 0xc8, 0xfb, 0x18,
-	0xd6, 0xcc, 0x71, 0xf8, 0x7e, 0xbb, 0xf0, 0x5d, 0x03, 0xae, 0x54, 0x86, 0xa0, 0xbd, 0x38, 0xff,
-	0x54, 0xd9, 0x97, 0x34, 0xd6, 0xee, 0xb8, 0xc8, 0x33, 0x6a, 0xd2, 0x67, 0xec, 0x92, 0xf7, 0x35,
-	0x5c, 0xad, 0x32, 0xa3, 0x04, 0xf3, 0xb1, 0x35, 0x99, 0x25, 0x9e, 0x8d, 0x6a, 0xb6, 0x68, 0x09,
-	0xc9, 0xed, 0xe0, 0xbd, 0x6b, 0x89, 0xca, 0x3e, 0x15, 0x37, 0xaa, 0x25, 0xf8, 0x9e, 0x55, 0x70,
-	0xf7, 0x0e, 0x2c, 0x86, 0x9c, 0x33, 0x72, 0xcf, 0x62, 0xc8, 0x3a, 0x29, 0x53, 0x95, 0x61, 0xea,
-	0xe4, 0x92, 0x7a, 0xfb, 0xb0, 0xf8, 0x78, 0xcf, 0x92, 0xb5, 0xde, 0x97, 0x86, 0xa5, 0xc7, 0x46,
-	0x6e, 0xcd, 0x7a, 0xb9, 0xb5, 0x1c, 0xb9, 0xfd, 0x0c, 0x96, 0xf4, 0x88, 0xcf, 0xab, 0x00, 0x1f,
-	0xc1, 0xb2, 0x61, 0x46, 0x2d, 0xed, 0x0d, 0x98, 0x3b, 0x19, 0x59, 0x42, 0xd6, 0x56, 0xcb, 0xe6,
-	0xd9, 0x67, 0x12, 0xef, 0x97, 0xb0, 0x4a, 0x65, 0x12, 0x7b, 0x72, 0xaa, 0x87, 0xc5, 0x85, 0xcc,
-	0xb6, 0xb2, 0x61, 0xce, 0x1c, 0x58, 0x18, 0xaa, 0x04, 0x13, 0xa4, 0x4b, 0xae, 0x0a, 0xc2, 0xc3,
-	0x13, 0xc4, 0x31, 0x5f, 0x8e, 0xe1, 0xa7, 0xb7, 0x0d, 0x97, 0xad, 0xd1, 0xcd, 0x21, 0xe9, 0x45,
-	0x1a, 0x59, 0xa9, 0xa6, 0x9a, 0x8a, 0x8d, 0x5f, 0x92, 0xa0, 0x85, 0x7b, 0xbc, 0xb7, 0x4d, 0x67,
-	0x5d, 0x73, 0xb8, 0x5a, 0xd6, 0x5c, 0x3a, 0xca, 0x01, 0x94, 0xa5, 0xcf, 0xa6, 0x5d, 0xfa, 0xf4,
-	0x5e, 0x83, 0xd5, 0xb2, 0x33, 0x33, 0x50, 0xb3, 0x5f, 0xde, 0xab, 0x38, 0x89, 0x2f, 0x47, 0xe9,
-	0x89, 0x99, 0xa4, 0x8e, 0xec, 0x0f, 0x71, 0x38, 0x4d, 0x56, 0x0e, 0x37, 0x28, 0x6f, 0xe4, 0xe8,
-	0x9b, 0x22, 0xcc, 0x60, 0x92, 0x1b, 0xab, 0x41, 0x80, 0xf7, 0x9b, 0x06, 0x5c, 0x7e, 0x94, 0xcb,
-	0x6c, 0xbb, 0x7a, 0x0f, 0x6a, 0x6e, 0x52, 0x1b, 0xe7, 0xdd, 0xa4, 0x36, 0xeb, 0x6e, 0x52, 0x29,
-	0x18, 0xa1, 0x5c, 0xdb, 0xba, 0x6d, 0xb5, 0x51, 0xb3, 0xee, 0x5a, 0xbd, 0x5f, 0x37, 0xe0, 0x0a,
-	0x72, 0xc5, 0x75, 0x6c, 0x79, 0x28, 0x33, 0x99, 0x0c, 0x54, 0x61, 0x3f, 0x28, 0x8e, 0xf4, 0xfa,
-	0xf1, 0x1b, 0xc5, 0xac, 0xca, 0xdc, 0x7a, 0xeb, 0x15, 0x34, 0xeb, 0x72, 0x54, 0xbc, 0x8e, 0x61,
-	0x5d, 0x11, 0x44, 0x31, 0xbb, 0x5c, 0xed, 0x9c, 0xad, 0x39, 0x99, 0xc0, 0xfb, 0x47, 0x16, 0xd0,
-	0xa7, 0x51, 0x7c, 0x0e, 0x23, 0x14, 0xfa, 0xc7, 0x32, 0x29, 0x0d, 0x97, 0x81, 0x89, 0x5e, 0x66,
-	0x23, 0xed, 0x57, 0xf0, 0xdb, 0xd4, 0x77, 0xda, 0xd6, 0x8d, 0xc4, 0x1a, 0x74, 0x86, 0x59, 0x3a,
-	0x19, 0xf3, 0x35, 0x85, 0x02, 0xc4, 0x2d, 0xc3, 0xee, 0x9c, 0x13, 0x70, 0x18, 0xbe, 0x34, 0xb3,
-	0x7f, 0x02, 0xf3, 0x88, 0xc3, 0xbf, 0xda, 0xf0, 0xdd, 0x0c, 0xdf, 0xb4, 0x87, 0xbf, 0x03, 0xab,
-	0x41, 0x18, 0x46, 0x45, 0x94, 0x26, 0x41, 0xfc, 0x0b, 0x44, 0xe9, 0x72, 0xe9, 0x14, 0xde, 0xdb,
-	0x81, 0xb9, 0x47, 0x2a, 0xd8, 0x15, 0xd0, 0xfe, 0xdc, 0x1a, 0x5f, 0xbb, 0xcf, 0xcf, 0x82, 0x2c,
-	0xe4, 0xa8, 0x98, 0xbe, 0x11, 0x77, 0x90, 0x1e, 0xea, 0xac, 0x98, 0xbe, 0xbd, 0xdf, 0xce, 0xc1,
-	0x92, 0xa3, 0x75, 0x67, 0x71, 0x5b, 0x73, 0xe9, 0xd3, 0x87, 0x2e, 0xc6, 0x36, 0x61, 0xa4, 0xaf,
-	0x51, 0x34, 0x88, 0x9a, 0x99, 0x49, 0xaa, 0xc2, 0xf3, 0x85, 0x9f, 0x92, 0xac, 0x8b, 0xd4, 0x57,
-	0x77, 0x9d, 0xf2, 0xea, 0xee, 0x03, 0x2a, 0xaa, 0x0d, 0x8a, 0xb8, 0xe2, 0xaa, 0x1d, 0x0e, 0x37,
-	0x0f, 0x88, 0x84, 0x5d, 0xb5, 0xa2, 0x17, 0xaf, 0x43, 0x5b, 0x26, 0x27, 0x79, 0xa5, 0x40, 0x53,
-	0xb9, 0x99, 0x23, 0x12, 0x4a, 0xbd, 0xd4, 0x7d, 0x20, 0x15, 0x63, 0x7a, 0xbe, 0x06, 0xd1, 0xb6,
-	0x49, 0x1c, 0x75, 0x9c, 0x46, 0x49, 0xc1, 0x77, 0x87, 0x16, 0x46, 0x6c, 0xea, 0x9b, 0x42, 0xa0,
-	0x59, 0xfa, 0x75, 0xdc, 0xd9, 0xb7, 0x85, 0xef, 0x96, 0x17, 0x43, 0x0b, 0x8e, 0x4b, 0xab, 0x39,
-	0x51, 0xe5, 0x15, 0xd1, 0x26, 0x74, 0x28, 0x10, 0xe4, 0x5b, 0xc6, 0x7e, 0x55, 0xc5, 0x4c, 0x0f,
-	0x45, 0x26, 0x7e, 0xc2, 0xda, 0xbb, 0x34, 0xa5, 0x91, 0xf8, 0xc7, 0xea, 0xfc, 0x41, 0xe5, 0x5e,
-	0xb1, 0x5e, 0xb2, 0x75, 0x77, 0x49, 0xaa, 0xcc, 0xbf, 0x62, 0xca, 0xfc, 0x2f, 0x03, 0x1c, 0x14,
-	0xe9, 0xf8, 0x20, 0x1a, 0x26, 0x41, 0xdc, 0xbf, 0xac, 0x1c, 0x40, 0x89, 0x11, 0xb7, 0xa0, 0x3b,
-	0x21, 0xbd, 0xcc, 0xfb, 0x82, 0xa6, 0x5a, 0xd2, 0x53, 0x11, 0xd6, 0xd7, 0xad, 0x94, 0x34, 0xa7,
-	0x43, 0x7a, 0x4f, 0x71, 0x45, 0xa9, 0x0f, 0x83, 0x8e, 0xc1, 0x58, 0xab, 0x18, 0x0c, 0x32, 0x9e,
-	0x83, 0x23, 0xd9, 0xbf, 0xaa, 0x8d, 0xe7, 0xe0, 0x48, 0x62, 0xa4, 0x66, 0x69, 0xc5, 0xf3, 0x44,
-	0x6a, 0x3f, 0x24, 0xc8, 0xbb, 0x07, 0x8b, 0x24, 0x62, 0xc9, 0x95, 0x35, 0x7d, 0xed, 0xd6, 0xa8,
-	0xbd, 0x76, 0x73, 0x7d, 0xcf, 0xa1, 0x32, 0x10, 0xb8, 0xa3, 0x67, 0x3d, 0xa1, 0x91, 0xc9, 0x20,
-	0x0d, 0xa3, 0x64, 0xa8, 0x6d, 0x98, 0x86, 0x91, 0xc7, 0x49, 0x16, 0xf1, 0xa1, 0xc3, 0x4f, 0xa5,
-	0xd3, 0x49, 0x21, 0x13, 0xfd, 0x58, 0x43, 0x83, 0xe8, 0xc3, 0x4b, 0x6d, 0xfb, 0x62, 0x8c, 0x26,
-	0xc4, 0xd8, 0xbb, 0x46, 0xfd, 0x0d, 0x6c, 0x73, 0xea, 0x06, 0xd6, 0xdc, 0x06, 0xb7, 0xdc, 0xdb,
-	0x60, 0xef, 0x9f, 0x1a, 0x00, 0xe5, 0xf0, 0xcf, 0x7b, 0x07, 0x7b, 0x98, 0x66, 0xa3, 0xa0, 0x30,
-	0x57, 0xc6, 0x04, 0x89, 0xb7, 0x60, 0x2e, 0x25, 0x36, 0xd9, 0x23, 0x5c, 0x9b, 0x3a, 0x33, 0x6a,
-	0x15, 0x3e, 0x93, 0xa9, 0xbb, 0x67, 0xaa, 0x7e, 0x76, 0xf4, 0xdd, 0x33, 0xd5, 0x3f, 0x8d, 0xa6,
-	0xcc, 0x59, 0x9a, 0xe2, 0xfd, 0x6d, 0x43, 0x19, 0x3c, 0x53, 0x62, 0xc1, 0xfe, 0x4f, 0xb2, 0x28,
-	0x1c, 0x9a, 0xca, 0x82, 0x82, 0x48, 0xf1, 0xb5, 0x7d, 0x6e, 0x46, 0x63, 0xca, 0x6f, 0x0f, 0x69,
-	0x79, 0xcc, 0xb0, 0x82, 0x70, 0x37, 0x46, 0xc1, 0x80, 0xe5, 0x8e, 0x9f, 0x84, 0x29, 0x26, 0x5c,
-	0x3e, 0xc0, 0x4f, 0x94, 0xee, 0x30, 0x28, 0xe4, 0xd3, 0xe0, 0x54, 0xdf, 0x6f, 0x33, 0xc8, 0xc7,
-	0x2b, 0xd4, 0xc7, 0xcb, 0xfb, 0x0c, 0x04, 0xb2, 0xa7, 0x8b, 0xff, 0xc1, 0xe0, 0x58, 0x26, 0xa1,
-	0x75, 0x27, 0xda, 0x70, 0xee, 0x44, 0x67, 0x3c, 0xb4, 0xf2, 0xfe, 0xa6, 0x01, 0x0b, 0xd6, 0x50,
-	0x74, 0x53, 0xaa, 0x3e, 0xcd, 0x30, 0x25, 0xc2, 0x09, 0x02, 0x9a, 0x95, 0x07, 0x57, 0xe7, 0x87,
-	0x10, 0x6f, 0x41, 0x07, 0xe7, 0xcd, 0xb9, 0xec, 0x7f, 0xdd, 0xda, 0x33, 0x77, 0x25, 0xbe, 0xa2,
-	0xf3, 0xfe, 0xb2, 0x01, 0x8b, 0x98, 0xf7, 0xa4, 0xc3, 0xed, 0x34, 0x39, 0x8c, 0x86, 0xa6, 0x82,
-	0xdd, 0xb0, 0x2a, 0xd8, 0xef, 0xc3, 0xdc, 0x80, 0x5a, 0xf9, 0x7a, 0xe3, 0x15, 0x2b, 0x61, 0xd2,
-	0x1d, 0x37, 0xd5, 0x3f, 0x6c, 0xb3, 0x14, 0x39, 0x9e, 0x69, 0x0b, 0xfd, 0x5c, 0x67, 0xfa, 0x18,
-	0x16, 0x70, 0x45, 0x7b, 0xc1, 0x78, 0x8c, 0xca, 0x3f, 0x15, 0x63, 0x35, 0x2a, 0x89, 0xd0, 0x54,
-	0x94, 0xc6, 0xc2, 0x33, 0x51, 0x9a, 0x2d, 0xd8, 0x56, 0x25, 0xba, 0x4a, 0x60, 0x0d, 0x69, 0x46,
-	0x6a, 0xb2, 0xaf, 0x8e, 0xa2, 0x82, 0xa2, 0x5a, 0x8c, 0x03, 0xa8, 0x1a, 0x9b, 0x04, 0x31, 0x97,
-	0x13, 0xf4, 0x43, 0x8c, 0x29, 0x3c, 0xd2, 0xca, 0x67, 0x15, 0xda, 0xa6, 0xa2, 0xad, 0xe2, 0xbd,
-	0xdf, 0xcc, 0x41, 0x17, 0xf7, 0x64, 0x3f, 0x0d, 0xeb, 0xae, 0x6f, 0x91, 0x67, 0x3b, 0x68, 0xd2,
-	0xb0, 0xd9, 0x9c, 0x96, 0xb5, 0x39, 0xdf, 0xd7, 0xc7, 0xdf, 0xad, 0xa4, 0xe3, 0xb6, 0x4f, 0xdc,
-	0x4f, 0xc3, 0x5a, 0x1f, 0xf4, 0x16, 0x3a, 0x04, 0xb6, 0x22, 0x5d, 0xa7, 0xda, 0x62, 0xdb, 0x5f,
-	0xdf, 0x10, 0x89, 0x57, 0xa1, 0x15, 0xa7, 0x43, 0x2a, 0xad, 0x95, 0xb4, 0xb6, 0xda, 0xf8, 0xd8,
-	0x8e, 0xdc, 0x85, 0x89, 0x7e, 0x25, 0x84, 0x9f, 0xe2, 0x5d, 0xe7, 0x7d, 0x06, 0x38, 0x79, 0xba,
-	0xe3, 0x2b, 0x9d, 0x37, 0x1a, 0xaf, 0x6a, 0x97, 0xad, 0xdc, 0xfc, 0x54, 0x54, 0xc8, 0x9e, 0xfa,
-	0x8d, 0x32, 0x1e, 0x50, 0xbe, 0xbd, 0x26, 0xda, 0x35, 0x61, 0xc0, 0xbb, 0x4e, 0xe9, 0x7e, 0x69,
-	0x8a, 0x13, 0x63, 0xc0, 0x9c, 0xca, 0xfd, 0x26, 0xcc, 0xf3, 0xb9, 0xd4, 0x9e, 0x5e, 0x4c, 0x9f,
-	0x45, 0xdf, 0xd0, 0x88, 0x2f, 0xe1, 0xea, 0xb8, 0x46, 0x03, 0x73, 0x72, 0xf8, 0x0b, 0x77, 0x5f,
-	0x32, 0xa2, 0x9b, 0xa6, 0xf1, 0xeb, 0x7b, 0x8a, 0xf7, 0x60, 0xd1, 0x6a, 0xc8, 0xfb, 0xab, 0x0e,
-	0x1b, 0xd6, 0xe1, 0xf2, 0x1d, 0x3a, 0x0c, 0x2c, 0xc2, 0x24, 0x57, 0xc6, 0x3d, 0xef, 0x5f, 0x56,
-	0xd1, 0x57, 0x89, 0x41, 0xfb, 0x15, 0x26, 0xf9, 0x81, 0x0c, 0xb2, 0xc1, 0x11, 0x85, 0x16, 0x3d,
-	0xbf, 0x44, 0xfc, 0x10, 0x37, 0xee, 0xc3, 0xea, 0x7e, 0x1a, 0xba, 0x49, 0xa4, 0x2a, 0x93, 0x1d,
-	0x8c, 0xe5, 0xa0, 0x52, 0x26, 0x63, 0x35, 0xf5, 0x75, 0x73, 0x7d, 0x32, 0xef, 0xbd, 0x0e, 0x97,
-	0xad, 0x31, 0x39, 0x19, 0xac, 0x2f, 0xd2, 0xdd, 0xa6, 0xe9, 0xdd, 0xf4, 0xb2, 0x9e, 0xf2, 0x23,
-	0x1a, 0xf4, 0x7b, 0x67, 0x98, 0xff, 0xde, 0xb0, 0x2b, 0x4a, 0xe9, 0x30, 0xbf, 0x50, 0x99, 0x44,
-	0x39, 0xea, 0x38, 0x4e, 0x9f, 0xd2, 0x68, 0xf3, 0x3e, 0x43, 0xb8, 0x5f, 0xa6, 0x22, 0x99, 0x73,
-	0x62, 0x67, 0x61, 0xc8, 0x68, 0xe8, 0xc4, 0x0e, 0x8d, 0x46, 0x10, 0xc5, 0xc8, 0x58, 0x1e, 0x25,
-	0x03, 0xed, 0xaa, 0x15, 0xa0, 0x2a, 0x1f, 0x61, 0x3a, 0x51, 0x97, 0x31, 0xf3, 0x3e, 0x43, 0x8c,
-	0x97, 0x59, 0xc6, 0x2f, 0xbf, 0x18, 0xf2, 0x5e, 0xb7, 0x2b, 0x49, 0xb4, 0x0e, 0x96, 0xc5, 0xaa,
-	0x3a, 0xf6, 0xb8, 0x84, 0x45, 0x3a, 0xe1, 0x18, 0xa2, 0xed, 0xd0, 0xdb, 0xae, 0x19, 0xaf, 0x50,
-	0xcb, 0xc2, 0x4b, 0xd3, 0x29, 0xbc, 0x2c, 0xc1, 0x82, 0x55, 0x4c, 0xf2, 0xbe, 0x6b, 0xc1, 0xa2,
-	0x53, 0x26, 0x5a, 0x86, 0xa6, 0xd9, 0xa1, 0xe6, 0xee, 0x0e, 0x0a, 0xc4, 0x79, 0xdb, 0x85, 0xfb,
-	0x61, 0x5b, 0x09, 0x0c, 0x20, 0x30, 0x71, 0xca, 0xd9, 0x83, 0x32, 0x64, 0xbd, 0x46, 0x6b, 0x3b,
-	0xaf, 0xd1, 0xde, 0x84, 0x6e, 0xc8, 0x8c, 0x75, 0x9c, 0x62, 0x8d, 0xbd, 0x22, 0x5f, 0xd3, 0xa0,
-	0x41, 0x0e, 0xd3, 0xc1, 0xb1, 0xcc, 0xfc, 0x34, 0x2d, 0xca, 0x07, 0x94, 0x2e, 0x52, 0x6c, 0x82,
-	0x88, 0x92, 0x50, 0x3e, 0x43, 0x53, 0x20, 0xb3, 0xad, 0x30, 0xa4, 0x7a, 0xbe, 0x7a, 0x51, 0x59,
-	0xd3, 0x22, 0x6e, 0xc3, 0x8a, 0x7c, 0x26, 0x07, 0x13, 0x3c, 0x83, 0x6a, 0x5e, 0x7e, 0x15, 0x54,
-	0x45, 0x53, 0x9c, 0x28, 0x47, 0x0f, 0xe9, 0x59, 0x45, 0x8f, 0x8a, 0xb0, 0x06, 0x56, 0xef, 0x00,
-	0xc3, 0x9c, 0x6e, 0x28, 0x5a, 0x3e, 0x7d, 0xe3, 0xc8, 0xe9, 0x58, 0x66, 0x01, 0x3d, 0xd8, 0x55,
-	0x75, 0xf1, 0x05, 0x35, 0x72, 0x05, 0x6d, 0x36, 0x6d, 0xb1, 0xdc, 0x34, 0x2f, 0x80, 0xcb, 0xf7,
-	0x9f, 0xc9, 0x81, 0x7b, 0x6a, 0xcf, 0x2f, 0x6c, 0x5a, 0xc9, 0x5f, 0xd3, 0x4d, 0xfe, 0xd8, 0x53,
-	0xb5, 0x8c, 0xa7, 0xf2, 0x7e, 0x1f, 0x84, 0x3d, 0x05, 0xef, 0xfa, 0x3a, 0xcc, 0xe1, 0xca, 0xcd,
-	0xf0, 0x0c, 0x79, 0x4f, 0x60, 0x15, 0xa9, 0x0f, 0xd0, 0xf9, 0x5d, 0x9c, 0x9f, 0x72, 0xb4, 0xa6,
-	0x3d, 0x1a, 0x1d, 0x94, 0x22, 0x8c, 0xd4, 0xdb, 0xb0, 0x45, 0x5f, 0x01, 0xde, 0x1b, 0x6a, 0xd1,
-	0x3c, 0x47, 0xc9, 0x10, 0x9f, 0x1e, 0xa5, 0xf7, 0x0c, 0x79, 0x8f, 0x60, 0x09, 0x89, 0x1f, 0xef,
-	0xcd, 0xae, 0x1b, 0x9e, 0x2d, 0x91, 0x7a, 0x1e, 0x76, 0x60, 0x59, 0x0f, 0x3b, 0x9b, 0x01, 0xe7,
-	0x45, 0x7a, 0xd3, 0x7d, 0x91, 0xee, 0x49, 0x5e, 0x09, 0xe5, 0x8c, 0x3f, 0x5c, 0x5c, 0xc8, 0x82,
-	0x4a, 0x48, 0x5b, 0xa4, 0x61, 0x0c, 0x79, 0x6b, 0x6a, 0x0b, 0xf5, 0x34, 0x8a, 0x61, 0xef, 0x16,
-	0x15, 0xe7, 0x9d, 0x9d, 0xaa, 0x37, 0xb8, 0x82, 0x4c, 0xb3, 0x23, 0x6e, 0x2f, 0x80, 0x85, 0xaf,
-	0x82, 0xa8, 0xb8, 0x98, 0xed, 0xbc, 0x01, 0xbd, 0x71, 0x96, 0x0e, 0x64, 0x9e, 0xef, 0xea, 0x07,
-	0x80, 0x25, 0x02, 0xb9, 0x4e, 0xd2, 0xcf, 0x82, 0x64, 0xc8, 0x5a, 0xc7, 0x90, 0x77, 0x07, 0x16,
-	0xd5, 0x14, 0x2c, 0xe0, 0x19, 0x4f, 0xfb, 0xbd, 0xfb, 0xb0, 0xb4, 0x55, 0x14, 0xc1, 0xe0, 0x68,
-	0x8f, 0x9f, 0x55, 0x9e, 0x2f, 0x44, 0x01, 0xed, 0x30, 0x28, 0x02, 0xe2, 0x67, 0xd1, 0xa7, 0x6f,
-	0xef, 0x1b, 0x58, 0x37, 0x26, 0xd5, 0x3d, 0x53, 0x76, 0x31, 0xdc, 0xf2, 0x87, 0xf5, 0x41, 0x91,
-	0x4b, 0x7a, 0x86, 0x6f, 0xfc, 0x10, 0xae, 0x4d, 0xcd, 0xc5, 0x2b, 0x3d, 0x97, 0x79, 0xef, 0x9e,
-	0x65, 0xfb, 0x9d, 0x1d, 0xfc, 0x31, 0x2c, 0x1a, 0xba, 0x5f, 0x99, 0xc8, 0xd6, 0xea, 0x1b, 0x7a,
-	0x7d, 0x6b, 0x91, 0xee, 0xa6, 0x8e, 0xad, 0x16, 0x9f, 0x0a, 0x85, 0x7a, 0xd8, 0x3b, 0xb0, 0x9a,
-	0xc6, 0xe1, 0xb6, 0x73, 0x19, 0xa2, 0x86, 0x9e, 0xc2, 0x23, 0x6d, 0x22, 0x9f, 0x6e, 0xd7, 0x5c,
-	0x9c, 0x4c, 0xe1, 0xbd, 0xeb, 0x96, 0x10, 0xf4, 0x8c, 0xcc, 0xcc, 0x87, 0x0e, 0x33, 0x76, 0x58,
-	0x70, 0x81, 0x35, 0xba, 0xe3, 0xda, 0x91, 0x82, 0xf7, 0xdb, 0x06, 0xc0, 0xd6, 0xa4, 0x38, 0xe2,
-	0x8c, 0x6b, 0x03, 0xe6, 0x31, 0xf3, 0xb7, 0xdc, 0xa1, 0x81, 0xd5, 0x5b, 0xce, 0x3c, 0x7f, 0x9a,
-	0x66, 0x61, 0xf9, 0x96, 0x53, 0xc1, 0xf4, 0x86, 0x7e, 0x52, 0x1c, 0xe9, 0x64, 0x00, 0xbf, 0x71,
-	0xa3, 0xe5, 0xa8, 0x74, 0xf6, 0x0a, 0x40, 0x8f, 0x94, 0x93, 0x33, 0x09, 0xd8, 0xcd, 0x28, 0xaf,
-	0xef, 0x22, 0x55, 0x22, 0x31, 0x8c, 0xf2, 0x22, 0x3b, 0x2d, 0xd2, 0x63, 0x99, 0x68, 0xbf, 0xe5,
-	0x20, 0xbd, 0x80, 0xef, 0x22, 0xf6, 0x27, 0x71, 0x6c, 0x1d, 0x5a, 0x55, 0x96, 0x6c, 0xd8, 0x65,
-	0x49, 0x34, 0xe4, 0x81, 0xae, 0x62, 0xe0, 0xa7, 0x78, 0xd5, 0xe2, 0xb8, 0x0c, 0xba, 0x4b, 0x51,
-	0xa8, 0x45, 0x78, 0xb7, 0xf8, 0x42, 0x42, 0x4d, 0x51, 0x86, 0x57, 0x74, 0x58, 0x1a, 0xd6, 0x61,
-	0xf9, 0x95, 0xe1, 0x25, 0x3f, 0xb2, 0x2e, 0x04, 0x32, 0x39, 0x4e, 0x75, 0x60, 0x81, 0xdf, 0x2f,
-	0x82, 0x13, 0x9c, 0x60, 0x06, 0x27, 0x8f, 0x41, 0x10, 0xe1, 0x54, 0xf4, 0x58, 0x23, 0x97, 0x35,
-	0xe8, 0x1c, 0xa6, 0xba, 0x0e, 0x33, 0xef, 0x2b, 0x80, 0x8e, 0x68, 0x36, 0x49, 0x24, 0x9b, 0x20,
-	0x05, 0x78, 0x5b, 0xb0, 0x40, 0xe3, 0xee, 0xc8, 0x58, 0x16, 0x54, 0xe9, 0x9d, 0x24, 0x45, 0x30,
-	0x94, 0x5a, 0xe5, 0x34, 0x88, 0x2d, 0xa1, 0x54, 0x8f, 0x14, 0xb8, 0x6c, 0xc4, 0xa0, 0xb7, 0x05,
-	0x57, 0x1c, 0xd6, 0x78, 0x15, 0x77, 0x4c, 0x10, 0xd4, 0x70, 0xf2, 0x02, 0x6b, 0x3a, 0x1d, 0x18,
-	0x79, 0xbe, 0x15, 0xaf, 0x1e, 0x14, 0xe9, 0xf8, 0xb9, 0xdc, 0x3c, 0x46, 0xa2, 0xe8, 0x93, 0xd4,
-	0x2f, 0x69, 0x34, 0xe8, 0x5d, 0x73, 0xec, 0x07, 0x8e, 0xc9, 0xa7, 0x63, 0x15, 0x96, 0xf9, 0xf5,
-	0xb5, 0x0e, 0xf8, 0xfe, 0x08, 0x56, 0x0c, 0x86, 0xb9, 0xef, 0x43, 0xf7, 0x84, 0x1f, 0x6d, 0xb3,
-	0x20, 0x18, 0xac, 0xbc, 0xe8, 0x6e, 0x56, 0x5f, 0x74, 0x7b, 0xf7, 0xe1, 0x0a, 0x67, 0x5f, 0x95,
-	0xfb, 0xae, 0x32, 0x5f, 0x6b, 0x9c, 0x9f, 0xaf, 0x79, 0x77, 0x40, 0x38, 0xc3, 0xcc, 0xf2, 0x5e,
-	0x5f, 0xc3, 0x65, 0xa6, 0xdd, 0x0a, 0xc3, 0xd9, 0x41, 0x80, 0xcd, 0x46, 0xf3, 0x02, 0x6c, 0xac,
-	0x19, 0x36, 0x68, 0x68, 0x16, 0x61, 0x39, 0xe1, 0x8e, 0x8c, 0xff, 0xbf, 0x26, 0xa4, 0xa1, 0x79,
-	0xc2, 0x5f, 0xc2, 0x1a, 0x63, 0x1f, 0x8d, 0x43, 0xcb, 0x67, 0xbd, 0x98, 0x39, 0xaf, 0xc1, 0xd5,
-	0xca, 0xe8, 0x3c, 0xed, 0x26, 0xac, 0x5b, 0x69, 0xec, 0xf9, 0x1b, 0xf1, 0x25, 0x5c, 0x9b, 0xa2,
-	0xe7, 0xfd, 0xe7, 0x64, 0x79, 0x4f, 0x27, 0xcb, 0x8d, 0xd9, 0xc9, 0xb2, 0xa6, 0xf3, 0x8e, 0xa0,
-	0x6f, 0x35, 0xee, 0xa5, 0x61, 0x74, 0x78, 0x3a, 0x7b, 0xf5, 0xd5, 0x99, 0x9a, 0x17, 0x9c, 0xe9,
-	0x25, 0xb8, 0x5e, 0x33, 0x13, 0x4b, 0x42, 0x3d, 0x44, 0xb1, 0xcf, 0xe6, 0xac, 0x87, 0x28, 0xf6,
-	0x79, 0x7b, 0x8e, 0xbc, 0xf5, 0x63, 0x15, 0x85, 0x39, 0xa1, 0x62, 0xfd, 0x1a, 0xcb, 0x30, 0xb0,
-	0xe9, 0x84, 0x81, 0x57, 0x28, 0x71, 0xae, 0x8d, 0x02, 0xf7, 0x71, 0x8a, 0x8b, 0x44, 0x81, 0x4c,
-	0xc8, 0x9d, 0x55, 0x7e, 0xff, 0x28, 0x19, 0x9f, 0xdf, 0x7d, 0x0d, 0x84, 0x4d, 0xca, 0x03, 0xfc,
-	0x6b, 0x83, 0x46, 0x55, 0x35, 0x8b, 0xd9, 0xab, 0xda, 0x80, 0xf9, 0xf4, 0x44, 0x66, 0x59, 0x14,
-	0x6a, 0xdb, 0x6d, 0x60, 0xf1, 0x61, 0xe5, 0xd7, 0x41, 0x3f, 0xb1, 0x6a, 0x5d, 0xf6, 0xd0, 0x2f,
-	0xfa, 0x7d, 0x8b, 0x92, 0xa8, 0x9e, 0xa2, 0x1a, 0x57, 0x17, 0xb3, 0x57, 0xe4, 0xfd, 0x5c, 0xc7,
-	0xd5, 0x45, 0x6e, 0xbd, 0x4c, 0x98, 0x1f, 0x33, 0xae, 0xf2, 0xd4, 0xdf, 0x90, 0x1a, 0x02, 0x4c,
-	0xcd, 0xf7, 0x51, 0x55, 0xd9, 0x52, 0xbf, 0x0d, 0x8b, 0x0a, 0x2c, 0xc3, 0xc8, 0xa3, 0xd3, 0xb1,
-	0xcc, 0xac, 0xe1, 0x7a, 0xbe, 0x8d, 0xf2, 0x8e, 0xec, 0x50, 0xf0, 0x02, 0x9a, 0x75, 0xfe, 0xcf,
-	0x22, 0xcf, 0x4a, 0x41, 0xec, 0x80, 0xac, 0xa2, 0x81, 0xdf, 0xc2, 0xea, 0xc3, 0x87, 0x5f, 0xfb,
-	0x32, 0x8f, 0xbe, 0x95, 0x2f, 0x24, 0x65, 0x7c, 0x1a, 0x85, 0x1c, 0x5c, 0x74, 0x7c, 0x05, 0xd0,
-	0xcd, 0x01, 0xbd, 0xb3, 0xe3, 0x1f, 0x83, 0x31, 0x84, 0x1b, 0x68, 0xcd, 0xad, 0x18, 0xba, 0xfb,
-	0x3f, 0xeb, 0xd0, 0xdb, 0x9f, 0x3c, 0x89, 0xa3, 0xc1, 0xd6, 0xfe, 0xae, 0xb8, 0x47, 0xbf, 0x6c,
-	0xa2, 0x82, 0xf4, 0xd5, 0xea, 0x53, 0x25, 0x62, 0x76, 0x63, 0x7d, 0xea, 0x05, 0x93, 0x5a, 0xd8,
-	0x25, 0xf1, 0x31, 0xfd, 0x32, 0x4c, 0x45, 0xf7, 0xe2, 0x5a, 0x49, 0xe6, 0xe4, 0x16, 0x1b, 0xfd,
-	0xe9, 0x06, 0x33, 0xc2, 0xbd, 0xf2, 0x77, 0x55, 0x57, 0x2b, 0x4f, 0xd4, 0xa6, 0x67, 0xb7, 0xeb,
-	0x32, 0x66, 0x76, 0x15, 0x79, 0xd8, 0xb3, 0x3b, 0x61, 0x92, 0x3d, 0x7b, 0x25, 0x52, 0xbe, 0x24,
-	0x3e, 0xd2, 0x3f, 0xe2, 0xc9, 0x0a, 0xb1, 0xee, 0xe8, 0xa1, 0xc9, 0x38, 0x36, 0xae, 0x4d, 0xe1,
-	0x2b, 0xcc, 0xa3, 0xbd, 0xb3, 0x99, 0xb7, 0xec, 0xe4, 0xc6, 0x7a, 0x15, 0x5d, 0x61, 0x9e, 0x6f,
-	0x53, 0xed, 0x39, 0x6c, 0x35, 0xb5, 0x99, 0xaf, 0x68, 0x95, 0x66, 0x9e, 0x0c, 0x96, 0xcd, 0xbc,
-	0x6d, 0xea, 0x6c, 0xe6, 0x5d, 0xcb, 0x76, 0x49, 0x6c, 0x03, 0x94, 0x06, 0x4b, 0x58, 0x13, 0xb9,
-	0xe6, 0x6e, 0xe3, 0x7a, 0x4d, 0x8b, 0x19, 0xe4, 0x43, 0x98, 0x53, 0x65, 0x02, 0xa1, 0x33, 0x45,
-	0xa7, 0x18, 0xb1, 0x71, 0xb5, 0x82, 0xd5, 0x1d, 0x6f, 0x37, 0xde, 0x6e, 0x88, 0x07, 0xd6, 0x6f,
-	0xa9, 0x49, 0xff, 0x5e, 0xaa, 0x7f, 0x0b, 0xa6, 0x86, 0xba, 0x71, 0xc6, 0x43, 0x31, 0xcd, 0xca,
-	0x83, 0xea, 0x2f, 0xb3, 0x5f, 0xaa, 0x7d, 0xc8, 0x75, 0xd6, 0x68, 0xd3, 0xba, 0x65, 0x9e, 0x2d,
-	0x99, 0xed, 0xa9, 0x3e, 0x93, 0x32, 0xdb, 0x33, 0xf5, 0xc2, 0xc9, 0xbb, 0x24, 0xde, 0x87, 0x39,
-	0xf5, 0xdc, 0xca, 0x88, 0xc6, 0x79, 0xdf, 0x65, 0x44, 0xe3, 0x3e, 0xdd, 0xa2, 0x8d, 0x59, 0x3c,
-	0x90, 0x85, 0xb1, 0xbb, 0xb6, 0x72, 0x38, 0xc6, 0xde, 0x56, 0x8e, 0x8a, 0x89, 0xb6, 0x34, 0xbb,
-	0xc8, 0x2b, 0x9a, 0x6d, 0xac, 0x76, 0x45, 0xb3, 0x0b, 0xbb, 0xfb, 0xe7, 0xf6, 0xd6, 0xa4, 0xc3,
-	0xbc, 0x66, 0x6b, 0xca, 0xca, 0x72, 0xcd, 0xd6, 0x58, 0xe5, 0x5a, 0xef, 0xd2, 0xdb, 0x0d, 0xe1,
-	0x5b, 0x8f, 0x7e, 0xd9, 0x5c, 0xfc, 0xa8, 0xda, 0xc9, 0x35, 0x1a, 0x2f, 0x9f, 0xd5, 0x6c, 0x78,
-	0xfc, 0x02, 0x96, 0xdd, 0x3c, 0x5f, 0xdc, 0xa8, 0xf9, 0xb9, 0x67, 0x79, 0x90, 0x7f, 0x74, 0x46,
-	0xab, 0x19, 0xd0, 0x66, 0x52, 0x25, 0xeb, 0xd3, 0x4c, 0x3a, 0x65, 0x83, 0x69, 0x26, 0x2b, 0x39,
-	0xbe, 0x3b, 0x26, 0x1f, 0xf6, 0x69, 0x3e, 0x9c, 0x23, 0xff, 0xf2, 0x59, 0xcd, 0xb5, 0x9a, 0x4e,
-	0xc6, 0xe7, 0xa5, 0xe9, 0x95, 0x95, 0x26, 0xe8, 0x46, 0x7d, 0xe3, 0x19, 0xab, 0x26, 0x5b, 0x5a,
-	0xb3, 0x6a, 0xdb, 0xa2, 0xbe, 0x7c, 0x56, 0xb3, 0x6d, 0x5b, 0xca, 0x9a, 0xaa, 0xb1, 0x2d, 0x53,
-	0x95, 0x5c, 0x63, 0x5b, 0xa6, 0x0b, 0xb0, 0xde, 0x25, 0xb1, 0x03, 0x3d, 0x53, 0x06, 0x35, 0x87,
-	0xa0, 0x5a, 0x7c, 0xdd, 0xe8, 0x4f, 0x37, 0x38, 0x46, 0x86, 0x59, 0x61, 0xd9, 0x3b, 0xd4, 0x8e,
-	0xd8, 0xaf, 0xd7, 0xb4, 0x58, 0x86, 0x7e, 0x4e, 0x95, 0xdf, 0xcc, 0x59, 0x76, 0xaa, 0x71, 0x1b,
-	0xb5, 0x58, 0x66, 0xe0, 0x1d, 0x68, 0xd3, 0xaf, 0x47, 0x84, 0xf5, 0x9f, 0x57, 0xe8, 0x49, 0xaf,
-	0x38, 0x38, 0xdb, 0xf8, 0x18, 0xaf, 0x6d, 0x56, 0x5e, 0x8d, 0x21, 0xcc, 0xca, 0xa7, 0x1c, 0xbc,
-	0x77, 0x49, 0x7c, 0x0a, 0x0b, 0x56, 0x02, 0x29, 0xf4, 0xe2, 0xa6, 0x93, 0xca, 0x8d, 0x8d, 0xba,
-	0x26, 0x7b, 0x23, 0xcb, 0x0c, 0xd0, 0x48, 0x6f, 0x2a, 0xdf, 0xdc, 0xb8, 0x5e, 0xd3, 0x62, 0x31,
-	0xb3, 0x54, 0x66, 0x75, 0xd2, 0x52, 0x88, 0xa9, 0x34, 0xb2, 0x3a, 0x8e, 0x9d, 0x05, 0x92, 0xde,
-	0x3b, 0x99, 0x9a, 0xd1, 0xfb, 0xba, 0xec, 0xd0, 0xe8, 0x7d, 0x7d, 0x72, 0x47, 0x7a, 0x5f, 0x49,
-	0xd7, 0x8c, 0xde, 0xd7, 0xa7, 0x7d, 0x46, 0xef, 0xcf, 0xc8, 0xf2, 0xbc, 0x4b, 0xe2, 0x11, 0x26,
-	0x4a, 0xa6, 0x11, 0x45, 0xf6, 0xca, 0x74, 0x1f, 0x27, 0x8d, 0xdb, 0xb8, 0x79, 0x36, 0xc1, 0x19,
-	0xc3, 0xee, 0xc8, 0xf8, 0xc5, 0x0c, 0xfb, 0x09, 0xfb, 0xb8, 0xfd, 0x49, 0x1c, 0xbb, 0x3e, 0xce,
-	0x2a, 0xbf, 0xb9, 0x3e, 0xce, 0x2e, 0x9a, 0x91, 0x61, 0x2f, 0xc7, 0xc8, 0x8f, 0xaa, 0x63, 0x98,
-	0xb2, 0x59, 0x75, 0x8c, 0xb2, 0xdc, 0x45, 0x63, 0x7c, 0xca, 0x65, 0x28, 0xb6, 0x3e, 0xd7, 0x6d,
-	0x62, 0xd7, 0xf2, 0x6c, 0xd4, 0x35, 0x99, 0xf5, 0xbc, 0x03, 0x6d, 0xcc, 0x0f, 0xcc, 0x49, 0xb3,
-	0x72, 0x07, 0x73, 0xd2, 0xec, 0x04, 0x42, 0x75, 0xa1, 0x58, 0xc1, 0xd4, 0xa7, 0xac, 0x10, 0xe1,
-	0x8a, 0x83, 0xb3, 0x83, 0x3e, 0xfd, 0xfb, 0x7d, 0xe3, 0xc2, 0x9d, 0x8a, 0x92, 0x09, 0xfa, 0x2a,
-	0x65, 0x25, 0xef, 0xd2, 0x93, 0x39, 0x7a, 0x13, 0xf2, 0xd3, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff,
-	0x15, 0x71, 0xe5, 0x93, 0x74, 0x49, 0x00, 0x00,
+	// 7025 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3c, 0x5d, 0x6f, 0x24, 0xc7,
+	0x71, 0x9a, 0xdd, 0x25, 0xb9, 0x5b, 0xfc, 0x1e, 0x7e, 0xed, 0xcd, 0x51, 0xe7, 0xd3, 0xd8, 0x92,
+	0x4e, 0x27, 0x9b, 0x96, 0x64, 0xc9, 0x52, 0x24, 0x2b, 0x36, 0xef, 0x78, 0x92, 0x08, 0xeb, 0x74,
+	0xd4, 0xf0, 0xee, 0x64, 0xc5, 0x86, 0xed, 0xb9, 0x9d, 0x26, 0x39, 0xbe, 0xdd, 0x99, 0xcd, 0xcc,
+	0x2c, 0xef, 0x68, 0x04, 0x70, 0xec, 0x00, 0x81, 0x01, 0x23, 0x79, 0x09, 0x10, 0x38, 0x41, 0xf2,
+	0x12, 0x27, 0x40, 0xe0, 0x97, 0x24, 0xc8, 0x4b, 0x92, 0x37, 0x3f, 0xe5, 0x25, 0xbf, 0xc0, 0xf0,
+	0x63, 0x5e, 0x12, 0xbf, 0xe4, 0x27, 0x04, 0xd5, 0x5f, 0x53, 0xdd, 0x33, 0xbb, 0xcb, 0x3b, 0x0a,
+	0xc9, 0x03, 0xc1, 0xa9, 0xea, 0xea, 0xee, 0xea, 0xea, 0xea, 0xea, 0xee, 0xaa, 0xea, 0x85, 0xf9,
+	0xe2, 0x6c, 0xc8, 0xf2, 0x9d, 0x61, 0x96, 0x16, 0xa9, 0x3b, 0xc3, 0x01, 0xff, 0x2f, 0x1c, 0x58,
+	0xbc, 0x99, 0x26, 0x45, 0x18, 0x27, 0x2c, 0x3b, 0x48, 0xb3, 0xc2, 0x75, 0xa1, 0x95, 0x84, 0x03,
+	0xd6, 0x75, 0xae, 0x3a, 0xd7, 0x3a, 0x01, 0xff, 0x76, 0x3d, 0x68, 0x9f, 0xa4, 0x79, 0x81, 0xe5,
+	0xdd, 0xc6, 0x55, 0xe7, 0xda, 0x4c, 0xa0, 0x61, 0xf7, 0x0b, 0xb0, 0xd8, 0xa3, 0x0d, 0x74, 0x9b,
+	0x9c, 0xc0, 0x44, 0x62, 0x0b, 0xbc, 0xdf, 0x5e, 0xda, 0xef, 0xb6, 0x78, 0xcb, 0x1a, 0x76, 0x37,
+	0x61, 0x16, 0x5b, 0xdb, 0x3f, 0xe8, 0xce, 0xf0, 0x12, 0x09, 0xf9, 0x6f, 0xc1, 0xd2, 0xad, 0xe4,
+	0x34, 0xce, 0xd2, 0x64, 0xc0, 0x92, 0xe2, 0x7e, 0x98, 0xb9, 0x2b, 0xd0, 0x64, 0xc9, 0xa9, 0x64,
+	0x0d, 0x3f, 0xdd, 0x75, 0x98, 0x39, 0x0d, 0xfb, 0x23, 0xc6, 0xd9, 0xea, 0x04, 0x02, 0xf0, 0xbf,
+	0x0d, 0xf3, 0xf7, 0xd3, 0xfe, 0x68, 0xc0, 0x6e, 0xa7, 0xa3, 0xa4, 0x7e, 0x48, 0xdb, 0xd0, 0x19,
+	0x60, 0xe1, 0x41, 0x58, 0x9c, 0xc8, 0xca, 0x25, 0x02, 0xd9, 0xcd, 0x58, 0x18, 0xdd, 0x49, 0xfa,
+	0x67, 0x7c, 0x3c, 0xed, 0x40, 0xc3, 0xfe, 0x8b, 0xb0, 0xf8, 0x49, 0x18, 0x17, 0x71, 0x72, 0x7c,
+	0x58, 0x84, 0xc5, 0x28, 0x47, 0xfe, 0x33, 0x16, 0xe6, 0x69, 0x22, 0x3b, 0x90, 0x90, 0xff, 0x25,
+	0x58, 0x0c, 0x46, 0x49, 0x52, 0x12, 0x6e, 0x43, 0x27, 0x2f, 0xc2, 0xac, 0x60, 0xd1, 0x6e, 0x21,
+	0x69, 0x4b, 0x84, 0xff, 0x73, 0x07, 0xe0, 0x2e, 0xcb, 0x06, 0x92, 0xd8, 0x83, 0x36, 0x7b, 0x1c,
+	0x17, 0x37, 0xd3, 0x48, 0x30, 0x3e, 0x13, 0x68, 0x98, 0xf4, 0xd8, 0xa0, 0x3d, 0xba, 0x5d, 0x98,
+	0x1b, 0xb0, 0x3c, 0x0f, 0x8f, 0x19, 0xe7, 0xba, 0x13, 0x28, 0xd0, 0xec, 0xba, 0x65, 0x75, 0xed,
+	0x5e, 0x01, 0x38, 0x8a, 0x93, 0x38, 0x3f, 0xe1, 0xc5, 0x62, 0x16, 0x08, 0xc6, 0xff, 0x1f, 0x07,
+	0x96, 0xb5, 0x96, 0x48, 0xfe, 0xea, 0x84, 0x7a, 0x15, 0xe6, 0xf5, 0xb4, 0xef, 0xef, 0x49, 0xe6,
+	0x28, 0x0a, 0xe7, 0x6b, 0x78, 0x12, 0xe6, 0x8a, 0x3f, 0x01, 0xb8, 0x3b, 0x30, 0xf7, 0x48, 0x88,
+	0x94, 0xf3, 0x36, 0xff, 0xda, 0xfa, 0x8e, 0xd0, 0x55, 0x43, 0xd0, 0x81, 0x22, 0x42, 0xfa, 0x4c,
+	0x48, 0xb6, 0x3b, 0x63, 0xd0, 0x1b, 0xf2, 0x0e, 0x14, 0x91, 0xfb, 0x2a, 0x40, 0xc1, 0xb2, 0x41,
+	0x9c, 0x84, 0x05, 0x8b, 0xba, 0xb3, 0xbc, 0xca, 0xaa, 0xac, 0x52, 0x8a, 0x3c, 0x20, 0x44, 0xfe,
+	0x2f, 0xe8, 0xc2, 0xd8, 0x4f, 0x8e, 0x52, 0x77, 0x07, 0x3a, 0x7a, 0x24, 0x7c, 0xd4, 0xf3, 0xaf,
+	0xad, 0xc8, 0x36, 0x34, 0x61, 0x50, 0x92, 0xa0, 0xc8, 0x7b, 0x19, 0x0b, 0x85, 0xc8, 0x51, 0x14,
+	0xcd, 0xa0, 0x44, 0x70, 0x41, 0xa4, 0xd1, 0xfe, 0x9e, 0x16, 0x04, 0x02, 0xee, 0x0e, 0xcc, 0xe6,
+	0x9c, 0x17, 0x29, 0x87, 0x4d, 0xbb, 0x03, 0xc9, 0xa9, 0xa4, 0xf2, 0xff, 0xa1, 0x05, 0x1d, 0x5d,
+	0xf6, 0xf4, 0x53, 0x12, 0x0f, 0x4a, 0x95, 0x11, 0x00, 0xaa, 0x12, 0xff, 0xd8, 0xdf, 0x93, 0xea,
+	0xa2, 0x40, 0xf7, 0x1a, 0x2c, 0xf3, 0xcf, 0x83, 0x51, 0xbf, 0x7f, 0x90, 0xf6, 0xe3, 0xde, 0x99,
+	0xd4, 0x18, 0x1b, 0x8d, 0x6a, 0xf5, 0x28, 0xcd, 0x1e, 0xc6, 0xc9, 0xf1, 0x5e, 0x9c, 0x71, 0xb1,
+	0x77, 0x02, 0x82, 0x41, 0x7e, 0x47, 0x39, 0xcb, 0xba, 0x73, 0x82, 0x5f, 0xfc, 0xc6, 0x25, 0x5e,
+	0x14, 0x67, 0xdd, 0x36, 0x5f, 0x74, 0xf8, 0x89, 0x0b, 0xa1, 0x97, 0x0e, 0x06, 0x61, 0x12, 0xe5,
+	0xdd, 0xce, 0xd5, 0x26, 0x9a, 0x0e, 0x05, 0x63, 0x0b, 0x61, 0x76, 0x9c, 0x77, 0x81, 0xe3, 0xf9,
+	0xb7, 0x7b, 0x1d, 0x25, 0x9b, 0x15, 0x79, 0x77, 0xfe, 0x6a, 0x93, 0xa8, 0x86, 0x61, 0xe5, 0x02,
+	0x41, 0xe2, 0xbe, 0x28, 0x0c, 0xca, 0x02, 0xa7, 0xdc, 0x90, 0x94, 0xa6, 0xd1, 0x11, 0x76, 0xe6,
+	0xab, 0xb0, 0x70, 0x5a, 0x5a, 0x94, 0xbc, 0xbb, 0xc8, 0x6b, 0xb8, 0xb2, 0x06, 0x31, 0x36, 0x81,
+	0x41, 0xe7, 0xbe, 0x0e, 0xb3, 0xfd, 0xf0, 0x01, 0xeb, 0xe7, 0xdd, 0x25, 0x5e, 0x63, 0xdb, 0xe6,
+	0x66, 0xe7, 0x43, 0x5e, 0x7c, 0x2b, 0x29, 0xb2, 0xb3, 0x40, 0xd2, 0xa2, 0xe0, 0xb2, 0x34, 0x2d,
+	0x8e, 0xf2, 0xc3, 0xf8, 0x87, 0xac, 0xbb, 0xcc, 0x75, 0x87, 0x60, 0xbc, 0xdf, 0x81, 0x79, 0x52,
+	0x0d, 0x65, 0xf6, 0x90, 0x9d, 0x29, 0xb3, 0xf8, 0x90, 0x9d, 0xd5, 0x9b, 0xc5, 0xb7, 0x1b, 0x6f,
+	0x39, 0xfe, 0xbf, 0x3a, 0xb0, 0x1c, 0xdc, 0xd8, 0x13, 0x1c, 0x1f, 0xa6, 0xa3, 0xac, 0xc7, 0xcd,
+	0xfb, 0x20, 0x4d, 0xe2, 0x22, 0xcd, 0xf2, 0xae, 0x23, 0x24, 0xac, 0xe0, 0x52, 0x3b, 0x1a, 0x54,
+	0x3b, 0x36, 0x61, 0xf6, 0x28, 0xbf, 0x7b, 0x36, 0x54, 0x4a, 0x23, 0x21, 0x9c, 0x8f, 0x61, 0xaa,
+	0x4d, 0x3c, 0xff, 0xd6, 0xb3, 0x3c, 0x43, 0x66, 0xb9, 0x0b, 0x73, 0x0f, 0xd9, 0x59, 0x86, 0x0b,
+	0x58, 0xa8, 0x85, 0x02, 0x0d, 0xcb, 0x3b, 0x67, 0x59, 0xde, 0x5f, 0x38, 0xd0, 0x39, 0x48, 0x23,
+	0xc1, 0x7b, 0xad, 0xb6, 0x6f, 0xc2, 0x6c, 0xce, 0xc7, 0xa4, 0x0c, 0xa3, 0x80, 0x10, 0x1f, 0x65,
+	0xf1, 0x29, 0xcb, 0x14, 0xbf, 0x02, 0x72, 0xaf, 0x41, 0x33, 0x7b, 0x10, 0x59, 0x8b, 0xcd, 0x12,
+	0x4f, 0x80, 0x24, 0xd8, 0x5b, 0x8e, 0x93, 0x31, 0xc3, 0x27, 0x83, 0x7f, 0xa3, 0x6c, 0x46, 0xdc,
+	0xd8, 0xce, 0x72, 0xa4, 0x00, 0xfc, 0x9f, 0x34, 0x60, 0xee, 0x20, 0x8d, 0x0e, 0x87, 0xac, 0xe7,
+	0x5e, 0x87, 0x39, 0xa1, 0x0e, 0x42, 0xb0, 0xa5, 0xc5, 0xd0, 0xc3, 0x08, 0x14, 0x81, 0xfb, 0x0a,
+	0x80, 0x5e, 0x96, 0x79, 0xb7, 0x61, 0x90, 0x97, 0x06, 0x86, 0xd0, 0xb8, 0xaf, 0x69, 0xe5, 0x6a,
+	0x72, 0x6a, 0xaf, 0x6c, 0x1c, 0x7b, 0xaf, 0x55, 0x2d, 0x17, 0x5a, 0xa7, 0xbd, 0xe1, 0x88, 0x0f,
+	0x79, 0x26, 0xe0, 0xdf, 0x28, 0x9d, 0x01, 0x1b, 0xa4, 0x99, 0x58, 0xc8, 0x33, 0x81, 0x84, 0x2e,
+	0xa2, 0x66, 0x3f, 0x6e, 0xf0, 0xa9, 0x92, 0x7b, 0x85, 0xb6, 0xfa, 0x0e, 0xb5, 0xfa, 0x64, 0xb7,
+	0x6a, 0x98, 0xbb, 0x55, 0xb9, 0xbf, 0x35, 0x8d, 0xfd, 0xad, 0x3c, 0x29, 0xb4, 0xe8, 0x49, 0x41,
+	0x19, 0x53, 0x3c, 0x40, 0x34, 0x95, 0x31, 0x3d, 0xd0, 0x7b, 0xde, 0xdd, 0x78, 0xc0, 0xa4, 0x9a,
+	0x95, 0x08, 0xf7, 0x1b, 0xb0, 0xdc, 0x33, 0xad, 0x6a, 0x77, 0xee, 0x6a, 0x93, 0xa8, 0x81, 0x6d,
+	0x73, 0x6d, 0xf2, 0x72, 0xd7, 0xe4, 0x1d, 0xb4, 0xe9, 0xae, 0x89, 0x18, 0xff, 0xbf, 0x1c, 0xae,
+	0x08, 0x7c, 0xf3, 0xd0, 0xe6, 0xde, 0xa1, 0xe6, 0xde, 0x85, 0xd6, 0xc3, 0x38, 0x89, 0xe4, 0xf0,
+	0xf9, 0x37, 0xb6, 0x1a, 0x0e, 0xe3, 0xfb, 0x2c, 0xcb, 0x63, 0x3d, 0x7e, 0x82, 0x71, 0x97, 0xa0,
+	0x71, 0x3a, 0x90, 0xe3, 0x6f, 0x9c, 0x0e, 0xcc, 0x6d, 0x66, 0xc6, 0xde, 0x66, 0x7c, 0x68, 0xe5,
+	0x43, 0xd6, 0x93, 0x7b, 0xde, 0x92, 0xa9, 0x20, 0x01, 0x2f, 0x73, 0xaf, 0xe9, 0x4d, 0x67, 0xce,
+	0xd8, 0xd5, 0xf4, 0xfc, 0xa9, 0xed, 0x06, 0x67, 0x6c, 0x98, 0x46, 0x1f, 0x85, 0x7a, 0xb8, 0x0a,
+	0xf4, 0xff, 0xa6, 0x01, 0x9d, 0x7d, 0xbe, 0x41, 0xe0, 0x68, 0x97, 0xa0, 0x11, 0x47, 0x72, 0xa8,
+	0x8d, 0x38, 0xe2, 0xa7, 0xbf, 0x30, 0x63, 0x49, 0xa1, 0x77, 0x20, 0x0d, 0x8b, 0x05, 0x3f, 0x4c,
+	0xef, 0x86, 0xc7, 0x42, 0x8d, 0x3b, 0x81, 0x86, 0x71, 0xf3, 0xc2, 0xef, 0xbd, 0xf8, 0x98, 0xe5,
+	0x05, 0xee, 0x89, 0x58, 0x4c, 0x51, 0xc8, 0x91, 0x1c, 0xac, 0x1c, 0xbb, 0x02, 0xb1, 0xee, 0x69,
+	0x9c, 0x15, 0xa3, 0xb0, 0xcf, 0x8d, 0xa8, 0x58, 0xa2, 0x14, 0x45, 0x6c, 0xf3, 0x9c, 0x61, 0x9b,
+	0xf5, 0x38, 0xea, 0x16, 0xd0, 0x45, 0x16, 0xc5, 0xbf, 0x39, 0xd0, 0xbe, 0xf5, 0x98, 0xf5, 0xb8,
+	0x8c, 0x36, 0x61, 0x96, 0xe1, 0xb7, 0x52, 0x09, 0x09, 0x9d, 0xf3, 0x0c, 0x55, 0x3d, 0x3a, 0xa0,
+	0x24, 0xc4, 0xb6, 0x28, 0xe5, 0xa4, 0x40, 0xb5, 0xa5, 0xce, 0x94, 0x5b, 0xea, 0xa6, 0x9e, 0x71,
+	0xb1, 0x2c, 0x24, 0x64, 0x9c, 0x39, 0xe7, 0xcc, 0x33, 0xa7, 0xff, 0xab, 0x06, 0xb4, 0xa5, 0x46,
+	0xe4, 0xee, 0x73, 0xd0, 0x44, 0x23, 0x22, 0x4e, 0x41, 0xcb, 0x6a, 0xc1, 0x0c, 0x47, 0xbc, 0x34,
+	0xc0, 0x32, 0xf7, 0x45, 0x98, 0x79, 0xd0, 0x4f, 0x7b, 0x0f, 0xbb, 0x0d, 0xe3, 0xb8, 0x75, 0xa3,
+	0xff, 0x30, 0x4e, 0x05, 0x99, 0x28, 0x77, 0xaf, 0x6b, 0xeb, 0xd3, 0xbc, 0xea, 0x90, 0x4d, 0xf5,
+	0x36, 0x47, 0x0a, 0x52, 0x49, 0xe1, 0x7e, 0x09, 0xe6, 0x12, 0x56, 0xe0, 0x11, 0x42, 0xda, 0xec,
+	0x35, 0x49, 0xfc, 0x91, 0xc0, 0x0a, 0x6a, 0x45, 0xe3, 0xee, 0xe0, 0x0a, 0xed, 0xb3, 0xfc, 0x2c,
+	0x2f, 0xd8, 0x80, 0x1b, 0x87, 0x72, 0x0d, 0xbc, 0x97, 0x0b, 0x62, 0x42, 0x81, 0x6b, 0xa9, 0x88,
+	0x07, 0x2c, 0x2f, 0xc2, 0xc1, 0x50, 0x6a, 0x4c, 0x89, 0x30, 0x2c, 0x86, 0xa8, 0x3c, 0xce, 0x62,
+	0xc8, 0xa6, 0x6d, 0x72, 0xff, 0x10, 0xda, 0x4a, 0x48, 0xee, 0xf3, 0x6a, 0xf3, 0xa8, 0x08, 0xf1,
+	0x1e, 0xa2, 0xe5, 0x6e, 0x82, 0xea, 0xf0, 0x61, 0x1a, 0x46, 0xbb, 0xa7, 0x2c, 0x53, 0x86, 0x72,
+	0x26, 0xa0, 0x28, 0x3f, 0x82, 0xb6, 0xaa, 0x84, 0xaa, 0x51, 0xa4, 0x45, 0xd8, 0xe7, 0x8d, 0xb6,
+	0x02, 0x01, 0xe0, 0x74, 0x0f, 0x59, 0x76, 0x73, 0x38, 0xe2, 0xbb, 0x4a, 0x2b, 0x90, 0x90, 0xde,
+	0x99, 0x9b, 0x9c, 0x98, 0x7f, 0x23, 0xad, 0x14, 0x57, 0x8b, 0x63, 0x25, 0xe4, 0xff, 0x47, 0x0b,
+	0xa0, 0x9c, 0x3b, 0xf7, 0x0e, 0x6c, 0xc5, 0xe9, 0x21, 0xcb, 0x4e, 0xe3, 0x1e, 0xbb, 0x71, 0x56,
+	0xb0, 0x3c, 0x60, 0xbd, 0x51, 0x96, 0xc7, 0xa7, 0xac, 0xeb, 0x18, 0x87, 0x29, 0x5d, 0x47, 0xac,
+	0xa2, 0x71, 0xb5, 0xdc, 0xf7, 0x61, 0x4d, 0x17, 0x45, 0x65, 0x63, 0x8d, 0x49, 0x8d, 0xd5, 0xd5,
+	0x70, 0x6f, 0xc2, 0x6a, 0x9c, 0x7e, 0x3c, 0x62, 0x23, 0xda, 0x4c, 0x73, 0x52, 0x33, 0x55, 0x7a,
+	0xf7, 0x36, 0x6c, 0xea, 0xb6, 0xd1, 0x96, 0x97, 0x2d, 0xb5, 0x26, 0xb5, 0x34, 0xa6, 0x92, 0x18,
+	0x1c, 0xde, 0x65, 0xcc, 0xb6, 0x66, 0xa6, 0x0c, 0xae, 0x52, 0x43, 0x0c, 0xee, 0x36, 0xcb, 0x8e,
+	0xe9, 0xe0, 0x66, 0xa7, 0x0c, 0xce, 0xa2, 0x77, 0xbf, 0x0e, 0xcb, 0x71, 0x6a, 0x72, 0x32, 0x37,
+	0xa9, 0x09, 0x9b, 0xda, 0xdd, 0x85, 0x95, 0x9c, 0xf5, 0xf0, 0x78, 0x58, 0xb6, 0xd0, 0x9e, 0xd4,
+	0x42, 0x85, 0xdc, 0xff, 0x6f, 0x07, 0x96, 0x4c, 0xa2, 0xda, 0xf3, 0x9c, 0x0b, 0x2d, 0x6c, 0x50,
+	0x6d, 0x90, 0xf8, 0x4d, 0xce, 0x78, 0x4d, 0xe3, 0x8c, 0xb7, 0x0e, 0x33, 0x83, 0xf0, 0x07, 0x69,
+	0x26, 0x15, 0x57, 0x00, 0x1c, 0x1b, 0x27, 0xa9, 0x38, 0x7e, 0xb6, 0x02, 0x01, 0xb8, 0x5f, 0x81,
+	0x16, 0x9a, 0x3c, 0x29, 0xba, 0xcf, 0xd5, 0x72, 0xbd, 0x53, 0xf2, 0xcf, 0x89, 0xbd, 0x37, 0xa1,
+	0x53, 0x72, 0x3b, 0xc5, 0xee, 0xb7, 0xa8, 0xdd, 0xff, 0xad, 0x03, 0xf3, 0xc4, 0x9a, 0x95, 0xe7,
+	0x46, 0xb9, 0x4a, 0x39, 0x40, 0x6e, 0x4b, 0x87, 0xac, 0x90, 0x8d, 0x10, 0x0c, 0x1a, 0xf8, 0xa3,
+	0x30, 0xee, 0xf7, 0x92, 0x42, 0x2e, 0x58, 0x05, 0xba, 0x37, 0x88, 0x0b, 0x66, 0x2f, 0x2c, 0x42,
+	0x69, 0x1b, 0xb7, 0xab, 0x86, 0x54, 0x7c, 0x22, 0x4d, 0x60, 0x56, 0x71, 0x3f, 0x80, 0x95, 0x93,
+	0x98, 0x65, 0x61, 0xd6, 0x3b, 0x89, 0x7b, 0x61, 0x9f, 0x37, 0x33, 0x73, 0x8e, 0x66, 0x2a, 0xb5,
+	0xfc, 0x8f, 0x61, 0xa3, 0x96, 0x94, 0x9f, 0x1e, 0x8e, 0x8f, 0xc2, 0x51, 0xbf, 0x90, 0x03, 0x57,
+	0x20, 0x0e, 0x7d, 0x78, 0x3c, 0x08, 0x7f, 0x20, 0x0a, 0xe5, 0xd0, 0x4b, 0x8c, 0xff, 0x33, 0x07,
+	0x16, 0xa8, 0x85, 0x77, 0xdf, 0x00, 0x88, 0x93, 0x82, 0x65, 0x47, 0x61, 0x4f, 0x1f, 0xad, 0x95,
+	0xee, 0xed, 0xab, 0x02, 0x69, 0xdf, 0x4b, 0x42, 0xf7, 0x2a, 0x34, 0x8b, 0xde, 0x50, 0xee, 0x48,
+	0x6a, 0x23, 0xb8, 0xdb, 0x1b, 0x22, 0x65, 0x80, 0x45, 0x78, 0x5e, 0x2a, 0x7a, 0xc3, 0xaf, 0x76,
+	0x9b, 0xb5, 0x24, 0xbc, 0xcc, 0xff, 0xe7, 0x06, 0xcc, 0x49, 0x0c, 0x9a, 0x67, 0x96, 0x17, 0xe1,
+	0x83, 0x3e, 0x77, 0x95, 0xc8, 0x71, 0x51, 0x14, 0x8e, 0x3a, 0x3f, 0x4b, 0x0e, 0x59, 0xa2, 0x06,
+	0xa6, 0x40, 0x59, 0x12, 0xb0, 0xde, 0xa9, 0x9a, 0x50, 0x09, 0xe2, 0x3e, 0x7c, 0x14, 0x27, 0xb8,
+	0xfc, 0x5f, 0x95, 0xda, 0xac, 0x61, 0x52, 0xf6, 0x9a, 0xd4, 0x69, 0x0d, 0x63, 0x19, 0x6e, 0x57,
+	0x08, 0xf0, 0xed, 0xab, 0x15, 0x68, 0x18, 0x95, 0xae, 0xd7, 0x4f, 0x73, 0xb1, 0xb1, 0xb7, 0x02,
+	0x01, 0xf0, 0xd3, 0x23, 0x7e, 0xf0, 0x2a, 0x6d, 0x5e, 0x52, 0x22, 0x90, 0xc3, 0x7e, 0x98, 0x17,
+	0xbb, 0xbd, 0x87, 0xdd, 0x8e, 0xe0, 0x50, 0x82, 0xb8, 0x08, 0xfb, 0x71, 0x5e, 0xb0, 0xa4, 0x0b,
+	0x62, 0x9b, 0x10, 0x10, 0xd6, 0xc0, 0xea, 0x78, 0xb1, 0x9b, 0x17, 0x35, 0x24, 0xe8, 0xff, 0xb4,
+	0x01, 0x4b, 0xe6, 0xd4, 0xd4, 0xae, 0xf8, 0x2e, 0xcc, 0x65, 0x8f, 0xf9, 0xde, 0xa0, 0xc4, 0x25,
+	0x41, 0x64, 0x35, 0x7b, 0x7c, 0x10, 0xf6, 0x1e, 0xb2, 0x22, 0x97, 0x02, 0x2b, 0x11, 0xfc, 0x18,
+	0xf9, 0xf8, 0x56, 0x96, 0xe1, 0x1d, 0x56, 0x8a, 0x4c, 0xc1, 0xa2, 0xe6, 0x5e, 0x96, 0x0e, 0x87,
+	0xf2, 0x98, 0xd8, 0x0a, 0x4a, 0x04, 0xf6, 0x58, 0xc8, 0x1e, 0x85, 0xcc, 0x14, 0x88, 0xf5, 0x0a,
+	0xdd, 0xa3, 0x10, 0x5b, 0xa7, 0xa0, 0x3d, 0x16, 0xaa, 0xc7, 0xb6, 0x14, 0x36, 0xe9, 0xb1, 0xd0,
+	0x3d, 0x76, 0x54, 0x4d, 0x89, 0xf0, 0x7f, 0xdb, 0x84, 0x39, 0x79, 0xfc, 0xe0, 0x37, 0x53, 0x86,
+	0x3b, 0x86, 0x3a, 0x06, 0x0a, 0x08, 0xa7, 0xab, 0x1f, 0x0f, 0x62, 0xa5, 0x34, 0x02, 0x28, 0x2d,
+	0x47, 0x93, 0x5a, 0x8e, 0x6d, 0xe8, 0x84, 0xa7, 0x61, 0xdc, 0x0f, 0x1f, 0xf4, 0x99, 0x1c, 0x7c,
+	0x89, 0x70, 0x5f, 0x80, 0x25, 0xbc, 0x41, 0xe7, 0x37, 0xd3, 0xc1, 0xb0, 0xcf, 0x0a, 0x2d, 0x02,
+	0x0b, 0x2b, 0x0e, 0xdb, 0x61, 0x94, 0x8b, 0xed, 0x42, 0xca, 0x82, 0xa2, 0x90, 0x42, 0x1b, 0xf2,
+	0x30, 0x92, 0x12, 0xa1, 0x28, 0x75, 0x7b, 0xd7, 0x17, 0xa2, 0x56, 0xa0, 0x61, 0xf4, 0x1b, 0x3d,
+	0xca, 0xe2, 0x82, 0x11, 0x46, 0x84, 0x64, 0x6c, 0xb4, 0xeb, 0xc3, 0x82, 0x40, 0x49, 0x56, 0x84,
+	0x8a, 0x19, 0x38, 0x1c, 0x95, 0xec, 0xf8, 0x93, 0x2c, 0x2e, 0x50, 0x11, 0x85, 0xbe, 0x59, 0x58,
+	0x94, 0x0d, 0xaf, 0xc7, 0x59, 0x5a, 0x10, 0xb2, 0xd1, 0x08, 0xec, 0x29, 0x4e, 0xf7, 0x93, 0x83,
+	0x2c, 0x3d, 0xce, 0x58, 0x8e, 0x6e, 0x1d, 0xde, 0x13, 0xc5, 0xe1, 0x0c, 0x89, 0x0d, 0xb0, 0xbb,
+	0x24, 0x54, 0x5d, 0x40, 0xc8, 0xc1, 0x23, 0x16, 0x1f, 0x9f, 0x14, 0x2c, 0xda, 0x17, 0xe5, 0xcb,
+	0x82, 0x03, 0x13, 0xeb, 0xff, 0x7d, 0x83, 0x38, 0x4f, 0xe5, 0xac, 0x5b, 0x87, 0x7c, 0xa7, 0x7a,
+	0xc8, 0x97, 0x27, 0xec, 0xc6, 0x79, 0x4e, 0xd8, 0xcd, 0x73, 0x9f, 0xb0, 0x5b, 0x4f, 0x72, 0xc2,
+	0x9e, 0x79, 0xe2, 0x13, 0xf6, 0xec, 0x93, 0x9d, 0xb0, 0xe7, 0xac, 0x13, 0xb6, 0xbf, 0x07, 0x4b,
+	0xf2, 0xc2, 0x1c, 0xb0, 0xdf, 0x1f, 0xb1, 0xbc, 0x18, 0x73, 0x6f, 0xde, 0x86, 0x0e, 0x1a, 0x8b,
+	0x7c, 0x18, 0x6a, 0x4f, 0x4f, 0x89, 0xf0, 0xdf, 0x81, 0x65, 0xdd, 0x4a, 0x3e, 0x4c, 0x93, 0x1c,
+	0x75, 0x6f, 0x6e, 0x28, 0x50, 0xf2, 0xb8, 0x4d, 0x6e, 0xc2, 0x9c, 0x50, 0x15, 0xfb, 0xef, 0xc1,
+	0xca, 0x41, 0x1a, 0xdd, 0x7a, 0x8c, 0xfe, 0xc1, 0x8b, 0x30, 0xf1, 0x2e, 0xac, 0x92, 0x76, 0x0c,
+	0x36, 0xf0, 0xea, 0x6d, 0xb1, 0x71, 0x2f, 0x47, 0xbf, 0x64, 0x14, 0xa8, 0x62, 0xff, 0x5b, 0x5c,
+	0x12, 0x1f, 0xc6, 0xf9, 0x14, 0x26, 0xd0, 0x9d, 0x33, 0xd0, 0xd7, 0x44, 0xfe, 0x6d, 0x32, 0xd6,
+	0xb4, 0x19, 0xfb, 0xab, 0x06, 0x2c, 0xea, 0xa6, 0xf3, 0x51, 0x7f, 0x5c, 0xcb, 0xe4, 0xae, 0xdf,
+	0x30, 0xee, 0xfa, 0xba, 0xcf, 0x26, 0xe9, 0x73, 0xd3, 0x70, 0x5c, 0x97, 0x37, 0xca, 0xc9, 0xde,
+	0x89, 0xb7, 0xf4, 0x0d, 0x5c, 0x68, 0xce, 0xd5, 0x72, 0x56, 0x4a, 0xfe, 0x6a, 0xdd, 0x58, 0xc6,
+	0x18, 0xe7, 0xac, 0x31, 0x5e, 0xe4, 0x8e, 0xbe, 0x0b, 0xcb, 0x65, 0xef, 0x62, 0xd6, 0x76, 0xb8,
+	0x24, 0x10, 0xd5, 0x75, 0x0c, 0x97, 0xb2, 0xc1, 0x66, 0xa0, 0x88, 0xfc, 0x4f, 0x79, 0x13, 0x9f,
+	0x84, 0x45, 0xef, 0x44, 0x4d, 0xde, 0x35, 0x58, 0xce, 0x98, 0x38, 0xa7, 0x2a, 0xcf, 0x8e, 0x38,
+	0x2a, 0xd8, 0xe8, 0x29, 0x5a, 0xf5, 0x1b, 0x07, 0x96, 0x54, 0xdb, 0x77, 0x1e, 0xfc, 0x80, 0xf5,
+	0x0a, 0xf7, 0x05, 0x68, 0x0e, 0xd3, 0x48, 0xea, 0x53, 0x3d, 0x67, 0x48, 0xe0, 0xbe, 0x5d, 0xe3,
+	0x5e, 0xf4, 0xec, 0x8b, 0x2b, 0xa9, 0x44, 0xa8, 0xd1, 0xfb, 0x8d, 0x7a, 0x3c, 0x08, 0x87, 0xc3,
+	0x38, 0x39, 0x56, 0xee, 0x46, 0x57, 0x77, 0x96, 0x15, 0xb7, 0x45, 0x51, 0x60, 0xd0, 0xb9, 0x3b,
+	0xd0, 0xce, 0xc5, 0x7d, 0x28, 0xef, 0xb6, 0x8c, 0x3a, 0xa8, 0xf0, 0xf2, 0xaa, 0x14, 0x68, 0x1a,
+	0xff, 0xef, 0x84, 0x6e, 0xf2, 0xe1, 0xdd, 0x3a, 0x65, 0x22, 0x74, 0x87, 0x15, 0xd4, 0x11, 0xa1,
+	0x90, 0x4e, 0xe6, 0x8a, 0xd7, 0xac, 0x46, 0xc0, 0xcd, 0x7a, 0x01, 0xbf, 0x28, 0xe4, 0x25, 0x2c,
+	0xe1, 0x46, 0x29, 0x2f, 0x22, 0x53, 0x21, 0xb0, 0xb7, 0x68, 0xbc, 0x47, 0xd8, 0xc2, 0x49, 0xf2,
+	0x2a, 0x89, 0xdd, 0x57, 0xa1, 0x9d, 0x27, 0xe1, 0x30, 0x3f, 0x49, 0x0b, 0xeb, 0x72, 0x66, 0xf5,
+	0xa3, 0xc9, 0xdc, 0x2f, 0x4a, 0xc7, 0xb9, 0xf0, 0xc0, 0x75, 0x25, 0xf9, 0x61, 0x91, 0xe2, 0x15,
+	0xff, 0x20, 0x4d, 0xfb, 0xd2, 0x13, 0xc7, 0xa9, 0xfc, 0x5f, 0x39, 0xb0, 0x5a, 0x29, 0x1b, 0x17,
+	0xb9, 0x8d, 0xc2, 0x22, 0xbc, 0x97, 0xb3, 0x48, 0xc6, 0xa0, 0x34, 0x8c, 0xaa, 0x86, 0xdf, 0x77,
+	0xb9, 0xc3, 0xa0, 0x29, 0xd6, 0xa6, 0x46, 0xe0, 0xd6, 0x38, 0x60, 0x45, 0xa8, 0x6b, 0xb7, 0x38,
+	0x81, 0x81, 0xc3, 0xd8, 0xaf, 0x82, 0x45, 0x2b, 0x62, 0x85, 0x9b, 0x48, 0x7e, 0x94, 0x61, 0xa7,
+	0xac, 0x2f, 0x9d, 0x4d, 0x02, 0xf0, 0xbf, 0x0b, 0xeb, 0x96, 0x18, 0x3f, 0x5b, 0x3b, 0xf7, 0x33,
+	0x07, 0xd6, 0x6a, 0xe6, 0xe9, 0x1c, 0x5b, 0x2f, 0x8d, 0x68, 0x13, 0xfb, 0x67, 0x22, 0xc7, 0x78,
+	0xe1, 0xc6, 0xd8, 0x41, 0xff, 0x53, 0xd8, 0xb0, 0x99, 0x11, 0xc6, 0xe5, 0x1b, 0xa4, 0x33, 0x62,
+	0x62, 0x26, 0x69, 0x9a, 0x59, 0xc1, 0x0f, 0x88, 0x20, 0xe9, 0xd6, 0xb9, 0x6d, 0xc7, 0x2b, 0x3b,
+	0x56, 0x74, 0x72, 0x82, 0x9d, 0x39, 0x84, 0x0d, 0xab, 0x4d, 0xc9, 0xee, 0xdb, 0x84, 0x5d, 0xb2,
+	0x9d, 0x56, 0x82, 0x6c, 0xbc, 0x92, 0x49, 0xea, 0x5f, 0x83, 0x15, 0xed, 0x5a, 0x25, 0xb3, 0x2d,
+	0xc2, 0x4b, 0x0e, 0x09, 0x2f, 0xf9, 0x37, 0x61, 0x95, 0x50, 0x6a, 0x33, 0xdc, 0x89, 0x15, 0xd2,
+	0x8a, 0xbf, 0x96, 0xc4, 0x25, 0x89, 0xff, 0x12, 0x2c, 0x2b, 0x67, 0xab, 0xea, 0x6d, 0x8c, 0xcf,
+	0xd5, 0xff, 0x3a, 0xac, 0x94, 0xa4, 0xb2, 0xbb, 0x97, 0xd1, 0x17, 0x2a, 0x70, 0x96, 0x8b, 0x4e,
+	0x93, 0x6a, 0x02, 0x8c, 0xdd, 0x2f, 0xdc, 0xbf, 0x4d, 0xb4, 0x4c, 0xe9, 0xab, 0x43, 0xf4, 0x55,
+	0x6b, 0x4c, 0xa3, 0x5e, 0x63, 0x9a, 0xc6, 0xce, 0xb9, 0x22, 0x0e, 0x80, 0x22, 0x4e, 0xd3, 0x9c,
+	0x10, 0xa6, 0x31, 0xf7, 0xd8, 0x59, 0x6b, 0x8f, 0xf5, 0x3f, 0x81, 0x45, 0xc5, 0xd9, 0x67, 0xbb,
+	0xc0, 0xde, 0x85, 0x25, 0x3d, 0x64, 0x25, 0xb2, 0xd9, 0xd3, 0x01, 0x51, 0x62, 0x75, 0x74, 0xa4,
+	0x92, 0x09, 0x24, 0x89, 0xff, 0x1d, 0xa9, 0x0d, 0x94, 0x35, 0x1e, 0x51, 0xe9, 0x17, 0x2c, 0xdb,
+	0xc5, 0xa0, 0xae, 0xa3, 0x22, 0x2a, 0x0a, 0xc3, 0xc3, 0x8e, 0x1c, 0x52, 0xe1, 0x3d, 0x01, 0xa1,
+	0xac, 0xc2, 0x7e, 0x5f, 0x66, 0x6a, 0xe0, 0xa7, 0xd6, 0x20, 0x6b, 0x23, 0xef, 0xc4, 0x0a, 0x69,
+	0xc5, 0xe3, 0x6c, 0x0d, 0xe2, 0x2c, 0x7e, 0x0c, 0xcb, 0xf7, 0x6f, 0xdf, 0xe4, 0x92, 0x54, 0x1c,
+	0xae, 0x94, 0x8e, 0xef, 0xca, 0xac, 0x34, 0x8c, 0x59, 0x59, 0x87, 0x99, 0x30, 0x3f, 0x4b, 0x7a,
+	0x92, 0x2b, 0x01, 0xf8, 0x2f, 0xc0, 0x4a, 0xd9, 0xa4, 0x64, 0xab, 0x46, 0x57, 0xfc, 0xe7, 0xb1,
+	0xeb, 0x80, 0x0d, 0xd2, 0x53, 0xdd, 0x75, 0x1d, 0xd9, 0xd7, 0x60, 0xa5, 0x24, 0x2b, 0x9b, 0xeb,
+	0x95, 0x49, 0x23, 0xfc, 0x9b, 0x5f, 0xfe, 0xc3, 0x51, 0xae, 0xcf, 0x3b, 0x1c, 0xf0, 0xff, 0xcc,
+	0x81, 0x55, 0xdc, 0x88, 0x6f, 0xda, 0xa9, 0x3a, 0x3a, 0xd9, 0xc7, 0x99, 0x96, 0xec, 0xd3, 0xa8,
+	0x4b, 0xf6, 0xe1, 0xf7, 0x44, 0xbe, 0xa5, 0x93, 0x84, 0x20, 0x8a, 0x9a, 0x94, 0x0e, 0xe4, 0xff,
+	0xd4, 0x81, 0x35, 0xe4, 0x4a, 0xc6, 0x47, 0xd9, 0x11, 0xcb, 0x58, 0xd2, 0xe3, 0xe3, 0x1a, 0x62,
+	0xb2, 0x8e, 0x1c, 0x3f, 0x7e, 0xa3, 0xf0, 0x45, 0xf8, 0x54, 0x29, 0x84, 0x80, 0x26, 0xe5, 0xef,
+	0xb8, 0x2f, 0xe1, 0x8d, 0xbb, 0x08, 0xe3, 0x7e, 0xb7, 0x65, 0xdc, 0x9b, 0x48, 0x9f, 0x92, 0xc0,
+	0xff, 0xa5, 0x14, 0xd0, 0x7b, 0x71, 0x7f, 0x0a, 0x23, 0xdc, 0x2b, 0xd3, 0x67, 0x49, 0xb9, 0x5d,
+	0x68, 0x98, 0xd3, 0xb3, 0x6c, 0xa0, 0xce, 0xcb, 0xf8, 0xad, 0x5d, 0xef, 0x2d, 0x12, 0x14, 0x5f,
+	0x87, 0x99, 0xe3, 0x2c, 0x1d, 0x0d, 0x65, 0xa4, 0x5c, 0x00, 0xee, 0x8b, 0x9a, 0xdd, 0x59, 0xc3,
+	0x0a, 0x69, 0xbe, 0x14, 0xb3, 0xdf, 0x87, 0x36, 0xe2, 0xf0, 0xaf, 0xf6, 0x28, 0xa0, 0x9b, 0x6f,
+	0xd0, 0xe6, 0xaf, 0xc3, 0x4a, 0x18, 0x45, 0x71, 0x11, 0xa7, 0x49, 0xd8, 0x7f, 0x1f, 0x51, 0x2a,
+	0x0c, 0x57, 0xc1, 0xfb, 0x7b, 0x30, 0x7b, 0x4f, 0xf8, 0x21, 0x5c, 0x68, 0x7d, 0x44, 0xda, 0x57,
+	0xd7, 0x82, 0x0f, 0xc2, 0x2c, 0x92, 0x0e, 0x0b, 0xfe, 0x8d, 0xb8, 0xc3, 0xf4, 0x48, 0x39, 0x2c,
+	0xf9, 0xb7, 0xff, 0xeb, 0x59, 0x58, 0x34, 0xb4, 0x6e, 0x1c, 0xb7, 0x35, 0x79, 0x07, 0x5d, 0x98,
+	0xc3, 0x6b, 0x67, 0x14, 0xab, 0x40, 0xbe, 0x02, 0x51, 0x33, 0x33, 0xc6, 0xa3, 0xbb, 0x32, 0x27,
+	0x45, 0x48, 0xd6, 0x44, 0xd6, 0x84, 0xc2, 0xde, 0xe2, 0xf1, 0x8e, 0x5e, 0xd1, 0xb7, 0xae, 0x20,
+	0x06, 0x87, 0x3b, 0x87, 0x9c, 0x44, 0x5e, 0x41, 0x04, 0xbd, 0xfb, 0x12, 0xb4, 0x58, 0x72, 0x9a,
+	0x77, 0xe7, 0x26, 0x25, 0x8f, 0x70, 0x12, 0x1a, 0x9b, 0x6b, 0x9b, 0xb1, 0xb9, 0x2b, 0x00, 0x0c,
+	0x5b, 0x1d, 0xa6, 0x71, 0x52, 0xc8, 0xf4, 0x16, 0x82, 0x71, 0x77, 0x54, 0x32, 0x0b, 0x5c, 0x6d,
+	0x92, 0x83, 0x61, 0x65, 0xd5, 0xaa, 0x84, 0x96, 0xd7, 0xcb, 0x84, 0x83, 0x79, 0xe3, 0x20, 0x51,
+	0xb3, 0xa2, 0xca, 0xd4, 0x83, 0x1d, 0x98, 0xe1, 0x77, 0xf4, 0xee, 0x42, 0xa5, 0x17, 0x43, 0xf5,
+	0x03, 0x41, 0xe6, 0x7e, 0x5e, 0x6a, 0xef, 0x62, 0x45, 0x23, 0xf1, 0x4f, 0xaa, 0xf3, 0x5b, 0x56,
+	0xea, 0x4b, 0xbd, 0x64, 0xeb, 0x2e, 0x77, 0x22, 0x7c, 0xbc, 0xac, 0xc3, 0xc7, 0x57, 0x00, 0x0e,
+	0x8b, 0x74, 0x78, 0x18, 0x1f, 0x27, 0x61, 0xbf, 0xbb, 0xca, 0xf1, 0x04, 0xe3, 0xbe, 0x08, 0x73,
+	0x23, 0xae, 0x97, 0x79, 0xd7, 0xe5, 0x5d, 0x2d, 0xaa, 0xae, 0x38, 0x36, 0x50, 0xa5, 0xdc, 0x9f,
+	0x99, 0x1e, 0xf3, 0x94, 0xbf, 0x35, 0xa1, 0x3e, 0x12, 0x34, 0x0c, 0xc6, 0xba, 0x65, 0x30, 0xb8,
+	0xf1, 0xec, 0x9d, 0xb0, 0xee, 0x86, 0x32, 0x9e, 0xbd, 0x13, 0x66, 0xe5, 0xe8, 0x6c, 0xd6, 0xe5,
+	0xe8, 0x10, 0xad, 0x79, 0x92, 0x3b, 0xe8, 0x45, 0xae, 0xaf, 0x6f, 0xc3, 0x02, 0x9f, 0x02, 0x79,
+	0xe9, 0xd1, 0xe9, 0x1e, 0x4e, 0x6d, 0xba, 0x87, 0xb1, 0x63, 0xf9, 0x47, 0xd0, 0x56, 0x33, 0x3e,
+	0xee, 0x2e, 0xc1, 0x92, 0x5e, 0x1a, 0xa1, 0x73, 0x57, 0xda, 0x38, 0x05, 0x23, 0x8f, 0xa3, 0x2c,
+	0x96, 0x8b, 0x12, 0x3f, 0x85, 0xce, 0x27, 0x05, 0x4b, 0x54, 0xbe, 0xa1, 0x02, 0x71, 0xe7, 0x2f,
+	0xb5, 0xf1, 0xce, 0x10, 0x4d, 0x8c, 0xb6, 0x87, 0x4e, 0x7d, 0x92, 0x50, 0xa3, 0x92, 0x24, 0xa4,
+	0x13, 0x96, 0x9a, 0x66, 0xc2, 0x92, 0xff, 0x93, 0x26, 0x40, 0xd9, 0xfc, 0x93, 0x66, 0x09, 0x1d,
+	0xa5, 0xd9, 0x20, 0x2c, 0x74, 0x56, 0x13, 0x87, 0xdc, 0x2f, 0xc3, 0x6c, 0xca, 0xd9, 0x94, 0x3b,
+	0xc6, 0x56, 0x65, 0x4d, 0x89, 0x51, 0x04, 0x92, 0x8c, 0x37, 0x94, 0x23, 0x8d, 0xca, 0x68, 0x15,
+	0x50, 0xa9, 0x49, 0xb3, 0x54, 0x93, 0x54, 0x6a, 0xd1, 0x1c, 0x49, 0x2d, 0x7a, 0x07, 0xed, 0x42,
+	0x2f, 0x3b, 0x13, 0xdd, 0xb6, 0x79, 0xb7, 0x97, 0x2b, 0xdd, 0xde, 0xd2, 0x24, 0x01, 0x21, 0x77,
+	0x77, 0x01, 0x44, 0x7e, 0xd3, 0x9d, 0x61, 0x21, 0x72, 0xe6, 0xe6, 0x5f, 0x7b, 0xae, 0x52, 0x79,
+	0x67, 0x4f, 0xd3, 0x88, 0xe5, 0x47, 0x2a, 0x79, 0xef, 0xc2, 0xb2, 0x55, 0xfc, 0x44, 0x6a, 0xf8,
+	0x7d, 0x58, 0xaf, 0xe3, 0x12, 0x05, 0xf3, 0x90, 0x9d, 0x05, 0xec, 0x48, 0x1d, 0xc0, 0x05, 0x84,
+	0xf8, 0x5e, 0x3c, 0x3c, 0x29, 0x0f, 0x76, 0x02, 0xa2, 0xe9, 0x1d, 0x62, 0x1b, 0x57, 0xa0, 0xff,
+	0xd7, 0x8e, 0xd8, 0x45, 0x74, 0x48, 0x01, 0xdb, 0x78, 0x90, 0xc5, 0x91, 0xbe, 0x4b, 0x48, 0x88,
+	0x5b, 0x13, 0xb5, 0xe9, 0x35, 0xe2, 0x21, 0xd2, 0xc5, 0x47, 0x5c, 0x27, 0xe4, 0x2c, 0x0b, 0x08,
+	0xc7, 0x37, 0x08, 0x7b, 0x52, 0x59, 0xf1, 0x93, 0x63, 0x8a, 0x91, 0x74, 0x97, 0xe3, 0x27, 0xf2,
+	0x73, 0x1c, 0x16, 0xec, 0x51, 0x78, 0xa6, 0xf2, 0xd6, 0x24, 0x28, 0x6d, 0x56, 0xa4, 0x6c, 0x96,
+	0xff, 0x01, 0xb8, 0xc4, 0xc7, 0x71, 0x03, 0x63, 0x06, 0x49, 0x44, 0x12, 0x98, 0x1c, 0x23, 0x81,
+	0x69, 0x42, 0x82, 0xb5, 0xff, 0x97, 0x0e, 0xcc, 0x93, 0xa6, 0x78, 0x5a, 0x93, 0xf8, 0xd4, 0xcd,
+	0x94, 0x08, 0xe3, 0x64, 0xd5, 0xb0, 0x12, 0xad, 0xa7, 0x9f, 0xcb, 0xbe, 0x0c, 0x33, 0xd8, 0xaf,
+	0xf2, 0xd6, 0x5c, 0xaa, 0x7a, 0x6b, 0xe4, 0x48, 0x02, 0x41, 0xe7, 0xff, 0xb9, 0x03, 0x0b, 0xe8,
+	0x6c, 0x4a, 0x8f, 0x6f, 0xa6, 0xc9, 0x51, 0x7c, 0x5c, 0xeb, 0xb0, 0x79, 0x13, 0x66, 0x7b, 0xbc,
+	0xb4, 0xdb, 0x30, 0xe2, 0xad, 0xb4, 0xe2, 0x8e, 0xf8, 0x27, 0x37, 0x02, 0x41, 0x8e, 0x86, 0x90,
+	0xa0, 0x9f, 0x48, 0x03, 0x1f, 0xc2, 0x3c, 0xf1, 0x4b, 0x55, 0x0f, 0xae, 0x8e, 0x75, 0xa7, 0xaf,
+	0x1c, 0x7d, 0xa5, 0xf0, 0x14, 0x6c, 0x08, 0xb6, 0x69, 0x1d, 0x59, 0x13, 0x58, 0x3f, 0x28, 0xfd,
+	0x5e, 0x9f, 0x9c, 0xc4, 0x05, 0xbf, 0x40, 0xe0, 0xe1, 0x8a, 0x47, 0x1f, 0x93, 0xb0, 0x2f, 0xdd,
+	0xe7, 0x2a, 0xc1, 0xb2, 0x82, 0x47, 0x5a, 0xf6, 0xd8, 0xa2, 0x6d, 0x08, 0x5a, 0x1b, 0xef, 0xff,
+	0xd3, 0x2c, 0xcc, 0x49, 0x97, 0x71, 0x5d, 0xae, 0x15, 0xf2, 0x4c, 0x4f, 0xa2, 0x0a, 0xd6, 0x93,
+	0xd3, 0x24, 0x93, 0xf3, 0xb4, 0x07, 0xa7, 0xd7, 0x2c, 0xdf, 0xad, 0x67, 0xba, 0xb2, 0x6b, 0x37,
+	0xf6, 0x2f, 0xe3, 0x2e, 0x2b, 0x4d, 0xef, 0x9c, 0x11, 0x5d, 0xa0, 0x9b, 0x56, 0xa0, 0x89, 0xdc,
+	0xe7, 0xa1, 0xd9, 0x4f, 0x8f, 0xbb, 0x6d, 0x83, 0x96, 0xaa, 0x4d, 0x80, 0xe5, 0xc8, 0x5d, 0x94,
+	0xa8, 0xec, 0x60, 0xfc, 0x74, 0x5f, 0x37, 0xbc, 0x9d, 0x60, 0xb8, 0x6d, 0x8d, 0x03, 0x88, 0xe1,
+	0xe7, 0x7c, 0x5e, 0x9d, 0x83, 0xc4, 0xd9, 0xa9, 0x72, 0xd4, 0x16, 0xa5, 0xee, 0xcb, 0xe5, 0x21,
+	0x4b, 0x1c, 0x98, 0x6a, 0xae, 0x10, 0x8a, 0x02, 0x39, 0x21, 0xa1, 0xea, 0xc5, 0x0a, 0x27, 0xda,
+	0x80, 0x19, 0x91, 0x6a, 0xea, 0x39, 0x5d, 0x9a, 0xee, 0x39, 0x75, 0x3f, 0x86, 0x8d, 0x61, 0x8d,
+	0x06, 0xe6, 0xdd, 0x65, 0x63, 0xeb, 0xa8, 0xd3, 0xd2, 0xa0, 0xbe, 0x66, 0xc5, 0xe9, 0xbb, 0x72,
+	0x4e, 0xa7, 0xef, 0x15, 0x80, 0x28, 0xc9, 0xc5, 0x8e, 0x98, 0x77, 0x57, 0xc5, 0x91, 0xb6, 0xc4,
+	0x70, 0xb7, 0x63, 0x92, 0x1f, 0x32, 0x4c, 0x1a, 0xe0, 0xe7, 0xb5, 0x4e, 0x50, 0x22, 0x4c, 0x9f,
+	0xc3, 0xda, 0x67, 0xe8, 0xd8, 0x7f, 0xcc, 0x03, 0x3b, 0xe6, 0x6d, 0xfe, 0xdc, 0xf1, 0x98, 0x31,
+	0xbe, 0x9b, 0x2f, 0xc0, 0xe2, 0xc3, 0xd1, 0x03, 0x96, 0x25, 0xac, 0x60, 0xf9, 0x41, 0x2a, 0x36,
+	0xa9, 0x85, 0xc0, 0x44, 0xfa, 0xb7, 0x60, 0x95, 0xf4, 0x2c, 0x6f, 0xe9, 0xf5, 0x5e, 0x18, 0x0f,
+	0xda, 0x8f, 0xc2, 0x2c, 0xe1, 0xf2, 0x16, 0x8b, 0x5f, 0xc3, 0x32, 0x32, 0x65, 0xfa, 0x04, 0x9e,
+	0x3e, 0x32, 0xf5, 0xd4, 0x4e, 0x83, 0xff, 0x74, 0xa8, 0xe3, 0x36, 0x3d, 0xce, 0xcf, 0xe7, 0x6f,
+	0xe4, 0x67, 0xab, 0x7e, 0x3f, 0x7d, 0xc4, 0x5b, 0x6b, 0x07, 0x12, 0x42, 0x6d, 0xd1, 0xf1, 0xbf,
+	0x5c, 0x6e, 0xf2, 0x04, 0xc3, 0x4d, 0x96, 0xba, 0xab, 0xa3, 0xc9, 0x0a, 0x63, 0xee, 0x50, 0xce,
+	0xe3, 0xa4, 0xa7, 0x4e, 0x57, 0x02, 0x10, 0x8e, 0xb4, 0x28, 0x1d, 0x09, 0x1f, 0x58, 0x3b, 0x90,
+	0x90, 0xc4, 0xb3, 0x2c, 0x93, 0xf9, 0xe4, 0x12, 0x32, 0xa5, 0xd4, 0xb6, 0xa5, 0xf4, 0x12, 0x6c,
+	0x58, 0xa3, 0x94, 0x92, 0x5a, 0x11, 0x26, 0xc9, 0xe1, 0x33, 0x8d, 0x9f, 0x78, 0xe6, 0x16, 0x67,
+	0xa5, 0x09, 0x7e, 0xf8, 0xd2, 0xcb, 0xd7, 0x30, 0xfc, 0xc2, 0x8b, 0x30, 0x4f, 0x1c, 0x94, 0xfe,
+	0xcf, 0x9a, 0xb0, 0x60, 0x78, 0x21, 0x97, 0xa0, 0xa1, 0x67, 0xb7, 0xb1, 0xbf, 0x87, 0xe2, 0x32,
+	0xa2, 0x38, 0x38, 0x5b, 0x04, 0x83, 0xfd, 0xf0, 0x9b, 0x72, 0x2e, 0x77, 0x77, 0x09, 0x91, 0x04,
+	0xf8, 0x96, 0x91, 0x00, 0xff, 0x25, 0x98, 0x8b, 0x24, 0x63, 0x33, 0x86, 0xcf, 0x8e, 0x8e, 0x28,
+	0x50, 0x34, 0xa8, 0xf0, 0x51, 0xda, 0x7b, 0xc8, 0xb2, 0x20, 0x4d, 0x8b, 0xf2, 0x51, 0x87, 0x89,
+	0x74, 0x77, 0xc0, 0x8d, 0x93, 0x88, 0x3d, 0x46, 0x33, 0xc5, 0xb2, 0xdd, 0x28, 0xe2, 0xb1, 0x75,
+	0x11, 0xa5, 0xab, 0x29, 0xc1, 0xe0, 0x0d, 0x7a, 0x52, 0x47, 0x68, 0x1f, 0x44, 0xbf, 0x72, 0x3e,
+	0x6c, 0x34, 0x3f, 0xf8, 0xb3, 0x81, 0x88, 0x35, 0x74, 0x44, 0x38, 0x43, 0xc1, 0xe2, 0xed, 0x41,
+	0x94, 0xf3, 0x6c, 0x81, 0x66, 0xc0, 0xbf, 0xb1, 0xe5, 0x74, 0xc8, 0xb2, 0x90, 0x3f, 0x22, 0x12,
+	0x31, 0xea, 0x79, 0xd1, 0xb2, 0x85, 0xd6, 0x93, 0xb6, 0x50, 0x4e, 0x9a, 0xff, 0x23, 0x58, 0x45,
+	0x5f, 0xaf, 0x69, 0x33, 0xa6, 0xc7, 0x0f, 0xc8, 0x6d, 0xbf, 0x51, 0x9b, 0x89, 0xdb, 0x2c, 0x77,
+	0x51, 0x43, 0x09, 0x5b, 0xb6, 0x12, 0x7e, 0x11, 0x5c, 0xca, 0x80, 0xd4, 0x89, 0x71, 0x5e, 0xec,
+	0x3f, 0x74, 0x84, 0x1b, 0xfb, 0x10, 0xf7, 0xed, 0xf3, 0xb3, 0x5b, 0x36, 0xd7, 0xa0, 0xcd, 0xf1,
+	0x55, 0x56, 0x44, 0x71, 0x22, 0x8d, 0x9a, 0x00, 0xa6, 0x30, 0xfc, 0x32, 0xac, 0x12, 0x0e, 0x4a,
+	0x7e, 0xe5, 0xc2, 0x14, 0x8b, 0x46, 0x42, 0x7e, 0x0e, 0x8b, 0x48, 0x7c, 0xff, 0xb6, 0xe2, 0x75,
+	0x6c, 0x20, 0x7a, 0x8c, 0x38, 0x9f, 0x86, 0xc3, 0x3d, 0x58, 0x52, 0x9d, 0x4e, 0x66, 0xcf, 0x48,
+	0x86, 0x6e, 0x58, 0xc9, 0xd0, 0x7f, 0xe4, 0xc8, 0x81, 0x72, 0x07, 0xc4, 0xc5, 0x65, 0x8d, 0x3c,
+	0xf0, 0xa6, 0x64, 0x1c, 0x4e, 0x42, 0x53, 0xc6, 0xb2, 0x0e, 0x2e, 0x65, 0x42, 0x8c, 0xc7, 0xbf,
+	0xc5, 0xc3, 0xcf, 0x86, 0x12, 0x3c, 0xcd, 0x36, 0xe1, 0xc2, 0x4a, 0xd9, 0x8c, 0x6c, 0xfa, 0x47,
+	0x30, 0x8f, 0x79, 0x61, 0xe7, 0x8e, 0x30, 0x0d, 0xb3, 0xb4, 0xc7, 0xf2, 0x7c, 0x5f, 0xc5, 0x6a,
+	0x4b, 0x04, 0x8e, 0x38, 0x49, 0x3f, 0x08, 0x93, 0x63, 0xb9, 0x1a, 0x24, 0x34, 0x65, 0xc4, 0xd7,
+	0x61, 0x41, 0x30, 0x20, 0xe7, 0x6e, 0xc2, 0x23, 0x49, 0xbf, 0x07, 0x8b, 0xbb, 0x45, 0x11, 0xf6,
+	0x4e, 0x6e, 0xcb, 0x57, 0x25, 0xd3, 0xa7, 0xc7, 0x85, 0x16, 0x86, 0x2d, 0x39, 0xb7, 0x0b, 0x01,
+	0xff, 0x9e, 0x12, 0x04, 0xf9, 0xa9, 0x03, 0x9b, 0x7a, 0x9f, 0x30, 0x0d, 0x05, 0x0d, 0x95, 0x91,
+	0x23, 0x46, 0xfd, 0x29, 0xd4, 0x24, 0x1d, 0x73, 0xdc, 0x98, 0xcc, 0xca, 0x3b, 0xb0, 0x55, 0xe1,
+	0x44, 0x8a, 0x69, 0xea, 0xc8, 0xfd, 0x6f, 0x91, 0xed, 0xce, 0x50, 0x9d, 0xe7, 0x60, 0x41, 0xd3,
+	0x7d, 0x2f, 0x8e, 0xaa, 0x75, 0xa3, 0x29, 0x7a, 0xd4, 0x85, 0x4d, 0xbb, 0x65, 0xa9, 0x4d, 0x7f,
+	0x4a, 0x65, 0x17, 0x70, 0x5f, 0xb9, 0xea, 0xf5, 0x3a, 0xac, 0xa4, 0xfd, 0xe8, 0xa6, 0x11, 0x85,
+	0x15, 0x3d, 0x57, 0xf0, 0x48, 0x9b, 0xb0, 0x47, 0x37, 0x6b, 0x22, 0xb6, 0x15, 0xfc, 0x14, 0x09,
+	0x5e, 0x82, 0xad, 0x0a, 0x3f, 0x92, 0xd7, 0xdf, 0x18, 0xf3, 0x9c, 0x0e, 0x06, 0xe7, 0x5d, 0x05,
+	0x2e, 0xb4, 0x32, 0x36, 0x4c, 0x55, 0x5c, 0x0d, 0xbf, 0xf9, 0x36, 0x10, 0x1e, 0x2b, 0x67, 0x59,
+	0x11, 0x1e, 0xe3, 0x6a, 0x08, 0x47, 0xc5, 0x49, 0xaa, 0xb7, 0x67, 0x01, 0xd1, 0x27, 0x52, 0x33,
+	0xe6, 0x13, 0x29, 0xb4, 0x8a, 0x27, 0x61, 0x72, 0xcc, 0xc4, 0xfd, 0xab, 0x13, 0x28, 0x90, 0xeb,
+	0x0e, 0x3f, 0xb6, 0x89, 0xe3, 0x8e, 0x00, 0xa6, 0x9e, 0x76, 0xb6, 0x2a, 0xa3, 0x2b, 0x4f, 0x20,
+	0xf4, 0x7e, 0xe9, 0x7f, 0x6a, 0x4c, 0x1a, 0x3d, 0x8c, 0x5e, 0x58, 0x55, 0x4c, 0xf9, 0xd3, 0xf3,
+	0x29, 0x3e, 0x9d, 0x81, 0xdd, 0x51, 0x71, 0x22, 0xbd, 0x0c, 0x1e, 0xb4, 0x47, 0x39, 0xde, 0x89,
+	0xb5, 0x5e, 0x68, 0x58, 0x3c, 0x36, 0xca, 0xf3, 0x47, 0x69, 0x16, 0x95, 0x8f, 0x8d, 0x04, 0x8c,
+	0xb3, 0x81, 0x92, 0x55, 0x17, 0x60, 0xfc, 0x46, 0x79, 0xb1, 0x41, 0x79, 0xc4, 0x14, 0x00, 0x9e,
+	0x74, 0x72, 0x7e, 0x48, 0x09, 0xe5, 0xf1, 0x45, 0xc8, 0xdf, 0x44, 0x8a, 0xcb, 0xf3, 0x71, 0x9c,
+	0x17, 0xd9, 0x59, 0x91, 0x3e, 0x64, 0x89, 0x3a, 0x0f, 0x19, 0x48, 0x3f, 0x94, 0xa1, 0x4e, 0x7c,
+	0x1a, 0x3b, 0x31, 0xf0, 0xad, 0x34, 0xa3, 0x51, 0x6a, 0xc6, 0xf3, 0x84, 0xe3, 0xf2, 0xa2, 0x59,
+	0x8a, 0x42, 0x0c, 0xc2, 0x7f, 0x11, 0x56, 0x49, 0x17, 0xe5, 0xa1, 0x9e, 0x9b, 0x33, 0xa7, 0x34,
+	0x67, 0xfe, 0xf7, 0x34, 0x2f, 0xf9, 0x09, 0x89, 0x2c, 0x72, 0x1d, 0x75, 0xaa, 0x3a, 0x7a, 0x11,
+	0x4e, 0xf2, 0x93, 0x89, 0x9c, 0xbc, 0x23, 0x09, 0x6f, 0x8c, 0xe2, 0x7e, 0x44, 0x58, 0xa9, 0x7b,
+	0x04, 0x60, 0x5b, 0x65, 0xff, 0x1a, 0xb8, 0xb4, 0xf2, 0x84, 0x6e, 0x7e, 0xe9, 0xa8, 0x40, 0x73,
+	0x1a, 0x4e, 0xec, 0xe6, 0x0d, 0x94, 0xc2, 0x91, 0x4a, 0x8d, 0x7a, 0x8e, 0x06, 0x86, 0x49, 0xd5,
+	0x9d, 0x80, 0x1d, 0x49, 0xaf, 0x06, 0x27, 0xd7, 0x7d, 0x36, 0xcb, 0x3e, 0xf1, 0xa5, 0x80, 0x26,
+	0x7b, 0xa2, 0x4b, 0xaa, 0x12, 0x9e, 0xe8, 0x70, 0xc2, 0xa8, 0xfe, 0x51, 0x8d, 0xea, 0x30, 0x34,
+	0x6e, 0x83, 0x7c, 0x31, 0x49, 0x1f, 0x93, 0x00, 0x88, 0x57, 0xbb, 0x61, 0x78, 0xb5, 0xd5, 0x78,
+	0x9b, 0xd5, 0xf1, 0x92, 0x46, 0xed, 0xf1, 0x5e, 0x7c, 0x6c, 0xa2, 0xf1, 0x09, 0x63, 0xbb, 0x2f,
+	0xe7, 0xb6, 0x72, 0xd5, 0xad, 0x59, 0x30, 0xeb, 0x30, 0x73, 0x94, 0x2a, 0x4f, 0x7e, 0x3b, 0x10,
+	0x00, 0x62, 0x87, 0xd9, 0x28, 0x61, 0x2a, 0xf6, 0xce, 0x01, 0x7f, 0x17, 0xe6, 0x79, 0xbb, 0x7b,
+	0xac, 0xcf, 0x0a, 0x6e, 0x41, 0x47, 0x49, 0x11, 0x1e, 0x33, 0x65, 0xa9, 0x14, 0x88, 0x25, 0x11,
+	0x13, 0x19, 0xca, 0x32, 0xf0, 0x20, 0x41, 0x7f, 0x17, 0xd6, 0x0c, 0xd6, 0xe4, 0x28, 0xae, 0xeb,
+	0x5b, 0x97, 0x63, 0x38, 0x49, 0x48, 0x77, 0xea, 0x26, 0xe6, 0xbf, 0x0e, 0xf3, 0xb8, 0x66, 0xd4,
+	0xb0, 0xd4, 0xaa, 0x72, 0x26, 0xaf, 0xaa, 0x17, 0x60, 0x41, 0xd4, 0xa2, 0x87, 0x56, 0x7e, 0x6d,
+	0x73, 0x8c, 0xfb, 0xe4, 0x90, 0x5c, 0xce, 0x31, 0x42, 0xf6, 0x44, 0xb7, 0x16, 0xbc, 0x76, 0xe3,
+	0x39, 0x58, 0x24, 0x8a, 0x29, 0x70, 0xca, 0xa6, 0xb9, 0x05, 0x1b, 0x56, 0x8f, 0xd2, 0x64, 0xaf,
+	0xc0, 0x92, 0xcc, 0xb9, 0x53, 0xb7, 0xdb, 0x6f, 0xc2, 0xb2, 0xc6, 0xc8, 0x71, 0x74, 0x61, 0xee,
+	0x94, 0x24, 0x44, 0x76, 0x02, 0x05, 0x5a, 0xef, 0x60, 0x1b, 0xf6, 0x3b, 0x58, 0xff, 0x16, 0xac,
+	0x49, 0x37, 0x98, 0x95, 0xe3, 0x51, 0x3a, 0xce, 0x9c, 0x73, 0xa4, 0x1c, 0x7e, 0x07, 0x5c, 0xa3,
+	0x99, 0x29, 0x39, 0x32, 0x7d, 0xf1, 0x28, 0x0d, 0xb5, 0x8a, 0x7f, 0x4f, 0x11, 0xce, 0x23, 0x58,
+	0x95, 0xad, 0xef, 0x46, 0xd1, 0xe4, 0xc6, 0x29, 0xe3, 0x8d, 0xe9, 0x8c, 0x4f, 0xe9, 0x78, 0x1d,
+	0x5c, 0xda, 0xb1, 0x9c, 0x92, 0x92, 0x9d, 0x3d, 0xd6, 0xff, 0xff, 0x61, 0x87, 0x77, 0x2c, 0xd9,
+	0xf9, 0x21, 0xac, 0x4b, 0xec, 0xbd, 0x61, 0x44, 0x4e, 0xce, 0xff, 0x17, 0x1c, 0x6d, 0xc1, 0x86,
+	0xd5, 0xb7, 0x64, 0xea, 0x43, 0xd8, 0x24, 0xbe, 0xcd, 0xe9, 0x4a, 0x31, 0xf9, 0x48, 0xf3, 0x31,
+	0x6c, 0x55, 0x5a, 0x93, 0x9a, 0x2a, 0xfd, 0xab, 0xb7, 0x95, 0x7f, 0xd5, 0x99, 0xec, 0x5f, 0x55,
+	0x74, 0xfe, 0x1f, 0x3b, 0xd0, 0x25, 0xa5, 0xb7, 0xd3, 0x28, 0x3e, 0x3a, 0x9b, 0xcc, 0xa3, 0xdd,
+	0x55, 0xe3, 0x7c, 0x5d, 0x4d, 0x11, 0xe1, 0x65, 0xb8, 0x54, 0xc3, 0x87, 0x14, 0xe3, 0x8f, 0x1b,
+	0x00, 0xc2, 0x15, 0xce, 0x5f, 0x3b, 0xd7, 0x6d, 0xb8, 0xcf, 0xcb, 0xb7, 0xe9, 0x8d, 0x71, 0x69,
+	0x38, 0xbc, 0xd8, 0x7d, 0xc3, 0xfa, 0x95, 0x83, 0x67, 0x8d, 0x1f, 0xdd, 0x18, 0xf7, 0x4e, 0x5b,
+	0xbb, 0x83, 0xc4, 0x63, 0x68, 0xfe, 0x3d, 0x25, 0x1b, 0xbd, 0xf6, 0xe7, 0x1c, 0x2e, 0xe2, 0x72,
+	0xfe, 0x17, 0x07, 0xd6, 0x04, 0x97, 0xe6, 0xcd, 0xb0, 0x4e, 0x18, 0xbf, 0xab, 0x47, 0x29, 0x26,
+	0xe7, 0x05, 0x63, 0x94, 0x46, 0xfd, 0x71, 0xc3, 0xe5, 0x41, 0xe4, 0x66, 0x19, 0x44, 0xbe, 0x58,
+	0x1a, 0xfc, 0xba, 0xd9, 0xb3, 0x54, 0xda, 0x97, 0x74, 0xc2, 0x95, 0xb9, 0x61, 0x95, 0x93, 0xa1,
+	0x72, 0xb0, 0xfc, 0x35, 0x58, 0x15, 0x58, 0xb2, 0x86, 0xfc, 0x5d, 0x70, 0x29, 0x52, 0x27, 0x0e,
+	0x5a, 0x3f, 0x93, 0x51, 0xd3, 0xac, 0xa2, 0xf0, 0xaf, 0x2b, 0xd6, 0xf6, 0x13, 0xd4, 0x8f, 0x62,
+	0x82, 0x54, 0xfd, 0x1b, 0xb0, 0x61, 0xd1, 0x3e, 0xf9, 0x38, 0x5e, 0x52, 0x93, 0x58, 0x49, 0xc7,
+	0xab, 0x74, 0xb7, 0x09, 0xeb, 0x26, 0xa9, 0x5c, 0x0c, 0x7f, 0xeb, 0xa8, 0x61, 0x1f, 0xca, 0x84,
+	0xef, 0xb1, 0x8b, 0x62, 0x6c, 0x92, 0xa8, 0x64, 0xb7, 0x69, 0xe4, 0xb9, 0xa9, 0x59, 0x6f, 0x95,
+	0xb3, 0x3e, 0x45, 0xc9, 0x31, 0x21, 0x9c, 0xbf, 0x30, 0xdb, 0xdf, 0xe3, 0x7a, 0x3e, 0x13, 0x68,
+	0xd8, 0xff, 0x13, 0x07, 0x36, 0x4c, 0x36, 0x27, 0x9b, 0x95, 0x71, 0xd9, 0x77, 0x6a, 0x5c, 0x4d,
+	0x6b, 0x5c, 0xfc, 0x56, 0xda, 0x1a, 0x7b, 0x2b, 0x9d, 0xb1, 0x0d, 0xcc, 0x1d, 0xd8, 0xb4, 0xd9,
+	0x91, 0xd3, 0xf7, 0x06, 0xc9, 0xb0, 0x17, 0x13, 0x78, 0xc9, 0x98, 0x40, 0x2a, 0xe6, 0x32, 0xcb,
+	0xde, 0xbf, 0x03, 0x97, 0xcc, 0xf2, 0x8b, 0x9a, 0xf7, 0x7b, 0xe0, 0xd5, 0x35, 0x28, 0xb9, 0x7c,
+	0x13, 0x3a, 0xaa, 0x6b, 0xa5, 0xd8, 0x13, 0xd8, 0x2c, 0x69, 0xfd, 0x3f, 0x50, 0xea, 0x72, 0xb3,
+	0x9f, 0x26, 0x5a, 0xe3, 0x3c, 0x6b, 0xd0, 0x9d, 0x72, 0x64, 0x4f, 0xa8, 0x36, 0x93, 0x9d, 0x6c,
+	0x1b, 0xb0, 0x66, 0xf4, 0x2e, 0x95, 0xf8, 0x55, 0xb8, 0x6c, 0xcf, 0xc6, 0xb4, 0xf5, 0x70, 0x05,
+	0xb6, 0xeb, 0xab, 0xc8, 0x26, 0x43, 0xd5, 0xd3, 0x79, 0xde, 0x5b, 0x8d, 0xd3, 0xb6, 0xc9, 0x9b,
+	0x94, 0xb6, 0x16, 0xd6, 0x53, 0xac, 0xba, 0x8b, 0xc7, 0x48, 0xb1, 0xb3, 0x3f, 0x78, 0x7a, 0x76,
+	0x6a, 0xee, 0x83, 0x53, 0xe4, 0xad, 0xad, 0xc6, 0xfe, 0x80, 0xb2, 0xe8, 0x9f, 0xc1, 0xa2, 0x7c,
+	0xe4, 0x71, 0x27, 0x1b, 0x9e, 0x84, 0x89, 0x7e, 0xf8, 0xe2, 0x90, 0x87, 0x2f, 0x2a, 0x19, 0xb5,
+	0x41, 0x92, 0x51, 0x57, 0xc4, 0x13, 0x17, 0xe9, 0x5f, 0xc2, 0xb7, 0x2c, 0xf8, 0xaa, 0x96, 0x8b,
+	0x3e, 0x92, 0xeb, 0x4f, 0x81, 0x38, 0x38, 0x86, 0x6f, 0x56, 0x55, 0x2c, 0x8d, 0x03, 0x98, 0x3b,
+	0x2f, 0xe2, 0x22, 0xef, 0xdf, 0x24, 0xb9, 0xf3, 0x51, 0x76, 0x16, 0x8c, 0xc4, 0x41, 0xbd, 0x1d,
+	0x48, 0xc8, 0xbf, 0x01, 0x2b, 0x25, 0x69, 0xf9, 0x62, 0x2a, 0xe5, 0x2c, 0xe7, 0xd6, 0x8b, 0x29,
+	0x63, 0x3c, 0x81, 0x22, 0xf2, 0x5f, 0x86, 0x0d, 0xd1, 0xc6, 0xde, 0x0d, 0xcc, 0x2f, 0x19, 0x0d,
+	0x89, 0x52, 0xd9, 0xa9, 0xb6, 0xfe, 0x7b, 0xb0, 0x69, 0x13, 0x97, 0x73, 0x6a, 0x53, 0x8b, 0x91,
+	0xf7, 0xd2, 0x2c, 0xca, 0xd5, 0xb5, 0x46, 0x82, 0xf2, 0xb1, 0x21, 0xbd, 0x24, 0x3d, 0xfd, 0x63,
+	0x43, 0x7a, 0xf1, 0x79, 0x82, 0x58, 0xea, 0x77, 0x85, 0x8f, 0xdd, 0x08, 0x22, 0x8c, 0xd5, 0x36,
+	0x19, 0x20, 0x68, 0x8c, 0x0f, 0x10, 0x54, 0x94, 0x7f, 0x0d, 0x56, 0x49, 0xfb, 0x46, 0x7c, 0xe0,
+	0x00, 0x19, 0xb8, 0x78, 0x7c, 0x40, 0x36, 0x23, 0x9b, 0x7e, 0x9f, 0xf7, 0x77, 0x2f, 0x19, 0x5e,
+	0xb4, 0xf1, 0x75, 0x70, 0x69, 0x43, 0xb2, 0xf9, 0x5f, 0x3b, 0xbc, 0x4f, 0x71, 0xa6, 0x99, 0xdc,
+	0xbc, 0x07, 0xed, 0xf4, 0x94, 0x65, 0x59, 0x1c, 0xa9, 0xeb, 0x9a, 0x86, 0xdd, 0x77, 0xac, 0x03,
+	0xe5, 0xe7, 0x49, 0x5e, 0x09, 0x6d, 0x7a, 0xfa, 0xc3, 0xc3, 0xd6, 0x67, 0x98, 0x9f, 0x20, 0xe6,
+	0x4a, 0x31, 0x60, 0xc7, 0x72, 0x8a, 0xfc, 0x22, 0xe2, 0xfc, 0x3a, 0xac, 0x94, 0xcd, 0x94, 0xef,
+	0x5b, 0x86, 0x12, 0x67, 0xbd, 0x6f, 0xd1, 0xa4, 0x9a, 0x00, 0xb7, 0x04, 0xe9, 0x8e, 0x60, 0xfd,
+	0xd2, 0x17, 0xe6, 0xff, 0x1e, 0xac, 0x9b, 0xe8, 0xf2, 0x56, 0x1f, 0x0e, 0x87, 0xfd, 0x98, 0xbb,
+	0x56, 0xb8, 0x73, 0x5a, 0x82, 0xf2, 0x9d, 0x9e, 0x0a, 0x30, 0xc4, 0x19, 0x53, 0x41, 0x3d, 0x1b,
+	0x8d, 0x91, 0xf1, 0x03, 0xbc, 0x91, 0xc8, 0xae, 0x5e, 0x81, 0x05, 0x01, 0x96, 0x21, 0x8d, 0x93,
+	0xb3, 0x21, 0xcb, 0xc8, 0x08, 0x3a, 0x01, 0x45, 0xe1, 0x3d, 0x89, 0x44, 0x1e, 0xce, 0xb1, 0xc6,
+	0xa6, 0xff, 0xf2, 0xd2, 0xd3, 0x85, 0xe9, 0xa8, 0x5b, 0xdb, 0x5a, 0x8b, 0x3f, 0x77, 0x60, 0xe5,
+	0xee, 0xdd, 0x4f, 0x03, 0x86, 0x27, 0xb7, 0xcf, 0x24, 0x64, 0xfb, 0x28, 0x8e, 0xa4, 0x8f, 0x76,
+	0x26, 0x10, 0x00, 0x52, 0x9f, 0xf0, 0x27, 0xe9, 0xf2, 0x31, 0x91, 0x84, 0xa6, 0x1c, 0xbe, 0xd6,
+	0x60, 0x95, 0x70, 0x26, 0xf8, 0x7d, 0xed, 0xdf, 0xbf, 0x00, 0x9d, 0x83, 0xd1, 0x83, 0x7e, 0xdc,
+	0xdb, 0x3d, 0xd8, 0x77, 0xdf, 0xe6, 0xbf, 0x6f, 0xc6, 0x33, 0xdd, 0x36, 0xec, 0x87, 0xa7, 0x7c,
+	0x28, 0xde, 0xa6, 0x8d, 0x96, 0xe3, 0x7e, 0xc6, 0xfd, 0x06, 0xff, 0x7d, 0x38, 0x71, 0xbb, 0x70,
+	0xb7, 0x4a, 0x32, 0xe3, 0xa6, 0xe3, 0x75, 0xab, 0x05, 0xba, 0x85, 0xb7, 0xcb, 0x5f, 0x57, 0xdb,
+	0xb0, 0x5e, 0x73, 0x57, 0x7b, 0xa7, 0x49, 0x15, 0xba, 0x77, 0x71, 0x24, 0xa0, 0xbd, 0x1b, 0xe7,
+	0x10, 0xaf, 0x5b, 0x2d, 0xb0, 0x5a, 0x10, 0xe7, 0x19, 0xda, 0x82, 0x71, 0x28, 0xf2, 0xba, 0xd5,
+	0x02, 0xdd, 0xc2, 0xbb, 0xea, 0xf7, 0xb4, 0xb2, 0xc2, 0xdd, 0x34, 0x96, 0x5e, 0xc9, 0xc1, 0x56,
+	0x05, 0x6f, 0x0d, 0x1f, 0x37, 0x1e, 0x3a, 0x7c, 0xb2, 0x9d, 0x79, 0x9b, 0x36, 0xda, 0x62, 0x5e,
+	0x66, 0xcf, 0xd3, 0x3e, 0xe8, 0x2a, 0xf1, 0xba, 0xd5, 0x02, 0x8b, 0x79, 0x6e, 0xfd, 0x29, 0xf3,
+	0x74, 0x57, 0xf1, 0xb6, 0x2a, 0x78, 0x5d, 0xfd, 0x26, 0x40, 0x69, 0xdf, 0x5d, 0xd2, 0x91, 0xb9,
+	0x77, 0x78, 0x97, 0x6a, 0x4a, 0x74, 0x23, 0x5f, 0x83, 0xb6, 0x7a, 0x4f, 0x4b, 0x79, 0xa0, 0x0f,
+	0xaf, 0xbd, 0x75, 0x0b, 0xcf, 0x5f, 0x15, 0xfb, 0xcf, 0xbc, 0xe2, 0xe0, 0x2e, 0x20, 0x12, 0x01,
+	0xdc, 0x75, 0xf2, 0xae, 0x4f, 0x27, 0x23, 0x78, 0x1b, 0x16, 0x56, 0x75, 0x7b, 0xcd, 0x79, 0xc5,
+	0x71, 0x3f, 0x24, 0x3f, 0x0e, 0xcb, 0xf5, 0xff, 0x72, 0xfd, 0x7b, 0x4d, 0xd1, 0xd4, 0x76, 0x7d,
+	0xa1, 0x1e, 0xc8, 0x87, 0xf6, 0x4f, 0xcd, 0x5e, 0xae, 0x7d, 0x4e, 0x39, 0xae, 0x35, 0x4b, 0xb7,
+	0xdf, 0xa5, 0x3f, 0x32, 0x67, 0x3f, 0x59, 0xb4, 0xa6, 0xc6, 0x7e, 0xf5, 0x28, 0x74, 0x43, 0xbf,
+	0x9c, 0xd3, 0xba, 0x61, 0xbf, 0xd4, 0xf3, 0xba, 0xd5, 0x82, 0x4a, 0x0b, 0x9c, 0x83, 0xad, 0xca,
+	0x03, 0xbb, 0xba, 0x16, 0x2c, 0x1e, 0xde, 0x84, 0x59, 0xf1, 0x66, 0x50, 0xcf, 0x8d, 0xf1, 0x84,
+	0xd1, 0xdb, 0xb0, 0xb0, 0x74, 0xec, 0xea, 0x79, 0x9d, 0x1e, 0xbb, 0xf5, 0x84, 0xcf, 0xdb, 0xaa,
+	0xe0, 0xcd, 0xea, 0x72, 0x4d, 0x97, 0xd5, 0xcd, 0x25, 0xbd, 0x55, 0xc1, 0x13, 0xad, 0x5e, 0x38,
+	0x64, 0x85, 0xde, 0xc5, 0xe9, 0xca, 0x32, 0x0e, 0x16, 0x5e, 0xb7, 0x5a, 0x50, 0x35, 0x0b, 0xf8,
+	0xe3, 0x30, 0xf6, 0x8e, 0x5c, 0x6b, 0x16, 0x0a, 0x5a, 0xfd, 0x23, 0xaa, 0x99, 0xe9, 0x71, 0x5e,
+	0xa3, 0x99, 0x65, 0xce, 0x9e, 0xb7, 0x5d, 0x5f, 0xa8, 0x5a, 0x7b, 0xc5, 0x71, 0x03, 0xf2, 0xe3,
+	0x25, 0x52, 0xb0, 0xcf, 0xda, 0x95, 0x4c, 0xf9, 0x5e, 0x19, 0x57, 0xac, 0x79, 0xbc, 0x03, 0x4b,
+	0x66, 0x4a, 0x80, 0xbb, 0x5d, 0xf3, 0x9b, 0x9b, 0xa5, 0x15, 0x7c, 0x76, 0x4c, 0xa9, 0x6e, 0x90,
+	0x32, 0x29, 0x02, 0xf7, 0x55, 0x26, 0x8d, 0x04, 0x03, 0xef, 0xca, 0xb8, 0xe2, 0xda, 0x36, 0x45,
+	0x48, 0xbc, 0x66, 0xe0, 0x34, 0x11, 0xc0, 0xbb, 0x32, 0xae, 0xb8, 0xb6, 0x4d, 0x69, 0x7d, 0xab,
+	0x63, 0x33, 0x6c, 0xf0, 0x95, 0x71, 0xc5, 0xb5, 0xc6, 0x83, 0xef, 0x06, 0x97, 0xab, 0xd2, 0x2a,
+	0xf7, 0x84, 0xed, 0xfa, 0xc2, 0x31, 0x92, 0xe4, 0x0b, 0xa1, 0x46, 0x92, 0x74, 0x3d, 0x5c, 0x19,
+	0x57, 0x4c, 0x8d, 0x7d, 0x99, 0xc5, 0xa6, 0x8d, 0x7d, 0x25, 0xb3, 0xce, 0xbb, 0x54, 0x53, 0xa2,
+	0x1b, 0xd9, 0x83, 0x8e, 0xce, 0x2c, 0x73, 0xa9, 0xf9, 0x32, 0x34, 0xa5, 0x5b, 0x2d, 0x30, 0xec,
+	0xb6, 0x64, 0x45, 0xca, 0xde, 0xa0, 0x36, 0xc4, 0x7e, 0xa9, 0xa6, 0x84, 0xec, 0xbc, 0xb3, 0x22,
+	0xb1, 0x48, 0x5b, 0x27, 0x23, 0xcf, 0xc8, 0xab, 0xc5, 0x4a, 0x06, 0x5e, 0x85, 0x16, 0xff, 0x65,
+	0x2d, 0x97, 0xfc, 0xc0, 0xb9, 0xea, 0x74, 0xcd, 0xc0, 0x51, 0x73, 0xaa, 0x0f, 0x62, 0x7a, 0xe4,
+	0xf6, 0xa1, 0xd1, 0xeb, 0x56, 0x0b, 0x74, 0x0b, 0xef, 0xc1, 0x3c, 0x89, 0x71, 0xb9, 0x6a, 0x70,
+	0xd5, 0xb8, 0x97, 0xe7, 0xd5, 0x15, 0xd1, 0x89, 0x2c, 0x83, 0x4a, 0x5a, 0x7a, 0x95, 0x00, 0x97,
+	0x77, 0xa9, 0xa6, 0x84, 0x30, 0xb3, 0x58, 0x86, 0x82, 0x18, 0x51, 0x88, 0x4a, 0x64, 0xca, 0xbb,
+	0x54, 0x53, 0x42, 0xf5, 0xde, 0x08, 0xe0, 0x68, 0xbd, 0xaf, 0x0b, 0x29, 0x79, 0xdb, 0xf5, 0x85,
+	0x54, 0xef, 0xad, 0x38, 0x8d, 0xd6, 0xfb, 0xfa, 0x68, 0x90, 0x77, 0x65, 0x5c, 0xb1, 0x6e, 0xf3,
+	0x1e, 0x2c, 0x91, 0x42, 0x14, 0xd9, 0xe7, 0xaa, 0x75, 0x8c, 0xf0, 0x8d, 0x77, 0x75, 0x3c, 0xc1,
+	0x98, 0x66, 0xf7, 0x58, 0xff, 0xb3, 0x69, 0x76, 0x1f, 0x16, 0xa8, 0xc7, 0xdf, 0xf5, 0xc6, 0x07,
+	0x20, 0xbc, 0xcb, 0xb5, 0x65, 0x54, 0x4f, 0x4a, 0x27, 0xbf, 0x9e, 0xdf, 0x4a, 0x30, 0xc0, 0xbb,
+	0x54, 0x53, 0x42, 0xe7, 0xd7, 0x70, 0xdd, 0xbb, 0x97, 0x2d, 0x17, 0x3d, 0x75, 0xfe, 0x7b, 0xdb,
+	0xf5, 0x85, 0xd5, 0xd1, 0x49, 0xa3, 0x66, 0x8e, 0xce, 0xb4, 0x68, 0x97, 0x6b, 0xcb, 0xe8, 0xee,
+	0x65, 0x3a, 0x35, 0xdd, 0xed, 0x5a, 0xa7, 0xae, 0xbd, 0x7b, 0xd5, 0xbb, 0xb2, 0xfd, 0x67, 0xdc,
+	0x6f, 0xdb, 0xc1, 0x01, 0x2e, 0xb6, 0xab, 0xb5, 0xd5, 0xa8, 0xf8, 0x9e, 0x9b, 0x40, 0x41, 0xd7,
+	0x3e, 0x71, 0xe6, 0xba, 0xa6, 0xc8, 0xa9, 0x7b, 0xd9, 0xf3, 0xea, 0x8a, 0x74, 0x3b, 0x21, 0xac,
+	0x9b, 0xfd, 0x48, 0x41, 0xfa, 0x63, 0x46, 0x47, 0x05, 0xfa, 0xf9, 0x89, 0x34, 0xba, 0x8b, 0x6f,
+	0xaa, 0x39, 0x92, 0xf7, 0x32, 0x93, 0x21, 0xf3, 0x6a, 0x76, 0xb9, 0xb6, 0x8c, 0x9c, 0x5b, 0x74,
+	0x63, 0xfb, 0x83, 0x9a, 0xc6, 0xf6, 0x07, 0xe3, 0x1b, 0xb3, 0xbc, 0xb0, 0xcf, 0x5c, 0x73, 0xdc,
+	0x1b, 0xd0, 0xd1, 0xd9, 0x55, 0xe6, 0x89, 0x96, 0xa4, 0x74, 0x79, 0xdd, 0x6a, 0x01, 0x61, 0xa8,
+	0x6c, 0x23, 0x3f, 0xb1, 0xdb, 0xc8, 0x4f, 0xc6, 0xb4, 0x91, 0x9f, 0x18, 0x6d, 0xbc, 0x27, 0x33,
+	0x58, 0xa4, 0xec, 0x2f, 0x51, 0x62, 0x53, 0xe4, 0x5e, 0x5d, 0x91, 0x96, 0xf4, 0xfb, 0x00, 0x65,
+	0xf6, 0x94, 0x6b, 0xf4, 0x49, 0xb3, 0xb1, 0xbc, 0x4b, 0x35, 0x25, 0xc6, 0x7e, 0xba, 0xa7, 0x2e,
+	0x0b, 0x69, 0x18, 0x59, 0x97, 0x85, 0xd2, 0x4d, 0xe4, 0x75, 0xab, 0x05, 0x46, 0x2b, 0x4a, 0x34,
+	0x98, 0x19, 0x64, 0xb6, 0x42, 0x12, 0x91, 0xbc, 0x6e, 0xb5, 0x80, 0x88, 0xe6, 0x55, 0x68, 0xa1,
+	0x7f, 0x48, 0x6f, 0xac, 0xc4, 0x77, 0xe4, 0xad, 0x19, 0x38, 0x2d, 0x85, 0x57, 0xa1, 0x25, 0xc2,
+	0x70, 0xaa, 0x61, 0x72, 0x3b, 0x59, 0x33, 0x70, 0xf4, 0xd2, 0xad, 0x7e, 0x47, 0x4a, 0xdf, 0x41,
+	0x8c, 0x1c, 0x17, 0x6f, 0xd3, 0x46, 0x53, 0x13, 0x44, 0x9d, 0x65, 0x2e, 0xf9, 0x89, 0x1f, 0xdb,
+	0xb1, 0xe6, 0x5d, 0xae, 0x2d, 0xa3, 0x9c, 0x63, 0x36, 0x90, 0xe6, 0x9c, 0x24, 0x14, 0x79, 0x6b,
+	0x06, 0x8e, 0x5e, 0x2b, 0x94, 0x9b, 0x5e, 0x5f, 0x2b, 0x2c, 0x17, 0xbf, 0xb7, 0x55, 0xc1, 0x53,
+	0xa3, 0x67, 0x3a, 0xdd, 0xb5, 0xd1, 0xab, 0x75, 0xdc, 0x7b, 0xcf, 0x8e, 0x29, 0x55, 0x0d, 0x3e,
+	0x98, 0xe5, 0x8f, 0x27, 0xbf, 0xf2, 0xbf, 0x03, 0x00, 0x98, 0xb0, 0x56, 0x9b, 0x95, 0x68, 0x00,
+	0x00,
 }
@@ -34,6 +34,9 @@ func (p *UserPod) CloneGlobalPart() *UserPod {
 		Resource:      p.Resource,
 		Log:           p.Log,
 		Dns:           p.Dns,
+		DnsOptions:    p.DnsOptions,
+		DnsSearch:     p.DnsSearch,
+		Namespace:     p.Namespace,
 		PortmappingWhiteLists: p.PortmappingWhiteLists,
 
 		Labels:     map[string]string{},
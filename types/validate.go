@@ -11,16 +11,27 @@ import (
 	"github.com/hyperhq/hyperd/utils"
 )
 
-func (pod *UserPod) Validate() error {
+var builtinVolumeFormats = map[string]bool{
+	"raw":   true,
+	"qcow2": true,
+	"vdi":   true,
+	"vfs":   true,
+	"rbd":   true,
+	"nas":   true,
+	// the named volume created by the volume API
+	"named": true,
+}
 
-	var volume_drivers = map[string]bool{
-		"raw":   true,
-		"qcow2": true,
-		"vdi":   true,
-		"vfs":   true,
-		"rbd":   true,
-		"nas":   true,
-	}
+// the plugin names are the names of their sockets or spec files
+var volumePluginReg = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// IsBuiltinVolumeFormat reports whether the volume format is handled by hyperd,
+// the other formats name the volume plugins.
+func IsBuiltinVolumeFormat(format string) bool {
+	return format == "" || builtinVolumeFormats[format]
+}
+
+func (pod *UserPod) Validate() error {
 
 	var volume_caches = map[string]bool{
 		"off":          true,
@@ -111,6 +122,10 @@ func (pod *UserPod) Validate() error {
 				return fmt.Errorf("in volume %d, does not support cache %s.", idx, container.Cache)
 			}
 		}
+
+		if container.RootfsSize < 0 {
+			return fmt.Errorf("in container %d, invalid rootfs size %d.", idx, container.RootfsSize)
+		}
 	}
 
 	for idx, v := range pod.Volumes {
@@ -118,7 +133,7 @@ func (pod *UserPod) Validate() error {
 			continue
 		}
 
-		if _, ok := volume_drivers[v.Format]; !ok {
+		if !IsBuiltinVolumeFormat(v.Format) && !volumePluginReg.MatchString(v.Format) {
 			return fmt.Errorf("in volume %d, volume does not support driver %s.", idx, v.Format)
 		}
 	}
@@ -133,6 +148,19 @@ func (pod *UserPod) Validate() error {
 		}
 	}
 
+	for idx, v := range pod.Volumes {
+		if v.Encryption == nil {
+			continue
+		}
+
+		if v.Encryption.KeyRef == "" {
+			return fmt.Errorf("in volume %d, encrypted volume requires a keyRef.", idx)
+		}
+		if v.Format != "" && v.Format != "raw" && v.Format != "qcow2" {
+			return fmt.Errorf("in volume %d, volume of format %s can not be encrypted.", idx, v.Format)
+		}
+	}
+
 	for _, dns := range pod.Dns {
 		if ip := net.ParseIP(dns); ip == nil {
 			return fmt.Errorf("incorrect dns %s.", dns)
@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+// YamlToJson converts a yaml document to json with the same keys, so that it
+// could be decoded with the json names of the fields. An empty document is
+// converted to "null".
+func YamlToJson(data []byte) ([]byte, error) {
+	var obj interface{}
+	if err := yaml.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	return json.Marshal(convertValue(obj))
+}
+
+// JsonToYaml converts a json document to yaml with the same keys, the numbers
+// are kept as numbers instead of strings.
+func JsonToYaml(data []byte) ([]byte, error) {
+	var obj interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&obj); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(convertValue(obj))
+}
+
+// convertValue turns the maps decoded by yaml into the ones json could encode,
+// and the numbers decoded by json into the ones yaml could encode.
+func convertValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(val))
+		for k, item := range val {
+			m[fmt.Sprint(k)] = convertValue(item)
+		}
+		return m
+	case map[string]interface{}:
+		for k, item := range val {
+			val[k] = convertValue(item)
+		}
+	case []interface{}:
+		for i, item := range val {
+			val[i] = convertValue(item)
+		}
+	case json.Number:
+		if i, err := val.Int64(); err == nil {
+			return i
+		}
+		if f, err := val.Float64(); err == nil {
+			return f
+		}
+	}
+	return v
+}
@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestYamlJson(t *testing.T) {
+	data, err := YamlToJson([]byte("name: web\nports: [80, 443]\nlabels:\n  1: one\nratio: 0.5\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"labels":{"1":"one"},"name":"web","ports":[80,443],"ratio":0.5}` {
+		t.Fatalf("unexpected json %s", data)
+	}
+	if data, err := YamlToJson([]byte("\n")); err != nil || string(data) != "null" {
+		t.Fatalf("unexpected json of an empty document %s, %v", data, err)
+	}
+
+	data, err = JsonToYaml([]byte(`{"name": "web", "size": 10737418240, "ratio": 0.5, "ports": [80]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "name: web\nports:\n- 80\nratio: 0.5\nsize: 10737418240\n" {
+		t.Fatalf("unexpected yaml:\n%s", data)
+	}
+	if _, err := JsonToYaml([]byte(`{"name"`)); err == nil {
+		t.Fatal("converted an invalid json")
+	}
+}